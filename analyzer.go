@@ -0,0 +1,203 @@
+package porter
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Script is the writing system of a token.
+type Script int
+
+const (
+	ScriptUnknown  Script = iota // no letters, e.g. a number
+	ScriptLatin                  // Latin letters
+	ScriptCyrillic               // Cyrillic letters
+	ScriptArabic                 // Arabic letters
+	ScriptCJK                    // Han, Hiragana, Katakana or Hangul
+	ScriptOther                  // letters of any other script
+)
+
+var scriptNames = [...]string{"Unknown", "Latin", "Cyrillic", "Arabic", "CJK", "Other"}
+
+func (s Script) String() string {
+	if s < 0 || int(s) >= len(scriptNames) {
+		return "Script(?)"
+	}
+	return scriptNames[s]
+}
+
+// scriptOf returns the script of the letter r, or ScriptUnknown if r is
+// not a letter or is shared between scripts, like the katakana-hiragana
+// prolonged sound mark.
+func scriptOf(r rune) Script {
+	switch {
+	case !unicode.IsLetter(r), unicode.Is(unicode.Common, r):
+		return ScriptUnknown
+	case r < 0x80 || unicode.Is(unicode.Latin, r):
+		return ScriptLatin
+	case unicode.Is(unicode.Cyrillic, r):
+		return ScriptCyrillic
+	case unicode.Is(unicode.Arabic, r):
+		return ScriptArabic
+	case unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul):
+		return ScriptCJK
+	}
+	return ScriptOther
+}
+
+// DetectScript returns the script of the first letter in token, or
+// ScriptUnknown if it has none.
+func DetectScript(token string) Script {
+	for _, r := range token {
+		if s := scriptOf(r); s != ScriptUnknown {
+			return s
+		}
+	}
+	return ScriptUnknown
+}
+
+// Token is one word of a text passed to Analyzer.Analyze.
+type Token struct {
+	Text     string   // the word as it appears in the text
+	Stem     string   // the stemmed, lowercased word
+	Script   Script   // the script of the word
+	Language Language // the language used to stem it, or LangUnknown
+}
+
+// LanguageStats counts the tokens an Analyzer has routed to a language.
+type LanguageStats struct {
+	Tokens  int // number of tokens
+	Stemmed int // number of tokens whose stem differs from the lowercased token
+}
+
+// languageStemmers are the stemmers an Analyzer dispatches to. Tokens of
+// other languages are only lowercased.
+var languageStemmers = map[Language]func(string) (string, error){
+	LangEnglish: Stem,
+	LangFinnish: StemFinnish,
+	LangArabic:  StemArabic,
+}
+
+// Analyzer splits text into words and stems each one with the stemmer for
+// its language, so that text mixing several languages can be indexed in
+// one field.
+//
+// The language of a word is decided by its script. Arabic words go to
+// StemArabic. Latin words go to Stem (Porter, for English) unless
+// DetectLanguage is set and the text is identified as another language, in
+// which case they go to that language's stemmer, if there is one.
+// Cyrillic, CJK and other words are lowercased but not stemmed.
+//
+// The zero value is ready to use. An Analyzer keeps per-language counts and
+// must not be used by several goroutines at once.
+type Analyzer struct {
+	// DetectLanguage enables identifying the language of the Latin-script
+	// part of each text with IdentifyLanguage.
+	DetectLanguage bool
+
+	stats map[Language]*LanguageStats
+}
+
+// tokenize splits text into runs of letters, digits and combining marks,
+// starting a new token wherever the script of the letters changes.
+func tokenize(text string) []Token {
+	var tokens []Token
+	start, script := -1, ScriptUnknown
+	for i, r := range text {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.Is(unicode.Mn, r) {
+			if start >= 0 {
+				tokens = append(tokens, Token{Text: text[start:i], Script: script})
+				start = -1
+			}
+			continue
+		}
+		s := scriptOf(r)
+		switch {
+		case start < 0:
+			start, script = i, s
+		case s != ScriptUnknown && script == ScriptUnknown:
+			script = s
+		case s != ScriptUnknown && s != script:
+			tokens = append(tokens, Token{Text: text[start:i], Script: script})
+			start, script = i, s
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, Token{Text: text[start:], Script: script})
+	}
+	return tokens
+}
+
+// Analyze splits text into words and stems them. text is treated as one
+// document: with DetectLanguage set, all of its Latin-script words are
+// stemmed as the same language.
+//
+// Example:
+//
+//	var a porter.Analyzer
+//	for _, t := range a.Analyze("running والكتاب") {
+//	    fmt.Println(t.Stem, t.Language)
+//	}
+//	// run en
+//	// كتاب ar
+func (a *Analyzer) Analyze(text string) []Token {
+	latin := LangEnglish
+	if a.DetectLanguage {
+		if lang, ok := IdentifyLanguage(text); ok {
+			latin = lang
+		}
+	}
+
+	tokens := tokenize(text)
+	for i := range tokens {
+		t := &tokens[i]
+		switch t.Script {
+		case ScriptLatin:
+			t.Language = latin
+		case ScriptArabic:
+			t.Language = LangArabic
+		default:
+			t.Language = LangUnknown
+		}
+		lower := strings.ToLower(t.Text)
+		t.Stem = lower
+		if stem, ok := languageStemmers[t.Language]; ok {
+			if stemmed, err := stem(lower); err == nil {
+				t.Stem = stemmed
+			}
+		}
+		a.count(t.Language, t.Stem != lower)
+	}
+	return tokens
+}
+
+func (a *Analyzer) count(lang Language, stemmed bool) {
+	if a.stats == nil {
+		a.stats = map[Language]*LanguageStats{}
+	}
+	s := a.stats[lang]
+	if s == nil {
+		s = &LanguageStats{}
+		a.stats[lang] = s
+	}
+	s.Tokens++
+	if stemmed {
+		s.Stemmed++
+	}
+}
+
+// Stats returns the counts for each language seen since the Analyzer was
+// created or last reset. Words that were not assigned a language are
+// counted under LangUnknown.
+func (a *Analyzer) Stats() map[Language]LanguageStats {
+	stats := make(map[Language]LanguageStats, len(a.stats))
+	for lang, s := range a.stats {
+		stats[lang] = *s
+	}
+	return stats
+}
+
+// ResetStats clears the per-language counts.
+func (a *Analyzer) ResetStats() {
+	a.stats = nil
+}
@@ -0,0 +1,163 @@
+package porter
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDetectScript(t *testing.T) {
+	var test = map[string]Script{
+		"running": ScriptLatin,
+		"café":    ScriptLatin,
+		"москва":  ScriptCyrillic,
+		"والكتاب": ScriptArabic,
+		"東京":      ScriptCJK,
+		"タワー":     ScriptCJK,
+		"한국어":     ScriptCJK,
+		"αβγ":     ScriptOther,
+		"2024":    ScriptUnknown,
+		"":        ScriptUnknown,
+		"42nd":    ScriptLatin,
+		"ー":       ScriptUnknown,
+	}
+
+	for token, want := range test {
+		if have := DetectScript(token); have != want {
+			t.Errorf("DetectScript(%s) want: %v have: %v", token, want, have)
+		}
+	}
+}
+
+func TestTokenize(t *testing.T) {
+	var test = map[string][]string{
+		"Hello, world!":     {"Hello", "world"},
+		"don't":             {"don", "t"},
+		"Москваcity":        {"Москва", "city"},
+		"2024年 東京タワー":       {"2024年", "東京タワー"},
+		"كِتَاب":            {"كِتَاب"},
+		"  ":                nil,
+		"tab\tand\nnewline": {"tab", "and", "newline"},
+		"über-größe straße": {"über", "größe", "straße"},
+	}
+
+	for text, want := range test {
+		tokens := tokenize(text)
+		if len(tokens) != len(want) {
+			t.Errorf("tokenize(%q) want: %q have: %v", text, want, tokens)
+			continue
+		}
+		for i, tok := range tokens {
+			if tok.Text != want[i] {
+				t.Errorf("tokenize(%q) want: %q have: %v", text, want, tokens)
+				break
+			}
+		}
+	}
+}
+
+func TestIdentifyLanguage(t *testing.T) {
+	var test = map[string]Language{
+		"The children were skating on the frozen lake all afternoon":              LangEnglish,
+		"Talvella järvet jäätyvät ja lapset luistelevat jäällä":                   LangFinnish,
+		"Die Kinder sind den ganzen Nachmittag auf dem See Schlittschuh gelaufen": LangGerman,
+		"Les enfants ont patiné sur le lac gelé tout l'après-midi":                LangFrench,
+		"Los niños patinaron en el lago helado toda la tarde":                     LangSpanish,
+	}
+
+	for text, want := range test {
+		if have, ok := IdentifyLanguage(text); !ok || have != want {
+			t.Errorf("IdentifyLanguage(%q) want: %s have: %s %v", text, want, have, ok)
+		}
+	}
+
+	for _, text := range []string{"", "hi there", "Дети катались на коньках по замёрзшему озеру", "12345 67890"} {
+		if have, ok := IdentifyLanguage(text); ok || have != LangUnknown {
+			t.Errorf("IdentifyLanguage(%q) want: und false have: %s %v", text, have, ok)
+		}
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	var a Analyzer
+	tokens := a.Analyze("Running والكتاب Москве 東京 2024 talossa")
+	want := []Token{
+		{"Running", "run", ScriptLatin, LangEnglish},
+		{"والكتاب", "كتاب", ScriptArabic, LangArabic},
+		{"Москве", "москве", ScriptCyrillic, LangUnknown},
+		{"東京", "東京", ScriptCJK, LangUnknown},
+		{"2024", "2024", ScriptUnknown, LangUnknown},
+		{"talossa", "talossa", ScriptLatin, LangEnglish},
+	}
+	if len(tokens) != len(want) {
+		t.Fatalf("want %d tokens have %v", len(want), tokens)
+	}
+	for i := range want {
+		if tokens[i] != want[i] {
+			t.Errorf("token %d want: %v have: %v", i, want[i], tokens[i])
+		}
+	}
+}
+
+func TestAnalyzeDetectLanguage(t *testing.T) {
+	a := Analyzer{DetectLanguage: true}
+	var test = map[string][]string{
+		"Kaupungin kirjastoissa on paljon kirjoja ja lehtiä": {"kaupung", "kirjasto", "on", "palj", "kirj", "ja", "leht"},
+		"The libraries of the city are full of books":        {"the", "librari", "of", "the", "citi", "ar", "full", "of", "book"},
+		"Die Bibliotheken der Stadt sind voller Bücher":      {"die", "bibliotheken", "der", "stadt", "sind", "voller", "bücher"},
+	}
+
+	for text, want := range test {
+		tokens := a.Analyze(text)
+		if len(tokens) != len(want) {
+			t.Errorf("%q want: %q have: %v", text, want, tokens)
+			continue
+		}
+		for i, tok := range tokens {
+			if tok.Stem != want[i] {
+				t.Errorf("%q: '%s' want '%s' have '%s'\n", text, tok.Text, want[i], tok.Stem)
+			}
+		}
+	}
+}
+
+func TestAnalyzerStats(t *testing.T) {
+	var a Analyzer
+	a.Analyze("running dogs والكتاب кошки")
+	a.Analyze("the cat")
+
+	want := map[Language]LanguageStats{
+		LangEnglish: {Tokens: 4, Stemmed: 2},
+		LangArabic:  {Tokens: 1, Stemmed: 1},
+		LangUnknown: {Tokens: 1, Stemmed: 0},
+	}
+	stats := a.Stats()
+	if len(stats) != len(want) {
+		t.Errorf("want: %v have: %v", want, stats)
+	}
+	for lang, w := range want {
+		if stats[lang] != w {
+			t.Errorf("%s want: %+v have: %+v", lang, w, stats[lang])
+		}
+	}
+
+	a.ResetStats()
+	if stats := a.Stats(); len(stats) != 0 {
+		t.Errorf("after ResetStats want no stats have: %v", stats)
+	}
+}
+
+func ExampleAnalyzer() {
+	var a Analyzer
+	for _, t := range a.Analyze("Running والكتاب") {
+		fmt.Println(t.Stem, t.Script, t.Language)
+	}
+	// Output:
+	// run Latin en
+	// كتاب Arabic ar
+}
+
+func ExampleIdentifyLanguage() {
+	lang, ok := IdentifyLanguage("Talvella järvet jäätyvät")
+	fmt.Println(lang, ok)
+	// Output: fi true
+}
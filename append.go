@@ -0,0 +1,92 @@
+package porter
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// AppendStem appends the stem of word to dst and returns the extended
+// buffer, like Stem but without allocating when dst has room for the word
+// and the word is ASCII. The word is lowercased like in Stem.
+//
+// Example:
+//
+//	var buf []byte
+//	for _, word := range words {
+//	    buf = porter.AppendStem(buf[:0], word)
+//	    // use buf
+//	}
+func AppendStem(dst []byte, word string) []byte {
+	n := len(dst)
+	if isASCIIString(word) {
+		dst = append(dst, word...)
+		lowerLetters(dst[n:])
+	} else {
+		dst = append(dst, strings.ToLower(word)...)
+	}
+	if len(dst) == n {
+		return dst
+	}
+	var z stemmer
+	bn := z.stemFrequent(dst[n:])
+	return dst[:n+bn+1]
+}
+
+// isASCIIString is true if s has no bytes beyond ASCII.
+func isASCIIString(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// maxStemmerStems is the number of stems a Stemmer keeps before it starts
+// over.
+const maxStemmerStems = 4096
+
+// Stemmer stems words like Stem, reusing a scratch buffer between calls so
+// that stemming does not allocate in the common case. A Stemmer is not safe
+// for concurrent use; use one per goroutine.
+//
+// The zero value is ready to use.
+//
+// Example:
+//
+//	var s porter.Stemmer
+//	for _, word := range words {
+//	    stemmed, err := s.StemString(word)
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//	    // use stemmed
+//	}
+type Stemmer struct {
+	buf   []byte
+	stems map[string]string // stems that are not a prefix of their word
+}
+
+// StemString returns the stem of word, like Stem. For a lowercase ASCII
+// word, it does not allocate when the stem is a prefix of the word, as in
+// "connect" for "connected", and otherwise only the first time it returns a
+// stem like "happi" for "happy": the Stemmer keeps the last few thousand of
+// those.
+func (s *Stemmer) StemString(word string) (string, error) {
+	if word == "" {
+		return "", nil
+	}
+	s.buf = AppendStem(s.buf[:0], word)
+	if len(s.buf) <= len(word) && word[:len(s.buf)] == string(s.buf) {
+		return word[:len(s.buf)], nil
+	}
+	if stem, ok := s.stems[string(s.buf)]; ok {
+		return stem, nil
+	}
+	if s.stems == nil || len(s.stems) >= maxStemmerStems {
+		s.stems = make(map[string]string)
+	}
+	stem := string(s.buf)
+	s.stems[stem] = stem
+	return stem, nil
+}
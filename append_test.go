@@ -0,0 +1,117 @@
+package porter
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestAppendStem(t *testing.T) {
+	buf := []byte("stems: ")
+	for _, test := range tests {
+		for _, in := range []string{test.in, strings.ToUpper(test.in)} {
+			have := AppendStem(buf, in)
+			if string(have) != "stems: "+test.out {
+				t.Errorf("'%s' want '%s' have '%s'\n", in, "stems: "+test.out, have)
+			}
+		}
+	}
+	var appendTests = []stemmerTest{
+		{"", ""},
+		{"Ab", "ab"},
+		{"ÉTÉS", "été"},
+	}
+	for _, test := range appendTests {
+		if have := string(AppendStem(nil, test.in)); have != test.out {
+			t.Errorf("'%s' want '%s' have '%s'\n", test.in, test.out, have)
+		}
+	}
+}
+
+func TestStemmerStemString(t *testing.T) {
+	var s Stemmer
+	for _, test := range tests {
+		for _, in := range []string{test.in, strings.ToUpper(test.in)} {
+			stemmed, err := s.StemString(in)
+			if err != nil {
+				t.Errorf("'%s' unexpected error: %v\n", in, err)
+				continue
+			}
+			if stemmed != test.out {
+				t.Errorf("'%s' want '%s' have '%s'\n", in, test.out, stemmed)
+			}
+		}
+	}
+	if len(s.stems) > maxStemmerStems {
+		t.Errorf("want at most %d stems have %d\n", maxStemmerStems, len(s.stems))
+	}
+}
+
+func TestStemmerAllocs(t *testing.T) {
+	var s Stemmer
+	buf := make([]byte, 0, 64)
+	for _, word := range []string{"connected", "happy", "relational", "running"} {
+		s.StemString(word)
+		if n := testing.AllocsPerRun(100, func() { s.StemString(word) }); n != 0 {
+			t.Errorf("'%s' StemString allocates %v times\n", word, n)
+		}
+		if n := testing.AllocsPerRun(100, func() { buf = AppendStem(buf[:0], word) }); n != 0 {
+			t.Errorf("'%s' AppendStem allocates %v times\n", word, n)
+		}
+	}
+}
+
+func BenchmarkStem(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Stem("running")
+	}
+}
+
+func BenchmarkAppendStem(b *testing.B) {
+	buf := make([]byte, 0, 64)
+	for i := 0; i < b.N; i++ {
+		buf = AppendStem(buf[:0], "running")
+	}
+}
+
+func BenchmarkStemmerStemString(b *testing.B) {
+	var s Stemmer
+	for i := 0; i < b.N; i++ {
+		s.StemString("running")
+	}
+}
+
+func BenchmarkStemmerStemStringVocabulary(b *testing.B) {
+	var s Stemmer
+	for i := 0; i < b.N; i++ {
+		s.StemString(tests[i%len(tests)].in)
+	}
+}
+
+func BenchmarkStemVocabularyString(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Stem(tests[i%len(tests)].in)
+	}
+}
+
+func ExampleStemmer() {
+	var s Stemmer
+	for _, word := range []string{"connected", "connecting", "happy"} {
+		stemmed, _ := s.StemString(word)
+		fmt.Println(stemmed)
+	}
+	// Output:
+	// connect
+	// connect
+	// happi
+}
+
+func ExampleAppendStem() {
+	var buf []byte
+	for _, word := range []string{"Running", "jumps"} {
+		buf = AppendStem(buf, word)
+		buf = append(buf, ' ')
+	}
+	fmt.Println(string(buf))
+	// Output: run jump
+}
@@ -0,0 +1,168 @@
+package porter
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// This file implements a light stemmer for Arabic following the "light10"
+// stemmer described in:
+//
+//	Larkey, Ballesteros, Connell, 2007, Light Stemming for Arabic
+//	Information Retrieval, in Arabic Computational Morphology,
+//	pp 221-243
+//
+// Unlike Porter, light stemming does not try to find the root of a word.
+// It normalizes spelling variants and then strips a small set of frequent
+// prefixes (the definite article and attached conjunctions/prepositions)
+// and suffixes (pronouns, dual and plural endings).
+
+const (
+	arTatweel     = '\u0640' // kashida, used to stretch words
+	arAlef        = '\u0627'
+	arAlefMadda   = '\u0622'
+	arAlefHamzaA  = '\u0623' // hamza above
+	arAlefHamzaB  = '\u0625' // hamza below
+	arAlefMaksura = '\u0649'
+	arYeh         = '\u064A'
+	arTehMarbuta  = '\u0629'
+	arHeh         = '\u0647'
+	arFathatan    = '\u064B' // first of the diacritics ...
+	arSukun       = '\u0652' // ... and the last
+)
+
+var (
+	// prefixes are tried in order and at most one is removed.
+	arPrefixes = []string{
+		"ال", "وال", "بال", "كال", "فال", "لل", "و",
+	}
+	// suffixes are tried once each, in order, and all that match are
+	// removed. light10 also lists ية and ة, which normalize() has already
+	// turned into يه and ه.
+	arSuffixes = []string{
+		"ها", "ان", "ات", "ون", "ين", "يه", "ه", "ي",
+	}
+)
+
+// arabicStemmer holds the state for stemming a single Arabic word.
+type arabicStemmer struct {
+	b []rune // the word, shortened as affixes are removed
+}
+
+// z.normalize() removes diacritics and tatweel, maps the hamza and madda
+// forms of alef to bare alef, alef maksura to yeh and teh marbuta to heh.
+func (z *arabicStemmer) normalize() {
+	n := 0
+	for _, r := range z.b {
+		switch {
+		case r == arTatweel, r >= arFathatan && r <= arSukun:
+			continue
+		case r == arAlefMadda, r == arAlefHamzaA, r == arAlefHamzaB:
+			r = arAlef
+		case r == arAlefMaksura:
+			r = arYeh
+		case r == arTehMarbuta:
+			r = arHeh
+		}
+		z.b[n] = r
+		n++
+	}
+	z.b = z.b[:n]
+}
+
+// z.startsWith(s) is true if the word starts with s.
+func (z *arabicStemmer) startsWith(s string) bool {
+	i := 0
+	for _, r := range s {
+		if i >= len(z.b) || z.b[i] != r {
+			return false
+		}
+		i++
+	}
+	return true
+}
+
+// z.endsWith(s) is true if the word ends with s.
+func (z *arabicStemmer) endsWith(s string) bool {
+	i := len(z.b)
+	for len(s) > 0 {
+		r, size := utf8.DecodeLastRuneInString(s)
+		i--
+		if i < 0 || z.b[i] != r {
+			return false
+		}
+		s = s[:len(s)-size]
+	}
+	return true
+}
+
+// z.stemPrefix() removes the first matching prefix. The conjunction و is
+// only removed if at least three letters remain, the definite articles if
+// at least two remain.
+func (z *arabicStemmer) stemPrefix() {
+	for _, p := range arPrefixes {
+		n := utf8.RuneCountInString(p)
+		keep := 2
+		if n == 1 {
+			keep = 3
+		}
+		if len(z.b)-n >= keep && z.startsWith(p) {
+			z.b = z.b[n:]
+			return
+		}
+	}
+}
+
+// z.stemSuffix() removes each matching suffix in turn, as long as at least
+// two letters remain.
+func (z *arabicStemmer) stemSuffix() {
+	for _, s := range arSuffixes {
+		n := utf8.RuneCountInString(s)
+		if len(z.b)-n >= 2 && z.endsWith(s) {
+			z.b = z.b[:len(z.b)-n]
+		}
+	}
+}
+
+// z.stem(b) normalizes and stems the word b and returns the stemmed word.
+// b is reused for the result.
+func (z *arabicStemmer) stem(b []rune) []rune {
+	z.b = b
+	z.normalize()
+	z.stemPrefix()
+	z.stemSuffix()
+	return z.b
+}
+
+// StemArabic stems the given Arabic word and returns the stemmed form as a
+// string, using a light10-style stemmer.
+//
+// Before stemming, the word is normalized: diacritics and tatweel are
+// removed, and the alef variants أ إ آ, alef maksura ى and teh marbuta ة
+// are replaced by ا, ي and ه respectively. Then at most one prefix (و or
+// a form of the definite article ال) and a fixed sequence of suffixes are
+// removed. The input must be valid UTF-8; non-Arabic letters are
+// converted to lowercase and otherwise left alone.
+//
+// Empty input is valid and returns an empty string with no error.
+//
+// Returns the stemmed word and nil error on success, or an empty string and
+// ErrInvalidInput if the word is not valid UTF-8.
+//
+// Example:
+//
+//	stemmed, err := porter.StemArabic("والكتاب")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	// stemmed is "كتاب"
+func StemArabic(word string) (string, error) {
+	if word == "" {
+		return "", nil
+	}
+	if !utf8.ValidString(word) {
+		return "", ErrInvalidInput
+	}
+	var z arabicStemmer
+	return string(z.stem([]rune(strings.ToLower(word)))), nil
+}
@@ -0,0 +1,101 @@
+package porter
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestStemArabic(t *testing.T) {
+	for _, test := range arabicTests {
+		stemmed, err := StemArabic(test.in)
+		if err != nil {
+			t.Errorf("'%s' unexpected error: %v\n", test.in, err)
+			continue
+		}
+		if stemmed != test.out {
+			t.Errorf("'%s' want '%s' have '%s'\n", test.in, test.out, stemmed)
+		}
+	}
+}
+
+func TestArabicNormalize(t *testing.T) {
+	var test = map[string]string{
+		"آجن":        "اجن",   // alef madda
+		"أحمد":       "احمد",  // hamza above
+		"إعاذ":       "اعاذ",  // hamza below
+		"بنى":        "بني",   // alef maksura
+		"فاطمة":      "فاطمه", // teh marbuta
+		"روبرـــت":   "روبرت", // tatweel
+		"بَنَاءٌ":    "بناء",  // fatha, dammatan
+		"مَدْرَسَةٌ": "مدرسه", // sukun
+	}
+
+	for term, want := range test {
+		z := arabicStemmer{b: []rune(term)}
+		z.normalize()
+		if have := string(z.b); have != want {
+			t.Errorf("normalize(%s) want: %s have: %s", term, want, have)
+		}
+	}
+}
+
+func TestStemArabicError(t *testing.T) {
+	stemmed, err := StemArabic("")
+	if err != nil || stemmed != "" {
+		t.Errorf("StemArabic(\"\") = %q, %v", stemmed, err)
+	}
+	if _, err := StemArabic("\xd8"); err != ErrInvalidInput {
+		t.Errorf("StemArabic(invalid UTF-8) want ErrInvalidInput, have %v", err)
+	}
+}
+
+func ExampleStemArabic() {
+	stemmed, _ := StemArabic("والكتاب")
+	fmt.Println(stemmed)
+	// Output: كتاب
+}
+
+var arabicTests = []stemmerTest{
+	// prefixes
+	{"الحسن", "حسن"},
+	{"والحسن", "حسن"},
+	{"بالحسن", "حسن"},
+	{"كالحسن", "حسن"},
+	{"فالحسن", "حسن"},
+	{"للاخر", "اخر"},
+	{"وحسن", "حسن"},
+
+	// suffixes
+	{"زوجها", "زوج"},
+	{"ساهدان", "ساهد"},
+	{"ساهدات", "ساهد"},
+	{"ساهدون", "ساهد"},
+	{"ساهدين", "ساهد"},
+	{"ساهديه", "ساهد"},
+	{"ساهدية", "ساهد"},
+	{"ساهده", "ساهد"},
+	{"ساهدة", "ساهد"},
+	{"ساهدي", "ساهد"},
+
+	// both
+	{"وساهدون", "ساهد"},
+	{"ساهدهات", "ساهد"},
+	{"والمعلمون", "معلم"},
+	{"بالمدرسة", "مدرس"},
+	{"المكتبات", "مكتب"},
+
+	// normalization
+	{"أحمد", "احمد"},
+	{"الكِتَابُ", "كتاب"},
+	{"مكتـــبة", "مكتب"},
+	{"مستشفى", "مستشف"},
+
+	// too short to stem
+	{"الو", "الو"},
+	{"و", "و"},
+	{"ولد", "ولد"},
+	{"به", "به"},
+
+	// not Arabic
+	{"English", "english"},
+}
@@ -0,0 +1,468 @@
+package main
+
+import (
+	"bufio"
+	"container/heap"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// countEntry is a stem and its number of occurrences.
+type countEntry struct {
+	stem string
+	n    int
+}
+
+// byStem orders entries for merging runs: equal stems end up adjacent.
+func byStem(a, b countEntry) bool { return a.stem < b.stem }
+
+// byCount orders entries for output: most frequent first, then by stem.
+func byCount(a, b countEntry) bool {
+	if a.n != b.n {
+		return a.n > b.n
+	}
+	return a.stem < b.stem
+}
+
+// countEntryOverhead estimates the memory a map entry takes besides the
+// bytes of its stem.
+const countEntryOverhead = 64
+
+// byteSize is a flag.Value for sizes like "512MB".
+type byteSize int64
+
+func (s *byteSize) String() string { return strconv.FormatInt(int64(*s), 10) }
+
+func (s *byteSize) Set(v string) error {
+	units := []struct {
+		suffix string
+		n      int64
+	}{
+		{"GB", 1 << 30}, {"G", 1 << 30},
+		{"MB", 1 << 20}, {"M", 1 << 20},
+		{"KB", 1 << 10}, {"K", 1 << 10},
+		{"B", 1},
+	}
+	num, mult := strings.ToUpper(strings.TrimSpace(v)), int64(1)
+	for _, u := range units {
+		if strings.HasSuffix(num, u.suffix) {
+			num, mult = strings.TrimSuffix(num, u.suffix), u.n
+			break
+		}
+	}
+	n, err := strconv.ParseInt(strings.TrimSpace(num), 10, 64)
+	if err != nil || n <= 0 {
+		return fmt.Errorf("invalid size %q", v)
+	}
+	*s = byteSize(n * mult)
+	return nil
+}
+
+// maxMergeRuns is the number of runs merged at once by default, well
+// below the limits on open files.
+const maxMergeRuns = 64
+
+// spiller writes sorted runs of entries to files in dir.
+type spiller struct {
+	dir     string
+	runs    []string // runs not yet merged
+	n       int      // number of runs written
+	maxOpen int      // number of runs merged at once, maxMergeRuns if below 2
+}
+
+// spill sorts entries with less and writes them to a new run file.
+func (s *spiller) spill(entries []countEntry, less func(a, b countEntry) bool) (string, error) {
+	sort.Slice(entries, func(i, j int) bool { return less(entries[i], entries[j]) })
+	return s.write(func(emit func(countEntry) error) error {
+		for _, e := range entries {
+			if err := emit(e); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// write writes the entries that fill passes to emit to a new run file.
+func (s *spiller) write(fill func(emit func(countEntry) error) error) (string, error) {
+	f, err := os.Create(filepath.Join(s.dir, fmt.Sprintf("run%d", s.n)))
+	if err != nil {
+		return "", err
+	}
+	s.n++
+	w := bufio.NewWriter(f)
+	err = fill(func(e countEntry) error {
+		_, err := fmt.Fprintf(w, "%d %s\n", e.n, e.stem)
+		return err
+	})
+	if err == nil {
+		err = w.Flush()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return "", err
+	}
+	s.runs = append(s.runs, f.Name())
+	return f.Name(), nil
+}
+
+// merge calls emit with the entries of all runs, each sorted by less, in
+// the order of less, and starts a new list of runs for any that emit
+// spills. To keep the number of open files down, runs are merged maxOpen at
+// a time into new runs until no more than maxOpen are left.
+func (s *spiller) merge(less func(a, b countEntry) bool, emit func(countEntry) error) error {
+	maxOpen := s.maxOpen
+	if maxOpen <= 1 {
+		maxOpen = maxMergeRuns
+	}
+	for len(s.runs) > maxOpen {
+		pass := s.runs[:maxOpen:maxOpen]
+		s.runs = s.runs[maxOpen:]
+		if _, err := s.write(func(emit func(countEntry) error) error {
+			return mergeRuns(pass, less, emit)
+		}); err != nil {
+			return err
+		}
+		for _, p := range pass {
+			os.Remove(p)
+		}
+	}
+	runs := s.runs
+	s.runs = nil
+	return mergeRuns(runs, less, emit)
+}
+
+// runReader reads the entries of a run file in order.
+type runReader struct {
+	f   *os.File
+	s   *bufio.Scanner
+	cur countEntry
+}
+
+// next reads the next entry into r.cur, and reports whether there was one.
+func (r *runReader) next() (bool, error) {
+	if !r.s.Scan() {
+		return false, r.s.Err()
+	}
+	num, stem, ok := strings.Cut(r.s.Text(), " ")
+	n, err := strconv.Atoi(num)
+	if !ok || err != nil {
+		return false, fmt.Errorf("%s: malformed line %q", r.f.Name(), r.s.Text())
+	}
+	r.cur = countEntry{stem, n}
+	return true, nil
+}
+
+// runHeap is a heap of run readers ordered by their current entries.
+type runHeap struct {
+	readers []*runReader
+	less    func(a, b countEntry) bool
+}
+
+func (h *runHeap) Len() int           { return len(h.readers) }
+func (h *runHeap) Less(i, j int) bool { return h.less(h.readers[i].cur, h.readers[j].cur) }
+func (h *runHeap) Swap(i, j int)      { h.readers[i], h.readers[j] = h.readers[j], h.readers[i] }
+func (h *runHeap) Push(x any)         { h.readers = append(h.readers, x.(*runReader)) }
+func (h *runHeap) Pop() any {
+	r := h.readers[len(h.readers)-1]
+	h.readers = h.readers[:len(h.readers)-1]
+	return r
+}
+
+// mergeRuns calls emit with the entries of the run files in paths, each
+// sorted by less, in the order of less. It opens all of them at once.
+func mergeRuns(paths []string, less func(a, b countEntry) bool, emit func(countEntry) error) error {
+	h := &runHeap{less: less}
+	defer func() {
+		for _, r := range h.readers {
+			r.f.Close()
+		}
+	}()
+	for _, p := range paths {
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		r := &runReader{f: f, s: bufio.NewScanner(f)}
+		ok, err := r.next()
+		if err != nil || !ok {
+			f.Close()
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		h.readers = append(h.readers, r)
+	}
+	heap.Init(h)
+	for h.Len() > 0 {
+		r := h.readers[0]
+		if err := emit(r.cur); err != nil {
+			return err
+		}
+		ok, err := r.next()
+		if err != nil {
+			return err
+		}
+		if ok {
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+			r.f.Close()
+		}
+	}
+	return nil
+}
+
+// topHeap is a min-heap by byCount that keeps the most frequent entries.
+type topHeap []countEntry
+
+func (h topHeap) Len() int           { return len(h) }
+func (h topHeap) Less(i, j int) bool { return byCount(h[j], h[i]) }
+func (h topHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *topHeap) Push(x any)        { *h = append(*h, x.(countEntry)) }
+func (h *topHeap) Pop() any {
+	old := *h
+	e := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return e
+}
+
+// counter counts stems in memory, spilling them to sorted runs when their
+// estimated size exceeds limit.
+type counter struct {
+	limit  int64
+	counts map[string]int
+	size   int64
+	sp     spiller
+}
+
+func (c *counter) add(stem string) error {
+	if _, ok := c.counts[stem]; !ok {
+		c.size += int64(len(stem)) + countEntryOverhead
+	}
+	c.counts[stem]++
+	if c.size > c.limit {
+		return c.flush()
+	}
+	return nil
+}
+
+// flush writes the counts in memory to a run sorted by stem.
+func (c *counter) flush() error {
+	if len(c.counts) == 0 {
+		return nil
+	}
+	entries := make([]countEntry, 0, len(c.counts))
+	for stem, n := range c.counts {
+		entries = append(entries, countEntry{stem, n})
+	}
+	if _, err := c.sp.spill(entries, byStem); err != nil {
+		return err
+	}
+	c.counts = map[string]int{}
+	c.size = 0
+	return nil
+}
+
+// each calls emit with the total count of every stem, in no particular
+// order if nothing was spilled and ordered by stem otherwise.
+func (c *counter) each(emit func(countEntry) error) error {
+	if len(c.sp.runs) == 0 {
+		for stem, n := range c.counts {
+			if err := emit(countEntry{stem, n}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := c.flush(); err != nil {
+		return err
+	}
+	c.counts = nil
+	var cur countEntry
+	err := c.sp.merge(byStem, func(e countEntry) error {
+		if e.stem == cur.stem && cur.n > 0 {
+			cur.n += e.n
+			return nil
+		}
+		if cur.n > 0 {
+			if err := emit(cur); err != nil {
+				return err
+			}
+		}
+		cur = e
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if cur.n > 0 {
+		return emit(cur)
+	}
+	return nil
+}
+
+// runCount implements "porter count": it stems the words of a corpus and
+// prints each stem with its number of occurrences, most frequent first,
+// like "porter | sort | uniq -c | sort -rn" does.
+func runCount(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("porter count", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	name := flags.String("stemmer", "porter", "stemmer to use: "+stemmerNames())
+	top := flags.Int("top", 0, "print only the `n` most frequent stems (0 for all)")
+	minCount := flags.Int("min-count", 1, "print only stems occurring at least `n` times")
+	limit := byteSize(256 << 20)
+	flags.Var(&limit, "memory", "memory for counting before spilling to temporary files, e.g. 64MB or 1GB")
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "usage: porter count [-stemmer name] [-top n] [-min-count n] [-memory size] [file ...]\n\n"+
+			"Reads the corpus from the files, or from standard input if there are none.\n\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	stem, err := lookupStemmer(*name)
+	if err != nil {
+		fmt.Fprintf(stderr, "porter count: %v\n", err)
+		return 2
+	}
+
+	dir, err := os.MkdirTemp("", "porter-count-")
+	if err != nil {
+		fmt.Fprintf(stderr, "porter count: %v\n", err)
+		return 1
+	}
+	defer os.RemoveAll(dir)
+
+	c := &counter{limit: int64(limit), counts: map[string]int{}, sp: spiller{dir: dir}}
+	status := 0
+	count := func(r io.Reader) error {
+		scanner := bufio.NewScanner(r)
+		scanner.Split(bufio.ScanWords)
+		for scanner.Scan() {
+			word := trimWord(scanner.Text())
+			if word == "" {
+				continue
+			}
+			stemmed, err := stem(word)
+			if err != nil {
+				fmt.Fprintf(stderr, "porter count: %q: %v\n", word, err)
+				status = 1
+				continue
+			}
+			if err := c.add(stemmed); err != nil {
+				return err
+			}
+		}
+		return scanner.Err()
+	}
+	if flags.NArg() == 0 {
+		if err := count(stdin); err != nil {
+			fmt.Fprintf(stderr, "porter count: %v\n", err)
+			return 1
+		}
+	}
+	for _, file := range flags.Args() {
+		f, err := os.Open(file)
+		if err != nil {
+			fmt.Fprintf(stderr, "porter count: %v\n", err)
+			return 1
+		}
+		err = count(f)
+		f.Close()
+		if err != nil {
+			fmt.Fprintf(stderr, "porter count: %s: %v\n", file, err)
+			return 1
+		}
+	}
+
+	out := bufio.NewWriter(stdout)
+	defer out.Flush()
+	emit := func(e countEntry) error {
+		_, err := fmt.Fprintf(out, "%7d %s\n", e.n, e.stem)
+		return err
+	}
+	if err := writeCounts(c, *top, *minCount, emit); err != nil {
+		fmt.Fprintf(stderr, "porter count: %v\n", err)
+		return 1
+	}
+	return status
+}
+
+// writeCounts calls emit with the counted stems that occur at least
+// minCount times, most frequent first, stopping after top stems if top is
+// positive. Sorting is done in memory unless c had to spill, in which case
+// the totals are sorted by count on disk as well.
+func writeCounts(c *counter, top, minCount int, emit func(countEntry) error) error {
+	if top > 0 {
+		h := &topHeap{}
+		err := c.each(func(e countEntry) error {
+			if e.n < minCount {
+				return nil
+			}
+			if h.Len() < top {
+				heap.Push(h, e)
+			} else if byCount(e, (*h)[0]) {
+				(*h)[0] = e
+				heap.Fix(h, 0)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		entries := []countEntry(*h)
+		sort.Slice(entries, func(i, j int) bool { return byCount(entries[i], entries[j]) })
+		for _, e := range entries {
+			if err := emit(e); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	spilled := len(c.sp.runs) > 0
+	var entries []countEntry
+	var size int64
+	err := c.each(func(e countEntry) error {
+		if e.n < minCount {
+			return nil
+		}
+		entries = append(entries, e)
+		size += int64(len(e.stem)) + countEntryOverhead
+		if spilled && size > c.limit {
+			if _, err := c.sp.spill(entries, byCount); err != nil {
+				return err
+			}
+			entries, size = nil, 0
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(c.sp.runs) == 0 {
+		sort.Slice(entries, func(i, j int) bool { return byCount(entries[i], entries[j]) })
+		for _, e := range entries {
+			if err := emit(e); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if len(entries) > 0 {
+		if _, err := c.sp.spill(entries, byCount); err != nil {
+			return err
+		}
+	}
+	return c.sp.merge(byCount, emit)
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/a2800276/porter"
+)
+
+// coverageReport is the result of "porter rules-coverage".
+type coverageReport struct {
+	Tokens int            `json:"tokens"`
+	Words  int            `json:"words"`
+	Rules  []ruleCoverage `json:"rules"`
+}
+
+// ruleCoverage is how often a rule was tried on the tokens of a corpus.
+type ruleCoverage struct {
+	Step     string   `json:"step"`
+	Rule     string   `json:"rule"`
+	Matched  int      `json:"matched"`
+	Passed   int      `json:"passed"`
+	Failed   int      `json:"failed"`
+	Examples []string `json:"examples"`
+}
+
+// runCoverage implements "porter rules-coverage": it stems every word of a
+// corpus with the rules of the Porter stemmer, or of a rule file, and
+// reports how often each rule's suffix matched and its condition passed or
+// failed.
+func runCoverage(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("porter rules-coverage", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	rulesFile := flags.String("rules", "", "rule `file` to use instead of the Porter rules")
+	examples := flags.Int("examples", 3, "number of example words to list per rule")
+	format := flags.String("format", "text", "output format: text or json")
+	unused := flags.Bool("unused", false, "list only the rules that were never applied")
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "usage: porter rules-coverage [-rules file] [-examples n] [-unused] [-format text|json] [file ...]\n\n"+
+			"Reads the corpus from the files, or from standard input if there are none.\n\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *format != "text" && *format != "json" {
+		fmt.Fprintf(stderr, "porter rules-coverage: unknown format %q (have text, json)\n", *format)
+		return 2
+	}
+	c := porter.Coverage{Examples: *examples}
+	if *rulesFile != "" {
+		f, err := os.Open(*rulesFile)
+		if err != nil {
+			fmt.Fprintf(stderr, "porter rules-coverage: %v\n", err)
+			return 1
+		}
+		c.Rules, err = porter.ParseRules(f)
+		f.Close()
+		if err != nil {
+			fmt.Fprintf(stderr, "porter rules-coverage: %s: %v\n", *rulesFile, err)
+			return 1
+		}
+	}
+
+	counts := map[string]int{}
+	tokens, err := countWords(flags.Args(), stdin, counts)
+	if err != nil {
+		fmt.Fprintf(stderr, "porter rules-coverage: %v\n", err)
+		return 1
+	}
+	// the most frequent words come first, so that they are the examples
+	words := make([]string, 0, len(counts))
+	for w := range counts {
+		words = append(words, w)
+	}
+	sort.Slice(words, func(i, j int) bool {
+		if counts[words[i]] != counts[words[j]] {
+			return counts[words[i]] > counts[words[j]]
+		}
+		return words[i] < words[j]
+	})
+	for _, w := range words {
+		if _, err := c.Add(w, counts[w]); err != nil {
+			fmt.Fprintf(stderr, "porter rules-coverage: %q: %v\n", w, err)
+			return 1
+		}
+	}
+
+	report := &coverageReport{Tokens: tokens, Words: len(counts), Rules: []ruleCoverage{}}
+	for _, rc := range c.Report() {
+		if *unused && rc.Passed > 0 {
+			continue
+		}
+		examples := rc.Examples
+		if examples == nil {
+			examples = []string{}
+		}
+		report.Rules = append(report.Rules, ruleCoverage{rc.Step, rc.Rule.String(), rc.Matched, rc.Passed, rc.Failed, examples})
+	}
+
+	if *format == "json" {
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			fmt.Fprintf(stderr, "porter rules-coverage: %v\n", err)
+			return 1
+		}
+		return 0
+	}
+	writeCoverage(stdout, report)
+	return 0
+}
+
+// writeCoverage prints r as text, a line per rule.
+func writeCoverage(out io.Writer, r *coverageReport) {
+	fmt.Fprintf(out, "# %d tokens, %d unique words\n", r.Tokens, r.Words)
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "step\trule\tmatched\tpassed\tfailed\texamples\n")
+	for _, rc := range r.Rules {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%s\n", rc.Step, rc.Rule, rc.Matched, rc.Passed, rc.Failed, strings.Join(rc.Examples, " "))
+	}
+	w.Flush()
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/a2800276/porter"
+)
+
+// runEval implements "porter eval": it evaluates one or more stemmers on a
+// file of word groups and prints a row of Paice's measures for each.
+func runEval(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("porter eval", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	names := flags.String("stemmer", "porter", "comma-separated stemmers to evaluate: "+stemmerNames())
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "usage: porter eval [-stemmer name,...] groups.txt\n\n"+
+			"groups.txt holds one family of words that should be conflated per line.\n"+
+			"Use - to read it from standard input.\n\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+
+	var stems []func(string) (string, error)
+	list := strings.Split(*names, ",")
+	for _, name := range list {
+		stem, err := lookupStemmer(name)
+		if err != nil {
+			fmt.Fprintf(stderr, "porter eval: %v\n", err)
+			return 2
+		}
+		stems = append(stems, stem)
+	}
+
+	in := stdin
+	if file := flags.Arg(0); file != "-" {
+		f, err := os.Open(file)
+		if err != nil {
+			fmt.Fprintf(stderr, "porter eval: %v\n", err)
+			return 1
+		}
+		defer f.Close()
+		in = f
+	}
+	groups, err := porter.ReadWordGroups(in)
+	if err != nil {
+		fmt.Fprintf(stderr, "porter eval: %v\n", err)
+		return 1
+	}
+
+	w := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "stemmer\tUI\tOI\tSW\tERRT\n")
+	words := 0
+	for i, stem := range stems {
+		s, err := porter.EvaluatePaice(groups, stem)
+		if err != nil {
+			fmt.Fprintf(stderr, "porter eval: %s: %v\n", list[i], err)
+			return 1
+		}
+		words = s.Words
+		fmt.Fprintf(w, "%s\t%.4f\t%.4f\t%.4f\t%.4f\n", list[i], s.UI, s.OI, s.SW, s.ERRT)
+	}
+	w.Flush()
+	fmt.Fprintf(stdout, "%d groups, %d words\n", len(groups), words)
+	return 0
+}
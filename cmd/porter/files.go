@@ -0,0 +1,427 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/a2800276/porter"
+)
+
+// bytesStemmers are the stemmers with an in-place byte slice API, which
+// runFiles uses to stem without allocating.
+var bytesStemmers = map[string]func([]byte) ([]byte, error){
+	"porter": porter.StemBytes,
+	"light":  porter.StemLightBytes,
+	"s":      porter.StemSBytes,
+}
+
+// expandFiles returns the files named by args in order: plain files,
+// every file below a directory, and the files and directories matching a
+// glob pattern.
+func expandFiles(args []string) ([]string, error) {
+	var files []string
+	for _, arg := range args {
+		paths := []string{arg}
+		if strings.ContainsAny(arg, "*?[") {
+			matches, err := filepath.Glob(arg)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", arg, err)
+			}
+			if len(matches) == 0 {
+				return nil, fmt.Errorf("%s: no matching files", arg)
+			}
+			paths = matches
+		}
+		for _, p := range paths {
+			err := filepath.WalkDir(p, func(path string, d fs.DirEntry, err error) error {
+				if err != nil {
+					return err
+				}
+				if d.Type().IsRegular() {
+					files = append(files, path)
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return files, nil
+}
+
+// outputPath returns where the output for the input file path goes in dir:
+// the same path below dir.
+func outputPath(dir, path string) (string, error) {
+	rel := strings.TrimLeft(filepath.Clean(path)[len(filepath.VolumeName(path)):], `/\`)
+	if !filepath.IsLocal(rel) {
+		return "", fmt.Errorf("%s: cannot place output for a path outside the current directory", path)
+	}
+	return filepath.Join(dir, rel), nil
+}
+
+// wordSplitter is a bufio.SplitFunc like bufio.ScanWords that removes the
+// punctuation around words and keeps track of their byte offsets.
+type wordSplitter struct {
+	offset     int // bytes consumed so far
+	start, end int // offsets of the last word
+}
+
+func (s *wordSplitter) split(data []byte, atEOF bool) (int, []byte, error) {
+	// tokens of punctuation only are skipped here: returning no token
+	// would make the scanner read more input, or stop at the end of it
+	n := 0
+	for {
+		adv, tok, err := bufio.ScanWords(data[n:], atEOF)
+		if tok == nil || err != nil {
+			s.offset += n + adv
+			return n + adv, nil, err
+		}
+		// tok is a slice of data
+		start := s.offset + n + cap(data[n:]) - cap(tok)
+		word := bytes.TrimLeftFunc(tok, isPunct)
+		start += len(tok) - len(word)
+		word = bytes.TrimRightFunc(word, isPunct)
+		n += adv
+		if len(word) > 0 {
+			s.start, s.end = start, start+len(word)
+			s.offset += n
+			return n, word, nil
+		}
+	}
+}
+
+// wordStemmer stems the words of its inputs and writes them in a format.
+// It has a buffer of its own, so that every worker of runFiles has one.
+type wordStemmer struct {
+	name      string
+	stem      func(string) (string, error)
+	stemBytes func([]byte) ([]byte, error) // nil if the stemmer has none
+	format    *outputFormat
+	buf       []byte
+}
+
+func newWordStemmer(name string, format *outputFormat) (*wordStemmer, error) {
+	stem, err := lookupStemmer(name)
+	if err != nil {
+		return nil, err
+	}
+	if format.rules && name != "porter" {
+		// only the Porter stemmer can trace its rules
+		return nil, fmt.Errorf("-fields rules needs -stemmer porter, not %s", name)
+	}
+	return &wordStemmer{name: name, stem: stem, stemBytes: bytesStemmers[name], format: format}, nil
+}
+
+// stemWord fills in the stem of r.word, and the rules if wanted.
+func (ws *wordStemmer) stemWord(r *wordRecord) error {
+	var err error
+	switch {
+	case ws.format.rules:
+		r.rules = r.rules[:0]
+		r.stem, err = porter.StemTrace(r.word, func(step, rule string) {
+			r.rules = append(r.rules, step+":"+strings.ReplaceAll(rule, " ", ""))
+		})
+	case ws.stemBytes != nil && isASCII(r.word):
+		// the byte slice stemmers only lowercase ASCII
+		ws.buf = append(ws.buf[:0], r.word...)
+		var stemmed []byte
+		stemmed, err = ws.stemBytes(ws.buf)
+		r.stem = string(stemmed)
+	default:
+		r.stem, err = ws.stem(r.word)
+	}
+	return err
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// stemWords stems the words read from in and writes them to w; file is
+// the name of the input for the file field. Words that cannot be stemmed
+// are passed to report and skipped.
+func (ws *wordStemmer) stemWords(in io.Reader, file string, w *recordWriter, report func(word string, err error)) error {
+	var split wordSplitter
+	scanner := bufio.NewScanner(in)
+	scanner.Split(split.split)
+
+	// with -unique, records are kept in the order words first appear
+	var records []*wordRecord
+	seen := map[string]*wordRecord{}
+	r := &wordRecord{file: file}
+	for scanner.Scan() {
+		if ws.format.unique {
+			if u, ok := seen[scanner.Text()]; ok {
+				u.count++
+				continue
+			}
+		}
+		r.word, r.start, r.end = scanner.Text(), split.start, split.end
+		if err := ws.stemWord(r); err != nil {
+			report(r.word, err)
+			continue
+		}
+		if ws.format.unique {
+			r.count = 1
+			seen[r.word] = r
+			records = append(records, r)
+			r = &wordRecord{file: file}
+			continue
+		}
+		if err := w.write(r); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	for _, r := range records {
+		if err := w.write(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fileResult is the output of stemming the i-th file.
+type fileResult struct {
+	i       int
+	path    string
+	size    int
+	out     *bytes.Buffer // nil if the output went to a file of its own
+	skipped []string      // the words that could not be stemmed, and why
+	err     error
+}
+
+// progress reports on w how many files and bytes have been processed, at
+// most every 200ms, overwriting its previous report.
+type progress struct {
+	w     io.Writer
+	total int
+	files int
+	bytes int64
+	last  time.Time
+}
+
+func (p *progress) add(size int) {
+	p.files++
+	p.bytes += int64(size)
+	if now := time.Now(); p.files == p.total || now.Sub(p.last) >= 200*time.Millisecond {
+		p.last = now
+		fmt.Fprintf(p.w, "\rporter: %d/%d files, %.1f MB", p.files, p.total, float64(p.bytes)/(1<<20))
+		if p.files == p.total {
+			fmt.Fprintln(p.w)
+		}
+	}
+}
+
+// runFiles stems the words of the files named by args (files, directories
+// or glob patterns) with the given number of workers. The stems go to
+// stdout in the order of the files or, if outDir is not empty, to a file
+// per input below outDir.
+func runFiles(args []string, name string, format *outputFormat, workers int, outDir string, showProgress bool, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprintf(stderr, "porter: -files needs at least one path\n")
+		return 2
+	}
+	files, err := expandFiles(args)
+	if err != nil {
+		fmt.Fprintf(stderr, "porter: %v\n", err)
+		return 1
+	}
+	if _, err := newWordStemmer(name, format); err != nil {
+		fmt.Fprintf(stderr, "porter: %v\n", err)
+		return 2
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	// window limits the results that are waiting for an earlier file to be
+	// written, so that memory does not grow with the number of files
+	window := make(chan struct{}, 2*workers)
+	jobs := make(chan int)
+	results := make(chan fileResult)
+	go func() {
+		for i := range files {
+			window <- struct{}{}
+			jobs <- i
+		}
+		close(jobs)
+	}()
+	var wg sync.WaitGroup
+	for n := 0; n < workers; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ws, _ := newWordStemmer(name, format)
+			for i := range jobs {
+				r := fileResult{i: i, path: files[i]}
+				r.err = stemFile(ws, &r, outDir)
+				results <- r
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	out := bufio.NewWriter(stdout)
+	defer out.Flush()
+	if outDir == "" {
+		w := newRecordWriter(format, out)
+		w.header()
+		w.flush()
+	}
+	prog := &progress{w: stderr, total: len(files)}
+	status := 0
+	pending := map[int]fileResult{}
+	next := 0
+	for r := range results {
+		pending[r.i] = r
+		for {
+			r, ok := pending[next]
+			if !ok {
+				break
+			}
+			delete(pending, next)
+			next++
+			<-window
+			for _, msg := range r.skipped {
+				fmt.Fprintf(stderr, "porter: %s: %s\n", r.path, msg)
+				status = 1
+			}
+			if r.err != nil {
+				fmt.Fprintf(stderr, "porter: %s: %v\n", r.path, r.err)
+				status = 1
+			} else if r.out != nil {
+				out.Write(r.out.Bytes())
+			}
+			if showProgress {
+				prog.add(r.size)
+			}
+		}
+	}
+	return status
+}
+
+// stemFile streams the words of the file r.path through the stemmer, or
+// with -inline copies the file with its words stemmed, and sets r.size to
+// the bytes read. The output goes to r.out or, if outDir is not empty, to
+// the output file for r.path below outDir, preceded by the header of the
+// format. Words that cannot be stemmed are added to r.skipped and left out.
+func stemFile(ws *wordStemmer, r *fileResult, outDir string) (err error) {
+	f, err := os.Open(r.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	in := &countingReader{r: f}
+	defer func() { r.size = in.n }()
+
+	var out io.Writer
+	if outDir == "" {
+		r.out = &bytes.Buffer{}
+		out = r.out
+	} else {
+		var o *os.File
+		if o, err = createOutput(outDir, r.path); err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := o.Close(); err == nil {
+				err = cerr
+			}
+		}()
+		bw := bufio.NewWriter(o)
+		defer func() {
+			if ferr := bw.Flush(); err == nil {
+				err = ferr
+			}
+		}()
+		out = bw
+	}
+
+	if ws.format.name == "inline" {
+		return porter.TextStemmer{Stem: ws.stem}.Copy(out, in)
+	}
+	w := newRecordWriter(ws.format, out)
+	if outDir != "" {
+		w.header()
+	}
+	err = ws.stemWords(in, r.path, w, func(word string, err error) {
+		r.skipped = append(r.skipped, fmt.Sprintf("%q: %v", word, err))
+	})
+	if err == nil {
+		err = w.flush()
+	}
+	return err
+}
+
+// countingReader counts the bytes read from r, for the progress report.
+type countingReader struct {
+	r io.Reader
+	n int
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += n
+	return n, err
+}
+
+// runInline copies the files in paths, or stdin if there are none, to
+// stdout with each word replaced by its stem.
+func runInline(paths []string, ws *wordStemmer, stdin io.Reader, stdout, stderr io.Writer) int {
+	t := porter.TextStemmer{Stem: ws.stem}
+	if len(paths) == 0 {
+		if err := t.Copy(stdout, stdin); err != nil {
+			fmt.Fprintf(stderr, "porter: %v\n", err)
+			return 1
+		}
+		return 0
+	}
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			fmt.Fprintf(stderr, "porter: %v\n", err)
+			return 1
+		}
+		err = t.Copy(stdout, f)
+		f.Close()
+		if err != nil {
+			fmt.Fprintf(stderr, "porter: %s: %v\n", path, err)
+			return 1
+		}
+	}
+	return 0
+}
+
+// createOutput creates the output file for path below dir, and the
+// directories it is in.
+func createOutput(dir, path string) (*os.File, error) {
+	name, err := outputPath(dir, path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return nil, err
+	}
+	return os.Create(name)
+}
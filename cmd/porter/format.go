@@ -0,0 +1,195 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// wordRecord is a word of the input with its stem.
+type wordRecord struct {
+	file       string
+	word       string
+	stem       string
+	start, end int      // byte offsets of the word in its input
+	rules      []string // the Porter rules applied, as "step:rule"
+	count      int      // occurrences of the word, with -unique
+}
+
+// outputFormat is how runStem prints stems: "text" prints one stem per
+// line, the others print a record per word with the word, its stem and the
+// optional fields.
+type outputFormat struct {
+	name   string // text, tsv, jsonl, csv, or inline for -inline
+	file   bool   // print the file the word is from
+	offset bool   // print the start and end offsets of the word
+	rules  bool   // print the Porter rules applied to the word
+	unique bool   // print each distinct word once, with its count
+}
+
+// parseFormat returns the outputFormat for the -format, -fields and
+// -unique flags.
+func parseFormat(name, fields string, unique bool) (*outputFormat, error) {
+	f := &outputFormat{name: name, unique: unique}
+	switch name {
+	case "text":
+		if fields != "" || unique {
+			return nil, fmt.Errorf("-fields and -unique need -format tsv, jsonl or csv")
+		}
+		return f, nil
+	case "tsv", "jsonl", "csv":
+	default:
+		return nil, fmt.Errorf("unknown format %q (have text, tsv, jsonl, csv)", name)
+	}
+	for _, field := range strings.Split(fields, ",") {
+		switch strings.TrimSpace(field) {
+		case "":
+		case "file":
+			f.file = true
+		case "offset":
+			f.offset = true
+		case "rules":
+			f.rules = true
+		default:
+			return nil, fmt.Errorf("unknown field %q (have file, offset, rules)", field)
+		}
+	}
+	return f, nil
+}
+
+// columns returns the names of the fields of a record in f, in order.
+func (f *outputFormat) columns() []string {
+	var c []string
+	if f.file {
+		c = append(c, "file")
+	}
+	c = append(c, "word", "stem")
+	if f.offset {
+		c = append(c, "start", "end")
+	}
+	if f.rules {
+		c = append(c, "rules")
+	}
+	if f.unique {
+		c = append(c, "count")
+	}
+	return c
+}
+
+// values returns the fields of r in the order of f.columns, with the rules
+// separated by spaces.
+func (f *outputFormat) values(r *wordRecord) []string {
+	var v []string
+	if f.file {
+		v = append(v, r.file)
+	}
+	v = append(v, r.word, r.stem)
+	if f.offset {
+		v = append(v, strconv.Itoa(r.start), strconv.Itoa(r.end))
+	}
+	if f.rules {
+		v = append(v, strings.Join(r.rules, " "))
+	}
+	if f.unique {
+		v = append(v, strconv.Itoa(r.count))
+	}
+	return v
+}
+
+// jsonRecord is a wordRecord as printed with -format jsonl; the optional
+// fields are pointers so that they are left out unless asked for.
+type jsonRecord struct {
+	File  *string   `json:"file,omitempty"`
+	Word  string    `json:"word"`
+	Stem  string    `json:"stem"`
+	Start *int      `json:"start,omitempty"`
+	End   *int      `json:"end,omitempty"`
+	Rules *[]string `json:"rules,omitempty"`
+	Count *int      `json:"count,omitempty"`
+}
+
+// tsvEscaper escapes the tabs, newlines and backslashes of a TSV field, as
+// PostgreSQL's text format does: words passed as arguments and file names
+// may hold them.
+var tsvEscaper = strings.NewReplacer(`\`, `\\`, "\t", `\t`, "\n", `\n`, "\r", `\r`)
+
+// recordWriter writes records to w in an outputFormat.
+type recordWriter struct {
+	f    *outputFormat
+	w    *bufio.Writer
+	csv  *csv.Writer
+	json *json.Encoder
+}
+
+func newRecordWriter(f *outputFormat, w io.Writer) *recordWriter {
+	rw := &recordWriter{f: f, w: bufio.NewWriter(w)}
+	switch f.name {
+	case "csv":
+		rw.csv = csv.NewWriter(rw.w)
+	case "jsonl":
+		rw.json = json.NewEncoder(rw.w)
+		rw.json.SetEscapeHTML(false)
+	}
+	return rw
+}
+
+// header writes the column names, for the formats that have them.
+func (rw *recordWriter) header() error {
+	switch rw.f.name {
+	case "tsv":
+		_, err := fmt.Fprintln(rw.w, strings.Join(rw.f.columns(), "\t"))
+		return err
+	case "csv":
+		return rw.csv.Write(rw.f.columns())
+	}
+	return nil
+}
+
+func (rw *recordWriter) write(r *wordRecord) error {
+	switch rw.f.name {
+	case "text":
+		rw.w.WriteString(r.stem)
+		return rw.w.WriteByte('\n')
+	case "tsv":
+		v := rw.f.values(r)
+		for i := range v {
+			v[i] = tsvEscaper.Replace(v[i])
+		}
+		_, err := fmt.Fprintln(rw.w, strings.Join(v, "\t"))
+		return err
+	case "csv":
+		return rw.csv.Write(rw.f.values(r))
+	}
+	j := jsonRecord{Word: r.word, Stem: r.stem}
+	if rw.f.file {
+		j.File = &r.file
+	}
+	if rw.f.offset {
+		j.Start, j.End = &r.start, &r.end
+	}
+	if rw.f.rules {
+		rules := r.rules
+		if rules == nil {
+			rules = []string{}
+		}
+		j.Rules = &rules
+	}
+	if rw.f.unique {
+		j.Count = &r.count
+	}
+	return rw.json.Encode(j)
+}
+
+func (rw *recordWriter) flush() error {
+	if rw.csv != nil {
+		rw.csv.Flush()
+		if err := rw.csv.Error(); err != nil {
+			return err
+		}
+	}
+	return rw.w.Flush()
+}
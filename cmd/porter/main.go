@@ -0,0 +1,250 @@
+// Command porter stems English words with the Porter stemming algorithm.
+//
+// Usage:
+//
+//	porter [-stemmer name] [-format f [-fields list] [-unique]] [word ...]
+//	porter -files [-j n] [-outdir dir] [-progress] [-stemmer name] path ...
+//	porter -csv|-tsv -columns list [-stemmer name] [file ...]
+//	porter -jsonl -fields list [-stemmer name] [file ...]
+//	porter -inline [-stemmer name] [file ...]
+//	porter eval [-stemmer name,...] groups.txt
+//	porter stats [-stemmer name] [-top n] [-format text|json] [file ...]
+//	porter count [-stemmer name] [-top n] [-min-count n] [-memory size] [file ...]
+//	porter top [-stemmer name] [-n n] [-capacity n] [-follow [-interval d]]
+//	porter rules [-format text|markdown|dot] [-title title] [file]
+//	porter rules-coverage [-rules file] [-examples n] [-unused] [-format text|json] [file ...]
+//
+// Without a command, porter stems the words given as arguments or, if there
+// are none, the words read from standard input, and prints one stem per
+// line. Punctuation around words read from standard input is ignored.
+//
+// With -files, the arguments are files, directories (whose files are all
+// read) or glob patterns, and the words in them are stemmed by -j files in
+// parallel. The stems are printed in the order of the files or, with
+// -outdir, written to a file per input at the same path below the output
+// directory. -progress reports the files done on standard error.
+//
+// -format tsv, jsonl or csv prints a record per word with the word and its
+// stem instead, so that stems can be matched with their words. TSV and CSV
+// start with a header line; TSV escapes tabs, newlines and backslashes in
+// fields as \t, \n and \\. -fields adds the file the word is from
+// ("file"), the byte offsets of the word in its input ("offset") and the
+// Porter rules applied to it ("rules", only with -stemmer porter). -unique
+// prints each distinct word of an input once, where it first occurs, with
+// a count of its occurrences.
+//
+// -csv, -tsv and -jsonl read records from the files given as arguments, or
+// from standard input, and replace the text in the named columns of CSV or
+// TSV (whose first line names the columns) or the named string fields of
+// JSON Lines (with dots for nested fields, as in "a.b") by its stems,
+// separated by spaces. The records are read one at a time and everything
+// else is copied unchanged, including spaces around the quotes of a CSV
+// field.
+//
+// -inline copies the text of the files given as arguments, or of standard
+// input, replacing each word by its stem and keeping everything between
+// words, so that the result can be compared with the original line by
+// line; see porter.TextStemmer. With -files, each file is copied like
+// this.
+//
+// The eval command evaluates stemmers against a file of word families
+// using Paice's measures; see porter.EvaluatePaice.
+//
+// The stats command reads a corpus and reports how strongly it is
+// conflated: unique words and stems, the mean size of the classes of words
+// sharing a stem, the largest classes, the mean number of characters
+// removed and, for the porter stemmer, how often each rule fired.
+//
+// The count command prints each stem of a corpus with its number of
+// occurrences, most frequent first. If the stems do not fit in the -memory
+// limit, they are counted in sorted runs in temporary files, which are
+// merged at the end.
+//
+// The top command estimates the most frequent stems of standard input in
+// constant memory; see porter.TopK. With -follow it prints them
+// periodically while reading, e.g. from "tail -f".
+//
+// The rules command prints the rules of the Porter stemmer, or of a rule
+// file read by porter.ParseRules, in the rule file format, as Markdown
+// tables or as a Graphviz graph; RULES.md is generated with it.
+//
+// The rules-coverage command stems a corpus with the same rules and
+// reports, for each rule, how many tokens its suffix matched, how many of
+// them passed or failed its condition, and the most frequent words it was
+// applied to; see porter.Coverage. -unused lists only the rules that were
+// never applied.
+//
+// The -stemmer flag selects the stemmer: porter (the default), light, s,
+// kstem, kstem+porter, lemma, lemma+porter, finnish or arabic.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/a2800276/porter"
+)
+
+// stemmers are the stemmers selectable with -stemmer.
+var stemmers = map[string]func(string) (string, error){
+	"porter":       porter.Stem,
+	"light":        porter.StemLight,
+	"s":            porter.StemS,
+	"kstem":        porter.StemKrovetz,
+	"kstem+porter": porter.KStemmer{PorterFallback: true}.Stem,
+	"lemma":        porter.Lemmatize,
+	"lemma+porter": porter.Lemmatizer{ThenStem: true}.Lemmatize,
+	"finnish":      porter.StemFinnish,
+	"arabic":       porter.StemArabic,
+}
+
+func stemmerNames() string {
+	names := make([]string, 0, len(stemmers))
+	for name := range stemmers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// lookupStemmer returns the stemmer called name.
+func lookupStemmer(name string) (func(string) (string, error), error) {
+	stem, ok := stemmers[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown stemmer %q (have %s)", name, stemmerNames())
+	}
+	return stem, nil
+}
+
+// commands are the subcommands; anything else is stemmed.
+var commands = map[string]func(args []string, stdin io.Reader, stdout, stderr io.Writer) int{
+	"count":          runCount,
+	"eval":           runEval,
+	"rules":          runRules,
+	"rules-coverage": runCoverage,
+	"stats":          runStats,
+	"top":            runTop,
+}
+
+func main() {
+	args := os.Args[1:]
+	if len(args) > 0 {
+		if cmd, ok := commands[args[0]]; ok {
+			os.Exit(cmd(args[1:], os.Stdin, os.Stdout, os.Stderr))
+		}
+	}
+	os.Exit(runStem(args, os.Stdin, os.Stdout, os.Stderr))
+}
+
+// isPunct is true for the characters trimmed from around words.
+func isPunct(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// trimWord removes the punctuation around a word read from text.
+func trimWord(w string) string {
+	return strings.TrimFunc(w, isPunct)
+}
+
+func runStem(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("porter", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	name := flags.String("stemmer", "porter", "stemmer to use: "+stemmerNames())
+	files := flags.Bool("files", false, "stem the words in the files, directories or glob patterns given as arguments")
+	workers := flags.Int("j", runtime.GOMAXPROCS(0), "number of files to stem in parallel with -files")
+	outDir := flags.String("outdir", "", "with -files, write the stems of each file to the same path below `dir`")
+	showProgress := flags.Bool("progress", false, "with -files, report progress on standard error")
+	formatName := flags.String("format", "text", "output `format`: text (one stem per line), tsv, jsonl or csv")
+	fields := flags.String("fields", "", "comma-separated fields to add to the word and stem with tsv, jsonl or csv: file, offset, rules")
+	unique := flags.Bool("unique", false, "with tsv, jsonl or csv, print each distinct word once, with a count field")
+	csvIn := flags.Bool("csv", false, "read CSV records from the files given as arguments or standard input and stem the -columns")
+	tsvIn := flags.Bool("tsv", false, "like -csv, for tab-separated values without quoting")
+	jsonlIn := flags.Bool("jsonl", false, "read JSON Lines from the files given as arguments or standard input and stem the -fields")
+	columns := flags.String("columns", "", "with -csv or -tsv, comma-separated names of the columns to stem")
+	inline := flags.Bool("inline", false, "copy the text of the files given as arguments, or standard input, with each word replaced by its stem")
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "usage: porter [-stemmer name] [-format f [-fields list] [-unique]] [word ...]\n"+
+			"       porter -files [-j n] [-outdir dir] [-progress] [-stemmer name] path ...\n"+
+			"       porter -csv|-tsv -columns list [-stemmer name] [file ...]\n"+
+			"       porter -jsonl -fields list [-stemmer name] [file ...]\n"+
+			"       porter -inline [-stemmer name] [file ...]\n"+
+			"       porter eval [-stemmer name,...] groups.txt\n"+
+			"       porter stats [-stemmer name] [-top n] [-format text|json] [file ...]\n"+
+			"       porter count [-stemmer name] [-top n] [-min-count n] [-memory size] [file ...]\n"+
+			"       porter top [-stemmer name] [-n n] [-capacity n] [-follow [-interval d]]\n"+
+			"       porter rules [-format text|markdown|dot] [-title title] [file]\n"+
+			"       porter rules-coverage [-rules file] [-examples n] [-unused] [-format text|json] [file ...]\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	var kinds []string
+	for kind, set := range map[string]bool{"csv": *csvIn, "tsv": *tsvIn, "jsonl": *jsonlIn} {
+		if set {
+			kinds = append(kinds, kind)
+		}
+	}
+	if len(kinds) > 0 {
+		return runRecordsFlags(kinds, *columns, *fields, *formatName, *unique, *files, *name, flags.Args(), stdin, stdout, stderr)
+	}
+	if *columns != "" {
+		fmt.Fprintf(stderr, "porter: -columns needs -csv or -tsv\n")
+		return 2
+	}
+	var format *outputFormat
+	var err error
+	if *inline {
+		if *formatName != "text" || *fields != "" || *unique {
+			fmt.Fprintf(stderr, "porter: -inline cannot be used with -format, -fields or -unique\n")
+			return 2
+		}
+		format = &outputFormat{name: "inline"}
+	} else if format, err = parseFormat(*formatName, *fields, *unique); err != nil {
+		fmt.Fprintf(stderr, "porter: %v\n", err)
+		return 2
+	}
+	if *files {
+		return runFiles(flags.Args(), *name, format, *workers, *outDir, *showProgress, stdout, stderr)
+	}
+	ws, err := newWordStemmer(*name, format)
+	if err != nil {
+		fmt.Fprintf(stderr, "porter: %v\n", err)
+		return 2
+	}
+	if *inline {
+		return runInline(flags.Args(), ws, stdin, stdout, stderr)
+	}
+
+	w := newRecordWriter(format, stdout)
+	defer w.flush()
+	w.header()
+	status := 0
+	report := func(word string, err error) {
+		fmt.Fprintf(stderr, "porter: %q: %v\n", word, err)
+		status = 1
+	}
+
+	if flags.NArg() > 0 {
+		for _, word := range flags.Args() {
+			r := wordRecord{word: word, end: len(word), count: 1}
+			if err := ws.stemWord(&r); err != nil {
+				report(word, err)
+				continue
+			}
+			w.write(&r)
+		}
+		return status
+	}
+	if err := ws.stemWords(stdin, "-", w, report); err != nil {
+		fmt.Fprintf(stderr, "porter: %v\n", err)
+		return 1
+	}
+	return status
+}
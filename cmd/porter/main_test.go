@@ -0,0 +1,627 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRunStem(t *testing.T) {
+	var test = []struct {
+		args  []string
+		stdin string
+		want  string
+	}{
+		{[]string{"running", "jumped", "easily"}, "", "run\njump\neasili\n"},
+		{nil, "running\njumped easily\n", "run\njump\neasili\n"},
+		{nil, "The runners, (running).\n", "the\nrunner\nrun\n"},
+		{nil, "if ok {\n\trunning -- }\n", "if\nok\nrun\n"},
+		{[]string{"-stemmer", "kstem", "cities"}, "", "city\n"},
+		{[]string{"-stemmer", "lemma", "mice", "went"}, "", "mouse\ngo\n"},
+		{nil, "", ""},
+	}
+
+	for _, tc := range test {
+		var stdout, stderr bytes.Buffer
+		if status := runStem(tc.args, strings.NewReader(tc.stdin), &stdout, &stderr); status != 0 {
+			t.Errorf("%q: exit status %d: %s", tc.args, status, stderr.String())
+		}
+		if stdout.String() != tc.want {
+			t.Errorf("%q %q: want %q have %q", tc.args, tc.stdin, tc.want, stdout.String())
+		}
+	}
+}
+
+func TestRunStemFormats(t *testing.T) {
+	in := "The \"runners\" ran, running!\nrunners"
+	var test = []struct {
+		args []string
+		want string
+	}{
+		{[]string{"-format", "tsv"}, "word\tstem\nThe\tthe\nrunners\trunner\nran\tran\nrunning\trun\nrunners\trunner\n"},
+		{[]string{"-format", "tsv", "-fields", "offset,rules", "-unique"}, "word\tstem\tstart\tend\trules\tcount\n" +
+			"The\tthe\t0\t3\t\t1\n" +
+			"runners\trunner\t5\t12\tstep1ab:s->\t2\n" +
+			"ran\tran\t14\t17\t\t1\n" +
+			"running\trun\t19\t26\tstep1ab:ing-> step1ab:nn->n\t1\n"},
+		{[]string{"-format", "csv", "-fields", "file", "-stemmer", "s"}, "file,word,stem\n-,The,the\n-,runners,runner\n-,ran,ran\n-,running,running\n-,runners,runner\n"},
+		{[]string{"-format", "jsonl", "-fields", "offset,rules", "running", "cats"}, `{"word":"running","stem":"run","start":0,"end":7,"rules":["step1ab:ing->","step1ab:nn->n"]}` + "\n" +
+			`{"word":"cats","stem":"cat","start":0,"end":4,"rules":["step1ab:s->"]}` + "\n"},
+		{[]string{"-format", "csv", "a,b"}, "word,stem\n\"a,b\",\"a,b\"\n"},
+		{[]string{"-format", "tsv", "a\tb\\c\n"}, "word\tstem\na\\tb\\\\c\\n\ta\\tb\\\\c\\n\n"},
+	}
+
+	for _, tc := range test {
+		var stdout, stderr bytes.Buffer
+		if status := runStem(tc.args, strings.NewReader(in), &stdout, &stderr); status != 0 {
+			t.Errorf("%q: exit status %d: %s", tc.args, status, stderr.String())
+		}
+		if stdout.String() != tc.want {
+			t.Errorf("%q: want %q have %q", tc.args, tc.want, stdout.String())
+		}
+	}
+
+	for _, args := range [][]string{{"-format", "xml"}, {"-fields", "offset"}, {"-unique"}, {"-format", "tsv", "-fields", "pos"}, {"-format", "tsv", "-fields", "rules", "-stemmer", "s"}} {
+		var stdout, stderr bytes.Buffer
+		if status := runStem(args, strings.NewReader(in), &stdout, &stderr); status != 2 {
+			t.Errorf("%q: want exit status 2 have %d", args, status)
+		}
+	}
+}
+
+func TestRunStemInline(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	in := "The runners,\n  (running) ran.\n"
+	if status := runStem([]string{"-inline"}, strings.NewReader(in), &stdout, &stderr); status != 0 {
+		t.Fatalf("exit status %d: %s", status, stderr.String())
+	}
+	if want := "the runner,\n  (run) ran.\n"; stdout.String() != want {
+		t.Errorf("want %q have %q", want, stdout.String())
+	}
+	if status := runStem([]string{"-inline", "-format", "tsv"}, nil, &stdout, &stderr); status != 2 {
+		t.Errorf("want exit status 2 have %d", status)
+	}
+}
+
+func TestRunStemUnknownStemmer(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if status := runStem([]string{"-stemmer", "lancaster", "x"}, nil, &stdout, &stderr); status != 2 {
+		t.Errorf("want exit status 2 have %d", status)
+	}
+	if !strings.Contains(stderr.String(), "unknown stemmer") {
+		t.Errorf("want unknown stemmer error have %q", stderr.String())
+	}
+}
+
+func TestRunEval(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	status := runEval([]string{"-stemmer", "porter,s", "../../testdata/groups.txt"}, nil, &stdout, &stderr)
+	if status != 0 {
+		t.Fatalf("exit status %d: %s", status, stderr.String())
+	}
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("want 4 lines have %q", stdout.String())
+	}
+	for i, prefix := range []string{"stemmer", "porter", "s ", "33 groups"} {
+		if !strings.HasPrefix(lines[i], prefix) {
+			t.Errorf("line %d: want prefix %q have %q", i, prefix, lines[i])
+		}
+	}
+}
+
+func TestRunEvalStdin(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	in := "connect connected connection\ngeneral generally\ngenerate generated\n"
+	if status := runEval([]string{"-"}, strings.NewReader(in), &stdout, &stderr); status != 0 {
+		t.Fatalf("exit status %d: %s", status, stderr.String())
+	}
+	if !strings.Contains(stdout.String(), "porter   0.0000  0.2500") {
+		t.Errorf("unexpected output %q", stdout.String())
+	}
+}
+
+func TestRunEvalUsage(t *testing.T) {
+	for _, args := range [][]string{nil, {"a", "b"}, {"-stemmer", "nope", "x"}} {
+		var stdout, stderr bytes.Buffer
+		if status := runEval(args, nil, &stdout, &stderr); status != 2 {
+			t.Errorf("%q: want exit status 2 have %d", args, status)
+		}
+	}
+}
+
+func TestRunStats(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	in := "Connect, connected and connecting. The connection connects! Connect\n"
+	if status := runStats([]string{"-top", "1"}, strings.NewReader(in), &stdout, &stderr); status != 0 {
+		t.Fatalf("exit status %d: %s", status, stderr.String())
+	}
+	for _, want := range []string{
+		"tokens              8\n",
+		"unique words        7\n",
+		"unique stems        3\n",
+		"  connect  5  connect connected connecting connection connects\n",
+		"  step1ab  ed ->   1\n",
+		"  step4    ion ->  1\n",
+	} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Errorf("want %q in %q", want, stdout.String())
+		}
+	}
+}
+
+func TestRunStatsJSON(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	in := "ponies pony ponies cats\n"
+	args := []string{"-stemmer", "s", "-format", "json"}
+	if status := runStats(args, strings.NewReader(in), &stdout, &stderr); status != 0 {
+		t.Fatalf("exit status %d: %s", status, stderr.String())
+	}
+	var s corpusStats
+	if err := json.Unmarshal(stdout.Bytes(), &s); err != nil {
+		t.Fatalf("%v: %s", err, stdout.String())
+	}
+	if s.Tokens != 4 || s.Words != 3 || s.Stems != 2 || s.MeanClassSize != 1.5 {
+		t.Errorf("unexpected counts %+v", s)
+	}
+	if len(s.Classes) != 2 || s.Classes[0].Stem != "pony" || len(s.Classes[0].Words) != 2 {
+		t.Errorf("unexpected classes %+v", s.Classes)
+	}
+	if s.Rules != nil {
+		t.Errorf("want no rule histogram for the s stemmer have %+v", s.Rules)
+	}
+}
+
+func TestRunStatsUsage(t *testing.T) {
+	for _, args := range [][]string{{"-format", "xml"}, {"-stemmer", "nope"}} {
+		var stdout, stderr bytes.Buffer
+		if status := runStats(args, strings.NewReader(""), &stdout, &stderr); status != 2 {
+			t.Errorf("%q: want exit status 2 have %d", args, status)
+		}
+	}
+}
+
+func TestRunCount(t *testing.T) {
+	in := "The runner runs. Running runners run; the cat ran, cats run!\n"
+	var test = []struct {
+		args []string
+		want string
+	}{
+		{nil, "      4 run\n      2 cat\n      2 runner\n      2 the\n      1 ran\n"},
+		{[]string{"-top", "2"}, "      4 run\n      2 cat\n"},
+		{[]string{"-min-count", "2"}, "      4 run\n      2 cat\n      2 runner\n      2 the\n"},
+		{[]string{"-stemmer", "lemma", "-top", "1"}, "      4 run\n"},
+	}
+
+	for _, tc := range test {
+		// a tiny memory limit makes every new stem spill to disk
+		for _, memory := range []string{"1MB", "1B"} {
+			var stdout, stderr bytes.Buffer
+			args := append([]string{"-memory", memory}, tc.args...)
+			if status := runCount(args, strings.NewReader(in), &stdout, &stderr); status != 0 {
+				t.Errorf("%q: exit status %d: %s", args, status, stderr.String())
+			}
+			if stdout.String() != tc.want {
+				t.Errorf("%q: want %q have %q", args, tc.want, stdout.String())
+			}
+		}
+	}
+}
+
+func TestCountMergePasses(t *testing.T) {
+	// every new stem spills, and only three runs are merged at a time
+	dir := t.TempDir()
+	c := &counter{limit: 1, counts: map[string]int{}, sp: spiller{dir: dir, maxOpen: 3}}
+	for _, stem := range strings.Fields("e d c b a e d c b e d c e d e") {
+		if err := c.add(stem); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var have strings.Builder
+	err := writeCounts(c, 0, 1, func(e countEntry) error {
+		have.WriteString(e.stem + strings.Repeat("+", e.n) + " ")
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "e+++++ d++++ c+++ b++ a+ "; have.String() != want {
+		t.Errorf("want %q have %q", want, have.String())
+	}
+	// the runs merged in passes are removed
+	files, _ := os.ReadDir(dir)
+	if len(files) >= c.sp.n {
+		t.Errorf("want fewer than %d runs left, have %d", c.sp.n, len(files))
+	}
+}
+
+func TestByteSize(t *testing.T) {
+	var test = []struct {
+		in   string
+		want int64
+	}{
+		{"100", 100},
+		{"2K", 2 << 10},
+		{"64MB", 64 << 20},
+		{"1gb", 1 << 30},
+		{"0", -1},
+		{"MB", -1},
+	}
+
+	for _, tc := range test {
+		var s byteSize
+		err := s.Set(tc.in)
+		if tc.want < 0 {
+			if err == nil {
+				t.Errorf("'%s' want error have %d", tc.in, s)
+			}
+			continue
+		}
+		if err != nil || int64(s) != tc.want {
+			t.Errorf("'%s' want %d have %d (%v)", tc.in, tc.want, s, err)
+		}
+	}
+}
+
+func TestRunTop(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	in := "runs running ran run cats cat\nconnect connection\n"
+	if status := runTop([]string{"-n", "2"}, strings.NewReader(in), &stdout, &stderr); status != 0 {
+		t.Fatalf("exit status %d: %s", status, stderr.String())
+	}
+	want := "# 8 tokens, counts over by at most 0\n      3 run\n      2 cat\n\n"
+	if stdout.String() != want {
+		t.Errorf("want %q have %q", want, stdout.String())
+	}
+}
+
+// syncBuffer is a bytes.Buffer that can be read while runTop writes to it.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestRunTopFollow(t *testing.T) {
+	r, w := io.Pipe()
+	var stdout, stderr syncBuffer
+	done := make(chan int)
+	go func() {
+		done <- runTop([]string{"-follow", "-interval", "5ms", "-n", "1"}, r, &stdout, &stderr)
+	}()
+
+	// the first report comes while the input is still open
+	io.WriteString(w, "runs running cats\n")
+	deadline := time.Now().Add(5 * time.Second)
+	for !strings.Contains(stdout.String(), "# 3 tokens") {
+		if time.Now().After(deadline) {
+			t.Fatalf("no report while following, have %q", stdout.String())
+		}
+		time.Sleep(time.Millisecond)
+	}
+	io.WriteString(w, "cat cat\n")
+	w.Close()
+	if status := <-done; status != 0 {
+		t.Fatalf("exit status %d: %s", status, stderr.String())
+	}
+	if want := "# 5 tokens, counts over by at most 0\n      3 cat\n\n"; !strings.HasSuffix(stdout.String(), want) {
+		t.Errorf("want suffix %q have %q", want, stdout.String())
+	}
+}
+
+func TestRunFiles(t *testing.T) {
+	dir := t.TempDir()
+	texts := map[string]string{
+		"a.txt":     "The runners, (running).\n",
+		"b.txt":     "connected connections\n",
+		"sub/c.txt": "easily\n",
+		"sub/d.md":  "generalizations\n",
+	}
+	for name, text := range texts {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(text), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var test = []struct {
+		args []string
+		want string
+	}{
+		{[]string{dir}, "the\nrunner\nrun\nconnect\nconnect\neasili\ngener\n"},
+		{[]string{filepath.Join(dir, "sub", "*.txt"), filepath.Join(dir, "a.txt")}, "easili\nthe\nrunner\nrun\n"},
+		{[]string{"-stemmer", "light", filepath.Join(dir, "sub")}, "easili\ngeneralization\n"},
+		{[]string{"-inline", filepath.Join(dir, "a.txt"), filepath.Join(dir, "b.txt")}, "the runner, (run).\nconnect connect\n"},
+		{[]string{"-format", "tsv", "-fields", "file,offset", filepath.Join(dir, "sub")}, "file\tword\tstem\tstart\tend\n" +
+			filepath.Join(dir, "sub", "c.txt") + "\teasily\teasili\t0\t6\n" +
+			filepath.Join(dir, "sub", "d.md") + "\tgeneralizations\tgener\t0\t15\n"},
+	}
+
+	for _, tc := range test {
+		for _, j := range []string{"1", "3"} {
+			var stdout, stderr bytes.Buffer
+			args := append([]string{"-files", "-j", j}, tc.args...)
+			if status := runStem(args, nil, &stdout, &stderr); status != 0 {
+				t.Errorf("%q: exit status %d: %s", args, status, stderr.String())
+			}
+			if stdout.String() != tc.want {
+				t.Errorf("%q: want %q have %q", args, tc.want, stdout.String())
+			}
+		}
+	}
+}
+
+func TestRunFilesOutDir(t *testing.T) {
+	in, out := t.TempDir(), t.TempDir()
+	if err := os.WriteFile(filepath.Join(in, "a.txt"), []byte("Hopping ponies\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(in); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	var stdout, stderr bytes.Buffer
+	args := []string{"-files", "-outdir", out, "-progress", "."}
+	if status := runStem(args, nil, &stdout, &stderr); status != 0 {
+		t.Fatalf("exit status %d: %s", status, stderr.String())
+	}
+	if stdout.Len() != 0 {
+		t.Errorf("want no output on stdout have %q", stdout.String())
+	}
+	if !strings.Contains(stderr.String(), "1/1 files") {
+		t.Errorf("want progress have %q", stderr.String())
+	}
+	data, err := os.ReadFile(filepath.Join(out, "a.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "hop\nponi\n"; string(data) != want {
+		t.Errorf("want %q have %q", want, data)
+	}
+}
+
+func TestRunFilesSkip(t *testing.T) {
+	// like stdin, words that cannot be stemmed are reported and skipped
+	path := filepath.Join(t.TempDir(), "a.txt")
+	if err := os.WriteFile(path, []byte("taloissa ta\xfflo kissoja\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	var stdout, stderr bytes.Buffer
+	if status := runStem([]string{"-files", "-stemmer", "finnish", path}, nil, &stdout, &stderr); status != 1 {
+		t.Errorf("want exit status 1 have %d", status)
+	}
+	if want := "talo\nkiso\n"; stdout.String() != want {
+		t.Errorf("want %q have %q", want, stdout.String())
+	}
+	if want := path + `: "ta\xfflo"`; !strings.Contains(stderr.String(), want) {
+		t.Errorf("want %q in %q", want, stderr.String())
+	}
+}
+
+func TestRunFilesErrors(t *testing.T) {
+	for _, args := range [][]string{{"-files"}, {"-files", "no/such/file"}, {"-files", "no/such/*.txt"}} {
+		var stdout, stderr bytes.Buffer
+		if status := runStem(args, nil, &stdout, &stderr); status == 0 {
+			t.Errorf("%q: want an error", args)
+		}
+	}
+}
+
+func TestRunStemRecords(t *testing.T) {
+	var test = []struct {
+		args     []string
+		in, want string
+	}{
+		{
+			[]string{"-csv", "-columns", "body,title"},
+			"id,title,body,n\r\n1,\"The Runners, running\",\"He said \"\"hopping\"\"\nand ran\",  3 \r\n2,cats,,x\r\n3",
+			"id,title,body,n\r\n1,the runner run,he said hop and ran,  3 \r\n2,cat,,x\r\n3",
+		},
+		{
+			[]string{"-csv", "-columns", "b", "-stemmer", "s"},
+			"a,b\n\"x,y\",\"ponies, cats\"\n",
+			"a,b\n\"x,y\",pony cat\n",
+		},
+		{
+			[]string{"-csv", "-columns", "title"},
+			"id, \"title\"\n1, \"Running, cats\" \n2,  dogs\n",
+			"id, \"title\"\n1, run cat \n2,dog\n",
+		},
+		{
+			// longer than the default buffer of a bufio.Scanner
+			[]string{"-tsv", "-columns", "y"},
+			"y\n" + strings.Repeat("x", 70000) + "\n",
+			"y\n" + strings.Repeat("x", 70000) + "\n",
+		},
+		{
+			[]string{"-tsv", "-columns", "y"},
+			"x\ty\n\"Running\" cats\tdogs\n",
+			"x\ty\n\"Running\" cats\tdog\n",
+		},
+		{
+			[]string{"-jsonl", "-fields", "a.b,c"},
+			`{"a": {"b": "Running   fast!", "c": [1, {"b": "x"}]}, "c":"Cats été",  "d": 5}` + "\n\n" +
+				`{"c": 3, "b": "runs"}` + "\r\n" + `{"a": "runs"}`,
+			`{"a": {"b": "run fast", "c": [1, {"b": "x"}]}, "c":"cat été",  "d": 5}` + "\n\n" +
+				`{"c": 3, "b": "runs"}` + "\r\n" + `{"a": "runs"}`,
+		},
+	}
+
+	for _, tc := range test {
+		var stdout, stderr bytes.Buffer
+		if status := runStem(tc.args, strings.NewReader(tc.in), &stdout, &stderr); status != 0 {
+			t.Errorf("%q: exit status %d: %s", tc.args, status, stderr.String())
+		}
+		if stdout.String() != tc.want {
+			t.Errorf("%q: want %q have %q", tc.args, tc.want, stdout.String())
+		}
+	}
+}
+
+func TestRunStemRecordErrors(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	in := "{\"c\": \"cats\"}\nnot json\n[1]\n"
+	if status := runStem([]string{"-jsonl", "-fields", "c"}, strings.NewReader(in), &stdout, &stderr); status != 1 {
+		t.Errorf("want exit status 1 have %d", status)
+	}
+	if want := "{\"c\": \"cat\"}\nnot json\n[1]\n"; stdout.String() != want {
+		t.Errorf("want %q have %q", want, stdout.String())
+	}
+	if !strings.Contains(stderr.String(), "-:2:") || !strings.Contains(stderr.String(), "-:3: not a JSON object") {
+		t.Errorf("want errors for lines 2 and 3 have %q", stderr.String())
+	}
+
+	stdout.Reset()
+	stderr.Reset()
+	if status := runStem([]string{"-csv", "-columns", "nope"}, strings.NewReader("a,b\n"), &stdout, &stderr); status != 1 {
+		t.Errorf("want exit status 1 have %d", status)
+	}
+
+	for _, args := range [][]string{
+		{"-csv"},
+		{"-csv", "-tsv", "-columns", "a"},
+		{"-csv", "-fields", "a"},
+		{"-jsonl", "-columns", "a"},
+		{"-csv", "-columns", "a", "-format", "tsv"},
+		{"-columns", "a"},
+	} {
+		if status := runStem(args, strings.NewReader(""), &stdout, &stderr); status != 2 {
+			t.Errorf("%q: want exit status 2 have %d", args, status)
+		}
+	}
+}
+
+func TestRunRules(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if status := runRules(nil, nil, &stdout, &stderr); status != 0 {
+		t.Fatalf("exit status %d: %s", status, stderr.String())
+	}
+	// the text output is a rule file for the same rules
+	path := filepath.Join(t.TempDir(), "porter.rules")
+	if err := os.WriteFile(path, stdout.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	text := stdout.String()
+	stdout.Reset()
+	if status := runRules([]string{path}, nil, &stdout, &stderr); status != 0 {
+		t.Fatalf("exit status %d: %s", status, stderr.String())
+	}
+	if stdout.String() != text {
+		t.Errorf("want %q have %q", text, stdout.String())
+	}
+	if !strings.Contains(text, "step 1b2 after 1b\n\tat -> ate\n") {
+		t.Errorf("no step 1b2 in %q", text)
+	}
+
+	stdout.Reset()
+	if status := runRules([]string{"-format", "dot", "-title", "porter"}, nil, &stdout, &stderr); status != 0 {
+		t.Fatalf("exit status %d: %s", status, stderr.String())
+	}
+	for _, want := range []string{"digraph \"porter\" {\n", "\t\"1b\" -> \"1b2\" [label=\"if 1b applied\"];\n", "\t\"1b\" -> \"1c\" [label=\"otherwise\"];\n"} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Errorf("want %q in %q", want, stdout.String())
+		}
+	}
+}
+
+func TestRunRulesErrors(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bad.rules")
+	os.WriteFile(path, []byte("step 1\n(m>0 ing ->\n"), 0o644)
+	var test = []struct {
+		args   []string
+		status int
+		want   string
+	}{
+		{[]string{"-format", "html"}, 2, "porter rules: unknown format \"html\" (have text, markdown, dot)\n"},
+		{[]string{path}, 1, "porter rules: " + path + ": line 2: unbalanced parentheses\n"},
+	}
+	for _, tc := range test {
+		var stdout, stderr bytes.Buffer
+		if status := runRules(tc.args, nil, &stdout, &stderr); status != tc.status {
+			t.Errorf("%q: want status %d have %d", tc.args, tc.status, status)
+		}
+		if stderr.String() != tc.want {
+			t.Errorf("%q: want %q have %q", tc.args, tc.want, stderr.String())
+		}
+	}
+}
+
+func TestRunCoverage(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	in := "Feed the agreed, hopping (hopping) rabbits\n"
+	if status := runCoverage([]string{"-unused", "-examples", "1"}, strings.NewReader(in), &stdout, &stderr); status != 0 {
+		t.Fatalf("exit status %d: %s", status, stderr.String())
+	}
+	if want := "# 6 tokens, 5 unique words\n"; !strings.HasPrefix(stdout.String(), want) {
+		t.Errorf("want prefix %q have %q", want, stdout.String())
+	}
+	var line string
+	for _, l := range strings.Split(stdout.String(), "\n") {
+		if strings.Contains(l, "(m=1 and *o) -> e") {
+			line = strings.Join(strings.Fields(l), " ")
+		}
+	}
+	if want := "1b2 (m=1 and *o) -> e 1 0 1"; line != want {
+		t.Errorf("want %q have %q", want, line)
+	}
+	if strings.Contains(stdout.String(), "ing ->") {
+		t.Errorf("applied rule listed with -unused: %q", stdout.String())
+	}
+
+	stdout.Reset()
+	if status := runCoverage([]string{"-format", "json", "-examples", "1"}, strings.NewReader(in), &stdout, &stderr); status != 0 {
+		t.Fatalf("exit status %d: %s", status, stderr.String())
+	}
+	var report coverageReport
+	if err := json.Unmarshal(stdout.Bytes(), &report); err != nil {
+		t.Fatal(err)
+	}
+	for _, rc := range report.Rules {
+		if rc.Rule == "(*v*) ing ->" {
+			if rc.Passed != 2 || len(rc.Examples) != 1 || rc.Examples[0] != "hopping" {
+				t.Errorf("want 2 hopping have %d %q", rc.Passed, rc.Examples)
+			}
+		}
+	}
+}
+
+func TestRunCoverageRules(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "toy.rules")
+	os.WriteFile(path, []byte("step 1\n\t(*v*) ing ->\n\t(m>0) ly ->\n"), 0o644)
+	var stdout, stderr bytes.Buffer
+	if status := runCoverage([]string{"-rules", path}, strings.NewReader("walking quickly fly\n"), &stdout, &stderr); status != 0 {
+		t.Fatalf("exit status %d: %s", status, stderr.String())
+	}
+	want := "# 3 tokens, 3 unique words\n" +
+		"step  rule          matched  passed  failed  examples\n" +
+		"1     (*v*) ing ->  1        1       0       walking\n" +
+		"1     (m>0) ly ->   2        1       1       quickly\n"
+	if stdout.String() != want {
+		t.Errorf("want %q have %q", want, stdout.String())
+	}
+}
@@ -0,0 +1,387 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// This file implements stemming chosen fields of CSV, TSV and JSON Lines
+// input. Records are read one at a time and only the chosen fields are
+// rewritten: everything else, including quoting, white space and line
+// endings, is copied byte for byte.
+
+// stemText returns the stems of the words in text separated by spaces.
+func (ws *wordStemmer) stemText(text string, report func(word string, err error)) string {
+	var b strings.Builder
+	var split wordSplitter
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	// a word may be as long as the text, which is in memory anyway
+	scanner.Buffer(nil, len(text)+1)
+	scanner.Split(split.split)
+	r := &wordRecord{}
+	for scanner.Scan() {
+		r.word = scanner.Text()
+		if err := ws.stemWord(r); err != nil {
+			report(r.word, err)
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(r.stem)
+	}
+	return b.String()
+}
+
+// delimitedReader reads the records of CSV (RFC 4180, with quoted fields)
+// or TSV (without quoting) input, keeping their raw bytes.
+type delimitedReader struct {
+	r      *bufio.Reader
+	comma  byte
+	quotes bool // fields may be quoted, as in CSV
+}
+
+// read returns the next record, including its line ending, and the spans
+// of its fields in it. It returns io.EOF at the end of the input.
+func (d *delimitedReader) read() ([]byte, [][2]int, error) {
+	var raw []byte
+	for {
+		line, err := d.r.ReadBytes('\n')
+		raw = append(raw, line...)
+		if err == io.EOF && len(raw) > 0 {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		// a newline inside quotes is part of the field
+		if !d.quotes || bytes.Count(raw, []byte{'"'})%2 == 0 {
+			break
+		}
+	}
+
+	end := len(raw)
+	if end > 0 && raw[end-1] == '\n' {
+		end--
+		if end > 0 && raw[end-1] == '\r' {
+			end--
+		}
+	}
+	var spans [][2]int
+	start, quoted := 0, false
+	for i := 0; i < end; i++ {
+		switch c := raw[i]; {
+		case c == '"' && d.quotes:
+			quoted = !quoted
+		case c == d.comma && !quoted:
+			spans = append(spans, [2]int{start, i})
+			start = i + 1
+		}
+	}
+	spans = append(spans, [2]int{start, end})
+	return raw, spans, nil
+}
+
+// trim returns the span s of a field of raw without the spaces around its
+// quotes, as some CSV writers put a space after the comma. Fields that are
+// not quoted keep their spaces.
+func (d *delimitedReader) trim(raw []byte, s [2]int) [2]int {
+	if !d.quotes {
+		return s
+	}
+	i, j := s[0], s[1]
+	for i < j && raw[i] == ' ' {
+		i++
+	}
+	for j > i && raw[j-1] == ' ' {
+		j--
+	}
+	if j-i >= 2 && raw[i] == '"' && raw[j-1] == '"' {
+		return [2]int{i, j}
+	}
+	return s
+}
+
+// value returns the value of a raw field, without its quotes.
+func (d *delimitedReader) value(field []byte) string {
+	if d.quotes && len(field) >= 2 && field[0] == '"' && field[len(field)-1] == '"' {
+		return strings.ReplaceAll(string(field[1:len(field)-1]), `""`, `"`)
+	}
+	return string(field)
+}
+
+// encode returns v as a raw field, quoted if necessary. For TSV, tabs and
+// newlines cannot occur, as stemmed text only has single spaces.
+func (d *delimitedReader) encode(v string) string {
+	if d.quotes && (strings.ContainsAny(v, "\"\r\n"+string(d.comma)) || strings.HasPrefix(v, " ")) {
+		return `"` + strings.ReplaceAll(v, `"`, `""`) + `"`
+	}
+	return v
+}
+
+// stemDelimited stems the columns named in columns of the delimited input
+// in, whose first record names the columns, and writes it to out.
+func stemDelimited(d *delimitedReader, columns []string, ws *wordStemmer, out *bufio.Writer, report func(word string, err error)) error {
+	raw, spans, err := d.read()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	index := map[string]int{}
+	for i, s := range spans {
+		s = d.trim(raw, s)
+		index[d.value(raw[s[0]:s[1]])] = i
+	}
+	stem := make([]bool, len(spans))
+	for _, c := range columns {
+		i, ok := index[c]
+		if !ok {
+			return fmt.Errorf("no column %q", c)
+		}
+		stem[i] = true
+	}
+	out.Write(raw)
+
+	for {
+		raw, spans, err := d.read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		last := 0
+		for i, s := range spans {
+			if i >= len(stem) || !stem[i] {
+				continue
+			}
+			s = d.trim(raw, s)
+			out.Write(raw[last:s[0]])
+			out.WriteString(d.encode(ws.stemText(d.value(raw[s[0]:s[1]]), report)))
+			last = s[1]
+		}
+		out.Write(raw[last:])
+	}
+}
+
+// jsonStringSpans returns the spans of the string values of the object in
+// line at the dotted paths in paths, such as "a.b" for the field "b" of the
+// object in field "a". Values that are not strings are ignored.
+func jsonStringSpans(line []byte, paths map[string]bool) ([][2]int, error) {
+	dec := json.NewDecoder(bytes.NewReader(line))
+	dec.UseNumber()
+	var spans [][2]int
+
+	// skip reads the rest of an array or object whose opening delimiter
+	// has been read.
+	skip := func() error {
+		for depth := 1; depth > 0; {
+			t, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			switch t {
+			case json.Delim('{'), json.Delim('['):
+				depth++
+			case json.Delim('}'), json.Delim(']'):
+				depth--
+			}
+		}
+		return nil
+	}
+	// object reads the fields of an object whose '{' has been read.
+	var object func(prefix string) error
+	object = func(prefix string) error {
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			path := prefix + key.(string)
+			// the value starts after the white space and colon following the key
+			start := int(dec.InputOffset())
+			for start < len(line) && (line[start] == ':' || line[start] == ' ' || line[start] == '\t' || line[start] == '\r' || line[start] == '\n') {
+				start++
+			}
+			v, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			switch v := v.(type) {
+			case json.Delim:
+				if v == '{' {
+					err = object(path + ".")
+				} else {
+					err = skip()
+				}
+				if err != nil {
+					return err
+				}
+			case string:
+				if paths[path] {
+					spans = append(spans, [2]int{start, int(dec.InputOffset())})
+				}
+			}
+		}
+		_, err := dec.Token() // '}'
+		return err
+	}
+
+	t, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	if t != json.Delim('{') {
+		return nil, fmt.Errorf("not a JSON object")
+	}
+	if err := object(""); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("data after the JSON object")
+	}
+	return spans, nil
+}
+
+// stemJSONLines stems the string fields named in fields of each JSON
+// object in the JSON Lines input in and writes it to out. Lines that are
+// not JSON objects are copied unchanged and reported to bad.
+func stemJSONLines(in *bufio.Reader, fields []string, ws *wordStemmer, out *bufio.Writer, report func(word string, err error), bad func(line int, err error)) error {
+	paths := map[string]bool{}
+	for _, f := range fields {
+		paths[f] = true
+	}
+	var value bytes.Buffer
+	enc := json.NewEncoder(&value)
+	enc.SetEscapeHTML(false)
+	for n := 1; ; n++ {
+		line, err := in.ReadBytes('\n')
+		if len(line) == 0 && err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if len(bytes.TrimSpace(line)) == 0 {
+			out.Write(line)
+			continue
+		}
+		spans, jerr := jsonStringSpans(line, paths)
+		if jerr != nil {
+			bad(n, jerr)
+			out.Write(line)
+			continue
+		}
+		last := 0
+		for _, s := range spans {
+			var v string
+			json.Unmarshal(line[s[0]:s[1]], &v)
+			value.Reset()
+			enc.Encode(ws.stemText(v, report))
+			out.Write(line[last:s[0]])
+			out.Write(bytes.TrimSuffix(value.Bytes(), []byte{'\n'}))
+			last = s[1]
+		}
+		out.Write(line[last:])
+	}
+}
+
+// splitList splits a comma-separated list of names.
+func splitList(list string) []string {
+	var names []string
+	for _, n := range strings.Split(list, ",") {
+		if n = strings.TrimSpace(n); n != "" {
+			names = append(names, n)
+		}
+	}
+	return names
+}
+
+// runRecords stems the fields named in names of the records of the given
+// kind ("csv", "tsv" or "jsonl") read from the files in paths, or from
+// stdin if there are none, and writes the records to stdout.
+func runRecords(kind string, names []string, paths []string, ws *wordStemmer, stdin io.Reader, stdout, stderr io.Writer) int {
+	out := bufio.NewWriter(stdout)
+	defer out.Flush()
+	status := 0
+	report := func(word string, err error) {
+		fmt.Fprintf(stderr, "porter: %q: %v\n", word, err)
+		status = 1
+	}
+	process := func(name string, r io.Reader) error {
+		in := bufio.NewReader(r)
+		if kind == "jsonl" {
+			return stemJSONLines(in, names, ws, out, report, func(line int, err error) {
+				fmt.Fprintf(stderr, "porter: %s:%d: %v\n", name, line, err)
+				status = 1
+			})
+		}
+		d := &delimitedReader{r: in, comma: ',', quotes: true}
+		if kind == "tsv" {
+			d.comma, d.quotes = '\t', false
+		}
+		return stemDelimited(d, names, ws, out, report)
+	}
+
+	if len(paths) == 0 {
+		if err := process("-", stdin); err != nil {
+			fmt.Fprintf(stderr, "porter: %v\n", err)
+			return 1
+		}
+		return status
+	}
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			fmt.Fprintf(stderr, "porter: %v\n", err)
+			return 1
+		}
+		err = process(path, f)
+		f.Close()
+		if err != nil {
+			fmt.Fprintf(stderr, "porter: %s: %v\n", path, err)
+			return 1
+		}
+	}
+	return status
+}
+
+// runRecordsFlags checks the flags for -csv, -tsv and -jsonl and runs
+// runRecords.
+func runRecordsFlags(kinds []string, columns, fields, format string, unique, files bool, name string, paths []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	usage := func(msg string) int {
+		fmt.Fprintf(stderr, "porter: %s\n", msg)
+		return 2
+	}
+	if len(kinds) > 1 {
+		return usage("only one of -csv, -tsv and -jsonl can be used")
+	}
+	if format != "text" || unique || files {
+		return usage("-csv, -tsv and -jsonl cannot be used with -format, -unique or -files")
+	}
+	kind := kinds[0]
+	names := splitList(columns)
+	if kind == "jsonl" {
+		if columns != "" {
+			return usage("-jsonl takes -fields, not -columns")
+		}
+		names = splitList(fields)
+	} else if fields != "" {
+		return usage("-csv and -tsv take -columns, not -fields")
+	}
+	if len(names) == 0 {
+		return usage("no columns or fields to stem")
+	}
+	ws, err := newWordStemmer(name, &outputFormat{name: "text"})
+	if err != nil {
+		return usage(err.Error())
+	}
+	return runRecords(kind, names, paths, ws, stdin, stdout, stderr)
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/a2800276/porter"
+)
+
+// runRules implements "porter rules": it prints the rules of the Porter
+// stemmer, or of a rule file, as text, Markdown or a Graphviz graph.
+func runRules(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("porter rules", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	format := flags.String("format", "text", "output format: text (the rule file format), markdown or dot")
+	title := flags.String("title", "Porter stemmer rules", "title of the Markdown document or name of the graph")
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "usage: porter rules [-format text|markdown|dot] [-title title] [file]\n\n"+
+			"Prints the rules of the Porter stemmer, or those of the rule file.\n\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() > 1 {
+		flags.Usage()
+		return 2
+	}
+
+	rules := porter.PorterRules()
+	if flags.NArg() == 1 {
+		f, err := os.Open(flags.Arg(0))
+		if err != nil {
+			fmt.Fprintf(stderr, "porter rules: %v\n", err)
+			return 1
+		}
+		rules, err = porter.ParseRules(f)
+		f.Close()
+		if err != nil {
+			fmt.Fprintf(stderr, "porter rules: %s: %v\n", flags.Arg(0), err)
+			return 1
+		}
+	}
+
+	var err error
+	switch *format {
+	case "text":
+		err = writeRules(stdout, rules)
+	case "markdown":
+		err = rules.WriteMarkdown(stdout, *title)
+	case "dot":
+		err = rules.WriteDOT(stdout, *title)
+	default:
+		fmt.Fprintf(stderr, "porter rules: unknown format %q (have text, markdown, dot)\n", *format)
+		return 2
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "porter rules: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// writeRules writes rules in the rule file format read by
+// porter.ParseRules.
+func writeRules(w io.Writer, rules *porter.RuleSet) error {
+	b := bufio.NewWriter(w)
+	for i, s := range rules.Steps {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		b.WriteString("step " + s.Name)
+		if s.After != "" {
+			b.WriteString(" after " + s.After)
+		}
+		b.WriteByte('\n')
+		for _, r := range s.Rules {
+			fmt.Fprintf(b, "\t%s\n", r.String())
+		}
+	}
+	return b.Flush()
+}
@@ -0,0 +1,223 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"unicode/utf8"
+
+	"github.com/a2800276/porter"
+)
+
+// corpusStats are the results of "porter stats".
+type corpusStats struct {
+	Stemmer          string      `json:"stemmer"`
+	Tokens           int         `json:"tokens"`
+	Words            int         `json:"words"`
+	Stems            int         `json:"stems"`
+	MeanClassSize    float64     `json:"mean_class_size"`
+	MeanCharsRemoved float64     `json:"mean_chars_removed"`
+	Classes          []stemClass `json:"classes"`
+	Rules            []ruleCount `json:"rules,omitempty"`
+}
+
+// stemClass is a stem and the words conflated to it.
+type stemClass struct {
+	Stem  string   `json:"stem"`
+	Words []string `json:"words"`
+}
+
+// ruleCount is the number of tokens a Porter rule was applied to.
+type ruleCount struct {
+	Step  string `json:"step"`
+	Rule  string `json:"rule"`
+	Count int    `json:"count"`
+}
+
+// stepOrder sorts the rule histogram in the order the steps run.
+var stepOrder = map[string]int{
+	"step1ab": 0, "step1c": 1, "step2": 2, "step3": 3, "step4": 4, "step5": 5,
+}
+
+// runStats implements "porter stats": it stems every word of a corpus and
+// reports how strongly the stemmer conflates them.
+func runStats(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("porter stats", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	name := flags.String("stemmer", "porter", "stemmer to use: "+stemmerNames())
+	top := flags.Int("top", 10, "number of largest conflation classes to list")
+	format := flags.String("format", "text", "output format: text or json")
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "usage: porter stats [-stemmer name] [-top n] [-format text|json] [file ...]\n\n"+
+			"Reads the corpus from the files, or from standard input if there are none.\n\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *format != "text" && *format != "json" {
+		fmt.Fprintf(stderr, "porter stats: unknown format %q (have text, json)\n", *format)
+		return 2
+	}
+	stem, err := lookupStemmer(*name)
+	if err != nil {
+		fmt.Fprintf(stderr, "porter stats: %v\n", err)
+		return 2
+	}
+
+	counts := map[string]int{}
+	tokens, err := countWords(flags.Args(), stdin, counts)
+	if err != nil {
+		fmt.Fprintf(stderr, "porter stats: %v\n", err)
+		return 1
+	}
+
+	s, err := collectStats(*name, stem, counts, *top)
+	if err != nil {
+		fmt.Fprintf(stderr, "porter stats: %v\n", err)
+		return 1
+	}
+	s.Tokens = tokens
+
+	if *format == "json" {
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(s); err != nil {
+			fmt.Fprintf(stderr, "porter stats: %v\n", err)
+			return 1
+		}
+		return 0
+	}
+	writeStats(stdout, s)
+	return 0
+}
+
+// countWords adds the lowercased words, without the punctuation around
+// them, of the files in paths, or of stdin if there are none, to counts
+// and returns the number of words read.
+func countWords(paths []string, stdin io.Reader, counts map[string]int) (int, error) {
+	tokens := 0
+	count := func(r io.Reader) error {
+		scanner := bufio.NewScanner(r)
+		scanner.Split(bufio.ScanWords)
+		for scanner.Scan() {
+			if word := trimWord(scanner.Text()); word != "" {
+				counts[strings.ToLower(word)]++
+				tokens++
+			}
+		}
+		return scanner.Err()
+	}
+	if len(paths) == 0 {
+		return tokens, count(stdin)
+	}
+	for _, file := range paths {
+		f, err := os.Open(file)
+		if err != nil {
+			return tokens, err
+		}
+		err = count(f)
+		f.Close()
+		if err != nil {
+			return tokens, fmt.Errorf("%s: %v", file, err)
+		}
+	}
+	return tokens, nil
+}
+
+// collectStats stems the words counted in counts and summarizes the result,
+// keeping the top largest classes. The rule histogram is only collected for
+// the porter stemmer.
+func collectStats(name string, stem func(string) (string, error), counts map[string]int, top int) (*corpusStats, error) {
+	s := &corpusStats{Stemmer: name, Words: len(counts), Classes: []stemClass{}}
+	classes := map[string][]string{}
+	rules := map[[2]string]int{}
+	removed := 0
+	for w, n := range counts {
+		var stemmed string
+		var err error
+		if name == "porter" {
+			stemmed, err = porter.StemTrace(w, func(step, rule string) {
+				rules[[2]string{step, rule}] += n
+			})
+		} else {
+			stemmed, err = stem(w)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%q: %v", w, err)
+		}
+		classes[stemmed] = append(classes[stemmed], w)
+		removed += utf8.RuneCountInString(w) - utf8.RuneCountInString(stemmed)
+	}
+	s.Stems = len(classes)
+	if s.Stems > 0 {
+		s.MeanClassSize = float64(s.Words) / float64(s.Stems)
+		s.MeanCharsRemoved = float64(removed) / float64(s.Words)
+	}
+
+	for st, words := range classes {
+		sort.Strings(words)
+		s.Classes = append(s.Classes, stemClass{st, words})
+	}
+	sort.Slice(s.Classes, func(i, j int) bool {
+		a, b := s.Classes[i], s.Classes[j]
+		if len(a.Words) != len(b.Words) {
+			return len(a.Words) > len(b.Words)
+		}
+		return a.Stem < b.Stem
+	})
+	if len(s.Classes) > top {
+		s.Classes = s.Classes[:top]
+	}
+
+	for r, n := range rules {
+		s.Rules = append(s.Rules, ruleCount{r[0], r[1], n})
+	}
+	sort.Slice(s.Rules, func(i, j int) bool {
+		a, b := s.Rules[i], s.Rules[j]
+		if a.Step != b.Step {
+			return stepOrder[a.Step] < stepOrder[b.Step]
+		}
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		return a.Rule < b.Rule
+	})
+	return s, nil
+}
+
+// writeStats prints s as text.
+func writeStats(out io.Writer, s *corpusStats) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "stemmer\t%s\n", s.Stemmer)
+	fmt.Fprintf(w, "tokens\t%d\n", s.Tokens)
+	fmt.Fprintf(w, "unique words\t%d\n", s.Words)
+	fmt.Fprintf(w, "unique stems\t%d\n", s.Stems)
+	fmt.Fprintf(w, "mean class size\t%.2f\n", s.MeanClassSize)
+	fmt.Fprintf(w, "mean chars removed\t%.2f\n", s.MeanCharsRemoved)
+	w.Flush()
+
+	if len(s.Classes) > 0 {
+		fmt.Fprintf(out, "\nlargest classes:\n")
+		w = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		for _, c := range s.Classes {
+			fmt.Fprintf(w, "  %s\t%d\t%s\n", c.Stem, len(c.Words), strings.Join(c.Words, " "))
+		}
+		w.Flush()
+	}
+
+	if len(s.Rules) > 0 {
+		fmt.Fprintf(out, "\nrules (tokens):\n")
+		w = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		for _, r := range s.Rules {
+			fmt.Fprintf(w, "  %s\t%s\t%d\n", r.Step, r.Rule, r.Count)
+		}
+		w.Flush()
+	}
+}
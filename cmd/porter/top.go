@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/a2800276/porter"
+)
+
+// runTop implements "porter top": it tracks the most frequent stems of
+// standard input in constant memory with a porter.TopK. With -follow it
+// prints them every -interval while reading, for tailing logs, as well as
+// at the end of the input.
+func runTop(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("porter top", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	name := flags.String("stemmer", "porter", "stemmer to use: "+stemmerNames())
+	n := flags.Int("n", 10, "number of stems to print")
+	capacity := flags.Int("capacity", 1000, "number of stems to track; counts are over by at most tokens/capacity")
+	follow := flags.Bool("follow", false, "print the top stems every interval while reading")
+	interval := flags.Duration("interval", 2*time.Second, "how often to print with -follow")
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "usage: porter top [-stemmer name] [-n n] [-capacity n] [-follow [-interval d]]\n\n"+
+			"Reads from standard input, e.g. tail -f app.log | porter top -follow\n\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() > 0 || *capacity <= 0 || *interval <= 0 {
+		flags.Usage()
+		return 2
+	}
+	stem, err := lookupStemmer(*name)
+	if err != nil {
+		fmt.Fprintf(stderr, "porter top: %v\n", err)
+		return 2
+	}
+
+	top := porter.NewTopK(*capacity)
+	status := 0
+	add := func(line string) {
+		for _, w := range strings.Fields(line) {
+			word := trimWord(w)
+			if word == "" {
+				continue
+			}
+			stemmed, err := stem(word)
+			if err != nil {
+				fmt.Fprintf(stderr, "porter top: %q: %v\n", word, err)
+				status = 1
+				continue
+			}
+			top.Add(stemmed)
+		}
+	}
+
+	// lines are read in their own goroutine so that -follow can print
+	// while waiting for input
+	lines := make(chan string, 64)
+	errc := make(chan error, 1)
+	go func() {
+		scanner := bufio.NewScanner(stdin)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+		errc <- scanner.Err()
+		close(lines)
+	}()
+
+	var tick <-chan time.Time
+	if *follow {
+		ticker := time.NewTicker(*interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	printed := int64(-1)
+	for {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				if err := <-errc; err != nil {
+					fmt.Fprintf(stderr, "porter top: %v\n", err)
+					return 1
+				}
+				if printed != top.Total() {
+					writeTop(stdout, top, *n)
+				}
+				return status
+			}
+			add(line)
+		case <-tick:
+			if printed != top.Total() {
+				writeTop(stdout, top, *n)
+				printed = top.Total()
+			}
+		}
+	}
+}
+
+// writeTop prints the n most frequent stems tracked by top, preceded by a
+// line with the number of tokens and the largest possible error of the
+// counts, and followed by a blank line.
+func writeTop(out io.Writer, top *porter.TopK, n int) {
+	var maxErr int64
+	stems := top.Top(n)
+	for _, c := range stems {
+		if c.Error > maxErr {
+			maxErr = c.Error
+		}
+	}
+	w := bufio.NewWriter(out)
+	fmt.Fprintf(w, "# %d tokens, counts over by at most %d\n", top.Total(), maxErr)
+	for _, c := range stems {
+		fmt.Fprintf(w, "%7d %s\n", c.Count, c.Stem)
+	}
+	fmt.Fprintln(w)
+	w.Flush()
+}
@@ -0,0 +1,106 @@
+package porter
+
+import (
+	"slices"
+	"strings"
+)
+
+// RuleCoverage is how often a rule was tried on the words stemmed by a
+// Coverage. A rule is tried when it has the longest suffix of its step
+// that the word ends in, and before it, no rule with the same suffix was
+// applied; it is then applied if its condition holds for the stem.
+type RuleCoverage struct {
+	Step string // the name of the step of the rule
+	Rule Rule
+	// Matched is the number of words the rule was tried on, Passed the
+	// number of them it was applied to and Failed the number of them whose
+	// stem failed its condition.
+	Matched, Passed, Failed int
+	// Examples are the first words the rule was applied to, up to
+	// Coverage.Examples of them.
+	Examples []string
+}
+
+// Coverage stems words with a RuleSet and counts, for each rule, how often
+// its suffix matched and how often its condition passed or failed, to see
+// which rules the words of a corpus exercise.
+//
+// Coverage runs the rule engine of RuleSet on its Rules, not the
+// hand-coded steps of Stem: the trace hook of the stemmer only reports the
+// rules it applied, not those whose condition failed. For PorterRules the
+// two give the same stems and apply the same rules, which the tests check
+// on the vocabulary, but a difference between them would not show up in
+// the counts.
+//
+// The zero value uses PorterRules and keeps no example words.
+//
+// Example:
+//
+//	c := porter.Coverage{Examples: 3}
+//	for _, word := range words {
+//	    c.Add(word, 1)
+//	}
+//	for _, r := range c.Report() {
+//	    fmt.Println(r.Step, r.Rule.String(), r.Passed, r.Failed, r.Examples)
+//	}
+type Coverage struct {
+	// Rules are the rules to stem with; nil means PorterRules.
+	Rules *RuleSet
+	// Examples is the number of example words to keep for each rule.
+	Examples int
+
+	counts [][]RuleCoverage // by step and rule
+}
+
+// Add stems word, counting it as n occurrences, and returns its stem.
+func (c *Coverage) Add(word string, n int) (string, error) {
+	if c.Rules == nil {
+		c.Rules = PorterRules()
+	}
+	if c.counts == nil {
+		c.counts = make([][]RuleCoverage, len(c.Rules.Steps))
+		for i, s := range c.Rules.Steps {
+			c.counts[i] = make([]RuleCoverage, len(s.Rules))
+			for j, r := range s.Rules {
+				c.counts[i][j] = RuleCoverage{Step: s.Name, Rule: r}
+			}
+		}
+	}
+	if word == "" {
+		return "", nil
+	}
+	word = strings.ToLower(word)
+	z := stemmer{b: []byte(word)}
+	bn := c.Rules.stem(&z, nil, func(step, rule int, passed bool) {
+		rc := &c.counts[step][rule]
+		rc.Matched += n
+		if !passed {
+			rc.Failed += n
+			return
+		}
+		rc.Passed += n
+		if len(rc.Examples) < c.Examples && !slices.Contains(rc.Examples, word) {
+			rc.Examples = append(rc.Examples, word)
+		}
+	})
+	if bn >= 0 && bn < len(z.b) {
+		return string(z.b[:bn+1]), nil
+	}
+	return "", ErrInvalidInput
+}
+
+// Report returns the counts for every rule, in the order of the rules, with
+// zero counts for the rules that were never tried.
+func (c *Coverage) Report() []RuleCoverage {
+	if c.counts == nil {
+		c.Add("", 0)
+	}
+	var report []RuleCoverage
+	for _, step := range c.counts {
+		for _, rc := range step {
+			rc.Examples = slices.Clone(rc.Examples)
+			report = append(report, rc)
+		}
+	}
+	return report
+}
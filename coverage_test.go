@@ -0,0 +1,96 @@
+package porter
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestCoverage(t *testing.T) {
+	c := Coverage{Examples: 2}
+	for _, w := range []string{"hopping", "Running", "falling", "feed", "agreed", "running"} {
+		c.Add(w, 2)
+	}
+	have := map[string]string{}
+	for _, rc := range c.Report() {
+		have[rc.Step+" "+rc.Rule.String()] = fmt.Sprintf("%d %d %d %s", rc.Matched, rc.Passed, rc.Failed, strings.Join(rc.Examples, " "))
+	}
+	var coverageTests = []stemmerTest{
+		{"1b (m>0) eed -> ee", "4 2 2 agreed"},
+		{"1b (*v*) ing ->", "8 8 0 hopping running"},
+		{"1b2 (*d and not (*L or *S or *Z)) -> single letter", "10 6 4 hopping running"},
+		{"1b2 (m=1 and *o) -> e", "4 0 4 "},
+		{"1a s ->", "0 0 0 "},
+	}
+	for _, test := range coverageTests {
+		if have[test.in] != test.out {
+			t.Errorf("'%s' want '%s' have '%s'\n", test.in, test.out, have[test.in])
+		}
+	}
+	if n := len(c.Report()); n != len(have) {
+		t.Errorf("want %d rules have %d\n", len(have), n)
+	}
+}
+
+func TestCoverageStems(t *testing.T) {
+	var c Coverage
+	for _, test := range tests {
+		stemmed, err := c.Add(test.in, 1)
+		if err != nil {
+			t.Errorf("'%s' unexpected error: %v\n", test.in, err)
+			continue
+		}
+		if stemmed != test.out {
+			t.Errorf("'%s' want '%s' have '%s'\n", test.in, test.out, stemmed)
+		}
+	}
+	for _, rc := range c.Report() {
+		if rc.Matched != rc.Passed+rc.Failed {
+			t.Errorf("'%s' matched %d, passed %d, failed %d\n", rc.Rule.String(), rc.Matched, rc.Passed, rc.Failed)
+		}
+	}
+}
+
+// TestCoverageTrace checks that the rules Coverage counts as applied to a
+// word are the ones the stemmer reports to StemTrace, as Coverage runs the
+// rule engine rather than the stemmer.
+func TestCoverageTrace(t *testing.T) {
+	for _, test := range tests {
+		var c Coverage
+		c.Add(test.in, 1)
+		var applied []string
+		for _, rc := range c.Report() {
+			switch {
+			case rc.Passed == 0 || rc.Rule.Suffix != "" && rc.Rule.Suffix == rc.Rule.Replacement:
+				// the stemmer does not report rules that change nothing
+			case rc.Rule.Undouble:
+				applied = append(applied, "single letter")
+			default:
+				applied = append(applied, strings.TrimSpace(rc.Rule.Suffix+" -> "+rc.Rule.Replacement))
+			}
+		}
+		var traced []string
+		StemTrace(test.in, func(step, rule string) {
+			if len(rule) == 7 && rule[0] == rule[1] && rule[1] == rule[6] && rule[2:6] == " -> " {
+				rule = "single letter"
+			}
+			traced = append(traced, rule)
+		})
+		if a, tr := strings.Join(applied, ", "), strings.Join(traced, ", "); a != tr {
+			t.Errorf("'%s' coverage applied '%s' stemmer traced '%s'\n", test.in, a, tr)
+		}
+	}
+}
+
+func ExampleCoverage() {
+	c := Coverage{Examples: 1}
+	for _, w := range []string{"relational", "rational", "national"} {
+		c.Add(w, 1)
+	}
+	for _, rc := range c.Report() {
+		if rc.Matched > 0 && rc.Step == "2" {
+			fmt.Println(rc.Rule.String(), rc.Passed, rc.Failed, rc.Examples)
+		}
+	}
+	// Output: (m>0) ational -> ate 1 2 [relational]
+}
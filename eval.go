@@ -0,0 +1,216 @@
+package porter
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+// This file implements the evaluation of stemmers against manually grouped
+// word families described in:
+//
+//	Paice, 1994, An Evaluation Method for Stemming Algorithms, Proceedings
+//	of ACM SIGIR '94, pp 42-50
+//
+// Every pair of words in the same group should be conflated and every pair
+// from different groups should not. The Understemming Index is the share of
+// pairs from the same group that a stemmer fails to conflate, and the
+// Overstemming Index the share of pairs from different groups that it
+// conflates anyway. Both depend on how aggressive a stemmer is, so ERRT
+// compares them with those of simply truncating words to a fixed length.
+
+// PaiceScores are the results of evaluating a stemmer on grouped words.
+type PaiceScores struct {
+	Groups int // number of groups
+	Words  int // number of words
+
+	GDMT float64 // global desired merge total: pairs in the same group
+	GDNT float64 // global desired non-merge total: pairs from different groups
+	GUMT float64 // global unachieved merge total: same-group pairs not conflated
+	GWMT float64 // global wrongly-merged total: different-group pairs conflated
+
+	UI   float64 // understemming index, GUMT/GDMT
+	OI   float64 // overstemming index, GWMT/GDNT
+	SW   float64 // stemming weight, OI/UI
+	ERRT float64 // error rate relative to truncation
+}
+
+// ReadWordGroups reads word groups for EvaluatePaice from r: one group per
+// line, with its words separated by white space. Blank lines and lines
+// starting with '#' are ignored. Words are lowercased.
+func ReadWordGroups(r io.Reader) ([][]string, error) {
+	var groups [][]string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		groups = append(groups, strings.Fields(strings.ToLower(line)))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// paiceCounts computes the four totals for the words in groups, given the
+// stem of each word in the same layout.
+func paiceCounts(groups, stems [][]string) (s PaiceScores) {
+	for _, g := range groups {
+		s.Words += len(g)
+	}
+	s.Groups = len(groups)
+
+	// stem -> group -> number of words
+	byStem := map[string]map[int]int{}
+	for i, g := range groups {
+		n := float64(len(g))
+		s.GDMT += 0.5 * n * (n - 1)
+		s.GDNT += 0.5 * n * float64(s.Words-len(g))
+
+		inGroup := map[string]int{}
+		for j := range g {
+			stem := stems[i][j]
+			inGroup[stem]++
+			if byStem[stem] == nil {
+				byStem[stem] = map[int]int{}
+			}
+			byStem[stem][i]++
+		}
+		for _, u := range inGroup {
+			s.GUMT += 0.5 * float64(u) * (n - float64(u))
+		}
+	}
+	for _, groups := range byStem {
+		total := 0
+		for _, v := range groups {
+			total += v
+		}
+		for _, v := range groups {
+			s.GWMT += 0.5 * float64(v) * float64(total-v)
+		}
+	}
+
+	if s.GDMT > 0 {
+		s.UI = s.GUMT / s.GDMT
+	}
+	if s.GDNT > 0 {
+		s.OI = s.GWMT / s.GDNT
+	}
+	if s.UI > 0 {
+		s.SW = s.OI / s.UI
+	}
+	return s
+}
+
+// stemGroups applies stem to every word in groups.
+func stemGroups(groups [][]string, stem func(string) (string, error)) ([][]string, error) {
+	stems := make([][]string, len(groups))
+	for i, g := range groups {
+		stems[i] = make([]string, len(g))
+		for j, w := range g {
+			s, err := stem(w)
+			if err != nil {
+				return nil, fmt.Errorf("stemming %q: %w", w, err)
+			}
+			stems[i][j] = s
+		}
+	}
+	return stems, nil
+}
+
+// truncationLine returns the (UI, OI) points of truncating the words in
+// groups to 1, 2, ... characters, up to the length of the longest word.
+func truncationLine(groups [][]string) [][2]float64 {
+	longest := 0
+	for _, g := range groups {
+		for _, w := range g {
+			if n := len([]rune(w)); n > longest {
+				longest = n
+			}
+		}
+	}
+	var line [][2]float64
+	for n := 1; n <= longest; n++ {
+		stems, _ := stemGroups(groups, func(w string) (string, error) {
+			if r := []rune(w); len(r) > n {
+				return string(r[:n]), nil
+			}
+			return w, nil
+		})
+		s := paiceCounts(groups, stems)
+		line = append(line, [2]float64{s.UI, s.OI})
+	}
+	return line
+}
+
+// errt returns the distance of (ui, oi) from the origin relative to that of
+// the point where the ray from the origin through it crosses the truncation
+// line.
+func errt(ui, oi float64, line [][2]float64) float64 {
+	if ui == 0 && oi == 0 {
+		return 0
+	}
+	if len(line) < 2 {
+		return math.NaN()
+	}
+	// cross is > 0 for points to the left of the ray
+	cross := func(p [2]float64) float64 { return ui*p[1] - oi*p[0] }
+
+	// find the segment that the ray crosses; if it misses the line, extend
+	// the segment at the end it passes
+	seg := 0
+	if cross(line[0]) > 0 {
+		for seg = 0; seg < len(line)-2; seg++ {
+			if cross(line[seg+1]) <= 0 {
+				break
+			}
+		}
+	}
+	a, b := line[seg], line[seg+1]
+
+	// intersect the ray t*(ui, oi) with the line a + u*(b-a)
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	denom := ui*dy - oi*dx
+	if denom == 0 {
+		return math.NaN()
+	}
+	t := (a[0]*dy - a[1]*dx) / denom
+	if t <= 0 {
+		return math.NaN()
+	}
+	return 1 / t
+}
+
+// EvaluatePaice evaluates stem on groups of words that should be conflated
+// with each other but not with the words of other groups, such as those
+// read by ReadWordGroups, and returns Paice's measures.
+//
+// A good stemmer has both a low UI and a low OI; SW shows which of the two
+// it trades for the other. An ERRT below 1 means the stemmer does better
+// than truncating words to a fixed length.
+//
+// Example:
+//
+//	groups := [][]string{
+//	    {"connect", "connected", "connection"},
+//	    {"general", "generally"},
+//	    {"generate", "generated"},
+//	}
+//	scores, err := porter.EvaluatePaice(groups, porter.Stem)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("UI %.3f OI %.3f\n", scores.UI, scores.OI)
+func EvaluatePaice(groups [][]string, stem func(string) (string, error)) (PaiceScores, error) {
+	stems, err := stemGroups(groups, stem)
+	if err != nil {
+		return PaiceScores{}, err
+	}
+	s := paiceCounts(groups, stems)
+	s.ERRT = errt(s.UI, s.OI, truncationLine(groups))
+	return s, nil
+}
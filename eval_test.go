@@ -0,0 +1,115 @@
+package porter
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestReadWordGroups(t *testing.T) {
+	in := "# comment\nConnect connected\n\n  general   generally \n"
+	groups, err := ReadWordGroups(strings.NewReader(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]string{{"connect", "connected"}, {"general", "generally"}}
+	if fmt.Sprint(groups) != fmt.Sprint(want) {
+		t.Errorf("want: %v have: %v", want, groups)
+	}
+}
+
+func TestEvaluatePaice(t *testing.T) {
+	groups := [][]string{{"cat", "cats"}, {"dog", "dogs", "doggy"}}
+	stems := map[string]string{"cat": "cat", "cats": "cat", "dog": "dog", "dogs": "dog", "doggy": "cat"}
+	s, err := EvaluatePaice(groups, func(w string) (string, error) { return stems[w], nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// "doggy" is not merged with "dog" and "dogs" (2 pairs), but is merged
+	// with "cat" and "cats" (2 pairs)
+	want := PaiceScores{Groups: 2, Words: 5, GDMT: 4, GDNT: 6, GUMT: 2, GWMT: 2, UI: 0.5, OI: 1.0 / 3, SW: 2.0 / 3}
+	s.ERRT = 0
+	if s != want {
+		t.Errorf("want: %+v have: %+v", want, s)
+	}
+}
+
+func TestEvaluatePaiceError(t *testing.T) {
+	errStem := errors.New("no")
+	_, err := EvaluatePaice([][]string{{"a", "b"}}, func(string) (string, error) { return "", errStem })
+	if !errors.Is(err, errStem) {
+		t.Errorf("want %v have %v", errStem, err)
+	}
+}
+
+func TestERRT(t *testing.T) {
+	line := [][2]float64{{0, 1}, {0.5, 0.5}, {1, 0}}
+	var test = []struct {
+		ui, oi, want float64
+	}{
+		{0.25, 0.25, 0.5}, // half way to (0.5, 0.5)
+		{0.5, 0.5, 1},     // on the line
+		{0.5, 0, 0.5},     // along the x axis to (1, 0)
+		{0, 0, 0},
+	}
+
+	for _, tc := range test {
+		if have := errt(tc.ui, tc.oi, line); math.Abs(have-tc.want) > 1e-9 {
+			t.Errorf("errt(%v, %v) want: %v have: %v", tc.ui, tc.oi, tc.want, have)
+		}
+	}
+}
+
+func TestEvaluatePaiceGroupsFile(t *testing.T) {
+	f, err := os.Open("testdata/groups.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	groups, err := ReadWordGroups(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scores := map[string]PaiceScores{}
+	for name, stem := range map[string]func(string) (string, error){
+		"porter": Stem,
+		"light":  StemLight,
+		"s":      StemS,
+		"none":   func(w string) (string, error) { return w, nil },
+	} {
+		s, err := EvaluatePaice(groups, stem)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.UI < 0 || s.UI > 1 || s.OI < 0 || s.OI > 1 || math.IsNaN(s.ERRT) {
+			t.Errorf("%s: scores out of range: %+v", name, s)
+		}
+		scores[name] = s
+	}
+
+	// not stemming at all understems everything and overstems nothing
+	if none := scores["none"]; none.UI != 1 || none.OI != 0 {
+		t.Errorf("none: want UI 1 OI 0 have: %+v", none)
+	}
+	// the lighter the stemmer, the more it understems and the less it
+	// overstems
+	if p, l, s := scores["porter"], scores["light"], scores["s"]; !(p.UI < l.UI && l.UI < s.UI) || !(p.OI > l.OI && l.OI >= s.OI) {
+		t.Errorf("want UI porter < light < s and OI porter > light >= s, have:\n%+v\n%+v\n%+v", p, l, s)
+	}
+}
+
+func ExampleEvaluatePaice() {
+	groups := [][]string{
+		{"connect", "connected", "connection"},
+		{"general", "generally"},
+		{"generate", "generated"},
+	}
+	scores, _ := EvaluatePaice(groups, Stem)
+	fmt.Printf("UI %.3f OI %.3f\n", scores.UI, scores.OI)
+	// Output: UI 0.000 OI 0.250
+}
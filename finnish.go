@@ -0,0 +1,431 @@
+package porter
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// This file implements the Snowball stemming algorithm for Finnish, see:
+//
+//	http://snowballstem.org/algorithms/finnish/stemmer.html
+//
+// Finnish is agglutinative: a word may carry a particle, a possessive
+// suffix and a case ending all at once (e.g. "taloissammekin", "in our
+// houses, too"), so the algorithm strips them in that order, each from
+// the end of whatever the previous step left over. The structure mirrors
+// the English stemmer: a state struct, one method per step, and suffix
+// tests that note where the matched suffix starts.
+
+var (
+	fiParticles = []string{
+		"kin", "kaan", "kään", "ko", "kö", "han", "hän", "pa", "pä", "sti",
+	}
+	fiPossessives = []string{
+		"si", "ni", "nsa", "nsä", "mme", "nne", "an", "än", "en",
+	}
+	fiCases = []string{
+		"han", "hen", "hin", "hon", "hän", "hön", "siin", "seen",
+		"den", "tten", "n",
+		"a", "ä", "tta", "ttä", "ta", "tä",
+		"ssa", "ssä", "sta", "stä",
+		"lla", "llä", "lta", "ltä", "lle",
+		"na", "nä", "ksi", "ine",
+	}
+	fiOtherEndings = []string{
+		"mpi", "mpa", "mpä", "mmi", "mma", "mmä",
+		"impi", "impa", "impä", "immi", "imma", "immä",
+		"eja", "ejä",
+	}
+	fiIPlurals = []string{"i", "j"}
+	fiTPlurals = []string{"mma", "imma"}
+	fiLongs    = []string{"aa", "ee", "ii", "oo", "uu", "ää", "öö"}
+
+	// case endings that may precede the vowel-length possessives -an, -än
+	// and -en, e.g. "talossaan" (in his house) = talo + ssa + an.
+	fiBeforeAn = []string{"ta", "ssa", "sta", "lla", "lta", "na"}
+	fiBeforeÄn = []string{"tä", "ssä", "stä", "llä", "ltä", "nä"}
+	fiBeforeEn = []string{"lle", "ine"}
+)
+
+// fiV1 is true for the Finnish vowels, including 'y'.
+func fiV1(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'y', 'ä', 'ö':
+		return true
+	}
+	return false
+}
+
+// fiV2 is true for the Finnish vowels excluding 'y'.
+func fiV2(r rune) bool {
+	return r != 'y' && fiV1(r)
+}
+
+// fiAEI is true for the vowels tidy() removes after a consonant.
+func fiAEI(r rune) bool {
+	switch r {
+	case 'a', 'ä', 'e', 'i':
+		return true
+	}
+	return false
+}
+
+// fiC is true for the Finnish consonants tidy() removes a vowel after and
+// undoubles.
+func fiC(r rune) bool {
+	return r < utf8.RuneSelf && strings.IndexByte("bcdfghjklmnpqrstvwxz", byte(r)) >= 0
+}
+
+// fiParticleEnd is true for the letters that may precede a clitic particle.
+func fiParticleEnd(r rune) bool {
+	return fiV1(r) || r == 'n' || r == 't'
+}
+
+// finnishStemmer holds the state for stemming a single Finnish word.
+type finnishStemmer struct {
+	b             []rune // the word, shortened as suffixes are removed
+	p1, p2        int    // start of the regions R1 and R2
+	endingRemoved bool   // set once caseEnding() removed a case ending
+}
+
+// z.endsAt(end, s, limit) is true if b[:end] ends with s and the suffix
+// starts at or after limit. It returns the start of the suffix.
+func (z *finnishStemmer) endsAt(end int, s string, limit int) (int, bool) {
+	i := end
+	for len(s) > 0 {
+		r, size := utf8.DecodeLastRuneInString(s)
+		i--
+		if i < limit || z.b[i] != r {
+			return 0, false
+		}
+		s = s[:len(s)-size]
+	}
+	return i, true
+}
+
+// z.among(limit, suffixes, cond) finds the longest of suffixes that ends
+// the word, starts at or after limit and, if cond is not nil, satisfies
+// cond. It returns the suffix and its start, or "" and -1.
+func (z *finnishStemmer) among(limit int, suffixes []string, cond func(s string, start int) bool) (string, int) {
+	match, start := "", -1
+	for _, s := range suffixes {
+		i, ok := z.endsAt(len(z.b), s, limit)
+		if !ok || (start >= 0 && i >= start) {
+			continue
+		}
+		if cond != nil && !cond(s, i) {
+			continue
+		}
+		match, start = s, i
+	}
+	return match, start
+}
+
+// z.endsAmong(end, suffixes) is true if b[:end] ends with one of suffixes.
+func (z *finnishStemmer) endsAmong(end int, suffixes []string) bool {
+	for _, s := range suffixes {
+		if _, ok := z.endsAt(end, s, 0); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// z.long(end, limit) is true if b[:end] ends with a double vowel that
+// starts at or after limit.
+func (z *finnishStemmer) long(end, limit int) bool {
+	for _, s := range fiLongs {
+		if _, ok := z.endsAt(end, s, limit); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// z.vi(end, limit) is true if b[:end] ends with 'i' preceded by a vowel
+// other than 'y', both at or after limit.
+func (z *finnishStemmer) vi(end, limit int) bool {
+	return end-2 >= limit && z.b[end-1] == 'i' && fiV2(z.b[end-2])
+}
+
+// z.before(pos, r) is true if the letter just before pos is r.
+func (z *finnishStemmer) before(pos int, r rune) bool {
+	return pos > 0 && z.b[pos-1] == r
+}
+
+// z.markRegions() sets p1 to the position after the first non-vowel that
+// follows a vowel, and p2 to the same position counted from p1. Either
+// region is empty if there is no such position.
+func (z *finnishStemmer) markRegions() {
+	z.p1 = len(z.b)
+	z.p2 = len(z.b)
+	p, ok := z.regionAfter(0)
+	if !ok {
+		return
+	}
+	z.p1 = p
+	if p, ok = z.regionAfter(p); ok {
+		z.p2 = p
+	}
+}
+
+func (z *finnishStemmer) regionAfter(i int) (int, bool) {
+	for i < len(z.b) && !fiV1(z.b[i]) {
+		i++
+	}
+	for i < len(z.b) && fiV1(z.b[i]) {
+		i++
+	}
+	if i == len(z.b) {
+		return 0, false
+	}
+	return i + 1, true
+}
+
+// z.particleEtc() removes the clitics -kin, -kaan, -ko, -han, -pa etc.
+// and the adverb ending -sti, e.g.
+//
+// taloko       ->  talo
+// tehokkaasti  ->  tehokkaa
+func (z *finnishStemmer) particleEtc() {
+	if len(z.b) < z.p1 {
+		return
+	}
+	s, start := z.among(z.p1, fiParticles, nil)
+	switch {
+	case start < 0:
+		return
+	case s == "sti":
+		if start < z.p2 {
+			return
+		}
+	default:
+		if start == 0 || !fiParticleEnd(z.b[start-1]) {
+			return
+		}
+	}
+	z.b = z.b[:start]
+}
+
+// z.possessive() removes possessive suffixes, e.g.
+//
+// taloni     ->  talo
+// talossaan  ->  taloss
+// kanssani   ->  kanssa
+// kokseni    ->  koksi
+func (z *finnishStemmer) possessive() {
+	if len(z.b) < z.p1 {
+		return
+	}
+	s, start := z.among(z.p1, fiPossessives, nil)
+	switch s {
+	case "":
+		return
+	case "si":
+		// leave -ksi, the translative case, alone
+		if z.before(start, 'k') {
+			return
+		}
+	case "ni":
+		z.b = z.b[:start]
+		// kseni = ksi + ni
+		if i, ok := z.endsAt(start, "kse", 0); ok {
+			z.b = append(z.b[:i], 'k', 's', 'i')
+		}
+		return
+	case "an":
+		if !z.endsAmong(start, fiBeforeAn) {
+			return
+		}
+	case "än":
+		if !z.endsAmong(start, fiBeforeÄn) {
+			return
+		}
+	case "en":
+		if !z.endsAmong(start, fiBeforeEn) {
+			return
+		}
+	}
+	z.b = z.b[:start]
+}
+
+// z.caseEnding() removes the endings of the grammatical cases, e.g.
+//
+// taloon    ->  talo   (illative)
+// talossa   ->  talo   (inessive)
+// talolla   ->  talo   (adessive)
+// taloa     ->  talo   (partitive)
+func (z *finnishStemmer) caseEnding() {
+	if len(z.b) < z.p1 {
+		return
+	}
+	s, start := z.among(z.p1, fiCases, func(s string, start int) bool {
+		switch s {
+		case "siin", "den", "tten":
+			return z.vi(start, z.p1)
+		case "seen":
+			return z.long(start, z.p1)
+		}
+		return true
+	})
+	switch s {
+	case "":
+		return
+	case "han", "hen", "hin", "hon", "hän", "hön":
+		// illative: the vowel before -h?n must match the one in it
+		v, _ := utf8.DecodeRuneInString(s[1:])
+		if !z.before(start, v) {
+			return
+		}
+	case "n":
+		// -Vn after a long vowel is an illative, and -en after -i a
+		// genitive plural; take the preceding letter along.
+		if start > 0 && z.long(start, 0) {
+			start--
+		} else if _, ok := z.endsAt(start, "ie", 0); ok {
+			start--
+		}
+	case "a", "ä":
+		if start < 2 || !fiV1(z.b[start-1]) || fiV1(z.b[start-2]) {
+			return
+		}
+	case "tta", "ttä":
+		if !z.before(start, 'e') {
+			return
+		}
+	}
+	z.b = z.b[:start]
+	z.endingRemoved = true
+}
+
+// z.otherEndings() removes comparative forms and the agent ending -eja
+// from R2, e.g.
+//
+// tehokkaampi  ->  tehokkaa
+func (z *finnishStemmer) otherEndings() {
+	if len(z.b) < z.p2 {
+		return
+	}
+	s, start := z.among(z.p2, fiOtherEndings, nil)
+	switch s {
+	case "":
+		return
+	case "mpi", "mpa", "mpä", "mmi", "mma", "mmä":
+		if _, ok := z.endsAt(start, "po", 0); ok {
+			return
+		}
+	}
+	z.b = z.b[:start]
+}
+
+// z.iPlural() removes the plural marker -i or -j left over after a case
+// ending has been removed.
+func (z *finnishStemmer) iPlural() {
+	if len(z.b) < z.p1 {
+		return
+	}
+	if _, start := z.among(z.p1, fiIPlurals, nil); start >= 0 {
+		z.b = z.b[:start]
+	}
+}
+
+// z.tPlural() removes the nominative plural marker -t, and then the
+// -mma ending it leaves behind, when no case ending was removed.
+func (z *finnishStemmer) tPlural() {
+	n := len(z.b)
+	if n < z.p1 || n-2 < z.p1 || z.b[n-1] != 't' || !fiV1(z.b[n-2]) {
+		return
+	}
+	z.b = z.b[:n-1]
+	if len(z.b) < z.p2 {
+		return
+	}
+	s, start := z.among(z.p2, fiTPlurals, nil)
+	switch s {
+	case "":
+		return
+	case "mma":
+		if _, ok := z.endsAt(start, "po", 0); ok {
+			return
+		}
+	}
+	z.b = z.b[:start]
+}
+
+// z.tidy() cleans up what the previous steps left: it undoubles a final
+// long vowel, drops a final -a, -ä, -e or -i after a consonant, handles
+// -oj, -uj and -jo, and finally undoubles the last consonant.
+func (z *finnishStemmer) tidy() {
+	if len(z.b) < z.p1 {
+		return
+	}
+	if n := len(z.b); z.long(n, z.p1) {
+		z.b = z.b[:n-1]
+	}
+	if n := len(z.b); n-2 >= z.p1 && fiAEI(z.b[n-1]) && fiC(z.b[n-2]) {
+		z.b = z.b[:n-1]
+	}
+	if n := len(z.b); n-2 >= z.p1 && z.b[n-1] == 'j' && (z.b[n-2] == 'o' || z.b[n-2] == 'u') {
+		z.b = z.b[:n-1]
+	}
+	if n := len(z.b); n-2 >= z.p1 && z.b[n-1] == 'o' && z.b[n-2] == 'j' {
+		z.b = z.b[:n-1]
+	}
+
+	i := len(z.b) - 1
+	for i >= 0 && fiV1(z.b[i]) {
+		i--
+	}
+	if i > 0 && fiC(z.b[i]) && z.b[i] == z.b[i-1] {
+		z.b = append(z.b[:i], z.b[i+1:]...)
+	}
+}
+
+// z.stem(b) stems the word b and returns the stemmed word. b is reused
+// for the result.
+func (z *finnishStemmer) stem(b []rune) []rune {
+	z.b = b
+	z.endingRemoved = false
+	z.markRegions()
+
+	z.particleEtc()
+	z.possessive()
+	z.caseEnding()
+	z.otherEndings()
+	if z.endingRemoved {
+		z.iPlural()
+	} else {
+		z.tPlural()
+	}
+	z.tidy()
+	return z.b
+}
+
+// StemFinnish stems the given Finnish word and returns the stemmed form as
+// a string, using the Snowball Finnish algorithm.
+//
+// The input word is converted to lowercase before stemming. It must be
+// valid UTF-8, since Finnish relies on the letters 'ä' and 'ö'.
+//
+// Empty input is valid and returns an empty string with no error.
+//
+// Returns the stemmed word and nil error on success, or an empty string and
+// ErrInvalidInput if the word is not valid UTF-8.
+//
+// Example:
+//
+//	stemmed, err := porter.StemFinnish("taloissamme")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	// stemmed is "talo"
+func StemFinnish(word string) (string, error) {
+	if word == "" {
+		return "", nil
+	}
+	if !utf8.ValidString(word) {
+		return "", ErrInvalidInput
+	}
+	var z finnishStemmer
+	return string(z.stem([]rune(strings.ToLower(word)))), nil
+}
@@ -0,0 +1,339 @@
+package porter
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestStemFinnish(t *testing.T) {
+	for _, test := range finnishTests {
+		stemmed, err := StemFinnish(test.in)
+		if err != nil {
+			t.Errorf("'%s' unexpected error: %v\n", test.in, err)
+			continue
+		}
+		if stemmed != test.out {
+			t.Errorf("'%s' want '%s' have '%s'\n", test.in, test.out, stemmed)
+		}
+	}
+}
+
+func TestFinnishRegions(t *testing.T) {
+	var test = map[string][2]int{
+		"talo":      {3, 4},
+		"kala":      {3, 4},
+		"ihminen":   {2, 5},
+		"kaupunki":  {4, 6},
+		"yö":        {2, 2},
+		"strategia": {5, 7},
+	}
+
+	for term, want := range test {
+		z := finnishStemmer{b: []rune(term)}
+		z.markRegions()
+		if z.p1 != want[0] || z.p2 != want[1] {
+			t.Errorf("markRegions(%s) want: %v have: [%d %d]", term, want, z.p1, z.p2)
+		}
+	}
+}
+
+func TestStemFinnishCase(t *testing.T) {
+	stemmed, err := StemFinnish("TALOISSAMMEKIN")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stemmed != "talo" {
+		t.Errorf("want 'talo' have '%s'", stemmed)
+	}
+}
+
+func TestStemFinnishNonLetters(t *testing.T) {
+	// tidy only removes a vowel after a consonant and undoubles consonants,
+	// like Snowball; digits and punctuation are neither
+	for _, test := range []stemmerTest{
+		{"talo1a", "talo1a"},
+		{"auto-e", "auto-e"},
+		{"ilta-a", "ilta-a"},
+		{"talo11", "talo11"},
+		{"kirja22", "kirja22"},
+		{"puhelin--", "puhelin--"},
+	} {
+		stemmed, err := StemFinnish(test.in)
+		if err != nil {
+			t.Errorf("'%s' unexpected error: %v\n", test.in, err)
+			continue
+		}
+		if stemmed != test.out {
+			t.Errorf("'%s' want '%s' have '%s'\n", test.in, test.out, stemmed)
+		}
+	}
+}
+
+func TestStemFinnishError(t *testing.T) {
+	stemmed, err := StemFinnish("")
+	if err != nil || stemmed != "" {
+		t.Errorf("StemFinnish(\"\") = %q, %v", stemmed, err)
+	}
+	if _, err := StemFinnish("talo\xff"); err != ErrInvalidInput {
+		t.Errorf("StemFinnish(invalid UTF-8) want ErrInvalidInput, have %v", err)
+	}
+}
+
+func ExampleStemFinnish() {
+	stemmed, _ := StemFinnish("taloissamme")
+	fmt.Println(stemmed)
+	// Output: talo
+}
+
+var finnishTests = []stemmerTest{
+	{"a", "a"},
+	{"aa", "aa"},
+	{"auringossa", "auringo"},
+	{"aurinkollaan", "aurinko"},
+	{"ei", "ei"},
+	{"hallituksessa", "hallituks"},
+	{"hattussa", "hatu"},
+	{"hatussa", "hatu"},
+	{"he", "he"},
+	{"helposti", "helpost"},
+	{"helppon", "helpo"},
+	{"hevosia", "hevos"},
+	{"hevosten", "hevost"},
+	{"huoneessa", "huone"},
+	{"huonesi", "huone"},
+	{"hän", "hän"},
+	{"ihan", "iha"},
+	{"ihminenmpaa", "ihminen"},
+	{"ihmisiä", "ihmis"},
+	{"ikkuna", "iku"},
+	{"ikkunaiden", "ikkun"},
+	{"joka", "joka"},
+	{"jokin", "jok"},
+	{"junaissa", "juna"},
+	{"junassakaan", "juna"},
+	{"junauja", "junau"},
+	{"järveen", "järv"},
+	{"kadulla", "kadu"},
+	{"kanssani", "kan"},
+	{"katolla", "kato"},
+	{"kattokaan", "kato"},
+	{"kattosti", "kattost"},
+	{"katua", "katu"},
+	{"katuna", "katu"},
+	{"kauneimmat", "kauneim"},
+	{"kauneudessa", "kauneud"},
+	{"kauneuskaan", "kauneusk"},
+	{"kauniimpi", "kauniimp"},
+	{"kaunisstaan", "kaunis"},
+	{"kauppaan", "kaup"},
+	{"kauppanä", "kaup"},
+	{"kauppapä", "kaup"},
+	{"kaupungeissa", "kaupung"},
+	{"kaupunkien", "kaupunk"},
+	{"kieleen", "kiele"},
+	{"kieliiksi", "kieli"},
+	{"kielinsä", "kieli"},
+	{"kielitko", "kieli"},
+	{"kirjastolla", "kirjasto"},
+	{"kirjastotä", "kirjasto"},
+	{"kirjoja", "kirj"},
+	{"kirjojen", "kirj"},
+	{"kissoineen", "kiso"},
+	{"kivellä", "kive"},
+	{"ko", "ko"},
+	{"koirineen", "koir"},
+	{"kokseni", "koks"},
+	{"koneella", "kone"},
+	{"kouluseen", "koulus"},
+	{"kukaan", "kuka"},
+	{"kukkastä", "kuk"},
+	{"kukkia", "kuk"},
+	{"kukko", "kuko"},
+	{"kukolla", "kuko"},
+	{"kuninkaalle", "kunink"},
+	{"kuninkaat", "kunink"},
+	{"kuninkaiden", "kunink"},
+	{"kuvalleen", "kuva"},
+	{"kuvansa", "kuva"},
+	{"kysymyksiä", "kysymyks"},
+	{"kysymyssti", "kysymys"},
+	{"käsiina", "käsi"},
+	{"käsinsa", "käsi"},
+	{"käteni", "käte"},
+	{"laissa", "lais"},
+	{"lakiille", "laki"},
+	{"lasten", "last"},
+	{"laukkussakaan", "lauku"},
+	{"laukussa", "lauku"},
+	{"lentokoneeja", "lentokon"},
+	{"lentokoneella", "lentokon"},
+	{"lentokonehan", "lentokon"},
+	{"lentokonehin", "lentokonehin"},
+	{"lentokonehon", "lentokonehon"},
+	{"lentokonehän", "lentokon"},
+	{"lentokoneiden", "lentokon"},
+	{"lentokoneien", "lentokon"},
+	{"lentokoneihin", "lentokon"},
+	{"lentokoneiin", "lentokon"},
+	{"lentokoneiksi", "lentokon"},
+	{"lentokoneilla", "lentokon"},
+	{"lentokoneille", "lentokon"},
+	{"lentokoneimmat", "lentokon"},
+	{"lentokoneina", "lentokon"},
+	{"lentokoneine", "lentokon"},
+	{"lentokoneissa", "lentokon"},
+	{"lentokoneissamme", "lentokon"},
+	{"lentokoneissammekin", "lentokon"},
+	{"lentokoneissä", "lentokon"},
+	{"lentokoneista", "lentokon"},
+	{"lentokoneitten", "lentokon"},
+	{"lentokoneja", "lentokon"},
+	{"lentokonejen", "lentokonej"},
+	{"lentokonejä", "lentokon"},
+	{"lentokonekaan", "lentokon"},
+	{"lentokonekin", "lentokon"},
+	{"lentokoneko", "lentokon"},
+	{"lentokoneksemme", "lentokoneks"},
+	{"lentokonekseni", "lentokon"},
+	{"lentokoneksi", "lentokon"},
+	{"lentokonekään", "lentokon"},
+	{"lentokonekö", "lentokon"},
+	{"lentokonellaan", "lentokon"},
+	{"lentokonellakin", "lentokon"},
+	{"lentokonelleen", "lentokon"},
+	{"lentokonemmat", "lentokon"},
+	{"lentokonemme", "lentokon"},
+	{"lentokonemmekin", "lentokon"},
+	{"lentokonempaa", "lentokon"},
+	{"lentokonempi", "lentokon"},
+	{"lentokoneni", "lentokon"},
+	{"lentokonenne", "lentokon"},
+	{"lentokonensa", "lentokon"},
+	{"lentokonensä", "lentokon"},
+	{"lentokoneoja", "lentokoneo"},
+	{"lentokonepa", "lentokon"},
+	{"lentokonepä", "lentokon"},
+	{"lentokoneseen", "lentokones"},
+	{"lentokonesi", "lentokon"},
+	{"lentokonessakaan", "lentokon"},
+	{"lentokonessamme", "lentokon"},
+	{"lentokonessani", "lentokon"},
+	{"lentokonestaan", "lentokon"},
+	{"lentokonesti", "lentokon"},
+	{"lentokonet", "lentokon"},
+	{"lentokonetko", "lentokon"},
+	{"lentokoneuja", "lentokoneu"},
+	{"lumessa", "lume"},
+	{"maihin", "maih"},
+	{"me", "me"},
+	{"meressä", "mere"},
+	{"metsäimmat", "metsäim"},
+	{"metsään", "mets"},
+	{"miehiä", "mieh"},
+	{"miesimmat", "miesim"},
+	{"miesjen", "miesj"},
+	{"miesssa", "mies"},
+	{"miesten", "miest"},
+	{"mikään", "mikä"},
+	{"ministerikaan", "minister"},
+	{"ministerimmekin", "minister"},
+	{"minä", "minä"},
+	{"mäellä", "mäel"},
+	{"mäkikö", "mäki"},
+	{"nainenksemme", "nainenks"},
+	{"naisia", "nais"},
+	{"naisten", "naist"},
+	{"niittykö", "niity"},
+	{"niittymmat", "niittym"},
+	{"niityllä", "niity"},
+	{"nopeampi", "nopeamp"},
+	{"nopeita", "nope"},
+	{"oikeudessa", "oikeud"},
+	{"onnellinenissammekin", "onnellinen"},
+	{"opettajakö", "opettaj"},
+	{"ovesta", "ove"},
+	{"ovista", "ov"},
+	{"pallomme", "palo"},
+	{"papalla", "papa"},
+	{"paremmin", "parem"},
+	{"pellolla", "pelo"},
+	{"perheessä", "perh"},
+	{"perheä", "perh"},
+	{"pieniilla", "pieni"},
+	{"pieniko", "pieni"},
+	{"po", "po"},
+	{"poikien", "poik"},
+	{"pojat", "poja"},
+	{"pojiksi", "poj"},
+	{"pojille", "poj"},
+	{"presidentille", "president"},
+	{"presidenttiihin", "president"},
+	{"presidenttiksemme", "presidenttiks"},
+	{"presidenttimpi", "president"},
+	{"puhelimella", "puhelim"},
+	{"puuhun", "puuhu"},
+	{"puuiin", "puuiin"},
+	{"päivähän", "päivä"},
+	{"päiväiksi", "päivä"},
+	{"päivälle", "päivä"},
+	{"päiväoja", "päiväo"},
+	{"päivässa", "päivä"},
+	{"päivään", "päivä"},
+	{"pöydällä", "pöydä"},
+	{"pöytältä", "pöytä"},
+	{"rakkautta", "rakkaut"},
+	{"rannalla", "ran"},
+	{"rantapa", "ran"},
+	{"runoiksi", "runo"},
+	{"saarella", "saare"},
+	{"sadeitten", "sade"},
+	{"sadensa", "sade"},
+	{"sairaalla", "saira"},
+	{"sanalleen", "sana"},
+	{"sanapä", "sana"},
+	{"sateessa", "sate"},
+	{"sinä", "sinä"},
+	{"soihin", "soih"},
+	{"suomalainen", "suomalain"},
+	{"suomalaisten", "suomalaist"},
+	{"suomea", "suome"},
+	{"suomessa", "suome"},
+	{"suomia", "suom"},
+	{"suurempi", "suuremp"},
+	{"sängyssä", "sängy"},
+	{"taivaalla", "taiva"},
+	{"taivasssakaan", "taivas"},
+	{"taivasta", "taiva"},
+	{"taksit", "taks"},
+	{"te", "te"},
+	{"tiehen", "tiehe"},
+	{"tiellaan", "tiel"},
+	{"tietokoneella", "tietokon"},
+	{"totuudessa", "totuud"},
+	{"tuulessa", "tuule"},
+	{"tuuliä", "tuul"},
+	{"tyttöinä", "tytö"},
+	{"työllakin", "työl"},
+	{"tähdellä", "tähd"},
+	{"töihin", "töih"},
+	{"uutta", "uut"},
+	{"vaikeaiin", "vaikea"},
+	{"vaikeita", "vaike"},
+	{"vanhoja", "vanho"},
+	{"vapaudessa", "vapaud"},
+	{"vapaus", "vapaus"},
+	{"vapautta", "vapaut"},
+	{"vastauksia", "vastauks"},
+	{"vedessä", "vede"},
+	{"veli", "veli"},
+	{"veljelle", "velj"},
+	{"veteen", "vete"},
+	{"vuorella", "vuore"},
+	{"ystävyydessä", "ystävyyd"},
+	{"ystävyysille", "ystävyys"},
+	{"ystäväkään", "ystäv"},
+	{"yöhan", "yöha"},
+	{"ä", "ä"},
+	{"äidille", "äid"},
+	{"öihin", "öih"},
+}
@@ -0,0 +1,17180 @@
+// Code generated by stemtablegen from frequent_words.txt. DO NOT EDIT.
+
+package porter
+
+func init() { frequentStems = &frequentStemTable }
+
+// frequentStemTable has the stems of the 15543 most frequent words of
+// frequent_words.txt of three letters or more.
+var frequentStemTable = stemTable{
+	maxLen: 16,
+	seeds: []uint16{
+		19, 23, 4, 14, 19, 38, 1, 2, 1, 1, 27, 4, 51, 2, 3, 0,
+		2, 27, 4, 50, 4, 1, 4, 17, 0, 1, 9, 4, 2, 1, 12, 34,
+		16, 2, 22, 52, 0, 49, 48, 42, 1, 13, 0, 0, 5, 31, 4, 35,
+		1, 1, 2, 22, 1, 3, 26, 7, 2, 1, 6, 2, 1, 23, 12, 46,
+		0, 46, 2, 19, 0, 1, 45, 4, 6, 1, 7, 3, 1, 2, 40, 4,
+		22, 34, 3, 17, 16, 25, 11, 2, 1, 90, 1, 3, 40, 2, 2, 3,
+		6, 0, 4, 54, 1, 2, 24, 10, 3, 3, 2, 0, 2, 0, 1, 15,
+		15, 11, 2, 20, 38, 14, 15, 4, 4, 1, 2, 1, 6, 2, 1, 3,
+		27, 0, 31, 2, 1, 2, 2, 8, 4, 0, 2, 6, 24, 16, 7, 4,
+		6, 2, 17, 6, 4, 12, 28, 1, 25, 2, 0, 43, 6, 4, 2, 1,
+		6, 7, 2, 14, 5, 0, 34, 1, 9, 6, 25, 1, 1, 7, 1, 53,
+		4, 6, 53, 1, 4, 59, 2, 12, 0, 19, 59, 26, 7, 3, 7, 6,
+		109, 56, 6, 21, 7, 17, 0, 14, 21, 1, 28, 7, 8, 4, 10, 12,
+		20, 38, 2, 1, 3, 16, 6, 7, 17, 4, 25, 6, 4, 13, 5, 0,
+		56, 1, 19, 44, 1, 4, 108, 3, 56, 4, 0, 10, 9, 34, 19, 20,
+		1, 11, 65, 7, 1, 8, 9, 6, 19, 2, 2, 7, 31, 1, 1, 4,
+		1, 32, 7, 0, 10, 0, 26, 12, 32, 8, 14, 6, 1, 2, 2, 1,
+		1, 0, 27, 11, 44, 5, 13, 54, 11, 3, 2, 1, 26, 5, 8, 3,
+		44, 36, 4, 0, 9, 9, 16, 45, 40, 18, 26, 13, 41, 1, 6, 1,
+		17, 0, 1, 3, 22, 8, 6, 61, 31, 6, 0, 16, 43, 0, 1, 1,
+		9, 4, 2, 2, 0, 1, 7, 1, 8, 22, 19, 0, 4, 5, 64, 5,
+		39, 2, 0, 6, 2, 4, 42, 25, 45, 0, 20, 11, 13, 3, 5, 20,
+		20, 35, 25, 6, 17, 34, 140, 1, 3, 3, 12, 17, 8, 11, 15, 9,
+		5, 1, 19, 5, 13, 3, 0, 15, 24, 34, 1, 6, 3, 2, 21, 1,
+		11, 181, 8, 1, 16, 6, 32, 4, 19, 2, 4, 20, 17, 35, 1, 9,
+		2, 26, 17, 35, 29, 4, 1, 129, 9, 1, 1, 1, 4, 0, 2, 1,
+		7, 11, 16, 4, 3, 2, 17, 1, 3, 6, 52, 0, 33, 1, 11, 2,
+		77, 1, 7, 9, 2, 1, 11, 32, 1, 52, 1, 79, 14, 8, 1, 3,
+		1, 5, 63, 34, 1, 2, 18, 3, 1, 8, 61, 50, 12, 77, 2, 50,
+		1, 2, 1, 15, 59, 4, 19, 39, 1, 21, 1, 1, 0, 57, 4, 77,
+		27, 73, 12, 9, 21, 0, 18, 16, 2, 13, 41, 3, 0, 38, 2, 2,
+		2, 10, 4, 27, 1, 1, 27, 12, 0, 22, 10, 4, 1, 0, 1, 0,
+		16, 0, 62, 8, 9, 3, 3, 3, 2, 1, 6, 25, 9, 0, 18, 1,
+		52, 7, 1, 2, 2, 25, 4, 0, 1, 1, 12, 2, 3, 47, 3, 1,
+		0, 6, 46, 2, 66, 2, 8, 0, 1, 0, 1, 5, 38, 2, 0, 40,
+		1, 1, 39, 1, 10, 2, 7, 9, 1, 6, 39, 4, 5, 12, 42, 15,
+		1, 1, 1, 22, 3, 11, 44, 5, 18, 6, 41, 5, 1, 1, 3, 35,
+		65, 3, 23, 18, 16, 14, 57, 9, 13, 33, 3, 22, 4, 9, 3, 20,
+		3, 44, 14, 49, 28, 3, 35, 9, 3, 7, 3, 1, 31, 8, 2, 65,
+		2, 12, 3, 1, 2, 1, 29, 2, 1, 7, 12, 5, 5, 15, 3, 12,
+		5, 64, 2, 17, 2, 2, 2, 2, 104, 2, 30, 1, 76, 7, 1, 8,
+		135, 3, 17, 8, 9, 2, 48, 0, 33, 0, 3, 30, 1, 2, 34, 5,
+		5, 2, 20, 9, 1, 10, 13, 0, 57, 3, 1, 7, 5, 29, 4, 22,
+		1, 2, 1, 15, 1, 3, 49, 5, 40, 17, 20, 7, 3, 1, 4, 2,
+		5, 8, 3, 3, 3, 8, 39, 5, 26, 27, 4, 13, 2, 18, 2, 22,
+		4, 23, 9, 9, 1, 6, 5, 54, 2, 15, 96, 21, 24, 11, 2, 2,
+		6, 1, 7, 5, 22, 7, 5, 4, 66, 50, 25, 0, 30, 6, 3, 1,
+		14, 14, 4, 7, 27, 5, 15, 3, 5, 4, 0, 5, 2, 0, 12, 11,
+		47, 4, 22, 5, 43, 1, 3, 14, 3, 1, 1, 1, 10, 9, 2, 4,
+		14, 57, 26, 20, 1, 2, 4, 5, 10, 7, 6, 3, 11, 2, 8, 3,
+		5, 11, 16, 31, 1, 6, 8, 2, 16, 28, 8, 31, 2, 3, 75, 18,
+		1, 2, 17, 34, 49, 311, 3, 1, 58, 45, 28, 40, 22, 4, 83, 20,
+		0, 1, 9, 27, 15, 6, 1, 16, 12, 1, 21, 1, 13, 37, 48, 8,
+		22, 32, 24, 1, 20, 1, 33, 10, 46, 1, 7, 60, 100, 65, 21, 0,
+		0, 5, 19, 0, 67, 0, 9, 15, 57, 17, 1, 28, 15, 22, 26, 13,
+		5, 17, 23, 6, 7, 17, 1, 2, 13, 0, 3, 30, 0, 20, 3, 17,
+		8, 0, 10, 73, 6, 33, 6, 21, 21, 5, 83, 3, 24, 106, 4, 27,
+		34, 0, 1, 75, 14, 5, 23, 5, 37, 10, 0, 10, 11, 7, 1, 48,
+		1, 1, 0, 24, 2, 49, 42, 7, 6, 99, 13, 27, 1, 16, 19, 6,
+		10, 0, 173, 1, 26, 4, 9, 2, 1, 2, 5, 1, 9, 2, 5, 3,
+		6, 1, 0, 4, 40, 8, 1, 17, 23, 4, 0, 2, 0, 50, 10, 16,
+		3, 3, 11, 11, 2, 47, 50, 37, 2, 10, 2, 1, 1, 6, 0, 1,
+		8, 22, 164, 0, 1, 21, 26, 2, 19, 3, 6, 9, 0, 28, 23, 9,
+		2, 6, 5, 12, 38, 1, 35, 4, 41, 1, 5, 1, 2, 31, 24, 5,
+		2, 2, 9, 43, 20, 52, 7, 0, 14, 18, 2, 2, 2, 1, 2, 12,
+		34, 2, 3, 52, 1, 29, 28, 55, 15, 32, 1, 28, 11, 1, 73, 1,
+		14, 20, 5, 11, 6, 5, 11, 10, 1, 55, 3, 43, 136, 3, 4, 5,
+		11, 0, 0, 1, 49, 3, 1, 81, 1, 7, 3, 3, 58, 29, 4, 35,
+		8, 26, 20, 13, 0, 36, 167, 6, 38, 1, 7, 2, 1, 1, 0, 4,
+		3, 34, 3, 7, 20, 1, 4, 57, 66, 1, 1, 2, 11, 2, 0, 6,
+		2, 1, 16, 16, 5, 2, 2, 4, 22, 9, 13, 2, 52, 8, 30, 4,
+		19, 25, 1, 34, 45, 0, 9, 21, 0, 93, 13, 3, 2, 3, 1, 23,
+		37, 27, 5, 25, 38, 35, 53, 9, 51, 100, 0, 3, 12, 0, 2, 27,
+		82, 31, 1, 19, 4, 4, 61, 10, 19, 42, 7, 8, 0, 4, 1, 1,
+		50, 8, 35, 5, 1, 9, 4, 4, 1, 5, 47, 5, 0, 7, 8, 7,
+		3, 14, 8, 66, 25, 2, 10, 7, 2, 7, 2, 1, 7, 5, 13, 51,
+		9, 1, 2, 23, 4, 0, 4, 24, 0, 43, 15, 8, 1, 4, 2, 3,
+		11, 31, 7, 82, 3, 10, 18, 53, 74, 37, 3, 18, 0, 12, 1, 25,
+		10, 2, 63, 24, 7, 14, 44, 17, 0, 2, 37, 2, 16, 8, 35, 1,
+		84, 32, 7, 61, 17, 4, 16, 47, 2, 1, 51, 0, 30, 9, 12, 121,
+		2, 3, 23, 28, 1, 1, 7, 11, 20, 8, 15, 2, 1, 54, 4, 58,
+		4, 2, 4, 4, 49, 44, 98, 25, 67, 2, 13, 33, 10, 1, 13, 0,
+		5, 19, 0, 8, 36, 114, 1, 7, 11, 1, 1, 59, 3, 0, 45, 3,
+		15, 7, 5, 35, 10, 34, 2, 12, 3, 2, 5, 27, 1, 6, 1, 11,
+		15, 39, 39, 14, 164, 37, 18, 18, 8, 0, 7, 32, 2, 9, 2, 9,
+		31, 9, 2, 4, 36, 1, 6, 11, 1, 0, 8, 5, 2, 6, 103, 1,
+		49, 17, 4, 1, 1, 46, 38, 26, 4, 4, 1, 1, 21, 1, 3, 41,
+		9, 61, 5, 5, 57, 34, 3, 0, 5, 19, 21, 61, 18, 6, 20, 2,
+		77, 23, 6, 4, 3, 7, 54, 0, 12, 4, 7, 4, 1, 28, 23, 27,
+		11, 37, 15, 48, 44, 27, 5, 10, 14, 25, 33, 8, 5, 1, 17, 29,
+		9, 100, 10, 5, 3, 46, 24, 10, 22, 127, 3, 38, 14, 1, 3, 48,
+		33, 2, 1, 2, 23, 1, 43, 101, 2, 10, 45, 163, 12, 50, 35, 63,
+		1, 18, 3, 3, 3, 0, 25, 1, 17, 0, 14, 7, 16, 140, 51, 5,
+		11, 11, 11, 41, 1, 35, 41, 6, 19, 1, 1, 0, 23, 8, 2, 64,
+		6, 3, 6, 82, 8, 22, 12, 0, 19, 3, 35, 15, 14, 97, 1, 9,
+		1, 24, 15, 11, 19, 3, 132, 14, 27, 5, 14, 2, 42, 12, 2, 1,
+		0, 2, 2, 21, 10, 0, 4, 36, 6, 35, 91, 4, 9, 80, 13, 3,
+		23, 2, 17, 1, 19, 0, 21, 7, 10, 8, 24, 12, 23, 15, 4, 2,
+		4, 20, 10, 26, 21, 7, 13, 38, 13, 1, 41, 49, 19, 24, 19, 19,
+		42, 33, 13, 8, 63, 40, 23, 3, 12, 41, 96, 34, 32, 2, 4, 6,
+		2, 6, 18, 47, 38, 2, 13, 5, 2, 1, 2, 1, 56, 19, 27, 15,
+		1, 24, 1, 1, 28, 73, 2, 2, 23, 1, 40, 89, 9, 1, 8, 8,
+		24, 0, 13, 31, 30, 3, 0, 2, 1, 66, 52, 8, 25, 7, 2, 83,
+		57, 24, 1, 1, 4, 6, 38, 3, 49, 159, 37, 23, 21, 1, 1, 3,
+		58, 120, 12, 2, 7, 17, 11, 48, 11, 2, 2, 5, 0, 71, 44, 4,
+		1, 27, 1, 61, 1, 9, 27, 137, 8, 10, 11, 8, 4, 25, 28, 0,
+		32, 3, 3, 30, 5, 14, 2, 14, 3, 14, 41, 16, 31, 14, 12, 26,
+		13, 21, 11, 25, 17, 16, 20, 64, 2, 33, 38, 3, 7, 10, 55, 4,
+		27, 1, 29, 74, 34, 4, 7, 13, 14, 1, 16, 3, 27, 43, 2, 2,
+		32, 3, 16, 33, 25, 3, 2, 1, 5, 23, 10, 1, 2, 5, 7, 1,
+		158, 5, 30, 0, 6, 27, 1, 24, 0, 1, 5, 3, 1, 86, 32, 4,
+		1, 41, 1, 25, 1, 90, 1, 1, 4, 9, 1, 5, 1, 4, 9, 58,
+		44, 1, 52, 11, 3, 21, 68, 1, 19, 97, 17, 33, 12, 4, 1, 8,
+		22, 4, 13, 1, 18, 2, 1, 17, 12, 69, 19, 24, 10, 0, 38, 6,
+		15, 113, 2, 3, 5, 2, 63, 20, 32, 12, 6, 6, 62, 0, 11, 31,
+		1, 45, 12, 33, 42, 12, 1, 2, 54, 20, 63, 50, 1, 4, 93, 19,
+		25, 28, 11, 22, 2, 68, 2, 1, 12, 14, 15, 2, 92, 11, 1, 10,
+		29, 1, 20, 88, 196, 33, 20, 2, 16, 1, 113, 22, 142, 0, 26, 79,
+		1, 51, 96, 7, 28, 14, 7, 73, 48, 4, 5, 24, 4, 6, 9, 11,
+		1, 5, 5, 57, 36, 133, 38, 56, 19, 43, 6, 16, 9, 5, 1, 91,
+		17, 1, 9, 21, 29, 0, 1, 15, 10, 57, 9, 1, 4, 4, 5, 33,
+		10, 16, 1, 5, 1, 23, 4, 8, 7, 1, 5, 5, 4, 1, 38, 8,
+		63, 15, 11, 25, 9, 4, 15, 19, 1, 17, 14, 123, 2, 1, 17, 12,
+		4, 6, 55, 4, 1, 31, 10, 56, 31, 33, 3, 10, 12, 1, 7, 52,
+		1, 22, 2, 135, 14, 6, 67, 2, 7, 21, 1, 14, 2, 3, 36, 19,
+		10, 15, 40, 70, 57, 10, 2, 6, 12, 6, 73, 4, 2, 1, 9, 27,
+		2, 3, 25, 5, 1, 35, 1, 5, 0, 6, 15, 115, 1, 25, 3, 11,
+		95, 24, 0, 8, 2, 5, 8, 8, 28, 25, 9, 12, 1, 12, 20, 45,
+		117, 89, 2, 0, 30, 3, 2, 29, 279, 12, 132, 24, 3, 8, 10, 1,
+		37, 91, 9, 4, 154, 1, 26, 32, 1, 15, 33, 7, 7, 48, 4, 4,
+		18, 4, 12, 79, 9, 25, 46, 1, 2, 1, 5, 1, 16, 8, 3, 1,
+		11, 23, 10, 6, 5, 15, 19, 3, 2, 3, 39, 15, 86, 17, 1, 1,
+		86, 3, 14, 1, 71, 33, 1, 35, 22, 4, 1, 30, 81, 28, 38, 23,
+		10, 1, 5, 0, 5, 82, 8, 39, 3, 1, 77, 23, 7, 40, 9, 8,
+		26, 9, 4, 168, 2, 10, 11, 2, 33, 3, 10, 67, 46, 2, 19, 9,
+		0, 6, 39, 1, 1, 53, 1, 33, 12, 14, 97, 11, 87, 10, 42, 22,
+		1, 7, 20, 59, 51, 0, 56, 7, 1, 25, 3, 0, 39, 106, 61, 6,
+		18, 2, 2, 178, 1, 5, 2, 57, 81, 14, 45, 54, 1, 63, 8, 29,
+		97, 2, 4, 96, 8, 16, 32, 5, 10, 1, 17, 30, 5, 204, 1, 30,
+		26, 12, 13, 9, 8, 10, 84, 4, 6, 5, 10, 3, 1, 12, 0, 7,
+		7, 9, 1, 8, 12, 43, 2, 6, 13, 25, 1, 0, 1, 72, 19, 54,
+		27, 11, 79, 0, 19, 131, 1, 277, 1, 0, 11, 7, 64, 69, 73, 22,
+		5, 11, 91, 39, 1, 4, 1, 16, 34, 9, 35, 28, 78, 41, 19, 0,
+		119, 21, 8, 115, 3, 13, 14, 1, 1, 5, 84, 3, 15, 151, 7, 29,
+		3, 31, 3, 8, 1, 2, 27, 18, 132, 159, 11, 0, 91, 187, 4, 11,
+		21, 4, 55, 0, 16, 3, 37, 6, 4, 1, 1, 14, 5, 32, 39, 32,
+		0, 7, 86, 0, 37, 2, 0, 50, 9, 18, 0, 2, 16, 62, 1, 3,
+		3, 149, 17, 5, 61, 16, 16, 12, 96, 91, 50, 1, 77, 0, 13, 8,
+		114, 15, 19, 42, 32, 50, 54, 0, 0, 31, 43, 19, 1, 25, 13, 164,
+		44, 7, 56, 4, 1, 0, 32, 17, 9, 27, 25, 22, 1, 47, 1, 1,
+		99, 81, 22, 7, 133, 4, 56, 55, 6, 10, 25, 2, 3, 17, 1, 15,
+		30, 2, 61, 24, 26, 11, 2, 1, 9, 1, 1, 2, 58, 12, 39, 6,
+		82, 34, 16, 26, 3, 12, 62, 2, 2, 59, 20, 86, 1, 2, 80, 74,
+		23, 67, 17, 2, 1, 34, 13, 2, 83, 164, 5, 48, 1, 46, 3, 17,
+		2, 5, 17, 2, 84, 8, 18, 116, 56, 21, 13, 18, 0, 11, 10, 5,
+		0, 5, 2, 1, 7, 7, 19, 71, 36, 14, 179, 11, 9, 174, 71, 34,
+		1, 7, 2, 12, 0, 4, 29, 0, 2, 9, 50, 8, 0, 9, 25, 15,
+		113, 2, 3, 5, 12, 1, 14, 47, 3, 84, 8, 32, 56, 1, 8, 92,
+		13, 0, 15, 4, 0, 1, 32, 3, 9, 4, 51, 2, 8, 13, 2, 5,
+		67, 30, 9, 115, 138, 103, 2, 43, 0, 2, 6, 1, 52, 69, 3, 101,
+		8, 13, 47, 2, 0, 33, 73, 12, 94, 175, 5, 10, 0, 64, 69, 9,
+		9, 44, 13, 0, 1, 38, 5, 6, 4, 53, 42, 47, 2, 21, 14, 1,
+		21, 7, 3, 64, 70, 46, 10, 90, 22, 45, 9, 0, 6, 110, 17, 27,
+		89, 1, 14, 28, 0, 18, 77, 35, 4, 9, 20, 73, 8, 30, 153, 0,
+		8, 6, 1, 78, 6, 16, 3, 4, 118, 1, 16, 141, 3, 10, 14, 56,
+		24, 15, 1, 17, 58, 2, 2, 11, 10, 4, 16, 4, 11, 11, 24, 0,
+		29, 55, 14, 21, 59, 12, 1, 56, 26, 91, 28, 58, 30, 38, 208, 6,
+		2, 44, 23, 26, 5, 19, 1, 15, 3, 11, 26, 41, 15, 164, 103, 13,
+		76, 107, 13, 8, 13, 3, 4, 5, 26, 3, 7, 67, 76, 3, 4, 61,
+		22, 38, 16, 32, 20, 29, 13, 11, 0, 54, 15, 8, 2, 129, 43, 238,
+		2, 19, 8, 5, 2, 116, 114, 0, 173, 15, 1, 13, 14, 43, 2, 6,
+		2, 52, 2, 20, 15, 216, 46, 1, 22, 1, 134, 1, 13, 12, 171, 1,
+		15, 43, 6, 11, 30, 84, 1, 63, 3, 4, 3, 21, 13, 1, 32, 15,
+		58, 1, 65, 103, 26, 29, 1, 119, 0, 58, 1, 86, 6, 12, 3, 9,
+		34, 28, 15, 15, 3, 183, 91, 17, 64, 0, 19, 3, 26, 34, 1, 64,
+		3, 13, 36, 1, 7, 0, 44, 13, 50, 27, 7, 4, 1, 28, 2, 1,
+		139, 12, 4, 6, 0, 22, 48, 11, 22, 6, 2, 5, 23, 7, 33, 12,
+		0, 10, 119, 30, 0, 32, 52, 22, 3, 60, 18, 7, 4, 29, 7, 3,
+		26, 14, 64, 29, 37, 2, 1, 83, 63, 16, 19, 13, 2, 0, 33, 10,
+		48, 40, 35, 35, 19, 1, 52, 6, 50, 36, 18, 211, 53, 42, 36, 78,
+		156, 58, 156, 20, 18, 9, 1, 5, 18, 81, 7, 137, 9, 12, 157, 5,
+		4, 2, 11, 73, 146, 78, 2, 19, 4, 2, 70, 1, 1, 2, 102, 0,
+		49, 150, 38, 60, 44, 96, 36, 1, 52, 19, 6, 18, 61, 8, 0, 68,
+		10, 25, 20, 6, 11, 77, 43, 36, 171, 70, 82, 14, 0, 10, 0, 8,
+		149, 41, 50, 6, 75, 55, 38, 55, 3, 39, 20, 9, 2, 8, 4, 87,
+		79, 6, 0, 6, 1, 0, 7, 11, 10, 78, 9, 37, 15, 23, 1, 10,
+		13, 5, 40, 34, 15, 25, 33, 1, 1, 17, 46, 8, 39, 110, 6, 164,
+		110, 2, 0, 336, 14, 19, 81, 40, 50, 2, 81, 14, 0, 51, 29, 64,
+		4, 9, 92, 25, 41, 0, 6, 0, 32, 4, 1, 8, 163, 11, 6, 2,
+		37, 166, 30, 4, 73, 17, 0, 16, 19, 39, 114, 39, 12, 0, 2, 80,
+		11, 69, 2, 2, 64, 8, 5, 12, 75, 0, 53, 53, 49, 0, 13, 0,
+		2, 38, 10, 0, 58, 23, 1, 36, 20, 0, 15, 0, 72, 3, 21, 1,
+		80, 59, 5, 8, 12, 1, 0, 3, 91, 0, 79, 59, 19, 6, 11, 2,
+		46, 108, 17, 160, 5, 25, 2, 2, 135, 1, 10, 14, 0, 114, 10, 21,
+		1, 173, 2, 6, 206, 23, 18, 16, 175, 29, 2, 7, 52, 4, 1, 1,
+		14, 29, 13, 57, 121, 11, 4, 26, 108, 3, 17, 5, 98, 222, 1, 20,
+		49, 5, 2, 99, 9, 139, 72, 2, 32, 137, 206, 3, 11, 25, 84, 34,
+		0, 8, 6, 330, 25, 27, 1, 17, 40, 10, 2, 0, 27, 1, 3, 56,
+		1, 267, 16, 40, 43, 11, 10, 4, 79, 51, 43, 11, 15, 4, 45, 35,
+		33, 53, 9, 7, 94, 334, 13, 0, 10, 212, 238, 1, 24, 13, 9, 12,
+		3, 12, 72, 9, 21, 7, 20, 118, 68, 22, 6, 17, 61, 8, 11, 12,
+		20, 27, 3, 200, 190, 9, 16, 1, 38, 169, 11, 47, 290, 16, 1, 4,
+		1, 2, 12, 1, 1, 77, 14, 88, 2, 81, 2, 3, 40, 163, 26, 136,
+		94, 2, 135, 47, 22, 1, 3, 5, 19, 11, 129, 2, 33, 8, 14, 84,
+		28, 37, 1, 10, 145, 16, 9, 9, 113, 0, 1, 50, 8, 3, 24, 65,
+		0, 24, 23, 96, 97, 23, 149, 0, 42, 10, 53, 14, 1, 63, 125, 14,
+		45, 5, 88, 7, 4, 41, 33, 37, 30, 8, 49, 34, 1, 21, 66, 5,
+		65, 2, 91, 6, 2, 23, 131, 7, 16, 4, 4, 45, 21, 0, 24, 51,
+		116, 1, 1, 171, 75, 0, 1, 1, 205, 21, 2, 3, 5, 39, 33, 9,
+		0, 52, 67, 72, 6, 118, 1, 28, 138, 29, 120, 0, 74, 1, 11, 9,
+		8, 202, 57, 5, 23, 18, 23, 33, 40, 13, 36, 1, 215, 8, 40, 40,
+		69, 1, 5, 23, 2, 86, 3, 7, 1, 14, 48, 57, 5, 30, 32, 78,
+		30, 5, 2, 19, 0, 473, 4, 6, 20, 2, 9, 27, 8, 10, 12, 72,
+		4, 19, 4, 110, 32, 102, 15, 1, 45, 46, 66, 9, 3, 98, 17, 5,
+		110, 1, 12, 1, 86, 7, 3, 26, 3, 109, 7, 8, 24, 42, 12, 59,
+		71, 52, 136, 5, 77, 1, 64, 37, 2, 36, 3, 31, 2, 10, 51, 11,
+		1, 1, 123, 5, 4, 10, 8, 197, 6, 15, 45, 13, 97, 13, 60, 73,
+		105, 476, 7, 64, 35, 3, 181, 21, 66, 8, 8, 8, 2, 41, 49, 20,
+		54, 0, 0, 7, 202, 215, 15, 48, 61, 118, 6, 24, 61, 10, 46, 1,
+		71, 1, 12, 37, 31, 135, 76, 15, 27, 23, 131, 1, 40, 16, 10, 10,
+		31, 2, 23, 21, 4, 77, 7, 34, 6, 1, 3, 30, 7, 1, 18, 36,
+		186, 1, 25, 4, 1, 7, 9, 15, 188, 53, 99, 28, 21, 8, 34, 13,
+		94, 67, 71, 64, 4, 4, 118, 29, 7, 152, 3, 257, 88, 23, 233, 36,
+		33, 9, 47, 15, 2, 1, 62, 3, 5, 224, 231, 94, 12, 47, 8, 23,
+		82, 15, 1, 5, 72, 46, 238, 13, 130, 16, 1, 125, 4, 0, 2, 39,
+		148, 5, 3, 109, 0, 0, 5, 10, 10, 1, 83, 19, 1, 35, 9, 0,
+		5, 6, 29, 2, 7, 128, 140, 21, 16, 6, 36, 1, 66, 65, 53, 19,
+		68, 64, 1, 62, 0, 35, 11, 4, 58, 86, 34, 6, 8, 31, 11, 165,
+		1, 0, 12, 3, 7, 75, 327, 0, 213, 78, 0, 210, 43, 80, 80, 9,
+		51, 1, 1, 3, 70, 1, 33, 1, 3, 39, 43, 182, 95, 34, 47, 33,
+		62, 237, 55, 36, 0, 112, 36, 561, 1, 4, 53, 28, 15, 14, 135, 13,
+		34, 28, 6, 21, 21, 3, 9, 7, 3, 11, 275, 51, 4, 28, 75, 159,
+		4, 65, 0, 3, 39, 16, 112, 39, 2, 119, 2, 138, 4, 18, 165, 8,
+		9, 1, 220, 8, 81, 17, 6, 0, 67, 5, 1, 0, 64, 6, 208, 0,
+		39, 128, 56, 90, 3, 0, 4, 72, 3, 1, 27, 14, 25, 19, 4, 68,
+		35, 86, 77, 4, 14, 2, 30, 16, 91, 16, 73, 235, 2, 84, 4, 53,
+		192, 49, 44, 0, 17, 81, 20, 20, 1, 1, 17, 80, 21, 23, 1, 32,
+		38, 40, 15, 1, 112, 1, 4, 96, 26, 96, 20, 21, 5, 75, 40, 41,
+		7, 6, 14, 602, 494, 4, 32, 58, 162, 52, 47, 0, 2, 6, 6, 63,
+		1, 44, 40, 26, 75, 1, 34, 3, 3, 35, 2, 285, 89, 119, 6, 12,
+		10, 68, 1, 4, 6, 174, 20, 7, 31, 1, 145, 47, 6, 20, 1, 37,
+		24, 12, 121, 59, 46, 308, 5, 60, 2, 57, 1, 2, 3, 34, 42, 17,
+		0, 2, 323, 137, 3, 12, 119, 1, 1, 29, 35, 6, 2, 327, 63, 15,
+		42, 109, 129, 76, 23, 11, 51, 7, 4, 13, 31, 43, 63, 156, 36, 1,
+		272, 1, 50, 59, 23, 186, 91, 88, 3, 12, 10, 28, 152, 175, 2, 268,
+		33, 33, 38, 8, 0, 30, 28, 59, 7, 2, 1, 16, 80, 97, 32, 42,
+		12, 53, 81, 182, 5, 462, 23, 22, 5, 8, 6, 128, 51, 0, 196, 35,
+		2, 315, 5, 145, 139, 18, 1, 0, 11, 29, 1, 122, 61, 30, 106, 85,
+		8, 0, 45, 48, 20, 1, 27, 13, 2, 24, 189, 150, 133, 86, 67, 17,
+		0, 5, 5, 74, 3, 140, 1, 14, 203, 33, 122, 81, 17, 94, 162, 149,
+		9, 756, 239, 8, 163, 132, 21, 10, 180, 1, 16, 2, 149, 132, 3, 7,
+		42, 10, 10, 12, 23, 4, 33, 9, 18, 5, 83, 104, 81, 0, 417, 25,
+		148, 2, 292, 71, 137, 5, 6, 22, 158, 83, 126, 82, 9, 332, 1, 16,
+		46, 8, 3, 7, 61, 84, 2, 2, 29, 33, 2, 28, 1, 18, 54, 15,
+		36, 7, 35, 197, 57, 57, 249, 1, 68, 57, 33, 16, 9, 1, 12, 104,
+		2, 199, 62, 4, 22, 46, 247, 9, 2, 13, 200, 1, 67, 29, 12, 573,
+		4, 4, 0, 2, 2, 37, 14, 164, 2, 113, 10, 11, 43, 299, 702, 80,
+		38, 170, 13, 86, 124, 21, 1228, 60, 10, 31, 278, 61, 0, 8, 11, 22,
+		1, 28, 72, 4, 3, 214, 110, 72, 93, 0, 27, 131, 64, 0, 14, 2,
+		305, 118, 4, 4, 8, 11, 170, 20, 211, 97, 57, 105, 24, 87, 1, 80,
+		9, 47, 16, 9, 0, 0, 0, 46, 13, 15, 52, 1, 9, 226, 22, 28,
+		66, 2, 215, 144, 92, 137, 96, 2, 61, 34, 0, 9, 93, 9, 10, 16,
+		212, 5, 63, 39, 6, 17, 150, 20, 66, 4, 56, 1, 7, 364, 20, 50,
+		29, 11, 215, 37, 49, 111, 1, 152, 163, 91, 37, 142, 147, 1, 78, 1,
+		38, 127, 221, 61, 9, 24, 51, 16, 1, 259, 273, 2, 20, 15, 137, 94,
+		75, 39, 0, 203, 21, 20, 213, 123, 10, 56, 50, 72, 7, 86, 11, 2,
+		47, 4, 56, 27, 41, 22, 2, 1, 45, 23, 2, 34, 1, 46, 14, 1,
+		9, 56, 20, 14, 13, 44, 0, 3, 61, 23, 145, 44, 12, 87, 3, 1,
+		493, 16, 158, 28, 196, 1, 34, 225, 8, 106, 1, 2, 335, 19, 2, 34,
+		37, 182, 20, 179, 74, 8, 1, 167, 6, 346, 194, 57, 73, 34, 0, 93,
+		0, 4, 505, 2, 90, 105, 30, 13, 1, 71, 317, 75, 1, 68, 12, 6,
+		2, 4, 4, 362, 1, 5, 152, 17, 352, 17, 7, 65, 908, 53, 22, 105,
+		28, 12, 1, 2, 345, 59, 14, 801, 1, 305, 301, 119, 26, 24, 2, 132,
+		9, 5, 39, 33, 377, 175, 162, 274, 4, 141, 44, 122, 98, 70, 15, 7,
+		31, 9, 70, 134, 15, 107, 449, 4, 25, 169, 39, 222, 103, 7, 39, 164,
+		14, 84, 248, 136, 151, 46, 257, 25, 258, 7, 130, 23, 36, 207, 72, 55,
+		4, 187, 11, 22, 2, 49, 74, 99, 3, 10, 5, 153, 2, 3, 198, 6,
+		394, 160, 57, 202, 327, 417, 0, 16, 0, 16, 104, 149, 1, 58, 18, 137,
+		51, 4, 160, 39, 216, 355, 10, 327, 84, 4, 140, 634, 103, 77, 57, 18,
+		745, 4, 0, 65, 526, 114, 16, 417, 16, 197, 2, 46, 128, 3, 24, 6,
+		55, 11, 293, 74, 84, 11, 3, 5, 84, 50, 84, 1, 51, 0, 137, 11,
+		669, 5, 174, 212, 41, 79, 693, 1, 30, 22, 159, 19, 8, 296, 351, 44,
+		2, 706, 14, 35, 1, 47, 63, 43, 14, 27, 213, 7, 443, 20, 2, 4,
+		0, 15, 107, 274, 95, 107, 1, 98, 1, 199, 43, 199, 2, 289, 47, 613,
+		5, 3, 99, 6, 488, 1, 246, 43, 342, 2, 34, 821, 9, 2, 22, 194,
+		265, 54, 1, 138, 29, 25, 369, 0, 15, 97, 12, 5, 43, 274, 2, 10,
+		115, 9, 294, 30, 3, 5, 26, 5, 9, 126, 54, 334, 16, 5, 82, 45,
+		60, 8, 82, 9, 1, 7, 191, 274, 86, 38, 54, 18, 9, 2, 75, 319,
+		26, 119, 277, 8, 10, 6, 265, 6, 60, 426, 337, 7, 111, 171, 55, 86,
+		0, 13, 143, 103, 104, 0, 209, 33, 324, 30, 121, 128, 563, 47, 347, 43,
+		212, 58, 56, 9, 41, 1, 2206, 483, 1, 50, 150, 152, 141, 4, 13, 0,
+		81, 0, 16, 239, 423, 470, 1, 44, 36, 3, 76, 1, 95, 10, 62, 57,
+		1, 23, 1, 162, 90, 25, 109, 693, 156, 2, 280, 43, 9, 35, 60, 428,
+		65, 321, 8, 411, 125, 7, 94, 385, 0, 1291, 41, 1052, 25, 113, 472, 17,
+		7, 11, 2, 113, 176, 5, 11, 46, 7, 163, 2, 11, 16, 127, 5, 356,
+		145, 0, 141, 148, 287, 1254, 520, 182, 14, 24, 238, 190, 1193, 5, 39, 188,
+		4, 11, 346, 61, 90, 10, 52, 326, 8, 1279, 322, 5, 86, 15, 104, 77,
+		98, 93, 56, 27, 151, 2, 193, 109, 0, 207, 8, 530, 58, 78, 109, 746,
+		136, 50, 32, 0, 112, 1, 0, 2, 60, 620, 4, 17, 2, 2, 6, 5,
+		1, 4, 208, 0, 9, 2, 8, 5, 1634, 18, 3, 236, 130, 393, 6, 21,
+		4, 77, 2, 21, 0, 670, 7, 15, 2, 186, 0, 187, 9, 21, 40, 5,
+		295, 205, 145, 97, 82, 113, 2, 46, 159, 40, 13, 941, 0, 360, 1, 0,
+		66, 989, 3, 7, 8, 29, 10, 234, 1, 20, 5, 229, 579, 390, 453, 76,
+		17, 76, 10, 1, 20, 308, 21, 23, 12, 2, 1273, 1005, 374, 38, 9, 1,
+		95, 30, 21, 29, 31, 312, 14, 11, 24, 20, 0, 236, 122, 199, 185, 48,
+		39, 178, 42, 12, 29, 5, 0, 4, 0, 122, 35, 2, 1, 298, 154, 1,
+		2, 10, 377, 13, 198, 379, 2, 59, 7, 452, 216, 142, 39, 12, 3, 0,
+		14, 5, 341, 16, 2, 88, 22, 622, 1, 84, 4, 35, 576, 122, 386, 1,
+		1, 1, 84, 2, 1, 14, 29, 22, 98, 3, 4, 103, 390, 6, 3, 10,
+		0, 16, 2, 35, 627, 444, 0, 5, 594, 48, 167, 340, 168, 60, 54, 413,
+		15, 105, 60, 15, 38, 7, 680, 707, 1164, 1160, 15, 250, 1, 54, 90, 114,
+		1089, 3191, 769, 61, 81, 1020, 133, 431, 8, 399, 7, 1, 0, 15, 6, 482,
+		12, 485, 67, 203, 860, 31, 202, 28, 137, 0, 385, 113, 47, 33, 590, 101,
+		0, 15, 19, 82, 144, 828, 15, 33, 290, 69, 518, 4, 0, 9, 233, 696,
+		1, 25, 3379, 125, 0, 9, 516, 230, 528, 23, 769, 30, 8, 1228, 225, 168,
+		557, 147, 1608, 20, 2, 206, 21, 8, 22, 840, 9, 4, 3, 5, 47, 119,
+		287, 2152, 43, 480, 144, 13, 23, 4, 70, 1, 342, 4, 40, 15, 40, 134,
+		6, 2, 81, 18, 2, 55, 1119, 1309, 119, 21, 32, 6, 1898, 101, 4, 103,
+		287, 33, 279, 60, 32, 4432, 166, 172, 80, 545, 66, 118, 140, 3, 6, 1444,
+		67, 124, 65, 105, 53, 11, 2, 82, 12, 2, 34, 16, 2599, 56, 98, 2,
+		162, 9, 5283, 77, 2, 47, 17, 98, 334, 32, 557, 410, 15, 0, 5979, 194,
+		3, 141, 200, 232, 106, 196, 12, 668, 351, 3078, 3, 184, 152, 3, 14, 85,
+		17, 177, 25, 804, 826, 40, 15, 9, 20, 380, 0, 5893, 51, 101, 301, 656,
+		471, 1, 21, 18, 25, 10, 2, 1, 4, 12627, 0, 15, 1, 1,
+	},
+	tags: []uint16{
+		0x399b, 0x6ccc, 0x61f9, 0xd9bb, 0x7ed0, 0xec15, 0xa269, 0xf9a7, 0x6290, 0x4c42, 0x0b65, 0x1afd,
+		0x0dc3, 0xe453, 0x4acc, 0xde10, 0x1c04, 0x5330, 0x4428, 0x0760, 0xb440, 0x6a92, 0xe06b, 0xc764,
+		0x6326, 0xec6f, 0x9544, 0xbdca, 0x7d9e, 0xf347, 0x4605, 0xa189, 0x3ea6, 0x2f74, 0x6178, 0xc00b,
+		0xe21d, 0xdf8f, 0xe475, 0xb34f, 0x5733, 0x03bf, 0x6d46, 0x2b3e, 0xb6a6, 0xe3de, 0x1aaa, 0xaa74,
+		0xd971, 0x1100, 0x50d9, 0xb7da, 0x115c, 0x76ee, 0xdbe3, 0x7f7d, 0x64d9, 0x8a1d, 0x4782, 0x2834,
+		0x68bd, 0xcfe3, 0x9f44, 0x7795, 0xde59, 0x3252, 0xe1b0, 0x96dc, 0xc73e, 0xa9a8, 0xc636, 0x0a71,
+		0xc042, 0x8c0c, 0x5bb8, 0x9f35, 0xaaa0, 0xe699, 0x58ea, 0x987f, 0x612e, 0x1ab0, 0x4417, 0xf042,
+		0x31f8, 0x4785, 0x4c72, 0xdef4, 0x538b, 0xb9c2, 0x4cc2, 0x5608, 0xdd95, 0xd88e, 0x52ac, 0x5f50,
+		0x55c7, 0xffea, 0x2e4b, 0xf24e, 0x8cfe, 0x914a, 0x96be, 0x3b6a, 0x3756, 0x4a44, 0xa6c6, 0xf572,
+		0xdd35, 0xc129, 0xa0e8, 0x3ac2, 0x0c22, 0xdcd3, 0x58f8, 0xb350, 0xba22, 0x260c, 0xcd13, 0x4d2a,
+		0x9c4f, 0x7e5a, 0x6ff9, 0x2131, 0xf2c1, 0x2a2b, 0x09ea, 0x38eb, 0xcf5e, 0x1b39, 0xda5d, 0xc6a5,
+		0xed43, 0x48a2, 0x4baf, 0x6df8, 0x4d49, 0x672c, 0x1632, 0x6c42, 0xdaf1, 0x338a, 0x65e9, 0x95f9,
+		0x0018, 0x29d0, 0x051c, 0xf869, 0x2ae1, 0xe39c, 0xcb4a, 0x1b4f, 0x8ce7, 0x2bef, 0x1db2, 0xc5dc,
+		0x08f4, 0xd998, 0x45cf, 0xba49, 0xb7fa, 0x9bcb, 0xba44, 0xdf83, 0xb817, 0x59b4, 0xe0ec, 0xf518,
+		0xaacb, 0x9264, 0xec38, 0x43b2, 0xd434, 0x5118, 0x5e95, 0x30fd, 0xaea7, 0x5310, 0x9a0b, 0xb97e,
+		0xe2fd, 0xc772, 0x25af, 0x13ac, 0x2608, 0x9ee8, 0xbd3a, 0x50bf, 0x47a9, 0x9e2f, 0xddb6, 0x2ccc,
+		0xb374, 0x1929, 0xa069, 0xabaf, 0x8ce3, 0x1251, 0xa9d2, 0x8887, 0xa404, 0xc159, 0x23fc, 0x0d9e,
+		0x3a7e, 0x6244, 0x7785, 0x2ae3, 0xc94c, 0x47a2, 0xa4a8, 0x1aef, 0xbad5, 0x80f2, 0x19d5, 0x1916,
+		0x02dd, 0x4e30, 0x46c0, 0xf604, 0xa264, 0x7c95, 0x5d9a, 0xd931, 0x42bf, 0x2437, 0xe55e, 0x7c4a,
+		0x3747, 0x4768, 0x3752, 0x4cfb, 0xb6a8, 0xc173, 0xf0d8, 0x0f98, 0x0acc, 0xcb5d, 0x4b9a, 0xcfb4,
+		0x5be0, 0x19be, 0x775c, 0xc790, 0x24f6, 0x8aaf, 0x3236, 0x91ba, 0x43a5, 0x80a8, 0x59e2, 0x2f18,
+		0x0cf9, 0x5b3c, 0x09fa, 0x988b, 0x652a, 0x9415, 0xb448, 0x3fd7, 0xc482, 0x289d, 0xf6ea, 0x1ff4,
+		0x46f6, 0xad92, 0x2d54, 0xa25e, 0x334c, 0x21b8, 0x7fe0, 0xce29, 0x337f, 0xe0e3, 0x2844, 0x2edc,
+		0xd65e, 0x4f52, 0xb583, 0x5d8e, 0x7e40, 0x47ca, 0x090e, 0x5487, 0xa061, 0x2f35, 0xe5db, 0x07d4,
+		0xf8b6, 0x2c3d, 0x663d, 0x82f1, 0xda6c, 0x7aa2, 0xdaaf, 0xa223, 0xaae0, 0x256c, 0x575c, 0x65c9,
+		0xf4f1, 0x98a2, 0xfc2a, 0xcd09, 0x432e, 0x8223, 0x7177, 0xe84e, 0xc424, 0xa61d, 0x4fea, 0xb558,
+		0x8a02, 0x892d, 0x5741, 0x5275, 0x368a, 0x1cca, 0x8400, 0xe4cd, 0x9f42, 0x1b47, 0x6273, 0x714c,
+		0x0a8d, 0xb8eb, 0x2d1b, 0x1110, 0x88fb, 0xe8ff, 0x1a20, 0xd6d1, 0xf5e0, 0xd487, 0xf1cc, 0x1d5b,
+		0x1e85, 0x0cfd, 0xd8d8, 0x43bc, 0x6959, 0xf9eb, 0x7946, 0xea35, 0x3153, 0x964e, 0xc957, 0xa03e,
+		0xc859, 0x0634, 0x1ff6, 0xface, 0x0ae1, 0xffb2, 0x4eba, 0xcae7, 0xc9de, 0x7028, 0xcc8e, 0xd4af,
+		0x76fa, 0x1d09, 0x68c4, 0xe83a, 0x6e75, 0x7061, 0x215c, 0xad0d, 0x1140, 0xd324, 0x54f7, 0xd619,
+		0x03b9, 0x4a66, 0xfe52, 0xa204, 0x0c32, 0x0918, 0x8643, 0xde8e, 0x518b, 0xece0, 0xc88e, 0x2f14,
+		0xe157, 0xe34a, 0xfa83, 0x5a3e, 0xd8ef, 0xbaad, 0x1405, 0xb69a, 0x9278, 0x70f4, 0x494b, 0xe633,
+		0x70af, 0x45a0, 0x64d9, 0x882b, 0x3efb, 0x171b, 0x1ed8, 0x1a53, 0x4a87, 0xbfdf, 0x2ca0, 0x2050,
+		0x0f94, 0x368d, 0xb68a, 0xaae9, 0x11c8, 0x9695, 0x4990, 0x1958, 0x42e8, 0x447e, 0x95c6, 0x9be1,
+		0xeaaf, 0xb26c, 0xee71, 0xeaf6, 0x6b73, 0xe79f, 0xa94b, 0xc0e5, 0x72bb, 0x5f87, 0xcb8e, 0x05b8,
+		0xdcb9, 0x8dea, 0xbfa9, 0x74ac, 0x1f21, 0x1fca, 0x69ba, 0x6b75, 0xd38f, 0x4ddf, 0x4df9, 0xf0fd,
+		0xc071, 0xa07a, 0xb33e, 0x0018, 0xaa6c, 0x7665, 0x7592, 0xfa43, 0xeff2, 0x704b, 0x66da, 0xe89d,
+		0x6fd9, 0x7182, 0xc532, 0x1fd2, 0x818a, 0x268b, 0x4b34, 0x3940, 0x1509, 0x6a3c, 0xd0eb, 0xeeca,
+		0x984c, 0xff42, 0x9035, 0x1cdd, 0xcf66, 0xc982, 0xeeee, 0xe279, 0x338c, 0x6c73, 0x5ebc, 0xc0b2,
+		0x74d5, 0x7ce4, 0x9e79, 0x1170, 0xd7dd, 0x78d7, 0x83d4, 0x73c7, 0xb691, 0x6d01, 0x9aee, 0x8404,
+		0x39b0, 0x71f2, 0xd1e3, 0x4fb4, 0xb6a6, 0xc3de, 0x206c, 0x1af5, 0x15ac, 0xebee, 0x93ae, 0x2b46,
+		0x9366, 0x78f2, 0x63ae, 0xb1e6, 0xe547, 0x98c1, 0x681f, 0xf076, 0x4631, 0x33c3, 0x5a7d, 0xa8b0,
+		0x3e4d, 0x6514, 0x9b96, 0x21e5, 0x1c71, 0x56de, 0xb7d5, 0xe5e0, 0x0c69, 0x4fc7, 0x81e2, 0xc5ab,
+		0x91c1, 0x1c63, 0xfa49, 0xed3d, 0xfd3f, 0x932f, 0xe08a, 0x3663, 0xfc09, 0xf8b0, 0x0b41, 0x8809,
+		0xb879, 0xebc7, 0x84df, 0x67de, 0x67f5, 0xc109, 0x702e, 0xf854, 0xaa28, 0xc7b1, 0xeca6, 0xa197,
+		0xc8bb, 0xa06b, 0x89e4, 0x2c87, 0x565f, 0x42f4, 0x04a0, 0x1234, 0x9aee, 0x2fa8, 0xfbbf, 0xc9e6,
+		0x1b9e, 0xaedc, 0xfa82, 0x345d, 0xe468, 0x7931, 0xea19, 0x241d, 0x896c, 0x30fc, 0x2915, 0x712e,
+		0xd092, 0x2e75, 0x7396, 0xf97f, 0x0ffb, 0xd390, 0x54bd, 0x9c89, 0x8b6c, 0xc26a, 0x9d63, 0xdfdd,
+		0xce76, 0x97e1, 0xd95d, 0xafe6, 0x674e, 0x43e2, 0xcbf0, 0x0996, 0x60d2, 0xda3b, 0x318b, 0xe3fb,
+		0xa223, 0x2367, 0xd35f, 0x810b, 0xd59e, 0x37b6, 0xb57e, 0xda1d, 0x34d6, 0x0675, 0xe2da, 0xd5ac,
+		0xdb6d, 0x83c5, 0x0ffb, 0x4c54, 0x0e56, 0xa936, 0xd2ce, 0xb46c, 0x60b0, 0xb062, 0xa80f, 0xea4a,
+		0x429e, 0x6024, 0xb28f, 0x282d, 0x4f2b, 0x677a, 0xe600, 0xf84b, 0xb120, 0xcea3, 0x84cc, 0x5c73,
+		0x9e7a, 0xa158, 0x8130, 0x0295, 0x42df, 0x50e2, 0xfd7f, 0x2b7b, 0xffbd, 0x2933, 0x2c16, 0x5bec,
+		0xcf62, 0x97a1, 0x661e, 0x9f8b, 0x418c, 0x21e1, 0x70c1, 0x15b3, 0x3cf3, 0xb9cf, 0x797d, 0x143c,
+		0x5d13, 0x21c7, 0xed38, 0x1957, 0xc71c, 0x79df, 0xbbc1, 0xde96, 0x7738, 0xbc0d, 0x0fb9, 0x5312,
+		0xed89, 0xbc31, 0x1063, 0x39fe, 0x45ff, 0x7392, 0xb7b4, 0x93b9, 0xb49f, 0x2e52, 0x3057, 0xcb97,
+		0x5c90, 0xc47e, 0xfa3f, 0xf2b1, 0x8c3b, 0xb12f, 0x223b, 0xbaa5, 0x92a8, 0x8c5a, 0x8677, 0x11c5,
+		0xb5be, 0x7aee, 0x65f5, 0x47ed, 0x14b7, 0x0c12, 0x444f, 0x5275, 0xef25, 0x3103, 0xe7c1, 0x0b62,
+		0xe047, 0x2625, 0xc5b4, 0xdd24, 0x07d8, 0xdc2d, 0x54ec, 0x3d27, 0xfae2, 0xaa4b, 0xda90, 0x0411,
+		0x6fff, 0xb71b, 0xe11f, 0x3371, 0x322c, 0x966b, 0xfe4a, 0xdd8f, 0xcfef, 0x986f, 0x34c2, 0xde59,
+		0x00e2, 0x77f0, 0xc9aa, 0x59e0, 0xa8f6, 0x929e, 0x8e2d, 0x55ca, 0x997b, 0x3659, 0xb75b, 0x7337,
+		0x3c65, 0x5e92, 0xfabe, 0x1b0c, 0xa3d7, 0x3ef1, 0x5319, 0xa4e0, 0xfe04, 0xb69e, 0xc197, 0xa1f9,
+		0xabde, 0x97d3, 0x5f8b, 0x30f8, 0xfeed, 0x7ad2, 0xefc2, 0x477e, 0x5666, 0xffa8, 0xdba6, 0x732a,
+		0x0eeb, 0xca48, 0x146e, 0x5735, 0xe59f, 0x79ad, 0x2f79, 0xccec, 0x38d0, 0xe8a1, 0x6594, 0x8512,
+		0x1d56, 0x1628, 0xe76c, 0xb4b4, 0xd0d5, 0x8c31, 0x3a30, 0xca80, 0xccd1, 0x301a, 0x410b, 0xa27f,
+		0x6714, 0x73c6, 0xd1a2, 0xf594, 0x4dc4, 0x0ac7, 0x8dd3, 0xee79, 0xb270, 0x12bf, 0x6e0e, 0x732e,
+		0x795c, 0x6848, 0x2341, 0x674c, 0x8fb7, 0x5989, 0x4e4a, 0x10e8, 0xb267, 0x5bd2, 0x3fba, 0xcb8d,
+		0xad34, 0x6250, 0x7e06, 0x03cb, 0xa60e, 0x04be, 0xea66, 0xd055, 0x6fa3, 0x594a, 0x19eb, 0x5c73,
+		0x1a16, 0xd6f2, 0x4b11, 0x3a75, 0x6b88, 0xe612, 0x71a5, 0x5650, 0x1c25, 0xeaa9, 0x28d2, 0x7474,
+		0xcd54, 0x3ebe, 0x90a2, 0x018b, 0x6534, 0xcfe0, 0x410f, 0xd73e, 0x3311, 0xa1ca, 0x3c7a, 0x6daa,
+		0xda6a, 0x9713, 0x64da, 0x77c7, 0x5dcc, 0x425c, 0x41c5, 0x4103, 0x52d2, 0x6369, 0x3e11, 0x1f39,
+		0xcd79, 0xe73f, 0xb410, 0x4f73, 0xa251, 0xd0ac, 0x43fb, 0xede4, 0x8361, 0x1778, 0xc10d, 0x519f,
+		0x31a9, 0xcc8d, 0xaf4a, 0x3ab3, 0xa92c, 0xce12, 0x218e, 0x6d38, 0x92c4, 0xd942, 0xd7c8, 0x457d,
+		0x74b3, 0x213c, 0x614e, 0x0f1e, 0xbecc, 0x8d4a, 0x214f, 0xc168, 0x6b4e, 0x5ab0, 0xcbe0, 0xfe59,
+		0xe505, 0x3daf, 0xca28, 0x50fd, 0xa1ee, 0x18b3, 0xb2c8, 0xaf63, 0xd504, 0xbb9c, 0x57d9, 0x1acd,
+		0xe7b6, 0x7317, 0x04ad, 0x574d, 0x471b, 0x4996, 0xf946, 0xb882, 0x2ac3, 0xdc84, 0x3b1b, 0x98dc,
+		0xf4cd, 0xccaf, 0x6e9b, 0x3f03, 0x55db, 0x4bec, 0x6b93, 0xf1df, 0xf160, 0xcfd0, 0xf759, 0xde2e,
+		0x9e7e, 0x208a, 0xb33b, 0xf261, 0x9590, 0x3698, 0x1afa, 0x3d6a, 0x5442, 0xef30, 0x843a, 0x77b3,
+		0xdbb8, 0x7911, 0xf720, 0x9ac1, 0x07a3, 0x4961, 0xed93, 0x8d5e, 0xdd68, 0xd71b, 0xb29c, 0x237c,
+		0xe773, 0x60b9, 0xf6e5, 0xa241, 0x8c70, 0x6e44, 0x3c1d, 0x8e78, 0xb1ec, 0xf29c, 0x0074, 0x8223,
+		0xd8fb, 0xc2e4, 0xdd9f, 0x17bb, 0xc45e, 0x1610, 0xc0e7, 0x34d8, 0x4fb5, 0x1f44, 0xb503, 0x5386,
+		0x3cfa, 0x53a5, 0x0770, 0xeeee, 0x81cc, 0xadde, 0x0ecc, 0x5a8b, 0xfcec, 0x6eb2, 0xb541, 0x930d,
+		0xb04c, 0xe59e, 0x5c84, 0x81cf, 0xf137, 0x6987, 0xdfed, 0xf378, 0x0f7c, 0xc469, 0xc794, 0xc296,
+		0xcff8, 0x900b, 0x7634, 0x9ec3, 0x0971, 0xe582, 0xa5d3, 0xbc85, 0x2fda, 0xe0fe, 0x7712, 0x4c3a,
+		0x4429, 0x0a18, 0xb26c, 0x11a7, 0xced1, 0x5457, 0xc5f4, 0x9e06, 0x8f70, 0x3e16, 0xa377, 0x824b,
+		0xcbe3, 0x8c83, 0xe502, 0x729c, 0x2d2d, 0x8828, 0xce44, 0x03f6, 0xed56, 0x807a, 0x4f51, 0x5926,
+		0xb888, 0xf7b5, 0x1204, 0xd798, 0x2bd5, 0x4bfc, 0xee9d, 0x6f55, 0xd4ab, 0xe403, 0xa4e6, 0x70a3,
+		0x6924, 0xc40b, 0xcf0a, 0xd1d1, 0x5809, 0xc526, 0x01d1, 0xb85b, 0xa0d4, 0x795b, 0x0b2f, 0xe441,
+		0xe3b6, 0xc8d5, 0xd781, 0x3c75, 0x5ae3, 0xd6ba, 0xe35d, 0xe960, 0x6ccd, 0xc680, 0xc3f9, 0xb9be,
+		0x5503, 0x00cb, 0xa17f, 0x9732, 0x1f1b, 0x7f27, 0x57b6, 0x1b1f, 0xc585, 0x3236, 0xca1e, 0x6826,
+		0xd724, 0x1512, 0x462a, 0x392c, 0x1570, 0x8e3e, 0xdb62, 0x146f, 0x436d, 0xe01c, 0xf012, 0x372a,
+		0x2c7d, 0x8287, 0xb171, 0x9665, 0x6cd0, 0x6c6c, 0xaf26, 0xb803, 0x1fba, 0xf288, 0xf442, 0x53cc,
+		0x62c4, 0xf3d5, 0xb37f, 0x0a4c, 0x9b23, 0x87c6, 0xd612, 0x7d41, 0x5eff, 0x4bd5, 0x9965, 0x5b23,
+		0xd070, 0x1ca0, 0x54ce, 0x05ca, 0x1eb2, 0x1478, 0xc4ef, 0x4cd6, 0x90ee, 0xa025, 0xd691, 0x8cb1,
+		0x9b0c, 0x2dee, 0x9144, 0x9ca4, 0xe188, 0xdadd, 0x8094, 0x2c00, 0xf79e, 0x5bb1, 0xb12e, 0x27df,
+		0xa373, 0xcf8f, 0x9342, 0x584f, 0x01a1, 0x4ca7, 0xd3db, 0x3187, 0x6123, 0x9ce8, 0x9be5, 0x3bc0,
+		0x3d9b, 0x49f2, 0x35ef, 0xe3d5, 0x1904, 0xe368, 0x724f, 0x93c4, 0xd2e1, 0xae46, 0xcf30, 0xb86f,
+		0x7a33, 0x22c9, 0xa78f, 0xf4d1, 0xd0d5, 0xcca7, 0x2dda, 0x37df, 0x2b95, 0x9429, 0x76e2, 0x703c,
+		0x6f58, 0x0a74, 0xfd26, 0xa5c5, 0x8287, 0x6b57, 0x4c33, 0x6eb8, 0xb691, 0xf646, 0x60d4, 0xcf12,
+		0x4da7, 0xab8d, 0xcbc9, 0xf731, 0x8867, 0x40fb, 0x4e97, 0x7c68, 0x88cc, 0x53f4, 0xc9ed, 0x5a15,
+		0x2b6f, 0x48ed, 0xbcba, 0xf247, 0xcda0, 0xb7d6, 0x7c2a, 0x7c70, 0x239b, 0xc232, 0xa3d2, 0x7fb7,
+		0x8ecc, 0x932c, 0xc577, 0x76ac, 0x5c59, 0xd0ab, 0x8bfe, 0x5d76, 0x357c, 0xcdb7, 0x729d, 0x564c,
+		0x7176, 0x4af0, 0x2d41, 0x5cde, 0xb5e4, 0x8088, 0x6c70, 0xa5ae, 0x53a1, 0x466f, 0x69a7, 0x6646,
+		0x42ed, 0x2991, 0x768c, 0x2336, 0xc14f, 0x1288, 0xa1bf, 0x126f, 0xe491, 0xe315, 0x8db7, 0x9a9f,
+		0x1afa, 0xf07c, 0xa873, 0xb1e0, 0x4a45, 0x7b35, 0x3b7c, 0xc92c, 0x017d, 0xa2d9, 0xa6ce, 0x8d45,
+		0x6c2c, 0x9066, 0xc468, 0xeadd, 0xbab3, 0x393c, 0x8b0e, 0xa8a6, 0x1784, 0x1072, 0x0c78, 0x786e,
+		0x9494, 0xaa96, 0x65b2, 0xc3da, 0xdb68, 0x73f5, 0x2108, 0xde14, 0xff16, 0xe915, 0x1d37, 0x0519,
+		0x8e01, 0xb47e, 0x6cd4, 0x7011, 0x45fc, 0xf9ee, 0x5e74, 0x2b7b, 0x6602, 0xbc8c, 0x31b8, 0x0768,
+		0x110b, 0x07e2, 0xab6e, 0xec13, 0xf992, 0xbebc, 0x4efc, 0xd81d, 0xfab2, 0x659e, 0x621c, 0x4dfd,
+		0xa7d9, 0xcfb3, 0xbe43, 0xdf5e, 0xaf88, 0x95fe, 0x624d, 0x58a9, 0xc511, 0x08f0, 0x5f15, 0x811a,
+		0x69e5, 0x67c9, 0xa25f, 0xa433, 0xf9e1, 0xa654, 0x6518, 0x0219, 0x3f22, 0xc561, 0xa363, 0x098a,
+		0x59c0, 0xecec, 0xeb33, 0x1395, 0x53f2, 0x8e35, 0xb6ce, 0x64c5, 0x7288, 0xe6b8, 0x4ee8, 0x0e6f,
+		0x2e0b, 0x1031, 0x8287, 0xd70d, 0x2ac5, 0xfc56, 0x7b31, 0xd8fe, 0xd43d, 0x6d14, 0x6a61, 0x4d01,
+		0x75dc, 0x0f06, 0xce63, 0x24ff, 0x5b63, 0x46c3, 0xf304, 0xa452, 0x7ea9, 0x555c, 0x17f2, 0x6ba8,
+		0xeb26, 0x7657, 0x545d, 0xfcde, 0x0679, 0x50b8, 0x044a, 0x312e, 0x16e1, 0x7f12, 0xae21, 0x75da,
+		0x57a4, 0xb68d, 0xf270, 0x9c13, 0xfc80, 0x9c57, 0xfa37, 0x8d94, 0xc901, 0x8db8, 0xcddd, 0xb893,
+		0x98a8, 0xd1ee, 0xc357, 0xcd93, 0xcd74, 0x6690, 0x69c8, 0x0e9e, 0x96b3, 0xd9be, 0x9b85, 0x6b38,
+		0x0c32, 0xb1fb, 0x6b0e, 0x36b7, 0x4787, 0x0850, 0x4bb4, 0xaa0b, 0x6a60, 0xb598, 0x5e41, 0xedb6,
+		0x1b21, 0xbce8, 0x4c24, 0x7dc6, 0x4439, 0x3a79, 0x06a8, 0x5af4, 0x50e8, 0x1635, 0xb0b3, 0xdf35,
+		0x1316, 0x4e98, 0xd2bb, 0x47da, 0xc8c8, 0x1183, 0xb0be, 0x6c46, 0xe916, 0xa4d5, 0x3611, 0xc009,
+		0x85cf, 0x2f14, 0x3baf, 0xdf44, 0xfb55, 0x0f99, 0xd890, 0x6f26, 0xfebb, 0x5e13, 0xdd56, 0xcf90,
+		0x25ce, 0x15e5, 0xa319, 0x5ade, 0x3963, 0x8286, 0x6149, 0x30a4, 0x0b6d, 0x6422, 0xcc10, 0xcabe,
+		0xdedc, 0x2965, 0x4085, 0xa6a6, 0x96d6, 0xe385, 0xa764, 0x9c5b, 0x4608, 0x9c6c, 0x1d66, 0x270e,
+		0x3523, 0x9863, 0x20de, 0x55ef, 0x9a05, 0xa2de, 0xa658, 0x5c11, 0x12c8, 0xc52d, 0x5d56, 0x93d9,
+		0x6855, 0x295b, 0x52ae, 0x5a0a, 0xfa80, 0xdf89, 0xb630, 0x27be, 0xa321, 0x6036, 0x13bb, 0xa2c0,
+		0xd5aa, 0xd7a8, 0x0ede, 0x340a, 0x9b98, 0x7d77, 0x3771, 0x8523, 0xda47, 0xd1ab, 0x1f91, 0xeee7,
+		0xc19d, 0x849b, 0xdfcc, 0x9387, 0xa6b5, 0x6ca8, 0x56e5, 0x6d37, 0xd8c6, 0xb3ca, 0xdc5d, 0x22c5,
+		0x2136, 0x9e99, 0xdc8f, 0x5bfb, 0xdd7c, 0xc5ce, 0x3445, 0x30fa, 0x6ac3, 0x27af, 0x944f, 0xcee9,
+		0x3a14, 0x6d00, 0x4cec, 0xcce8, 0xeadd, 0x1db8, 0x4d82, 0x36a8, 0xe9b0, 0x6b4e, 0xc6b4, 0x1f5b,
+		0xcecb, 0x752b, 0xe95a, 0x4177, 0x50cf, 0xd1e8, 0xb750, 0xe3bd, 0x5c10, 0x1296, 0xb260, 0x6de1,
+		0xce52, 0xa901, 0xf24b, 0xc115, 0x9a0b, 0x9fed, 0x3f69, 0x35a4, 0x65e0, 0x66c9, 0xb43d, 0x06eb,
+		0xbf5a, 0x7f0e, 0x8ef1, 0x9895, 0x5fa8, 0xafe6, 0x619d, 0x1985, 0x6193, 0x466e, 0x20c9, 0x5cf4,
+		0x9e53, 0xb2f1, 0x3301, 0xe1b1, 0x4054, 0x7e8b, 0xa798, 0x3105, 0x53a5, 0x2ebe, 0xa0a5, 0x2c31,
+		0x3619, 0x284c, 0x9a7f, 0x4118, 0x9f4c, 0xbd58, 0x560b, 0x4a2c, 0x5cbd, 0x521f, 0xc9bb, 0xa21a,
+		0x22d7, 0x2ae5, 0xb526, 0x2fac, 0x88f6, 0x9671, 0xc92b, 0xf0f9, 0x5d9a, 0x15a8, 0x05ca, 0xc9ef,
+		0x20e1, 0xed5c, 0x0fd6, 0x0187, 0xe4fb, 0x1cea, 0x168b, 0x5bd7, 0x6426, 0x3c14, 0x94ca, 0x0a5e,
+		0x92f7, 0x71af, 0x245a, 0x28f9, 0x7eb9, 0x7fc7, 0xbbee, 0x1c90, 0x46e7, 0x37b6, 0x54ad, 0x0b5f,
+		0x5259, 0x4636, 0xec3c, 0x02ca, 0x8798, 0xe7a8, 0xd5f9, 0x9441, 0x0789, 0x036a, 0xc25e, 0xe573,
+		0xf335, 0x72c0, 0xe9db, 0x969f, 0x89ff, 0x0eac, 0x75cb, 0x0ef8, 0x11a9, 0xc520, 0x823a, 0x78d4,
+		0x7ca4, 0x0734, 0x4139, 0x4e83, 0x9b7f, 0x0908, 0xc227, 0x695d, 0x4eaa, 0x819f, 0x9c9d, 0xadd0,
+		0xd58f, 0x9ac6, 0xdcc5, 0x7509, 0xe926, 0x779e, 0x43b5, 0x190a, 0x03a2, 0x0062, 0xf92c, 0x5e10,
+		0xad59, 0x696f, 0x5cfb, 0x6f5f, 0xd253, 0x8f7a, 0xe1ca, 0x8149, 0x9f83, 0x0370, 0x2c2d, 0x29b9,
+		0x5525, 0x063b, 0xbb9c, 0x3005, 0xed67, 0x2174, 0xbf4a, 0x2b07, 0x4a31, 0x1b61, 0xb4b5, 0x5fba,
+		0xf07e, 0x5487, 0x9a71, 0x11be, 0x0841, 0x6dd1, 0x3133, 0x3bfa, 0xfcab, 0x1b31, 0x3f1e, 0xdb53,
+		0x4473, 0x6854, 0xa5f2, 0x09c7, 0x0e6c, 0x3546, 0x59f0, 0xb02d, 0x07ff, 0x0063, 0x183e, 0x9018,
+		0xa92f, 0x51fc, 0x33d6, 0xcc3f, 0x502e, 0x36f9, 0x43f3, 0x53de, 0x5dd9, 0xef1f, 0x315c, 0x7098,
+		0x13df, 0x1647, 0x7fa2, 0xce99, 0x71e1, 0xc6a0, 0x6f7a, 0xd591, 0x66dc, 0xe49f, 0x3580, 0xe621,
+		0x4c25, 0xe113, 0x88ee, 0x5179, 0xff53, 0x208e, 0xe3f6, 0x50d5, 0x7670, 0x9da2, 0x951e, 0x66b0,
+		0x5f47, 0x5620, 0x422f, 0x1d2a, 0x4639, 0xdc1f, 0x4b2e, 0x2ef0, 0x17df, 0xa855, 0xc3bd, 0xe510,
+		0x2ccb, 0x87a7, 0xb7b4, 0x0c72, 0x6a90, 0x9e6a, 0x1707, 0xcc4f, 0x91da, 0x1ec3, 0x05e1, 0x50e3,
+		0xe06a, 0x1f62, 0xb75a, 0xd9f8, 0xc3da, 0x97d0, 0xd88c, 0x3b8d, 0x510d, 0xb0a5, 0x0196, 0x467b,
+		0xe14a, 0x043d, 0x2c2c, 0xf341, 0xd532, 0x9ce6, 0x06ef, 0x4c27, 0x3782, 0x3190, 0xa39f, 0x71c0,
+		0xd058, 0x3061, 0x6332, 0xf49a, 0x5e22, 0xf4a7, 0x8a59, 0xe7b2, 0x7bd7, 0xeb85, 0x41d6, 0x680e,
+		0x528c, 0xd03b, 0xe05c, 0x67f1, 0x551f, 0xc286, 0xbe43, 0x19f7, 0x0e69, 0x4c79, 0xe620, 0x7945,
+		0xccdb, 0x6c8c, 0x7501, 0xcacd, 0x0429, 0xdb05, 0x7a1a, 0xfa84, 0xac9e, 0x203a, 0x9803, 0x39ca,
+		0xe765, 0xe355, 0x222a, 0x07a6, 0x321f, 0x0782, 0x50f1, 0x6b7a, 0xdb1c, 0xa9e6, 0x7eaf, 0x9cc9,
+		0x2808, 0x2e48, 0x3f4b, 0x2c40, 0x5e95, 0x9c03, 0x65eb, 0xf899, 0xfe6c, 0x5b85, 0x9c05, 0xc6df,
+		0xb349, 0x5e7d, 0xf2b7, 0x8cd7, 0x0442, 0x1c03, 0x7d83, 0x4ed4, 0xf9e0, 0x7595, 0x35d3, 0x2aea,
+		0xc085, 0x1d34, 0x9231, 0x922a, 0xad0a, 0x692f, 0x6b38, 0xf856, 0x0da8, 0x39da, 0xa2dc, 0x6dd3,
+		0x98d8, 0x02d3, 0xccba, 0xdeb8, 0x650b, 0x54f9, 0x9b0e, 0x416d, 0xbc41, 0x7105, 0x5b06, 0x97f7,
+		0xc714, 0x2279, 0x2f7f, 0x9a05, 0x5e01, 0x4964, 0x4576, 0x279d, 0x4180, 0x4374, 0x1e08, 0x3c4e,
+		0xb2d0, 0xb347, 0x5eb2, 0x1e0d, 0xad36, 0x2144, 0xe8b9, 0x90ff, 0x04df, 0xfb98, 0xaaba, 0x1c51,
+		0xf6ab, 0xf26a, 0x46d8, 0x3162, 0x0eac, 0xbaef, 0x90dc, 0xe2c4, 0x3953, 0x5acb, 0x3659, 0x2649,
+		0x7f39, 0x3a57, 0x3606, 0x6756, 0x1b79, 0xacb5, 0x6c05, 0x4ce4, 0x07a0, 0x6e3c, 0xe22f, 0x1854,
+		0xe294, 0x99d2, 0xa9cd, 0x6d55, 0x1cb3, 0xaa31, 0x2992, 0x0ee2, 0x92eb, 0x44d4, 0x440c, 0xe4d4,
+		0xe540, 0xe076, 0x4b28, 0xad2b, 0x45af, 0x6c62, 0x117e, 0x8f5b, 0xd7fc, 0x3216, 0xd7a6, 0xbc8c,
+		0xa8b5, 0xc660, 0x582a, 0xd87e, 0x4a0e, 0xd0b4, 0x35f7, 0x31a3, 0x2125, 0x9fc3, 0x505f, 0x4b52,
+		0x2e12, 0x5b5b, 0x714a, 0x0d15, 0xbe58, 0xc574, 0x0e68, 0x81ce, 0x6e43, 0x874a, 0xd5fd, 0xe8fd,
+		0xd9f3, 0xf0b1, 0x31f8, 0x0a69, 0x0297, 0x7d5b, 0x79f9, 0x12b2, 0x642b, 0xf490, 0x49df, 0x1193,
+		0xf9b3, 0x1ac5, 0x71d4, 0x8038, 0xf149, 0x9c28, 0xab85, 0x5085, 0x752d, 0xdf9c, 0x8428, 0x2bbd,
+		0x154f, 0xde76, 0x72ff, 0x5f77, 0xa0bc, 0x80b3, 0x814b, 0xa4a8, 0x1341, 0x75fc, 0x9989, 0xa2e5,
+		0x2be5, 0xe451, 0x0e4a, 0x091e, 0xa988, 0xbbee, 0x9e7d, 0x5eee, 0xd600, 0x46f4, 0x8a12, 0x6f1a,
+		0x6074, 0x2c7c, 0x6f2f, 0xe1df, 0xd291, 0x0056, 0xcf07, 0x58e3, 0xd8c6, 0x5add, 0x3659, 0x5711,
+		0xbfa5, 0xc561, 0xb92a, 0x3434, 0x49ce, 0xa91d, 0x1e40, 0x6e92, 0x5367, 0x0460, 0xe9cf, 0x0866,
+		0xddf5, 0x293d, 0xc4c3, 0x854a, 0xe13a, 0xedc4, 0x44c2, 0x9386, 0xea05, 0x5293, 0x1e50, 0x2174,
+		0xfa79, 0xfb0f, 0x89a6, 0x8e61, 0x99cb, 0x83e9, 0xbb1f, 0xec35, 0x4b34, 0xfd29, 0x0dbc, 0x5a19,
+		0xd83c, 0x3417, 0xc4ee, 0x1e44, 0xaf3d, 0x6bce, 0x39e5, 0x2eb6, 0xc747, 0x3d93, 0xee23, 0xff74,
+		0xe00e, 0xe94f, 0x60b1, 0x4f54, 0x8747, 0xa13f, 0x3b1b, 0xb720, 0x8189, 0xa6c8, 0x2373, 0xd300,
+		0x49db, 0x5166, 0x4396, 0xe776, 0xe41a, 0xdcd0, 0xd61f, 0x351a, 0x2d37, 0x2e8c, 0x41ca, 0xbb85,
+		0x9def, 0x775c, 0xdbfc, 0x6d62, 0x433f, 0x0cba, 0x3305, 0x25ec, 0xff6a, 0x7260, 0x21cd, 0xf7d5,
+		0xd81b, 0x217d, 0x35d5, 0xa098, 0xe1b9, 0xd0bc, 0x76d9, 0x9e50, 0x3023, 0xcf68, 0x5965, 0x6345,
+		0x17db, 0xd3b0, 0x618e, 0x5f8a, 0x62ed, 0x5a5a, 0xf7d6, 0x842b, 0x1ac3, 0xbe6b, 0xb1dc, 0x1f65,
+		0x4333, 0x1221, 0x3625, 0x144a, 0x4ebf, 0xb523, 0x2ec0, 0xae7b, 0xbff7, 0x2ec1, 0x6d37, 0x0615,
+		0x7f12, 0xd02c, 0x9337, 0xbeb2, 0x880b, 0xfa25, 0x9bf3, 0x0f0a, 0x8024, 0xb088, 0xc379, 0xf34a,
+		0xd297, 0xd5f7, 0x6aea, 0x0ee7, 0x0dc4, 0x84e5, 0xf2dc, 0xec9d, 0xd76a, 0x5a44, 0x2999, 0x2fda,
+		0xcdd1, 0xa66a, 0x95dd, 0x8907, 0x0c5c, 0x367a, 0x0594, 0x5854, 0xf4d8, 0xc788, 0xb482, 0x7c2c,
+		0xb913, 0x3cbf, 0x4621, 0x2e6a, 0xf04e, 0x7c60, 0x3184, 0x79b7, 0x76ef, 0x2ab2, 0xeb43, 0x36ff,
+		0xf228, 0x2f8b, 0x2f75, 0x0f66, 0xbee1, 0x4c78, 0x63a9, 0xce5d, 0x213f, 0x4499, 0x31aa, 0xdc67,
+		0x2336, 0x1618, 0xf19f, 0x76c8, 0xe8ef, 0xd9ad, 0x2437, 0x7320, 0xdcac, 0xc643, 0xb113, 0x1cbc,
+		0x703f, 0xbb46, 0x3b49, 0x4079, 0xe6e8, 0xbe23, 0xc1ee, 0xa37c, 0xb53c, 0x482a, 0xf4fd, 0x05a9,
+		0x1b31, 0x6f4b, 0x57a8, 0xdd81, 0xb7db, 0x2f09, 0x92b8, 0xe099, 0x5537, 0x8f50, 0x5c88, 0x1815,
+		0x45c6, 0x5eeb, 0xa2d7, 0x1902, 0xb8c7, 0x1edb, 0x4b75, 0x2043, 0x0f95, 0xe349, 0x4376, 0x3ee2,
+		0x114c, 0xf122, 0x7ec1, 0xd56b, 0xe2b7, 0xf0f0, 0x7393, 0xdcff, 0xd2d9, 0x87c8, 0x5077, 0xed49,
+		0x1c55, 0x0d93, 0x2a14, 0xf653, 0x12a5, 0xbac5, 0x5733, 0x1c81, 0x08fc, 0x3bb1, 0x893a, 0x655b,
+		0xe517, 0xfd20, 0xe91e, 0x7f26, 0x9a54, 0x3859, 0xa2ce, 0x1e1c, 0xda0c, 0x9555, 0xd2e4, 0x9644,
+		0xb01c, 0x52ba, 0x5d67, 0xf2f7, 0x471f, 0x4392, 0x1ab7, 0xd7c7, 0x2b9e, 0x3294, 0x5720, 0x49c2,
+		0xe487, 0x4ddd, 0xc8c0, 0x6552, 0xc3b7, 0x2ff0, 0xcafa, 0x347f, 0x56ac, 0x67e0, 0x88ba, 0x5830,
+		0xd028, 0x19ee, 0x10a3, 0x4472, 0x45f1, 0x2a48, 0x5a44, 0x90b9, 0x2e87, 0xd84e, 0x2c73, 0xd1fb,
+		0x4b48, 0xf7dc, 0x62ff, 0x12f6, 0xb64e, 0xb609, 0x6194, 0xe9e5, 0x77eb, 0x7303, 0x6d9f, 0x4a36,
+		0x985c, 0xc274, 0x1975, 0x2aaa, 0x9a10, 0x8c16, 0x5b07, 0x9e37, 0x0fba, 0x98f1, 0xbe7b, 0xeb41,
+		0xef76, 0x0176, 0xfd18, 0x9cd1, 0x921b, 0x054e, 0x8de0, 0x6990, 0xfdbf, 0xbbac, 0xa5b5, 0xde68,
+		0xa9bb, 0x3215, 0x49ad, 0x9fdb, 0x15b4, 0x52a3, 0x5c0e, 0xd0cb, 0xcfcf, 0xb848, 0xf2e5, 0xc242,
+		0xd652, 0x87fe, 0x843d, 0x9e37, 0x56b6, 0xa2c0, 0x4cd7, 0xe727, 0xd215, 0x39e5, 0x9095, 0xeff5,
+		0x7b85, 0x0d5d, 0x864f, 0xc926, 0xf0dd, 0x7d82, 0x3bff, 0xda04, 0x768c, 0x25e2, 0xc784, 0xac8a,
+		0x215a, 0xe5b4, 0x8a4e, 0x6197, 0x36ce, 0x60a0, 0xb0a1, 0xa0c7, 0x8f27, 0x6bd9, 0xab5c, 0xba4a,
+		0x4015, 0x447e, 0x8c76, 0x52fd, 0x8075, 0x2551, 0x97c7, 0x4c42, 0xd7ce, 0x34ef, 0x2166, 0x8021,
+		0xb2a3, 0x6a78, 0xa463, 0x2b12, 0x6de1, 0xbe2f, 0xb5c7, 0x68dc, 0xc674, 0x59c6, 0x4286, 0x4fc9,
+		0x3215, 0xf909, 0x23a8, 0x4a19, 0x4359, 0x1e8c, 0xe312, 0xaed0, 0x5aaa, 0xdbfb, 0x4650, 0x1639,
+		0x5f30, 0x4e21, 0x6099, 0x7ea0, 0x5841, 0x6900, 0xbffb, 0xc9d1, 0x801f, 0x9afe, 0x23d8, 0xa9af,
+		0xbd75, 0x2c0a, 0xc897, 0x7260, 0x4646, 0x796f, 0xfca4, 0xba58, 0x196c, 0x4f63, 0xb181, 0xe4d8,
+		0xa4df, 0xb4a5, 0x83bb, 0x2902, 0x5ead, 0x826c, 0x33bc, 0x7ad1, 0x8379, 0x788b, 0x21ad, 0x6dc1,
+		0x5ef9, 0xef2f, 0x5820, 0x52de, 0x5697, 0xbdc4, 0x80d1, 0xd90d, 0xbed6, 0x9eaf, 0x5e66, 0x3359,
+		0xfe14, 0x6c4c, 0x4ee0, 0x2b4c, 0x95e7, 0xf541, 0x01d3, 0x5098, 0x012b, 0x349b, 0xbb84, 0xd41f,
+		0xac5a, 0x2baf, 0xa1c6, 0x3096, 0x7f3b, 0xf4d3, 0xac90, 0x81e4, 0xfc81, 0x50e2, 0x857c, 0xa71d,
+		0x3968, 0xd745, 0x0e46, 0x1664, 0xcd25, 0x729c, 0x873a, 0xc25b, 0x25da, 0x150e, 0xa5ae, 0x3e3b,
+		0xf56d, 0x2596, 0x6b12, 0x0a48, 0xd52e, 0xffbc, 0x8165, 0x7400, 0xa855, 0x061f, 0xdee1, 0xd687,
+		0xefdc, 0x8894, 0xcb79, 0x0639, 0x0a4a, 0xb997, 0x3659, 0xecbb, 0x2d55, 0x8878, 0xc165, 0x7f5d,
+		0x549a, 0x56c5, 0x3bb0, 0x5894, 0x71f2, 0xb55c, 0xc4fd, 0x69bb, 0x949b, 0x1a06, 0x08c1, 0xeb33,
+		0xafab, 0x84dd, 0xeebb, 0x2086, 0x748c, 0xe001, 0xe2fe, 0x42cb, 0xf79b, 0x9e03, 0x626f, 0x332f,
+		0xb07c, 0x0806, 0x10d4, 0x561c, 0x0072, 0xcb9c, 0xa911, 0x588e, 0x2a63, 0x3a0e, 0xbd0b, 0xdf14,
+		0x6408, 0x601b, 0x5207, 0xc24d, 0x2773, 0xbd9f, 0xe58e, 0x4bbc, 0x7478, 0xa770, 0xa004, 0x8bae,
+		0x84af, 0xc370, 0xb11a, 0x05ac, 0x00c1, 0xc901, 0x042f, 0x79c7, 0xda80, 0x25fe, 0xb855, 0x350d,
+		0x3088, 0x2e92, 0x1f89, 0x45f6, 0xb86c, 0x8bb8, 0x3570, 0x0570, 0x7249, 0x7417, 0x2678, 0x6f54,
+		0x4208, 0x0207, 0x387f, 0xb2e0, 0x66b5, 0x6afc, 0x213c, 0xe26c, 0x70cc, 0x0e68, 0xbf68, 0x3fac,
+		0xb210, 0xaa84, 0xb6b1, 0x061e, 0x6618, 0x6265, 0xd9cb, 0x70dc, 0xfaf8, 0xd8da, 0x7af7, 0x4b17,
+		0x9fb3, 0xae68, 0xe063, 0xe99d, 0x8fcf, 0x1026, 0x0f8a, 0x8f00, 0x4cce, 0xdf96, 0xadb4, 0xe390,
+		0xbc17, 0x7289, 0xc887, 0x538a, 0xf599, 0x0050, 0x63e7, 0x46cd, 0x745f, 0x42a8, 0x27f4, 0x331f,
+		0x8e36, 0x74a9, 0xfcab, 0x36c6, 0x0172, 0xebb3, 0x1e1e, 0x3d22, 0xde28, 0xa4e2, 0x22a3, 0x05ef,
+		0x4882, 0xea0d, 0x2abf, 0x9fd2, 0x8eba, 0x12ed, 0x4295, 0x7d07, 0xde6f, 0xa8bc, 0xd302, 0x2def,
+		0x12cf, 0xe24a, 0xf7e9, 0xfffe, 0x076d, 0xca10, 0xc3c2, 0x8d4f, 0xedc9, 0x5c2c, 0x8ff1, 0x65ca,
+		0xe4be, 0x7588, 0x03ae, 0x8fe0, 0x5cb4, 0xb4e8, 0x4f9a, 0x3fa6, 0xa9d8, 0x6d37, 0x0b5d, 0x0868,
+		0x0532, 0x1d19, 0x7c17, 0xa2ae, 0x3f36, 0x1ded, 0x90b9, 0x8970, 0x3274, 0x5c61, 0x62a5, 0x49f2,
+		0x48fd, 0xe719, 0xbd2d, 0x431d, 0x876d, 0xa5b6, 0xf80e, 0x4f50, 0x1691, 0x6983, 0x13dc, 0xc3f8,
+		0xaac6, 0x58dd, 0xf0c0, 0x3ff5, 0x9e30, 0x5bc1, 0x8dae, 0xea0b, 0xe71b, 0x10bf, 0x6c07, 0x968f,
+		0x2df5, 0x90f8, 0x9447, 0xcf70, 0x087e, 0x597b, 0x9c70, 0x98fe, 0x3a9e, 0x38e0, 0x1018, 0x891a,
+		0x8636, 0x46b7, 0x3b0f, 0x6b20, 0xf291, 0x6bf1, 0x4f87, 0xeedf, 0x2e42, 0x2dd5, 0x1e8d, 0xd279,
+		0x559e, 0x3786, 0x5f13, 0x58de, 0x5685, 0x5b64, 0x047b, 0xd8f7, 0xa2a3, 0xbd92, 0x70f3, 0x47ff,
+		0xf7b5, 0x29e2, 0x576f, 0x0c61, 0x6a3c, 0x8fc7, 0xf64a, 0xc81b, 0x0e82, 0xd7fb, 0x14a0, 0x0578,
+		0xf613, 0x01de, 0xa112, 0xcc59, 0xec33, 0x2997, 0x31cb, 0x9657, 0x9ce7, 0xde2e, 0xc439, 0xc7c0,
+		0xe222, 0xc54a, 0x38b1, 0x48c5, 0xaeb0, 0x3972, 0xc8e6, 0x0dee, 0x5969, 0x8f7c, 0x8c31, 0x8e5a,
+		0xf746, 0x2bec, 0xc8a5, 0x4290, 0xf700, 0x9c1b, 0x8c5a, 0x5174, 0x76c8, 0x453c, 0xb200, 0xc0d7,
+		0xc906, 0x33e7, 0x1c36, 0xe349, 0x3765, 0x8dab, 0x26e6, 0x20c8, 0xacc8, 0xa163, 0x3821, 0x9ef4,
+		0x9fe9, 0x486a, 0xde0d, 0x54d9, 0x6d2e, 0xc390, 0x2ea6, 0x0d2d, 0xe635, 0x2cbc, 0x217c, 0x83a3,
+		0xef64, 0xd4b8, 0x903a, 0xe0b0, 0xd57b, 0x016b, 0x597d, 0xee08, 0xde2f, 0x6faf, 0x1c21, 0x1b1f,
+		0x6032, 0xedbe, 0xeb55, 0xbf68, 0xf0d2, 0x9c70, 0xaa02, 0x3785, 0xf2f0, 0x959b, 0x401a, 0x0774,
+		0xd3fe, 0x3784, 0xa44b, 0x7ee4, 0x5087, 0x071d, 0xa27f, 0x8d40, 0x9407, 0x649f, 0x71b1, 0xd436,
+		0xabee, 0x2807, 0x5d0c, 0x252c, 0xa0c7, 0xad45, 0x24f5, 0x4cef, 0x2722, 0x8d44, 0xd2da, 0x596c,
+		0x1536, 0x7501, 0x6f37, 0x5e95, 0xd787, 0x86b5, 0xd401, 0x741b, 0x219d, 0x18a6, 0x73a7, 0xd70c,
+		0xc4ab, 0xd6f7, 0x853f, 0x7779, 0x4c0e, 0x1537, 0x2087, 0x5600, 0x1f8e, 0x6942, 0xca7a, 0x377e,
+		0x4685, 0x140e, 0x3659, 0x29e2, 0xbbe5, 0x3b20, 0x110e, 0x1740, 0x77f7, 0x5aa6, 0xf45e, 0x2352,
+		0x740d, 0x2c06, 0xfda1, 0x9b47, 0x4301, 0x47a2, 0x8b05, 0x2fe7, 0x2506, 0x8741, 0x76a2, 0xcc2a,
+		0x2f1f, 0xb8ac, 0x3d2c, 0xc8ed, 0x2254, 0xa2a2, 0xf3d1, 0x8fee, 0x6e36, 0x4372, 0xb2c0, 0x7b6f,
+		0x3780, 0x4997, 0x397e, 0xcd17, 0x8215, 0xe3a2, 0x0f8c, 0x488c, 0xcbce, 0x3ed8, 0xf7f2, 0x2de4,
+		0x9c5f, 0x0af8, 0x4e7c, 0xb7f0, 0x41ff, 0x2158, 0xa1b6, 0xdcef, 0x28ab, 0xe67f, 0x954e, 0x75e6,
+		0xe286, 0xd517, 0xbd94, 0x818e, 0x838c, 0xf343, 0x0ef5, 0x9714, 0x1cab, 0xa1d9, 0x117a, 0x4dbd,
+		0x4d19, 0x9b1d, 0x7e38, 0x5d67, 0xa91d, 0x3511, 0xf60c, 0x0dcf, 0x2376, 0x37c2, 0x8c7f, 0x8f2d,
+		0xfae0, 0x608f, 0x0e0f, 0x2524, 0x89cf, 0x1ac1, 0x7949, 0x5706, 0x958f, 0x68c2, 0x88ba, 0x89e1,
+		0x878b, 0x2df9, 0x6485, 0x3c2d, 0xe415, 0x472e, 0x7994, 0x4dd1, 0xa841, 0xad42, 0xa492, 0xb39e,
+		0x1376, 0x692b, 0xf82b, 0xa995, 0x2d2b, 0xacfc, 0xb370, 0xaebb, 0x0013, 0xc255, 0xb2e6, 0xdbde,
+		0x4bba, 0x2ddc, 0xf544, 0x2ba0, 0x8c86, 0xff27, 0x2ddd, 0xc19e, 0xb904, 0x166c, 0x7b7c, 0xb116,
+		0xd2b1, 0xabc3, 0xfce2, 0x8970, 0xe821, 0x0c1a, 0x1d56, 0x2d52, 0xeff9, 0x4547, 0xaf7c, 0x9d67,
+		0x7fad, 0xc663, 0xed41, 0xce25, 0xca9c, 0x1583, 0x5dd5, 0x7859, 0x98e1, 0x5410, 0x7412, 0xd253,
+		0x4822, 0x2eb1, 0xab1c, 0xacea, 0xe708, 0xf3c9, 0x455c, 0x0c0c, 0x1bd3, 0x5de0, 0xe3db, 0xdf5f,
+		0xc89e, 0x245d, 0x9a90, 0x6799, 0xdbfb, 0x5658, 0xe4e9, 0x4124, 0xced2, 0x77a7, 0xa4ae, 0x7cd3,
+		0xdeb0, 0x8904, 0xc443, 0x5d89, 0x1829, 0x659c, 0x0f91, 0xc803, 0x85a4, 0x7dc8, 0xe49d, 0xc887,
+		0x5c21, 0x1a21, 0xf663, 0x2e90, 0xb892, 0x805d, 0xd8d7, 0x830c, 0xf00b, 0x38c5, 0xc9f3, 0xea79,
+		0x73ea, 0xbf7d, 0xd543, 0xb89d, 0xe170, 0x6903, 0x0acb, 0xb2c4, 0x248b, 0x239d, 0x62f5, 0x89d3,
+		0x3658, 0xda8b, 0x2308, 0xeef3, 0x6a7c, 0x7a08, 0xc9f5, 0x019f, 0x3370, 0x9db1, 0x5dac, 0x4dbc,
+		0xabdb, 0xbac8, 0x090e, 0xb2f1, 0xb464, 0x43c5, 0x9d92, 0xdb8b, 0x40ee, 0xf7fc, 0xbfb3, 0x2b6c,
+		0xd410, 0xf75a, 0x1255, 0xd749, 0xceb7, 0x6583, 0xcaed, 0xcd1a, 0x2256, 0x6829, 0x7991, 0xa78d,
+		0x2473, 0x66ec, 0xc24f, 0x6136, 0x1481, 0x69a5, 0xca81, 0x4761, 0xf650, 0x5523, 0x30f2, 0x7a42,
+		0xf229, 0xcee4, 0x27f6, 0x4332, 0x2212, 0x3ca5, 0xb58b, 0x751a, 0x278b, 0xb298, 0x0cd6, 0xc2ed,
+		0x0c9d, 0x151e, 0xa508, 0xffbb, 0x023c, 0x680f, 0x1760, 0x186f, 0x8c15, 0xf6c0, 0x5b60, 0xec0c,
+		0x3c2e, 0x32c3, 0xf707, 0x2c7f, 0xb2f7, 0x6d7d, 0xa93d, 0xdf4e, 0x6018, 0x3e4e, 0x7b7d, 0xeb17,
+		0xc932, 0xdcf8, 0xb99f, 0xa08e, 0xae5c, 0xc64b, 0x0fee, 0xd40b, 0x61ea, 0xad45, 0x6b31, 0xc640,
+		0x4d37, 0xa0fc, 0xaba8, 0xa30d, 0x5a56, 0xc411, 0x0888, 0x3717, 0xebaf, 0xb86f, 0x34b7, 0x6c01,
+		0xc741, 0xa144, 0x24b4, 0xa055, 0xf356, 0xee67, 0x82ce, 0x1265, 0x4529, 0xc431, 0xb9a8, 0xe53a,
+		0xac29, 0x2777, 0x4c23, 0xf0db, 0x4368, 0x42a7, 0xc945, 0x4806, 0x269c, 0x6d19, 0x17ed, 0x94d7,
+		0xd687, 0xb032, 0x7128, 0xa024, 0x8eb7, 0x40f6, 0xc3c8, 0xe82e, 0x12c6, 0x9af5, 0x8366, 0x3e33,
+		0xaecb, 0x0dcd, 0xfc9d, 0xea12, 0xc459, 0xe38d, 0x83a7, 0x85f3, 0x3775, 0x9560, 0x3d33, 0x9a7a,
+		0xd4ea, 0xf314, 0xc0a8, 0x9ea8, 0x3da3, 0x2983, 0xa5d3, 0x2615, 0xa2c0, 0xaba1, 0x0418, 0xda83,
+		0x59af, 0x0383, 0x7714, 0xa4bc, 0x71b0, 0x7a07, 0x8b4b, 0x861e, 0x309b, 0x790f, 0x1d96, 0x169f,
+		0x85b3, 0xeb45, 0x708a, 0x8fe2, 0xfe6b, 0x4e37, 0x43ef, 0x3d0b, 0x5f70, 0x1f6b, 0x717e, 0x0dac,
+		0x6900, 0x718a, 0xe109, 0x1f10, 0x7ee1, 0xd6c0, 0xff13, 0x3a15, 0xc055, 0x43e7, 0xfc06, 0x64d2,
+		0xaff4, 0x5cb4, 0xfe2c, 0xbd7f, 0xe0d2, 0x173f, 0xcc96, 0x2904, 0xd5d4, 0xef5f, 0x7dee, 0x3f66,
+		0x4e4c, 0xad64, 0x8922, 0x733f, 0xe06b, 0xe83e, 0xb56d, 0xa874, 0x0be2, 0x4e59, 0xd738, 0x4f65,
+		0x96c9, 0x28bd, 0xb364, 0xd5ce, 0xe733, 0xf3c3, 0x82c9, 0x9de6, 0xf955, 0x6528, 0x26b2, 0x2dee,
+		0x5e46, 0xab29, 0x6416, 0xdbc5, 0x8428, 0xe87f, 0xbdf7, 0x670f, 0x1270, 0x3640, 0x0a36, 0x0988,
+		0x60cb, 0x1ae7, 0xc118, 0xb4f6, 0x4ccf, 0x9aa4, 0x6b80, 0x2d19, 0xc55a, 0x94f3, 0xc9f2, 0xf79b,
+		0x20d6, 0x2cf4, 0x408e, 0xf8d6, 0x4134, 0xf65a, 0x232a, 0x74e4, 0x2768, 0x3ec6, 0x6c79, 0x627b,
+		0x9f39, 0x908c, 0x645f, 0x099f, 0x1a11, 0x8f7e, 0xedda, 0xbb28, 0xfbda, 0x96e6, 0x8970, 0x6ea2,
+		0x6b4f, 0xa7c6, 0x66a5, 0x1260, 0x09a5, 0xcaf6, 0x3ef1, 0xa6e1, 0x4d20, 0x1b21, 0x9e29, 0xffc3,
+		0x5bae, 0xd05a, 0xcf1f, 0xf440, 0x3553, 0xb067, 0xc4c8, 0xb0df, 0x2edd, 0x1499, 0x5da3, 0xb71e,
+		0x3957, 0x4c91, 0x2a72, 0x7994, 0x4640, 0x3bf3, 0x9aee, 0x5f8e, 0x37a0, 0x0567, 0x36ff, 0x14f8,
+		0xdbfe, 0xd407, 0xf77a, 0x0213, 0xf624, 0xd33d, 0x161e, 0x6564, 0x57fa, 0x29fb, 0x5d10, 0xfab7,
+		0xba5b, 0x4543, 0x8baa, 0xce2c, 0x3737, 0x72b8, 0x83c1, 0x675a, 0xa94f, 0x6136, 0x321e, 0x4221,
+		0x80d2, 0xd274, 0xddd0, 0xc1ba, 0x05f2, 0xa5ed, 0xefc2, 0x5e31, 0xa47c, 0xb807, 0x30f6, 0x3b7b,
+		0xc5f5, 0xb70d, 0xa47e, 0x34a5, 0xf5bb, 0x437e, 0x0b54, 0x744d, 0x6a52, 0xa6de, 0x5f52, 0x73e0,
+		0x99c1, 0x23d8, 0x71f2, 0xc4ab, 0x41e0, 0x6fcf, 0x65f1, 0xce45, 0x873c, 0xf115, 0xd98e, 0xef6a,
+		0x3654, 0xc7be, 0x659f, 0x596c, 0xadbb, 0x3b58, 0xb716, 0x5f90, 0x8b19, 0xf7bd, 0xd4ac, 0x6568,
+		0x9dc4, 0x64b5, 0x193a, 0xc49e, 0x7e8b, 0x74b0, 0xf452, 0xca59, 0xf918, 0x1cab, 0x2631, 0x1921,
+		0x5fbd, 0x6144, 0xe62d, 0xd3e7, 0x3988, 0xc8f0, 0x53f9, 0x5d32, 0xc171, 0xe244, 0xf56d, 0x6756,
+		0x2c3d, 0xcfe6, 0xce3f, 0xda47, 0xb2a2, 0xf2f4, 0x534c, 0x3983, 0xf52b, 0x74c1, 0xc254, 0xe6b0,
+		0xd164, 0x1423, 0x0099, 0xb6f1, 0xdea6, 0xb2af, 0xa214, 0x15b6, 0xcc46, 0xbc3f, 0xa903, 0xc162,
+		0x8751, 0x5d3b, 0xd517, 0x42ca, 0x7a45, 0x9aee, 0xfc7c, 0x28e0, 0x41ea, 0xbf1b, 0x091d, 0xd328,
+		0x77ec, 0xa396, 0x3f02, 0xda2d, 0x6bca, 0xb514, 0x4fd0, 0x3b23, 0x9d7d, 0xe827, 0x4745, 0x5d38,
+		0x3dd3, 0x8bc3, 0x3327, 0xe2ce, 0x288e, 0x8826, 0x8692, 0xbd5a, 0x3954, 0x94af, 0x3e71, 0xfe90,
+		0xb314, 0x686f, 0xb8de, 0x01f5, 0xa127, 0xf643, 0x42be, 0xa37d, 0xfa81, 0x1cc0, 0xec75, 0xe1e8,
+		0x80e2, 0xf28e, 0x0fd6, 0xd843, 0x6ad0, 0x96d4, 0xcead, 0x1455, 0x3467, 0x912e, 0x6172, 0xab0f,
+		0xe0af, 0x01c2, 0xc634, 0x5eb2, 0x3f75, 0x7d89, 0x68ce, 0xe6d1, 0x823d, 0xb54a, 0x2448, 0xb2f1,
+		0xd34b, 0x0aac, 0xae38, 0x96bd, 0x7012, 0x01e0, 0xcf27, 0x747b, 0x6c7c, 0x004c, 0x5dcd, 0x6c3f,
+		0x710b, 0x60c8, 0x2538, 0x1892, 0xbe75, 0x783b, 0xb95d, 0x0c7a, 0x7088, 0x2e7b, 0x8a2d, 0x5634,
+		0x26a6, 0x5a22, 0x16d5, 0x458d, 0xfd08, 0x13cc, 0xd388, 0xfa0a, 0x87b7, 0x02b9, 0x276c, 0x8fe8,
+		0x818e, 0xfb93, 0xee20, 0x53e8, 0xac9e, 0xa861, 0x0b6d, 0x8e33, 0x37b2, 0xc3df, 0xb0d1, 0x6517,
+		0x9d5e, 0x996d, 0x9c67, 0x79ad, 0x20e9, 0xa9fa, 0x6789, 0x94b3, 0xd2fd, 0xe49c, 0xe882, 0xbfbc,
+		0x9602, 0x89e7, 0x830f, 0x2a99, 0x5c14, 0x5e88, 0x2e87, 0xcc25, 0xc8c0, 0xc3a7, 0xbf02, 0x1e5b,
+		0x3e6d, 0x325a, 0x3cb8, 0x1832, 0x0fdc, 0x88a7, 0xc0b9, 0x4a70, 0xe025, 0x54e9, 0x9b34, 0xddab,
+		0xc0ee, 0xdcc2, 0xb3ca, 0xb902, 0x2662, 0x6f93, 0xce7c, 0x6a8c, 0x6923, 0x4f77, 0xf808, 0x264c,
+		0x0dae, 0xcee2, 0x488a, 0xb4f2, 0xbcd9, 0x201b, 0x92d5, 0xf6b4, 0x5934, 0xe818, 0x4aff, 0xa2fc,
+		0x23a7, 0x927a, 0xa1af, 0x37f0, 0xae82, 0xc461, 0x3a2c, 0x59cb, 0x99d1, 0x8357, 0x8877, 0x6f4f,
+		0xeadd, 0xb70a, 0xcfce, 0x7fdb, 0x9e32, 0x2890, 0x6834, 0xa2de, 0x1b4d, 0xce37, 0xa15f, 0xa3cb,
+		0x5403, 0xf561, 0x5b60, 0xaf84, 0x987e, 0x07be, 0xffee, 0x8d5a, 0x765d, 0xdc78, 0x7e7d, 0xbc0f,
+		0xd875, 0x2ff8, 0x85ba, 0x50a0, 0xd2c1, 0xd3a1, 0x09c4, 0xa04e, 0x42ed, 0x77ce, 0x5ee4, 0xbc8c,
+		0xf282, 0x703e, 0xe2ea, 0x45bf, 0x1f82, 0xbc64, 0x4c47, 0xa963, 0x9b9e, 0xd77e, 0x38e5, 0xec18,
+		0xe965, 0x94fb, 0x3659, 0xc0f1, 0x1214, 0x2fcc, 0x9814, 0x3f7d, 0xd233, 0x184d, 0x7ebe, 0xdf04,
+		0x7c0d, 0x1950, 0x04b4, 0x7832, 0x6cdd, 0x95cd, 0xb880, 0xb3f5, 0xac5e, 0x5940, 0x6f23, 0x1538,
+		0x2d46, 0x5bfe, 0xa886, 0x991c, 0xadba, 0x0ab4, 0x6ef2, 0x1066, 0x3774, 0x2e8d, 0xe2d8, 0x7de8,
+		0xfe0f, 0x224b, 0x8ac2, 0x9c16, 0xf351, 0x435d, 0x149e, 0xae0f, 0xbc60, 0xe85a, 0xcb50, 0x890c,
+		0x4b23, 0xdc14, 0xb651, 0x09f0, 0x85a8, 0x17da, 0x13ac, 0x617a, 0xf6fb, 0x7a02, 0xf044, 0xba83,
+		0x8570, 0xe133, 0xeece, 0x0439, 0x35e2, 0x6dd8, 0x36f2, 0x5116, 0x1c8a, 0xa223, 0xfa2d, 0x520a,
+		0x7507, 0xe20b, 0xfabd, 0x3d7c, 0xad06, 0xb6ea, 0x728f, 0xe687, 0xc405, 0x9e16, 0xc1f7, 0xef37,
+		0x585f, 0x7402, 0x479c, 0x22a2, 0x86bc, 0x3388, 0x7c44, 0x34de, 0x5e5f, 0x6556, 0xa1f8, 0x5342,
+		0x0228, 0x7781, 0x129c, 0xf5b9, 0xe845, 0x1f90, 0x9486, 0x08fa, 0x9c79, 0x8792, 0xd2ce, 0x6d3b,
+		0x2013, 0xd21f, 0xcdf3, 0x7f35, 0xea40, 0x3db7, 0x002a, 0x570e, 0xc08a, 0x1c2c, 0xf912, 0xfa46,
+		0x4dd1, 0x9ee9, 0x35a8, 0x6d98, 0xdcd6, 0xa337, 0xca8c, 0x3e38, 0xe2b0, 0xcfc3, 0xcd2c, 0x8590,
+		0x639a, 0xf9b2, 0x5f86, 0xe3fd, 0x9751, 0xb00e, 0xaeb0, 0x689a, 0xd21f, 0xe413, 0xb229, 0xe87a,
+		0x742d, 0x52da, 0xc055, 0x8f17, 0x6e45, 0xf497, 0xe971, 0x37f4, 0x67c8, 0x149e, 0x8122, 0x0289,
+		0xd25a, 0x1938, 0x183d, 0x183a, 0x0995, 0xe56c, 0x6b76, 0x251f, 0x8341, 0x4568, 0x15d7, 0x3581,
+		0x9bae, 0xdc0d, 0xca13, 0x7557, 0xc27d, 0x92d4, 0x6fcd, 0xa0af, 0x3482, 0x2c52, 0xb77b, 0x52cd,
+		0xde11, 0xc6d2, 0x2a6c, 0x121b, 0xc039, 0x558a, 0xe0f4, 0x0c15, 0xae3d, 0xff7d, 0xee95, 0x5e02,
+		0x29f3, 0x604a, 0x429d, 0x6d7e, 0xda7b, 0x44a8, 0x3196, 0x74e6, 0xe900, 0x1fa1, 0xd22f, 0x0055,
+		0x4058, 0x28a8, 0x7053, 0x3ac7, 0x8f17, 0xf712, 0xac37, 0x33d9, 0xd687, 0x4ce1, 0x39d5, 0xb86e,
+		0x13df, 0x90a7, 0x533c, 0xfa0a, 0xaec3, 0x1a26, 0xf392, 0x8cf9, 0x3a11, 0xb54d, 0x9f95, 0x4b16,
+		0x7ef9, 0x06b8, 0xbc7f, 0x4b72, 0xcf4a, 0xefa4, 0x6619, 0x85e2, 0x2079, 0xf277, 0x42db, 0xcc8f,
+		0xe51f, 0x969f, 0x4a5f, 0xfc2a, 0x6012, 0xce51, 0x142f, 0x2fed, 0x1b96, 0x8e99, 0xced6, 0x8c2c,
+		0x0226, 0xd84f, 0x538d, 0xf01e, 0xb285, 0x8d9e, 0x2d36, 0xe091, 0x69d9, 0xcf28, 0x6e13, 0xce0f,
+		0xf89c, 0x0184, 0x59ba, 0x7465, 0x1fad, 0x9c38, 0xdf4e, 0xdebc, 0x0a27, 0x8f95, 0x1333, 0xaca4,
+		0x5326, 0x2e82, 0x75f0, 0x2b60, 0xb6d7, 0x210a, 0x7a13, 0x9a5e, 0x1ee6, 0x49c9, 0xc67b, 0xad81,
+		0x4ddf, 0x2042, 0x3cb2, 0x9c7b, 0x4959, 0xd692, 0xdcc4, 0xde15, 0x1e9b, 0x87df, 0xa84b, 0x168f,
+		0x3788, 0xbd7c, 0xb41f, 0xe21f, 0xe6e8, 0xfbe8, 0x9f0f, 0x8eca, 0x0f42, 0x77bd, 0x7a43, 0xfe91,
+		0xe58e, 0xc84d, 0xc412, 0x701d, 0xcd45, 0x1387, 0x0b4c, 0x2cfc, 0x32b8, 0xccc3, 0xe9bb, 0x1cbb,
+		0xb963, 0xa2ce, 0x8840, 0xb8e5, 0x82ff, 0x7b11, 0x0255, 0x28af, 0xaf34, 0x23d4, 0xcec0, 0x1eeb,
+		0x69a8, 0x67d6, 0x560f, 0x7b44, 0x22ff, 0x0a69, 0xd851, 0xd8b4, 0x37b1, 0xf2ed, 0x3172, 0x377f,
+		0x1d33, 0x8bdb, 0xdc08, 0xc019, 0xc522, 0x2457, 0x8bfd, 0x53ac, 0xefba, 0x77f0, 0x7a23, 0x3b90,
+		0x0a1b, 0x6f00, 0x5842, 0x1cea, 0xa80b, 0x25c3, 0xd675, 0x5951, 0x15b3, 0x4ef4, 0xc803, 0x7dac,
+		0xbb65, 0x215b, 0xb490, 0xbbc1, 0x1c85, 0x195b, 0x7b8b, 0x1b59, 0x2b3e, 0xe04d, 0x932d, 0xd2a4,
+		0x6d37, 0x358b, 0xa03d, 0x71b9, 0x11a4, 0x9a91, 0x5871, 0xcb5e, 0x4136, 0xf6dc, 0xddd4, 0xc50d,
+		0x6581, 0xbd62, 0xa4dc, 0xee56, 0x0cea, 0x9eac, 0x7b56, 0xe83b, 0xe8dc, 0x6e7b, 0xc8f3, 0x0ede,
+		0x0917, 0x4fc0, 0x3210, 0x780c, 0x031f, 0x9d12, 0xf468, 0x3a4c, 0xd6f0, 0xfd5b, 0x417f, 0xeae1,
+		0xb246, 0xa557, 0xa009, 0x5c85, 0x51cb, 0xebc0, 0xc2dd, 0x6c49, 0x4b80, 0x0c20, 0x50ad, 0x7a04,
+		0x2d2a, 0x21de, 0x9874, 0x6255, 0x0fae, 0x7ee4, 0x0c10, 0x3a96, 0x0510, 0x0bc7, 0x1be3, 0x697f,
+		0x3947, 0x88ee, 0xc6db, 0xa128, 0x71fa, 0x816d, 0xf5fe, 0x9765, 0x2b2d, 0x2ee2, 0xf167, 0x309f,
+		0xb662, 0x7f8a, 0x7f19, 0xcc97, 0xca4d, 0x6b9b, 0x05e3, 0x9e00, 0xf90a, 0x4aeb, 0xfa05, 0xb4cc,
+		0xd582, 0x4569, 0x669f, 0x2844, 0x65a5, 0xcc8a, 0xf3a2, 0x03fb, 0x865d, 0x7ada, 0x52c3, 0xbdaa,
+		0x7800, 0x1d89, 0xdece, 0x490b, 0xe30e, 0x2596, 0xd5bd, 0x5890, 0x3924, 0x92f3, 0xb46e, 0x18e1,
+		0xc27d, 0x61c1, 0xcc25, 0x8ede, 0xd0db, 0xfea3, 0xd056, 0x2e4f, 0xccdd, 0xd04e, 0x7a0e, 0xe496,
+		0xc0ee, 0xecd5, 0x476e, 0x6f05, 0x0e03, 0x81cf, 0xad54, 0xf86e, 0x4b38, 0xd245, 0xa1af, 0x8aca,
+		0x73ae, 0xab3b, 0xd565, 0x658e, 0xad3e, 0x91df, 0x1685, 0x64d4, 0x0b3e, 0x629c, 0x8efa, 0xa120,
+		0x8b6a, 0x2040, 0xb54d, 0x15ac, 0x5ebd, 0xdcc9, 0x802f, 0xd033, 0xdd56, 0xf1c7, 0x210e, 0xa3b9,
+		0xdb14, 0xb441, 0xfcd9, 0xb521, 0xfacf, 0xad21, 0x81bf, 0x4210, 0x9391, 0x60b2, 0xdd22, 0xe3b4,
+		0x7edc, 0x438d, 0x6c13, 0xcaf6, 0x5bec, 0xf918, 0x43ed, 0xd610, 0x9ec2, 0x6211, 0x5587, 0xc15a,
+		0x6f47, 0x9af2, 0x6d1b, 0x7a2f, 0xa97e, 0xc660, 0x66dc, 0x4605, 0x8bc8, 0x9cf9, 0x2271, 0x3b8a,
+		0xce82, 0xcbfa, 0x803f, 0xfb61, 0x9ca2, 0xc88d, 0x3e03, 0xe0cf, 0x6dab, 0xadc9, 0xf700, 0xd282,
+		0xd11a, 0xb09c, 0xed1a, 0x8b28, 0x4363, 0x083d, 0xc700, 0x6460, 0xd151, 0x6eca, 0x5111, 0x677d,
+		0x7baa, 0x183c, 0x066a, 0x09e6, 0x6aea, 0xd130, 0x96ff, 0xe5db, 0xab74, 0x3f42, 0x1add, 0x2515,
+		0x2914, 0xbdae, 0xb2f3, 0x5f14, 0x4e1d, 0xf73b, 0x86c2, 0xae86, 0xc5f5, 0xde9e, 0x2e16, 0x28e2,
+		0x2035, 0x6a00, 0x32b6, 0xa53d, 0xd994, 0x7f35, 0xd2de, 0xa7de, 0xd0c2, 0xf90c, 0x2ed1, 0x441d,
+		0x3659, 0x8894, 0xf535, 0xc36c, 0xf7b0, 0xd687, 0x3659, 0xf6b4, 0x9b0d, 0xaf9a, 0xbde5, 0x636c,
+		0xb2d4, 0xe638, 0x017b, 0x4a5f, 0x2b44, 0xf1a8, 0xc4ae, 0x3e32, 0x5d07, 0xcc01, 0xb8c6, 0x43d3,
+		0xa11a, 0x53c3, 0x94c6, 0xf151, 0xae67, 0x83ea, 0xf432, 0xd990, 0x25de, 0x956b, 0x1d05, 0x7c37,
+		0xbcb3, 0x6002, 0x3b9d, 0xe225, 0x0437, 0x8e07, 0x051b, 0xaa18, 0xf2e8, 0xd1a4, 0x2c4a, 0x832e,
+		0x5fbf, 0xbdbe, 0x9d6c, 0xa995, 0xa2de, 0x0654, 0xdc46, 0xd0ae, 0xe3ce, 0xeca1, 0xe6fa, 0xac10,
+		0xd796, 0xd06f, 0x54d3, 0x9a25, 0xe15b, 0xfb3d, 0xedfb, 0x7e73, 0xf29c, 0x70d4, 0xe8e8, 0x17d9,
+		0xaa24, 0x56aa, 0x05c3, 0x56c8, 0xf7d1, 0x869a, 0x62c6, 0x9609, 0xfbd6, 0xfe53, 0x7915, 0xe984,
+		0xf75a, 0x4d13, 0x7ba0, 0xe6fb, 0xe857, 0x3b0a, 0x44f3, 0x9589, 0xc268, 0x6148, 0xb8a3, 0x8d60,
+		0x88a6, 0x833e, 0xc17c, 0x62ef, 0xe617, 0xaabd, 0xdcbc, 0xde9a, 0xcf1a, 0x53cd, 0xf3ec, 0xfed0,
+		0xd248, 0x629c, 0x503a, 0x75ff, 0x7b6d, 0xc14e, 0x8a2d, 0xa8bd, 0x1a5a, 0x8817, 0x9a3d, 0x6e14,
+		0x456a, 0x51a8, 0x6e89, 0x0761, 0x78f4, 0xfb45, 0x48cc, 0x7522, 0x0070, 0xa4f5, 0xe821, 0x694d,
+		0x9bea, 0x050b, 0x0e97, 0xdebd, 0x174d, 0x9450, 0x9d89, 0x72ba, 0x4315, 0x8a59, 0x9021, 0x9b09,
+		0xf688, 0x2948, 0x48fd, 0x3c1a, 0x0ee3, 0x7cfd, 0x18c2, 0x393a, 0xfeb4, 0xd131, 0xdf75, 0x4266,
+		0x6198, 0x296a, 0x6274, 0x68ee, 0x162f, 0x720c, 0x7639, 0x9cc6, 0xa666, 0x80b6, 0x97ad, 0x18d6,
+		0xff67, 0x646c, 0xe928, 0x7000, 0x3047, 0x4c12, 0x0677, 0x8e2f, 0x0de3, 0x119d, 0x0112, 0x819e,
+		0x1750, 0xdda5, 0xfd3a, 0x187c, 0x0948, 0x5e38, 0x3adf, 0xf64a, 0xf9a7, 0x045a, 0x037b, 0x1537,
+		0x0d76, 0xda85, 0x385e, 0x8e19, 0x44c5, 0x9393, 0x8d6c, 0xd77e, 0xa889, 0x3e58, 0x2746, 0x9925,
+		0x8627, 0x897e, 0x8990, 0x2d53, 0xaf53, 0x18f9, 0xda75, 0xc052, 0xcdc2, 0x1923, 0x08d2, 0x9107,
+		0xba2b, 0xe104, 0xf4bc, 0x0b17, 0xda21, 0x4aaa, 0xef51, 0xa90a, 0x6d37, 0xb0ae, 0x0770, 0x4e51,
+		0xe5fb, 0xd435, 0xaf4b, 0xeccb, 0xb67f, 0xde67, 0x4ba1, 0x9e89, 0x10f7, 0x0e7b, 0xbe4a, 0xa731,
+		0xe9fa, 0x03cd, 0x1d40, 0x30f3, 0x8401, 0x75b3, 0x3e52, 0x3cfb, 0x7213, 0xa5c8, 0xf5fc, 0x8778,
+		0x395e, 0x41e1, 0x1fd4, 0x40b2, 0x4ca7, 0x791b, 0x11a3, 0xa415, 0xa679, 0xee12, 0x56dc, 0x3f49,
+		0x2b7c, 0x2a9c, 0xee2f, 0xd68b, 0xdaa0, 0x29eb, 0xcbde, 0xb19b, 0x5dd5, 0x4b0b, 0xc49f, 0xeb46,
+		0x96c2, 0x121e, 0xb2b1, 0xba7f, 0xe3a2, 0x1aa7, 0x041d, 0x52ef, 0x0802, 0x3c60, 0x1f91, 0x794a,
+		0xb96d, 0xb214, 0xddaa, 0xa377, 0x6a09, 0xe751, 0x8cd6, 0x341d, 0xe6e6, 0x57c5, 0xf5fa, 0x9731,
+		0x0120, 0xd37b, 0x4d82, 0x73a2, 0x409d, 0xe0c0, 0x83f9, 0x0c16, 0xc5f1, 0xc59d, 0x281f, 0x0d4d,
+		0xf6a2, 0x424c, 0x2c3d, 0x03e9, 0x343d, 0xcc14, 0x15dd, 0xf4c7, 0x169a, 0x019e, 0x2075, 0x0c3f,
+		0xc27f, 0x6da3, 0xae72, 0xd08b, 0x5c7a, 0x1a04, 0x244d, 0x16a3, 0xa23e, 0x4c0a, 0xbb5d, 0xa030,
+		0x0487, 0x887d, 0xc22b, 0x7f74, 0xc49b, 0x32e4, 0x472d, 0x1c59, 0x8d2e, 0xc7b9, 0xa62c, 0xd5bb,
+		0xcc6a, 0xe846, 0x3f91, 0x419a, 0x8be0, 0xd385, 0xb825, 0x5597, 0x46f9, 0x4eaa, 0x57c3, 0x8f0f,
+		0x5712, 0xdb78, 0xb21d, 0xc80e, 0x2d8d, 0xe9cf, 0xc102, 0x5f69, 0x6c18, 0x7576, 0x3570, 0x5faf,
+		0xd184, 0x9aee, 0x09d1, 0x8051, 0xe4ab, 0x74c4, 0x025b, 0x835c, 0x1b7b, 0xd3ca, 0xdfe5, 0xe3ed,
+		0x866a, 0xbe01, 0x3ad2, 0xc29d, 0xce90, 0xf4f6, 0xec50, 0x490a, 0x1adb, 0xabe7, 0x521a, 0x176b,
+		0x54a1, 0xe823, 0x5968, 0x7c5c, 0xfcb5, 0x560d, 0x3d2a, 0xe32f, 0x97da, 0x4a99, 0x9f47, 0x786a,
+		0xb6b1, 0xec74, 0x2fb3, 0x0929, 0x29ce, 0xe183, 0x9ea2, 0xc33a, 0x6273, 0x4b6f, 0x4394, 0xc1b3,
+		0xe49f, 0x5e38, 0x96c0, 0x7d70, 0x53b1, 0x36f8, 0xdb6d, 0xb6de, 0x6b57, 0xd17d, 0x4b4d, 0x90bf,
+		0x7413, 0xb1c6, 0x2f9b, 0x99a9, 0xb89c, 0x697d, 0xf5c0, 0x9b46, 0xa095, 0x2b9c, 0x1692, 0x023c,
+		0x5cc2, 0x3b85, 0x3fb9, 0x1abf, 0x187d, 0xc01c, 0x5240, 0x80f4, 0x0676, 0xdea2, 0x97b7, 0xc8df,
+		0x695c, 0xc133, 0x1dc8, 0x3cec, 0xcd21, 0xa9a5, 0x1dc9, 0x3ea6, 0x6d44, 0x5f46, 0xd553, 0x1429,
+		0xca33, 0x56ee, 0x9b88, 0xfd1c, 0xe062, 0xdc3a, 0xfbf2, 0x422b, 0x4e4e, 0xf1bc, 0x349f, 0x19c6,
+		0xf426, 0xe553, 0x9ec6, 0xc727, 0x62a9, 0xb9c6, 0x6611, 0x2556, 0xbf05, 0x9e0b, 0x03e1, 0xa17b,
+		0x8ca2, 0x7b2e, 0xb2c6, 0xe453, 0x6882, 0xa0c7, 0xab82, 0xf95a, 0x8dbe, 0xc859, 0x1d51, 0x50a5,
+		0x0e6e, 0x77ba, 0x52e9, 0x6577, 0xa08a, 0x45f5, 0x3445, 0x01db, 0xb0c1, 0x5a3b, 0xfe54, 0xd61f,
+		0xa7fa, 0x2cdb, 0x741b, 0x13e7, 0xb54d, 0xfa3c, 0x1815, 0xf898, 0x573b, 0x8e1d, 0x549d, 0x4a31,
+		0x0b1d, 0xf983, 0x7d97, 0x0ecb, 0x734e, 0x3602, 0x6df2, 0x7d2f, 0x1619, 0xe10b, 0x2236, 0x668a,
+		0x6063, 0xe7d8, 0x95ac, 0xcd3b, 0xe238, 0x60cd, 0x4b4c, 0x98f7, 0xa8ca, 0x9e41, 0xecbe, 0x0212,
+		0xb1dd, 0xc558, 0xc54b, 0x3f0e, 0x1846, 0x10b7, 0x67b8, 0xf3d2, 0xa42d, 0x30fe, 0xb33b, 0xc122,
+		0x066c, 0x5349, 0x0a4d, 0xccf6, 0xbd31, 0x4ebf, 0x008b, 0xdd64, 0x1688, 0xe570, 0xf2e9, 0x52e2,
+		0x4289, 0x1983, 0xc223, 0x2c7e, 0x1705, 0x1af6, 0x94d8, 0x7b34, 0xd976, 0xea05, 0xc5b1, 0x52bf,
+		0x33bd, 0x4bd6, 0xf1e5, 0x0770, 0xa7e2, 0xf029, 0x545b, 0x26a6, 0xe690, 0xa7a6, 0x8f5e, 0x3886,
+		0xffcb, 0x3541, 0x1f1d, 0xf76b, 0xa96d, 0xcea0, 0x35d0, 0xdffb, 0x31fe, 0x9b3e, 0x3c16, 0xa6b2,
+		0x8c82, 0xdb91, 0x30bc, 0x4dd1, 0x5d5d, 0x8b96, 0xf0ce, 0xe099, 0x2e0e, 0xdd83, 0x417a, 0x653a,
+		0x056f, 0x7e6a, 0x47d8, 0x1c32, 0x934c, 0x9bfb, 0x3ff7, 0x0a49, 0x79fb, 0x2313, 0x31f6, 0xb1af,
+		0x9688, 0x0d5c, 0xcb9c, 0xbb8e, 0xa399, 0x03b8, 0x2b98, 0x598a, 0x4494, 0x432e, 0xefbc, 0x5e30,
+		0x9204, 0xf7ec, 0x421d, 0xe949, 0x38a8, 0xe442, 0x08d3, 0xc58e, 0x2b9e, 0x8c05, 0x1c0c, 0xec9f,
+		0xd966, 0x27d0, 0x7b75, 0x6527, 0x3404, 0xfa7b, 0x0fca, 0x932c, 0x932e, 0x8294, 0xe3e9, 0xe609,
+		0x341d, 0x2de2, 0x23b4, 0xb297, 0x51c6, 0xe2e5, 0x1c9c, 0xbf00, 0xb3d5, 0x7a6d, 0xc5bc, 0x4560,
+		0xc3d4, 0x9a41, 0xa300, 0xd45d, 0x4773, 0xced0, 0xb070, 0x09b0, 0xd719, 0x0f31, 0xc22b, 0x46a0,
+		0x8383, 0xf033, 0xaa9a, 0x1b62, 0xcace, 0xd94e, 0x8bdc, 0x79ba, 0xef6e, 0xace5, 0x2b0b, 0xac21,
+		0x2e44, 0xd6ca, 0x63a1, 0x8404, 0x1f60, 0x748d, 0xd30c, 0xa569, 0xf6c0, 0x3e4f, 0x550b, 0xc194,
+		0xfc69, 0x9579, 0x5a34, 0xb8ee, 0x51f3, 0x9584, 0xed9f, 0x63f3, 0xbe1f, 0x4956, 0x6c50, 0x8b1c,
+		0xa09d, 0xd98f, 0x3a18, 0xa75f, 0x923d, 0xd774, 0xbc18, 0xc97b, 0x6470, 0x82ef, 0x2026, 0xf609,
+		0x1fbb, 0x8bf3, 0xf7d5, 0xfcb8, 0x2f68, 0xe33a, 0x3c38, 0xf490, 0x5c91, 0x827d, 0xac6d, 0x5f7e,
+		0x8141, 0x4d79, 0xd3e5, 0xa3a3, 0xe617, 0x5dc5, 0x81aa, 0x0f87, 0x4471, 0xe784, 0x9b0b, 0xed23,
+		0x7917, 0xdd35, 0xde04, 0x1a26, 0xbdfa, 0x3df9, 0x3e1a, 0x12c6, 0xa13c, 0x59e4, 0xde48, 0xd80d,
+		0x011c, 0xfd36, 0x2d89, 0xb239, 0xa0e4, 0x5896, 0x86a0, 0x4939, 0xf3dc, 0x2a04, 0x3dc8, 0x6894,
+		0xf07a, 0x0d9c, 0xf91b, 0x7e9d, 0xfcea, 0x6808, 0x07c8, 0xe4fd, 0xd3bb, 0x6ed8, 0xac23, 0xf702,
+		0x7556, 0xab46, 0x6113, 0x15fc, 0x0b45, 0x8407, 0xce39, 0xbce1, 0x36c6, 0x1b9a, 0x5ad3, 0x1b0d,
+		0x1011, 0x50a1, 0x4e73, 0x65f7, 0x7c48, 0x14f6, 0x2397, 0x123d, 0xb7df, 0x1fdd, 0x2d9a, 0x001f,
+		0x64a1, 0x45a0, 0xeb26, 0xc6db, 0xa4dd, 0x111d, 0x4b31, 0xde11, 0x439e, 0xec09, 0xc2bf, 0x0e55,
+		0xadba, 0x5ff2, 0x45b9, 0x4a5f, 0x3191, 0xac2f, 0x9122, 0x575a, 0xf242, 0x376a, 0x4cb2, 0x9e40,
+		0xc881, 0x5ae0, 0xc5b8, 0xfe25, 0x7c3c, 0x16a6, 0x21b2, 0x8039, 0xa179, 0xe9c8, 0xef5f, 0x944f,
+		0x2b38, 0x8b2c, 0x493f, 0x10d5, 0x0f7e, 0xc4b0, 0x39ed, 0xaf38, 0x6e98, 0x9936, 0xaf5f, 0x7e66,
+		0x2e29, 0x54cf, 0x44b2, 0x1cc7, 0x032b, 0xacac, 0x09bd, 0x6cb4, 0x0c6a, 0x1939, 0x16b3, 0x26c3,
+		0xee8c, 0xcb21, 0x57cb, 0x2c04, 0x9448, 0xfb47, 0x49c1, 0x136d, 0x045b, 0x45f5, 0x1fc5, 0x7bc6,
+		0xa758, 0xca60, 0x1cf8, 0x87fe, 0xfd67, 0x5023, 0x4038, 0x3ef2, 0xcc92, 0x655a, 0x1171, 0xaa12,
+		0x26d3, 0x3c7c, 0xe928, 0xa3cb, 0xa408, 0x930c, 0x9aee, 0x52fa, 0x5c94, 0x819e, 0x3dbd, 0xae8c,
+		0x1958, 0x7626, 0x02bc, 0x1b51, 0xd098, 0xd3e1, 0x6a57, 0xcb76, 0xc73e, 0xa726, 0x0cfe, 0x9e96,
+		0x729c, 0x5384, 0x292d, 0x369d, 0xeee6, 0xdcde, 0xfc69, 0xd9a5, 0x5984, 0x07e6, 0x53cd, 0xa523,
+		0x6c24, 0xd280, 0xdc34, 0x9385, 0x335e, 0x5a74, 0x8957, 0x0397, 0x1cc6, 0x8970, 0x978b, 0x6b97,
+		0x7bba, 0x7a2f, 0x6552, 0x4528, 0x1d92, 0xf0f9, 0x7941, 0x8267, 0xd94e, 0x82d0, 0xc676, 0xa7ba,
+		0xe75b, 0xff90, 0x5334, 0xe8bc, 0x26c5, 0xd734, 0x7de5, 0xd9c2, 0x7b7b, 0xeac1, 0xe19f, 0xc813,
+		0x7bd0, 0xf8d6, 0x3f03, 0x2ca2, 0x61ef, 0x8a1d, 0x484f, 0xfab2, 0x1f0f, 0x27a3, 0xe8d8, 0x07a0,
+		0x6e17, 0xde75, 0x8c6b, 0xeb94, 0xf0eb, 0xf2b5, 0x9236, 0xde7e, 0xd287, 0x3e07, 0x97e6, 0x65c9,
+		0x34f3, 0x9f05, 0xbec5, 0x3d11, 0x51d3, 0x04a0, 0x222d, 0x16fb, 0x40c7, 0xc0b2, 0x90b5, 0xfbc4,
+		0x6984, 0x1c98, 0x90c3, 0x3246, 0xf757, 0x151a, 0x110b, 0x73b8, 0xde69, 0x6f81, 0xbf1d, 0x0327,
+		0x1d60, 0x869f, 0xdf8c, 0x0dab, 0x10a9, 0x58e0, 0x1cab, 0xa2d7, 0x9610, 0xde41, 0x9350, 0x2a97,
+		0x6302, 0xaf72, 0x01a1, 0x1585, 0xb29b, 0x2a7e, 0x2919, 0xa4b2, 0x4681, 0x1cf8, 0x29ea, 0xe802,
+		0x68eb, 0x85be, 0xe438, 0xf497, 0x3f82, 0xd5c5, 0x4e1d, 0x06bd, 0xe63f, 0xf358, 0x9ecf, 0x7220,
+		0xaac5, 0x20ac, 0xe7f8, 0x2729, 0x5d60, 0x45af, 0x9939, 0xe4e0, 0x3def, 0xaa52, 0xd2f4, 0x33bd,
+		0x4940, 0x27c9, 0x0c6c, 0x8315, 0x70d0, 0xf18e, 0xcf63, 0xeeee, 0xb54f, 0x5176, 0x5a0e, 0x5283,
+		0x03ca, 0x01cf, 0x3aaa, 0xfcd4, 0xf7fb, 0xc696, 0xf77a, 0x0259, 0xd832, 0x9c86, 0xc3e5, 0x9909,
+		0x7231, 0x0464, 0xf448, 0xe129, 0x2a41, 0xcecd, 0x1e7b, 0xc20f, 0x60ce, 0xcd6e, 0xbbaa, 0x551f,
+		0xd59d, 0xd263, 0x6d54, 0xe1b9, 0x2cac, 0x5e1b, 0xc4f4, 0xe207, 0xb05f, 0xf84e, 0x42c3, 0x6c23,
+		0x7796, 0x6617, 0x47b3, 0xf624, 0x4c2d, 0x8415, 0x43a6, 0xa9c8, 0x0b86, 0x5b46, 0x2f43, 0x05b9,
+		0x01fd, 0x89eb, 0x6849, 0x3e12, 0xa151, 0xde80, 0xe26e, 0x25e3, 0xfbc4, 0xbff9, 0x9396, 0x5f15,
+		0x4981, 0x4cb3, 0xd31b, 0xd3c3, 0xe247, 0xbe97, 0x9bce, 0x6609, 0x0cba, 0xe658, 0xf0cd, 0x5fd0,
+		0x39f4, 0xdb8b, 0x4f62, 0xe9b2, 0x1c09, 0xbd92, 0x6c05, 0x55ca, 0x84e7, 0x0f49, 0xcd8c, 0x4ab1,
+		0x5705, 0xa38e, 0xb23b, 0x9a8a, 0xed1d, 0x116e, 0xaa51, 0xc79e, 0x74c3, 0xd186, 0xbc51, 0xb30d,
+		0x4440, 0x4831, 0xc7e3, 0x3e17, 0x87fe, 0xd222, 0xd81e, 0x7141, 0x5d5d, 0x472b, 0x4e6d, 0x9cd0,
+		0x1228, 0xaba1, 0xf420, 0x840d, 0x29f9, 0x31b5, 0xe65d, 0x4008, 0x2816, 0xf447, 0xc855, 0x3c00,
+		0x6594, 0x56ea, 0x01ba, 0x79d2, 0x2197, 0x50fd, 0x12d6, 0x9c8c, 0x5c34, 0x58ac, 0x9d09, 0x46bc,
+		0x0558, 0x7ea4, 0xf79c, 0x6b3f, 0x4a7c, 0x94e6, 0x0f25, 0x4425, 0x0afe, 0xf32e, 0x0874, 0x36c0,
+		0xdf8e, 0x1aa0, 0x8d17, 0xeca8, 0xd0e2, 0x8e0a, 0xd104, 0x912e, 0x9c8f, 0x598f, 0xb67b, 0x3b5f,
+		0x8112, 0xf622, 0x2850, 0xc7b3, 0x14fb, 0x3141, 0xefbb, 0x65bf, 0x4963, 0x7d6c, 0x4092, 0xb05c,
+		0x1c5c, 0x8ed6, 0x211b, 0xec9c, 0xb1be, 0x43eb, 0x1098, 0xdf9f, 0xbad5, 0x3c6f, 0x08d7, 0x88c0,
+		0x459c, 0x04c7, 0x022b, 0x7280, 0xa9c3, 0x2250, 0xf9e1, 0x2bac, 0x2704, 0xc22b, 0xa1bc, 0x71bb,
+		0x6c05, 0x4de9, 0x0f4b, 0x6c8e, 0x2b24, 0x8d3b, 0x8049, 0x1248, 0x3aea, 0x89c7, 0x4a50, 0x35f1,
+		0xce6c, 0x8d37, 0x1909, 0x74d7, 0x5d59, 0x451e, 0xae64, 0x1f4a, 0x7861, 0x4f6b, 0x6433, 0x7493,
+		0xde9f, 0x1c2f, 0x96a9, 0x943a, 0x1e23, 0x4244, 0x516a, 0x5864, 0xcbaa, 0xe55c, 0x81f2, 0x092e,
+		0x83e8, 0x31d5, 0xa2c0, 0x51a0, 0x4dba, 0xa076, 0xb7ea, 0xe40e, 0x2718, 0x9bc5, 0xd78c, 0x76e9,
+		0xaab4, 0x343d, 0xcecf, 0xe377, 0xd582, 0x2e28, 0xe28d, 0xab44, 0x90a4, 0x8155, 0xb277, 0x5a58,
+		0xd3e3, 0x9c00, 0x45ae, 0xa332, 0x9923, 0x4592, 0x1d06, 0xa1d0, 0x5612, 0x03fc, 0xc68a, 0x5e6a,
+		0x9945, 0x3354, 0x34db, 0xca98, 0x90ff, 0xf57c, 0xd45e, 0xde59, 0xb3bf, 0x1fe0, 0x26ef, 0x02b8,
+		0xfcbe, 0x6c8e, 0x3303, 0x19a9, 0xb265, 0xc81c, 0x2817, 0x969f, 0xe772, 0x3018, 0x4d0d, 0x03db,
+		0x59ae, 0x0802, 0xe53b, 0xa87f, 0x3eb0, 0xd046, 0x9a51, 0x95da, 0x638d, 0x4c30, 0x8cfe, 0x38ad,
+		0xcd08, 0x4673, 0xb4c1, 0x5230, 0x856d, 0x7045, 0xda91, 0xd4d6, 0x6ef8, 0xac74, 0xd62c, 0x922e,
+		0x2be1, 0x2366, 0x6786, 0x996b, 0xe164, 0xa2b4, 0x0f0d, 0xb7ec, 0x746e, 0xdc1b, 0xbfb3, 0x8d31,
+		0xda18, 0x510e, 0x5e8d, 0x3a5d, 0x7773, 0xf569, 0xf9b3, 0x2715, 0x4760, 0xc532, 0x32d7, 0x1a82,
+		0x06dc, 0x9fd5, 0xb67c, 0x3fe4, 0x102c, 0xbd75, 0xa9bb, 0xe194, 0x8a7c, 0xd4ca, 0xbda9, 0x3607,
+		0x172a, 0x53e0, 0xea96, 0x591d, 0x2cc3, 0xdb7c, 0x3fc5, 0xe88c, 0xddc3, 0x27ac, 0x0aee, 0x01fa,
+		0x0536, 0x5be6, 0x4a14, 0x2bc3, 0x6ace, 0x7e36, 0xc82b, 0xe136, 0x8ab8, 0xeba0, 0x89f9, 0x7120,
+		0x3ec7, 0x1268, 0x9818, 0x40fe, 0x7cdd, 0x5ca2, 0x13e0, 0xf1a1, 0xf172, 0x4700, 0xb2d9, 0xbb7f,
+		0x645f, 0xf2b5, 0xf9b3, 0x593f, 0x914a, 0xbc73, 0xed8e, 0xf4e9, 0xe475, 0xd66f, 0x9337, 0x2f40,
+		0x56c2, 0x2665, 0xd177, 0x3219, 0x0d96, 0xdfe3, 0x3939, 0xbaad, 0x8cb9, 0xbc98, 0x5c1e, 0x265a,
+		0xa871, 0x094a, 0xd09a, 0x7a8f, 0xefd9, 0x2de3, 0x027d, 0x2997, 0x8b90, 0x4fed, 0x62c1, 0x2190,
+		0x436c, 0x1c88, 0x11bb, 0xf61b, 0xd401, 0xe622, 0xaf67, 0x1e92, 0xddc5, 0xa47c, 0xe614, 0xe689,
+		0x3c73, 0x5a6c, 0xe7fc, 0x8958, 0xd414, 0xa114, 0x0606, 0xbb84, 0x5c2c, 0xe09c, 0xc812, 0x1788,
+		0xa3bd, 0xbe0c, 0xe914, 0xc796, 0x26a3, 0xc531, 0xc909, 0x97ec, 0x97fc, 0x92ca, 0x174e, 0x8623,
+		0x4a49, 0xc028, 0x4301, 0x073f, 0x5c9a, 0xf935, 0x1645, 0x92f8, 0x81d7, 0x38c7, 0x51ba, 0x31bc,
+		0x6562, 0x41c7, 0x42fb, 0x1014, 0xfb7a, 0x45b0, 0xccd9, 0x6afe, 0x7e9d, 0x96db, 0xd031, 0x8920,
+		0x88e1, 0x2a9d, 0x866c, 0x9706, 0x5cd3, 0xa1d5, 0x2f53, 0x4c71, 0x8bf4, 0x1e54, 0x1483, 0xe0e2,
+		0x6fd1, 0xeb2e, 0x2c7e, 0x724a, 0xe4f8, 0x6ba8, 0xef69, 0x77cc, 0xff2a, 0xdb21, 0x35b3, 0xa37c,
+		0x295a, 0xf79c, 0x6a9c, 0xd87f, 0x5dca, 0x9ac4, 0x3784, 0x1699, 0x5fd0, 0xb4d6, 0x65ba, 0x3f26,
+		0x935a, 0x9a49, 0xe17c, 0x49aa, 0xcde6, 0x645b, 0xf138, 0x973f, 0xe2cc, 0x8ec3, 0x4a5f, 0x566c,
+		0x3674, 0x810e, 0x8287, 0x4fa9, 0xf3e8, 0xa6ea, 0x68a2, 0xfdd1, 0x4db2, 0x1548, 0x3e12, 0x4a74,
+		0xf11c, 0x9664, 0x7729, 0xcb6c, 0xc2bc, 0xe79f, 0x48e1, 0x47c0, 0x17a5, 0xd871, 0x9597, 0xa08e,
+		0xdd86, 0xae49, 0xa2c9, 0x202e, 0xe3d7, 0x07b3, 0xb86c, 0x10bd, 0x85ff, 0x7720, 0x1b42, 0x470b,
+		0x98e2, 0x031d, 0xe45a, 0x6807, 0x47ae, 0x187e, 0x57a9, 0xab73, 0x2373, 0x7f97, 0x69a7, 0x66d0,
+		0x60c8, 0xaa3a, 0x871a, 0xc074, 0x2f17, 0xb76f, 0x8906, 0xc418, 0x7bb4, 0xc570, 0x8274, 0xe1fe,
+		0x1677, 0x2354, 0x019c, 0x0f4a, 0x6af5, 0xc214, 0x9d5a, 0x9e87, 0x679c, 0x2271, 0xebdd, 0x91ef,
+		0x2a7e, 0x14fb, 0xe892, 0x5ae0, 0x9b40, 0xf99f, 0x91d2, 0x36e9, 0x38ee, 0xf120, 0x82b6, 0x5766,
+		0xc347, 0xe0f4, 0x8d44, 0xcdcb, 0xd94a, 0x8dd5, 0x18a2, 0x16ca, 0xfca1, 0xdb37, 0x1acb, 0x2d42,
+		0xc948, 0xe571, 0x3d6c, 0xe3a1, 0x9de4, 0xc0f9, 0x8701, 0xbcdf, 0xbc55, 0x3b0d, 0x1ccf, 0x3378,
+		0xdb4d, 0x3684, 0x9bb3, 0x61c7, 0x8d25, 0x69aa, 0xa9e7, 0x3082, 0x98e5, 0xa369, 0xa2cf, 0xcfd7,
+		0xa881, 0xed6a, 0x2f80, 0xda80, 0x3eed, 0x9ec7, 0x2d01, 0x142c, 0x52e7, 0xec66, 0xf567, 0x53c1,
+		0xb021, 0x6f76, 0x83d1, 0x139b, 0x5629, 0x75ab, 0x7dc2, 0xb958, 0x4b86, 0xe14a, 0x7287, 0x3eb7,
+		0xf52a, 0xc1ae, 0x0d49, 0xa2c9, 0x6bd2, 0x3a16, 0x724f, 0x15fb, 0x130e, 0xf172, 0x5781, 0x3d4a,
+		0x9108, 0x9591, 0xd53b, 0xf03e, 0xeacf, 0x7297, 0xc353, 0xcc06, 0x2995, 0x2f12, 0xe111, 0x3e66,
+		0xb8ba, 0xb8c3, 0xc515, 0x2914, 0xdbd0, 0x5a43, 0x33f7, 0xda59, 0x4bc4, 0x4f53, 0x8dbb, 0xf53a,
+		0xb594, 0xf7ac, 0xf898, 0x5f1c, 0x4ff2, 0xb189, 0x4d11, 0xbb7d, 0x929f, 0x0aaf, 0x3a7a, 0x908c,
+		0x2cae, 0xab66, 0xda09, 0x4202, 0xd7db, 0x8da3, 0x9701, 0xcbe7, 0x3b85, 0x9714, 0xe2d6, 0x11d3,
+		0x6a12, 0x5305, 0x9640, 0x8021, 0xa1f0, 0x6217, 0x1e1c, 0xe39c, 0xd287, 0x4cdf, 0x7aa2, 0x0811,
+		0x8e98, 0x4b5f, 0xffcb, 0x24b5, 0xda04, 0xacdb, 0xb77b, 0xad16, 0x78c8, 0xa4a8, 0xd639, 0x2cc5,
+		0x5a5f, 0xf384, 0xdead, 0xcf1b, 0x84d8, 0xd860, 0xd3ec, 0x5317, 0x88d4, 0x642f, 0xc9f2, 0x164a,
+		0x4142, 0xa771, 0xcff4, 0xa852, 0xabbf, 0x3117, 0xe381, 0x2172, 0x620d, 0xe17f, 0x8af0, 0x9131,
+		0x3282, 0xffb9, 0x715a, 0xe684, 0x1f67, 0x3fb2, 0x4328, 0xafae, 0x8fb6, 0x75fc, 0xd184, 0xeb3c,
+		0x893f, 0x0eec, 0x95f4, 0x73fa, 0xe251, 0x181e, 0x7b93, 0xe13d, 0xe2f4, 0xed20, 0x7a67, 0x4c25,
+		0x4018, 0xd9ab, 0xd648, 0xa92e, 0xccd0, 0x63cb, 0x9233, 0x09e2, 0xd214, 0x6450, 0x4400, 0x7048,
+		0xd5db, 0xb91c, 0xbab6, 0xfa7f, 0x270e, 0xcd42, 0x3b85, 0x4f20, 0x1fba, 0x44e9, 0x1b00, 0x0de3,
+		0x7f46, 0x12e3, 0x06eb, 0x54c7, 0xb347, 0x4c07, 0x1ebb, 0xfd99, 0xbeb5, 0x937b, 0xe631, 0x5d8e,
+		0x3635, 0x9a18, 0x0c91, 0xe3e9, 0x4c54, 0x62c9, 0xd21e, 0x2202, 0xda73, 0xc376, 0xcb23, 0xfb05,
+		0xd1c3, 0x287a, 0x1905, 0xa0e9, 0x2747, 0x83a0, 0x687f, 0x0645, 0x4605, 0x3f19, 0x9090, 0x4743,
+		0xd9de, 0x83fd, 0xce30, 0xc6e4, 0xd546, 0x77bb, 0xf4a4, 0xc092, 0x6484, 0x2100, 0x4fe8, 0xf110,
+		0xffc0, 0x4e73, 0x5193, 0x463c, 0x4525, 0x4ee0, 0x588f, 0x7124, 0x1c88, 0x12b4, 0x539b, 0x46af,
+		0x08ab, 0xe579, 0xc4a3, 0x79d8, 0xe94f, 0x49c1, 0xfa63, 0x65ce, 0x5393, 0x30dc, 0x8b65, 0xfe00,
+		0x9aa4, 0xb798, 0x5bd1, 0x9424, 0xbdb7, 0x1dc7, 0x045f, 0xdc04, 0xa59b, 0x433a, 0x09fb, 0x8539,
+		0x32ef, 0xdd02, 0xc4ea, 0xc3c4, 0x0547, 0x4f35, 0xc6fa, 0xdc4f, 0x23e9, 0xb79f, 0x464e, 0x2b39,
+		0xaba5, 0x13a1, 0x3729, 0xcf6a, 0x74b5, 0x2bf9, 0x8953, 0x6c2f, 0xb258, 0xa07a, 0xe5ac, 0x160e,
+		0x5db8, 0xc6e9, 0x3c04, 0xb6c0, 0xa536, 0x83c1, 0x4fa5, 0xe10e, 0x9051, 0xa1e0, 0x02de, 0x39c4,
+		0xd865, 0x410d, 0xd519, 0xa3bd, 0x043b, 0x52f8, 0xe600, 0xaef9, 0x8fac, 0xe175, 0x3747, 0x3959,
+		0x617c, 0xd6ee, 0x0e8e, 0x59de, 0x621a, 0x8241, 0x5ce3, 0x8681, 0x4b3e, 0x38be, 0x3c94, 0xd78b,
+		0xa94b, 0x0e34, 0x7a08, 0xa1de, 0x3a2f, 0x6b5f, 0xb0bc, 0xc129, 0xdba9, 0xc889, 0x998a, 0x3c87,
+		0x6015, 0x63cb, 0x417f, 0x4b0f, 0xce6d, 0x34e1, 0x4824, 0x001f, 0xc12a, 0x062e, 0xb9ed, 0x847f,
+		0x28d2, 0xe638, 0x727a, 0xb014, 0x3e4a, 0xc2c4, 0xbfac, 0xdd7c, 0x8d36, 0x5ab4, 0x996e, 0x0c83,
+		0x1137, 0xd1ef, 0x32e1, 0x2f28, 0xf08b, 0x2e87, 0xdce3, 0xc02a, 0xcb6e, 0x09ca, 0x2652, 0x3d37,
+		0x5b7d, 0x4acc, 0x83fd, 0xe209, 0x0248, 0xd21f, 0xe723, 0xbd80, 0x90fa, 0xbaad, 0x3da4, 0x7519,
+		0x5ea3, 0x5276, 0x4bc5, 0x9f1a, 0xf409, 0xd0f6, 0x0b8d, 0xe2dd, 0xb82b, 0x86a4, 0x3ad5, 0x8642,
+		0x9534, 0xe03b, 0x46c6, 0x1229, 0x541a, 0x2569, 0x2993, 0x4a51, 0x3c75, 0xffee, 0x2b24, 0x2b65,
+		0x14ea, 0x9bce, 0xa4a1, 0x3f01, 0x6b97, 0xb985, 0xf174, 0x4ca0, 0xaa88, 0x819e, 0x9a05, 0xf8f6,
+		0x784e, 0xf7db, 0x11a5, 0x9a81, 0x886d, 0x551c, 0x0093, 0x85f3, 0xe8eb, 0xe025, 0x3057, 0x445f,
+		0xf454, 0xc02b, 0x0ba8, 0xd08a, 0xa900, 0x8287, 0x32be, 0xaa47, 0xa899, 0xd22e, 0x0813, 0x546b,
+		0x41c9, 0x1d42, 0xa50a, 0x0d36, 0x9d2b, 0xca60, 0x423e, 0xa728, 0x1eda, 0x893e, 0x2172, 0xa2da,
+		0x9439, 0xc85c, 0xa89e, 0x602a, 0xfea1, 0xf23b, 0xfc1b, 0x0d77, 0xe0fb, 0x6834, 0xd56f, 0x0db8,
+		0x19eb, 0x7528, 0x253d, 0xabfc, 0xf8a4, 0xcfbc, 0x373f, 0xa697, 0x948e, 0x52bb, 0x774e, 0xf0b0,
+		0x8432, 0xee19, 0x8574, 0xa8c9, 0xc086, 0x77cb, 0x8101, 0x570e, 0x9dce, 0xed4d, 0x6872, 0xf34a,
+		0x2cc2, 0x48b3, 0xd20c, 0xa631, 0x51cf, 0x4a4d, 0xae77, 0xe3bc, 0x1a33, 0x90eb, 0xd095, 0xf248,
+		0x334b, 0x591b, 0x5e15, 0x7cb2, 0x3b02, 0x0c74, 0xddd0, 0x39eb, 0x884f, 0x2f1d, 0x0a8f, 0x75c1,
+		0x8551, 0xa55b, 0xb5ea, 0x0347, 0x8d70, 0x1b16, 0x19c2, 0x9007, 0x6b50, 0x1ce7, 0x99e5, 0x4620,
+		0xbe62, 0x9fa2, 0x598e, 0x508b, 0x0ed6, 0x8f3f, 0x9d9e, 0x1c49, 0xb1f7, 0xb551, 0xaa0e, 0xd1ed,
+		0x3312, 0xadc9, 0xd469, 0x5d3e, 0xf10e, 0x8ee3, 0xf054, 0xcd7f, 0xda74, 0xec13, 0x1292, 0xad26,
+		0xf5bf, 0x0896, 0x752e, 0x77be, 0x1b85, 0xe5db, 0xa170, 0xadd1, 0xafc3, 0x2308, 0x50c6, 0x2436,
+		0x1a42, 0xce87, 0x4acc, 0xc0de, 0xb446, 0x2a60, 0xd642, 0xb427, 0x50ec, 0x33ae, 0x5444, 0x842d,
+		0x6bb9, 0x5cec, 0x733c, 0x2a29, 0xf3c4, 0x6e6f, 0xd553, 0x7d08, 0x12b1, 0x7153, 0x6369, 0x9841,
+		0xca9f, 0x6d4e, 0x1562, 0x6ddd, 0x99a2, 0xf174, 0x0840, 0xa525, 0xeab1, 0xe2e4, 0x0cd2, 0x919b,
+		0xcd9b, 0x7754, 0x0267, 0xc3b9, 0xd47b, 0x5560, 0x1a5a, 0x8a50, 0xe86f, 0xc039, 0xcb17, 0x8f11,
+		0x4389, 0x4d0f, 0xefa5, 0x1e1b, 0x0324, 0xbbd8, 0xb860, 0x1146, 0xb5e0, 0x6c05, 0x44ac, 0x5d51,
+		0x4049, 0x8d9e, 0xf3a6, 0x24ac, 0x73c1, 0x8cc1, 0x4448, 0x4e4a, 0x6e03, 0xd460, 0x5848, 0x489f,
+		0xf62f, 0x7468, 0xc3e6, 0xd386, 0x48e2, 0x12da, 0xa97c, 0x4fb0, 0xe563, 0x98f7, 0xe9b7, 0x2c56,
+		0x0af6, 0xfaa5, 0x4c0f, 0x9338, 0x97e6, 0xaeef, 0xcacc, 0x99cf, 0xfa1a, 0xf950, 0xc36e, 0xbad5,
+		0xa497, 0xa587, 0xe7cc, 0xa523, 0x6ea6, 0x569c, 0x7f2d, 0xdc85, 0x0c91, 0x627f, 0xa11f, 0x83cd,
+		0xe5c7, 0xc739, 0xa16a, 0xd6ac, 0xefab, 0x6676, 0xda0c, 0xe581, 0x3ad9, 0xc3d4, 0x22c0, 0x3c77,
+		0x8287, 0x4713, 0xb96b, 0xf641, 0x220b, 0x897f, 0xc9f2, 0xe2db, 0x8a0c, 0x97d3, 0x272c, 0xda8f,
+		0x92bc, 0x8d11, 0x3c98, 0x9b09, 0xfcd4, 0x05ca, 0xb0cc, 0xe2dd, 0xa4d7, 0x0ca2, 0x128c, 0x90f1,
+		0xe2ee, 0xca6b, 0x75a9, 0x6a3f, 0x1ea8, 0x575f, 0x8666, 0x990c, 0xa901, 0xd68f, 0x3e93, 0x1876,
+		0xbb9d, 0x376a, 0x7910, 0x6be7, 0x3b93, 0xf39b, 0xc028, 0x11bc, 0xa299, 0x1706, 0xe849, 0x67e9,
+		0x5911, 0x8e9c, 0x5313, 0xaf84, 0xa028, 0x8d07, 0xc2d8, 0xa76a, 0x57bb, 0xb807, 0x3853, 0x228f,
+		0x2b71, 0x120f, 0x40f7, 0x7ef0, 0x9c7b, 0x3bfa, 0xf5bb, 0x09a2, 0x1bb7, 0x0006, 0x723b, 0x79c2,
+		0x85b8, 0x999f, 0x2cb5, 0x4fd0, 0x102c, 0xb7ea, 0x7e28, 0x007d, 0x45f6, 0x32b3, 0x3cd6, 0x625e,
+		0xb915, 0xb0e0, 0x4db5, 0xc7c5, 0x0f79, 0x0fee, 0x8298, 0x066c, 0x2e99, 0x9ad5, 0xea7d, 0x2558,
+		0x0600, 0x621f, 0xe442, 0x9acf, 0xef1b, 0x2658, 0x3bf9, 0x0a04, 0x4019, 0x9ef0, 0x8347, 0xbafb,
+		0xb6a7, 0x1276, 0xc931, 0x66e3, 0x8eb5, 0x7e4c, 0x0d90, 0x4ac5, 0xf6e5, 0xe825, 0xe8eb, 0xcae5,
+		0x9243, 0x9c3c, 0xdb7c, 0x2190, 0x549f, 0x9dc0, 0x261f, 0x5c3a, 0xd715, 0x48fb, 0x19e3, 0x4e8b,
+		0x1519, 0x69da, 0x4e6c, 0x7b7a, 0x7f9f, 0x2a53, 0xb6d9, 0x18ce, 0x50f4, 0x9472, 0xcedb, 0x2863,
+		0x3778, 0x7c17, 0x4ebc, 0x51ee, 0xc6e2, 0xab83, 0x8a81, 0x7387, 0xd4b3, 0xc6dc, 0xc2fc, 0xbf4b,
+		0x7e84, 0x9fd9, 0xabdd, 0x6df2, 0xd85e, 0x312d, 0x3417, 0x98bb, 0x5887, 0x4d38, 0x24f5, 0x7424,
+		0x6aa4, 0xd0a3, 0x6dfa, 0x3416, 0x8541, 0x377f, 0xa869, 0x5221, 0x5fba, 0xa8e7, 0xdf95, 0x4d9b,
+		0xdb92, 0xce2c, 0x7d24, 0xbbb1, 0x336b, 0x23bb, 0x9ed7, 0xa36a, 0x3988, 0x8fa0, 0x15ba, 0x0cf5,
+		0xad67, 0x915a, 0x7b1f, 0xc360, 0x932f, 0x5c2e, 0x2e19, 0xdf62, 0x2caf, 0x932f, 0x642a, 0x48f4,
+		0x7520, 0x4b86, 0x2c76, 0xa74e, 0xab65, 0x6808, 0xff69, 0xe574, 0x6ae0, 0x9b58, 0x76ea, 0x7a38,
+		0xd16f, 0xf01d, 0xc041, 0x7cd5, 0x569d, 0x3819, 0x159e, 0x14c9, 0x84bb, 0x0bf7, 0x1173, 0x7306,
+		0x9e6d, 0xbf87, 0xb0a1, 0xac11, 0xd6f1, 0x0c02, 0xb1a3, 0x0eba, 0x2cde, 0x7c9a, 0x5fb4, 0xae24,
+		0xd440, 0x8d4a, 0x93fa, 0x2c0e, 0xc30b, 0xb514, 0xa250, 0x2b6c, 0xab5c, 0x95e3, 0x0ebf, 0x3c3a,
+		0x27fc, 0x2c6a, 0x9142, 0xff56, 0x5b84, 0x321b, 0x8524, 0xe95a, 0x4649, 0x22e6, 0x1587, 0xd083,
+		0x874f, 0x2dbf, 0xbbc4, 0xc284, 0xf8be, 0xd398, 0x93cb, 0x3911, 0x5620, 0xf6b6, 0xd78e, 0x4a29,
+		0x4023, 0xa652, 0x5e39, 0x9e4b, 0xa1ba, 0xf925, 0xc8c0, 0xc17a, 0x3c20, 0x2b7f, 0x8b48, 0xc137,
+		0xe44b, 0x3777, 0x412c, 0xf99f, 0xe80c, 0x4d37, 0x1dbe, 0x6ab9, 0x9b76, 0xda08, 0xdf71, 0xce80,
+		0x18ab, 0x7f52, 0x1711, 0x4c19, 0xc43e, 0x4208, 0xf68c, 0x1ab9, 0xcde5, 0x4072, 0xc643, 0xfaa7,
+		0x1669, 0x8909, 0x4d83, 0x8689, 0x21e8, 0x84fb, 0x62b1, 0x9f6e, 0x78e4, 0x2f44, 0xf918, 0xcce8,
+		0xa730, 0x4bc6, 0xe875, 0xfbf1, 0x69bb, 0x6f41, 0x4bb5, 0xd282, 0x049c, 0x2e69, 0xa6d0, 0x58cd,
+		0xba9e, 0xb714, 0x2278, 0x816a, 0xc488, 0xc766, 0x617a, 0x342a, 0x8fb9, 0xd0eb, 0xb81d, 0x76b1,
+		0x54c0, 0x158e, 0xf6ee, 0x1f5a, 0xd753, 0xd341, 0xe03e, 0x857a, 0xf02e, 0x28cf, 0x6d87, 0xebe2,
+		0x12b7, 0x3634, 0xc8d6, 0x2a36, 0xe478, 0x90d2, 0x43d6, 0xfd4c, 0xd4a8, 0xcb86, 0x20d9, 0x2018,
+		0xc412, 0xf304, 0xcee6, 0x7fd9, 0xafce, 0x791d, 0xae32, 0x8eb3, 0xedb9, 0x1eb6, 0xe6da, 0x86f1,
+		0x5d9e, 0xca00, 0x7f84, 0x8a68, 0x0ba0, 0x0c84, 0xd81e, 0xea5f, 0x7b24, 0xfb71, 0x406a, 0x042d,
+		0x44f2, 0x115d, 0x543d, 0x8ab7, 0x4f85, 0xaedd, 0x01e7, 0x55b9, 0xc080, 0xfa23, 0xf2be, 0xde64,
+		0x045f, 0xc0d0, 0xbd78, 0x0e83, 0x5f89, 0x8e33, 0x4c44, 0xb6fc, 0xf1e3, 0x8524, 0x3684, 0x16a9,
+		0x4b1e, 0x0d94, 0x8d3d, 0x222c, 0x9e5e, 0xc1f9, 0x818d, 0xaac7, 0x915e, 0x0a18, 0x2422, 0xf5dc,
+		0x8518, 0x4468, 0x6f90, 0x8fc9, 0xd4a1, 0xbc3e, 0x7e1d, 0x6a8a, 0xa8b5, 0x4b9d, 0x93ca, 0xa2c0,
+		0xe523, 0x5529, 0xd635, 0x1caa, 0xcc34, 0x728d, 0xbec5, 0x8fb9, 0xdd7b, 0x78ad, 0x38f8, 0xb2d4,
+		0xcaef, 0x2a09, 0x5b4a, 0x5d6e, 0x1eaf, 0x3d8f, 0xdf77, 0x42f4, 0x7afe, 0xb658, 0x0916, 0x82b5,
+		0xa251, 0x6aa2, 0x13ca, 0x0757, 0x5568, 0xf176, 0xd70c, 0x1de0, 0x3d7c, 0x5e28, 0x8300, 0x7392,
+		0xac16, 0x094b, 0x4b5a, 0x8c2a, 0x34cb, 0x87c9, 0x3143, 0xcbb9, 0x75ab, 0x5d3f, 0x4b27, 0xc8d7,
+		0x2986, 0xef86, 0x91ff, 0x1973, 0x4816, 0xbd6b, 0x40c4, 0x6a68, 0x34df, 0x2e82, 0x5891, 0x73a6,
+		0x936c, 0x3c65, 0xb52c, 0x8908, 0x4e45, 0xebb8, 0xff6a, 0xfa3f, 0x1339, 0x3e0b, 0x538b, 0x9105,
+		0x21eb, 0xa050, 0x010f, 0xacaf, 0xbb37, 0xbee5, 0x3aaa, 0x8b89, 0x9b1c, 0x1649, 0x8970, 0x44db,
+		0xb9b4, 0x9458, 0xf06e, 0xa361, 0xb896, 0x7599, 0xb568, 0x8319, 0x5723, 0xd149, 0x0098, 0x900d,
+		0x1416, 0x7957, 0xc3f5, 0x202e, 0x792f, 0x4a34, 0x2718, 0xd23b, 0x4cde, 0xd10d, 0x1043, 0x32f5,
+		0x0e21, 0xa916, 0xc578, 0x8690, 0x88d8, 0x30bf, 0xfadd, 0xd4de, 0x3247, 0x849a, 0x870c, 0xaec3,
+		0x154e, 0xd3d2, 0xf67d, 0xd508, 0x6bfd, 0xa153, 0x9ee5, 0x67fc, 0x0593, 0x1e53, 0x1baf, 0x42a1,
+		0x3c2c, 0xeb92, 0xb9cd, 0xa945, 0x5894, 0xd4d0, 0x9aee, 0x1c52, 0x626a, 0x606c, 0x3e2f, 0xae44,
+		0x84af, 0xf9a9, 0xa676, 0x0d2c, 0xbee9, 0x3ac6, 0x6d64, 0x74cf, 0x0f81, 0x6f0e, 0x1b98, 0xf91d,
+		0xd3f0, 0xbad1, 0x1e63, 0x5f26, 0x4857, 0x46f2, 0xc000, 0x0425, 0x6858, 0x8f07, 0xc57d, 0x7da3,
+		0x7d74, 0x643b, 0x11ce, 0x4b6e, 0x94a5, 0xfc56, 0xb4ed, 0x315b, 0x3d18, 0xf0bc, 0x0fc2, 0x9d4c,
+		0xecf9, 0x94e0, 0x9d47, 0x7ac4, 0x73cc, 0x5f7b, 0xe5a2, 0x818d, 0x503e, 0x7386, 0x2c9a, 0x49f3,
+		0xaf4b, 0x8a0d, 0x661d, 0x88aa, 0xb09a, 0x36c1, 0xb998, 0x2259, 0x3a85, 0x010b, 0xecc3, 0x108a,
+		0x839f, 0xe4b4, 0x184a, 0x2c3d, 0x833e, 0xd461, 0x922d, 0x3455, 0xf11b, 0x32b4, 0x5d26, 0x3a32,
+		0xb4f7, 0x9bab, 0xbc7e, 0x96af, 0x7d29, 0xb251, 0xacf2, 0xe193, 0x91d2, 0x1b8c, 0x2bf9, 0xd8df,
+		0xeb25, 0x6893, 0x951b, 0xf8c9, 0xe59b, 0x8aaf, 0x9037, 0x4b6d, 0xf447, 0x6f4d, 0xc489, 0xe8e3,
+		0xfb29, 0x8918, 0x5344, 0xa9f1, 0xad98, 0xe93c, 0xf8bc, 0xa8ed, 0x6116, 0xbf22, 0x1d1c, 0xd762,
+		0x3f88, 0x9808, 0x6d75, 0xc636, 0x3570, 0xebdb, 0x27aa, 0x7246, 0x6742, 0x04d4, 0x9a6e, 0xa341,
+		0x3d4a, 0x7f8e, 0x3d49, 0xdd27, 0x7401, 0x0a37, 0x2b67, 0x2740, 0xfacd, 0xbecb, 0x857e, 0x8b45,
+		0xae03, 0x8419, 0x3684, 0xfdf2, 0x2054, 0x4a25, 0x44c1, 0xa721, 0x7604, 0x445b, 0xa881, 0x8a0d,
+		0x3875, 0x3595, 0x46ad, 0x5d8e, 0xdb73, 0x9e29, 0x1065, 0x0567, 0x23e9, 0x849b, 0x6fa2, 0x3317,
+		0x0896, 0x21c9, 0x270b, 0x85a8, 0xcd2f, 0xa965, 0xc5dc, 0x7ea8, 0x37d2, 0x5b8c, 0x8641, 0x8584,
+		0x1d43, 0x35b5, 0x8a16, 0x1009, 0x30b3, 0x06a0, 0x84e0, 0x4a68, 0xe490, 0xf16e, 0xdbe3, 0x18ee,
+		0x5101, 0xcaf3, 0xe4d4, 0xf3cd, 0x73cb, 0x5ccc, 0xf914, 0xb229, 0x37bf, 0xa5d9, 0x45b1, 0x6258,
+		0xbf02, 0x4332, 0x8044, 0x90fc, 0xac40, 0x7a55, 0xd638, 0x645b, 0x99c9, 0xca68, 0xf161, 0xe6c7,
+		0xa86a, 0x51c0, 0xfc3d, 0x91a5, 0x73d3, 0x5ff9, 0x9580, 0x182f, 0x03d0, 0xcbbd, 0x640e, 0xff84,
+		0xac29, 0x832c, 0xc263, 0x4605, 0x8d62, 0x6b0b, 0x02af, 0xf53e, 0x64c3, 0xc0fa, 0xbafa, 0x80c7,
+		0x18bc, 0x60f0, 0x42fa, 0x2574, 0x225e, 0x4400, 0xb447, 0xb791, 0x4095, 0x7f97, 0xf22b, 0x7f68,
+		0x5d04, 0xedd1, 0x23ba, 0xc4d2, 0x64f5, 0xc9ef, 0xee04, 0xbc1d, 0x78c1, 0xec05, 0xfa14, 0xa079,
+		0x1932, 0xdc45, 0x19c8, 0xa574, 0x9b5b, 0xe5e6, 0xcae4, 0x0cfe, 0x4378, 0x4724, 0x6f7a, 0x42a0,
+		0xde5c, 0x909f, 0xd398, 0x851f, 0x8349, 0x73da, 0x975e, 0xd7b4, 0xf4b8, 0x2200, 0x2a57, 0x4363,
+		0x3eec, 0x2aef, 0x40b6, 0x48b5, 0x8edb, 0x72d6, 0x9ecf, 0x3c88, 0x2ff9, 0x546b, 0x2e62, 0x4b48,
+		0x9e3c, 0xfdca, 0x46c0, 0x1644, 0xd86f, 0x4dbc, 0x638a, 0xd8ea, 0x3bbf, 0x9226, 0x2775, 0xa1cc,
+		0xa2dc, 0xa0a6, 0x00c4, 0x3903, 0x37ac, 0x27ee, 0xa722, 0xbfeb, 0x34af, 0x13fa, 0x49f7, 0x92e7,
+		0x0f9a, 0x0da7, 0x0aba, 0x0843, 0x914a, 0xfd51, 0x231f, 0x005d, 0x413c, 0x0793, 0x52b9, 0x421f,
+		0x8aea, 0x3d92, 0x2d37, 0xc789, 0xa5b2, 0xb707, 0x6c34, 0x37d6, 0x7340, 0x5021, 0x16ac, 0xe41c,
+		0x82c2, 0x60e5, 0x6c05, 0x777a, 0xe622, 0x24cb, 0xe2a6, 0x7199, 0x460b, 0x6799, 0x83b7, 0xf5b2,
+		0xaaef, 0xc11a, 0x176c, 0xb26e, 0xf2b3, 0x21b0, 0x3fe5, 0x5bbb, 0xc978, 0x94c6, 0x8f9b, 0x259c,
+		0x253d, 0xa47d, 0x77f7, 0x2101, 0x3622, 0xfeed, 0x95ad, 0x75f6, 0x0cf1, 0x6d35, 0xa94c, 0x6dfa,
+		0xf258, 0xe8fc, 0x2272, 0x17b4, 0x5f39, 0x2fe1, 0x09c7, 0x0d0e, 0xeb0b, 0x7a1f, 0x93d7, 0x9c7b,
+		0x5f55, 0x7e67, 0x76d2, 0x5c41, 0x7707, 0x89d9, 0x634a, 0x7d6c, 0x333f, 0xe796, 0x5b71, 0x4c81,
+		0xa075, 0xf125, 0x17bb, 0x45dc, 0x7272, 0xf6f4, 0xe4f7, 0x4401, 0x2881, 0xe95d, 0x016d, 0xc301,
+		0xdb50, 0x4615, 0x0a31, 0xecc4, 0x569c, 0x34a6, 0xe6e7, 0x18a6, 0xbe44, 0x79bf, 0x2fad, 0xdb1f,
+		0xc38b, 0xcc5d, 0x746b, 0x1433, 0x9726, 0xd4b9, 0x5686, 0xdec8, 0xb0dc, 0xcc65, 0x598f, 0x46ff,
+		0xae65, 0x6084, 0x5651, 0x7420, 0xa96a, 0xb832, 0x4301, 0xfc29, 0xcbdd, 0x1bd3, 0x8550, 0xddb7,
+		0xe59e, 0x94e0, 0x7feb, 0x26e6, 0x988a, 0x0405, 0x93e8, 0x4db7, 0x3bf9, 0xbefe, 0xbe66, 0xe5f4,
+		0x34df, 0x5c9f, 0x355b, 0xcd3f, 0x3c97, 0x1dfc, 0x7409, 0xa19d, 0x9ec4, 0xdd82, 0x4fc9, 0xc12d,
+		0xc806, 0x09bd, 0x86a0, 0xab82, 0x20d5, 0x7293, 0x0b0e, 0x5b3d, 0x6543, 0xb4d7, 0x7bfd, 0xec64,
+		0xd2c8, 0x8ca8, 0xc9f2, 0xf12b, 0x389b, 0x10a8, 0x801d, 0xe35c, 0xf23f, 0x77ca, 0x7490, 0x1256,
+		0x88a4, 0x2c14, 0x8637, 0xc985, 0xea05, 0x1bbe, 0x0ec7, 0x29cf, 0x32cf, 0x7ea4, 0x58c7, 0x36d0,
+		0x89c7, 0xc095, 0xf788, 0xc9c3, 0x1745, 0x5c4f, 0xb0ff, 0xd68c, 0xcef1, 0xbd87, 0x4263, 0x5cec,
+		0x20d9, 0xcbfd, 0x8e2a, 0xfd7a, 0xf43f, 0xe7b8, 0xb412, 0xcca9, 0x21d5, 0xcb87, 0x1cc2, 0x00e2,
+		0x5bae, 0x0101, 0x6dc1, 0xcbfe, 0x6fd1, 0xe3db, 0x9623, 0xd0ec, 0xc791, 0xf442, 0x250f, 0xaee4,
+		0xfcf9, 0x0ddd, 0xe658, 0x4ae4, 0x591b, 0x1f52, 0x851a, 0xc7c4, 0x8a9c, 0x02f9, 0x53e6, 0x0994,
+		0xad31, 0x63b7, 0xb22f, 0x7c75, 0xa1f6, 0x0765, 0x2196, 0x9fa3, 0xf031, 0xadf6, 0x8828, 0xacce,
+		0xff68, 0x487a, 0x6507, 0xe633, 0xa8e1, 0x6a09, 0xe494, 0x999d, 0xea6b, 0x5c46, 0xd65d, 0x98c0,
+		0x4ad5, 0xd67c, 0x515c, 0x072f, 0xd28e, 0x9e1d, 0x39e1, 0xa382, 0xd3a5, 0x454a, 0x01c4, 0xd19d,
+		0xdef3, 0x597b, 0x9f85, 0xe122, 0xc0f9, 0x3326, 0x5b16, 0x56f3, 0xa6a6, 0x1421, 0x612a, 0x69ee,
+		0x3d43, 0xedf2, 0xb3d3, 0x7d69, 0xbfe4, 0x14a5, 0x2c4a, 0x788a, 0xfb41, 0x0311, 0x025b, 0x2edb,
+		0x1736, 0x4540, 0xa2a3, 0x3f1d, 0x5f70, 0x7ae4, 0x7aa9, 0x02b3, 0x4c1c, 0x7312, 0xa473, 0x002f,
+		0xa739, 0x7bcb, 0x2406, 0x6562, 0x6ac1, 0x966e, 0x73cd, 0xb316, 0xd5ee, 0xc474, 0x236d, 0x8f19,
+		0x5153, 0x60f9, 0x59be, 0x3418, 0x5a8c, 0xb55e, 0x33e7, 0x9eb8, 0xccfd, 0x5fe1, 0x1a31, 0x9507,
+		0x819b, 0xcce2, 0xdc83, 0x4ffa, 0x007d, 0xd51b, 0x39fb, 0x7272, 0x1d0d, 0xcbdd, 0xe532, 0x3b0c,
+		0x91a2, 0x9e7e, 0xd399, 0x40c0, 0x117f, 0xcdba, 0xbdee, 0x9bf5, 0x9c6e, 0xcadf, 0x050c, 0x795d,
+		0xa28f, 0x3d6d, 0x4750, 0x7908, 0x4445, 0x5295, 0x6e27, 0x2dd8, 0x0e94, 0xe15b, 0xbb46, 0xfda0,
+		0x8f64, 0x8e91, 0xad11, 0x3da8, 0x466e, 0xc91f, 0x3daf, 0x77f0, 0x3b5a, 0x8ad7, 0x6c19, 0x02f6,
+		0x27a6, 0x9320, 0x8d0e, 0x8b90, 0xf3ce, 0xcfb1, 0x415c, 0x5c95, 0xe422, 0x8e7b, 0x7dbe, 0x69b9,
+		0x8244, 0x6b43, 0xde0c, 0xd140, 0xc95d, 0x818a, 0xfdc4, 0x841e, 0x024a, 0x1560, 0xe2c4, 0x00f4,
+		0x6064, 0x0618, 0x0890, 0xa6f9, 0xa3ca, 0x55af, 0xd3b0, 0x9aa0, 0x0e71, 0x4448, 0xdbcc, 0xd1d9,
+		0xab64, 0x2ef2, 0x371f, 0xe9e9, 0xd1f6, 0x70e1, 0xc083, 0x7477, 0x8adb, 0x68c6, 0x4685, 0x6f67,
+		0x9deb, 0xbc3f, 0xbc47, 0x99d5, 0x4f41, 0x0248, 0xae27, 0x59ec, 0xc5ea, 0xda75, 0x0cb1, 0x9ef8,
+		0x3535, 0x14a8, 0x8f7e, 0x46d2, 0x4bdd, 0x4d34, 0x9209, 0x3995, 0xdb67, 0x472f, 0xa91a, 0xdfcc,
+		0x8a49, 0x0bf0, 0xa01b, 0xe83d, 0xe9b2, 0x7e37, 0x38f8, 0x69e3, 0xac5b, 0x5025, 0x2698, 0x35a2,
+		0x941b, 0xe49b, 0x8337, 0xbfbc, 0xd44a, 0x3e33, 0x94ab, 0x0918, 0x2298, 0xe89e, 0xa713, 0x83c7,
+		0x2321, 0x4f90, 0x4fc8, 0xcd6d, 0xfb31, 0x0749, 0xed22, 0x00e4, 0x3703, 0xf369, 0x728a, 0x80cd,
+		0x9188, 0x8d07, 0x159a, 0xe0a2, 0xc3b4, 0xb666, 0x288a, 0xeac9, 0x4560, 0xd453, 0x9818, 0x43fd,
+		0xe170, 0xb0c5, 0xe0c3, 0x1b62, 0xca5c, 0x6144, 0xcf82, 0x46cf, 0xa6fa, 0x3d5e, 0xc831, 0x563e,
+		0xcf27, 0x5d24, 0xda3c, 0x7c3d, 0x340d, 0xe590, 0xdda7, 0x5806, 0xeeee, 0xf6fc, 0x684d, 0x080e,
+		0x3516, 0x68d0, 0x5c59, 0x1de5, 0xe73c, 0x0446, 0x20f4, 0x199a, 0x6931, 0xcd95, 0x656a, 0x18b3,
+		0x6504, 0x560f, 0x9019, 0xfbd5, 0x31e7, 0x8ca9, 0x7af1, 0x6d80, 0xa557, 0xaed0, 0x1a3d, 0x0f5b,
+		0xa656, 0x2884, 0x7931, 0x58a6, 0xb66d, 0xebe1, 0x862f, 0x8642, 0xf036, 0x1b45, 0xc2dc, 0x78b2,
+		0x6c52, 0x9545, 0xb7d2, 0xc9f2, 0xe050, 0x94de, 0xc5a8, 0x0468, 0x0f1e, 0x32a8, 0xf7b6, 0x0a08,
+		0x42f7, 0x2a7f, 0x6da0, 0x36f7, 0x2dba, 0x49d4, 0x0a83, 0x71c6, 0x5c06, 0xd2d9, 0xdda1, 0x08cd,
+		0xb1c8, 0x7f37, 0x65ec, 0x72fc, 0x8615, 0xd9e6, 0x4fe0, 0x8e0d, 0xec3d, 0x64b3, 0xb546, 0xdede,
+		0x1aa2, 0x9e3f, 0x9e21, 0x9a94, 0xc6e5, 0x5413, 0x8287, 0x089e, 0xe5b6, 0x649d, 0x1360, 0xe8fc,
+		0xca0e, 0x7a8d, 0x97c5, 0xb712, 0x7ec9, 0x667b, 0x88bb, 0x54a7, 0x2a1a, 0xbd3e, 0xa0a4, 0xf77f,
+		0x71a0, 0xfd08, 0x8b34, 0x7c8e, 0x2b8b, 0x6451, 0xa269, 0x00d2, 0x95cc, 0xc3a1, 0x292f, 0x8b81,
+		0x0d0a, 0xa8ef, 0x019d, 0xd9bf, 0x4f38, 0xdca1, 0xf676, 0x769d, 0x267a, 0xbf5c, 0xe253, 0x4b2c,
+		0x436e, 0x475c, 0x90c5, 0xd7e5, 0x0e9c, 0x48eb, 0x2dd1, 0xc5ab, 0x1cf8, 0x4267, 0x3dd8, 0x7a9e,
+		0xfd96, 0x46fc, 0x1fc2, 0x2479, 0xaac2, 0x5d8e, 0x9e39, 0x6bd1, 0x520d, 0x1695, 0x93f6, 0x485c,
+		0xe8f8, 0x099f, 0xe2b2, 0x7403, 0x3be9, 0xcbf9, 0x25fe, 0x8479, 0x693a, 0x92d7, 0x81d6, 0x7d26,
+		0x93ac, 0xff70, 0x7c67, 0x53ee, 0x43fd, 0x3d4a, 0x8d73, 0xf892, 0xf116, 0x7d7e, 0x4b8e, 0x295d,
+		0xcf08, 0x7399, 0xb26c, 0x3530, 0xe4d5, 0x96ba, 0x63f2, 0xa26f, 0xd393, 0x2276, 0x62c6, 0xab20,
+		0x61b4, 0x018b, 0xe4e5, 0x1924, 0xc240, 0x3ea2, 0x8421, 0x740b, 0x31b6, 0xc806, 0x7229, 0x8890,
+		0xf329, 0xdb6d, 0x9568, 0x5c55, 0xf2bd, 0x4dbd, 0xd095, 0xb7ac, 0xf0ad, 0x0c10, 0xafa3, 0x4179,
+		0x2191, 0xb5b4, 0x63fd, 0x8416, 0x6bd8, 0x4dc8, 0x10b2, 0x44db, 0xe0d2, 0x9c2a, 0x27a9, 0xe874,
+		0xa089, 0x6a34, 0xbc5d, 0x0964, 0x74e8, 0x886d, 0x82da, 0x1f08, 0x13e8, 0xba59, 0xd5bd, 0x0ee0,
+		0xf2d2, 0x5d1e, 0x52f9, 0xb36e, 0x1495, 0x2036, 0x8063, 0xe123, 0x8bd1, 0x9235, 0x4ef3, 0x0514,
+		0xdd03, 0x29df, 0x8791, 0x1e42, 0x9239, 0x5ce3, 0xa77e, 0x0a1d, 0x5a49, 0xf4b3, 0x94a5, 0xe724,
+		0x5e85, 0xe74a, 0xf8d5, 0xfd0a, 0xa15d, 0xfa4a, 0xd616, 0x22fc, 0x1077, 0x8be7, 0xed3a, 0xeb28,
+		0x885e, 0x6c18, 0xc35e, 0x6e6c, 0xb5c6, 0xe762, 0xf271, 0xd7fe, 0x5e6e, 0xd99e, 0xbc49, 0x8c22,
+		0xdc50, 0x3c36, 0x3025, 0x7b03, 0x8c23, 0x17c7, 0x594f, 0x095b, 0xa574, 0x2a02, 0x0315, 0x0a71,
+		0x6476, 0x6e8f, 0xfe3e, 0x413f, 0xbcce, 0x6656, 0xdf4d, 0xea49, 0xf4d2, 0x2c8b, 0x249d, 0x4fe8,
+		0x446a, 0x6dba, 0x7628, 0x85a8, 0x11c0, 0xe94f, 0xda09, 0x1b07, 0x389e, 0x5419, 0xd380, 0xac3b,
+		0x2705, 0x633e, 0xe630, 0x66c2, 0x12bf, 0x70d9, 0xedc6, 0xaa3c, 0x8d63, 0x1062, 0xa1f7, 0xe7a3,
+		0x9aee, 0xad21, 0xdf3c, 0x0f62, 0x8fcf, 0x9d61, 0x2068, 0xff16, 0xef89, 0xf430, 0xe3a8, 0x7d7c,
+		0xa9f3, 0x6c34, 0x4a86, 0x6b6e, 0xb40c, 0x7bfe, 0x175b, 0x155e, 0x7e1c, 0x2058, 0x6e6d, 0x8086,
+		0xf5cf, 0xa5f0, 0x3949, 0x2e21, 0xa194, 0x097a, 0xa6ca, 0x5f33, 0x5e57, 0xa102, 0x9d1d, 0x508a,
+		0xb061, 0x810d, 0xde46, 0xd3e2, 0xde10, 0x7371, 0xf686, 0xf0a3, 0x24d2, 0xd686, 0x0b75, 0x3ff2,
+		0x5bdc, 0xf783, 0xbe6a, 0xd04e, 0x0e97, 0xe361, 0x0fe6, 0xb26c, 0x55fb, 0x7178, 0x4b67, 0xb412,
+		0x27c6, 0xcb14, 0x4575, 0x8f08, 0x8756, 0x2d68, 0xa6e2, 0xdb2b, 0xd94e, 0x8b72, 0x732d, 0xbe89,
+		0x8ee9, 0x7db6, 0x498a, 0xfa07, 0x8a33, 0xa3c9, 0xb29f, 0x8fcc, 0xb0d2, 0xe007, 0x326b, 0x8b3b,
+		0x56de, 0x1c01, 0xa992, 0x2a76, 0xe220, 0xd558, 0x53bf, 0xd95e, 0xee98, 0x4d0a, 0xc533, 0xae77,
+		0x4d5a, 0xadb5, 0x0450, 0x87b5, 0xd29c, 0xe2bb, 0x5279, 0x2420, 0xc80e, 0x892a, 0x2c84, 0x85e1,
+		0x295c, 0x3159, 0xaea8, 0xbc64, 0x9271, 0x3458, 0x305d, 0xb330, 0xd52a, 0x9a4e, 0x579f, 0x9196,
+		0x65be, 0x7e0f, 0xc1f6, 0x05b3, 0x5e7a, 0xb979, 0x4f36, 0xfad1, 0x4014, 0xdbd1, 0xa16b, 0xacb7,
+		0x0043, 0xc298, 0x634a, 0xcad5, 0xbb38, 0x6d70, 0xc066, 0x6f12, 0x973e, 0x49aa, 0x3090, 0xea23,
+		0x2996, 0x9a31, 0xa2c2, 0xc6fa, 0xbb0c, 0xc810, 0x4742, 0x77d5, 0x8492, 0xf6c0, 0xdb55, 0x4bd5,
+		0x42cc, 0x7337, 0x2d5d, 0xf97c, 0x9b53, 0xfe7c, 0x1066, 0xf2d5, 0x0f64, 0x9bfa, 0xd674, 0xed5b,
+		0x0f1b, 0x476e, 0x46d3, 0xd01e, 0x1bf5, 0xc36c, 0xe2d1, 0xdf51, 0x72e9, 0xf805, 0xd9ed, 0x2c76,
+		0xdd1f, 0xa81c, 0x5c14, 0x04c1, 0x10bc, 0x67fd, 0x7342, 0xf63e, 0xbcee, 0xdb67, 0xef10, 0x5e20,
+		0x26a7, 0xaf03, 0x9e08, 0xfb5d, 0x7c94, 0x0a0d, 0x90b8, 0x6d82, 0xec90, 0x70df, 0x12f6, 0x90b9,
+		0x3686, 0x2c04, 0x2e87, 0x2839, 0x6a90, 0x4992, 0xe003, 0x5c7b, 0x90ac, 0xd054, 0x3c7c, 0x0b12,
+		0xcc66, 0xe8a2, 0xecf1, 0x06a9, 0x07ae, 0x3c08, 0xd939, 0x187d, 0x2efa, 0xb739, 0x3c1e, 0x116d,
+		0xfd2f, 0xf85e, 0xe70d, 0xb5be, 0x7733, 0x2701, 0x44da, 0x0450, 0x4e06, 0x406a, 0x4b31, 0x134a,
+		0xfa3f, 0x6eed, 0x0a19, 0x0fee, 0xc50e, 0x8a50, 0x329e, 0x5c47, 0xe2a1, 0x7db3, 0xe13a, 0x5485,
+		0xe9f9, 0x6eba, 0x96dc, 0xafc1, 0xbccd, 0xb3e9, 0x17ec, 0x621c, 0x73b1, 0x6b86, 0x8671, 0xc857,
+		0xdc07, 0xd17b, 0x0c7c, 0x1846, 0x770d, 0x66b5, 0xb640, 0xae25, 0x7ee1, 0x4521, 0x8944, 0x50ef,
+		0xabff, 0xa5cc, 0x0118, 0xb228, 0x6547, 0x0894, 0x734b, 0xa553, 0x7a89, 0xbe37, 0x8923, 0x9939,
+		0xa903, 0x578c, 0xf151, 0x19bc, 0x6661, 0x4e6a, 0xb561, 0xcbdd, 0xb137, 0xa1d0, 0xe9e4, 0x9337,
+		0x9de0, 0x3b84, 0x81e1, 0x53fa, 0xa437, 0x5132, 0x4b76, 0x0d18, 0x14d5, 0x9b09, 0x26ec, 0x60e0,
+		0x186c, 0xbdfc, 0x1857, 0xdeea, 0x8c3b, 0x67ea, 0x2392, 0xd616, 0x74d1, 0xde7e, 0x502e, 0xc5b9,
+		0x8a7b, 0xc0f4, 0x556e, 0xbc4a, 0xcafa, 0x9667, 0xa349, 0xe878, 0xdbda, 0x973d, 0x4fe9, 0x1f9c,
+		0x50a3, 0x728f, 0x525f, 0xb637, 0xbb25, 0xc81c, 0xc1ec, 0x1934, 0xaf5c, 0x5406, 0xa9e1, 0x94e0,
+		0xcc99, 0xcd20, 0xc25b, 0xa9ac, 0x2eae, 0x987e, 0x3993, 0x6c3e, 0xa30e, 0xa656, 0x291b, 0x65de,
+		0x59f3, 0xa9fc, 0x0e6a, 0x229f, 0x4700, 0x1f2f, 0xb2b8, 0xabd4, 0x3fc3, 0xd0d5, 0x9a05, 0xb09d,
+		0x5056, 0x196b, 0x9637, 0xc24d, 0xbab1, 0x8300, 0x7503, 0x986c, 0x4d8e, 0x0d06, 0x4200, 0xc40e,
+		0xd2c3, 0x0e4e, 0x0112, 0x2d9f, 0x4e22, 0xb9eb, 0x12ad, 0x3b07, 0x198f, 0xc8d1, 0x1c53, 0x4256,
+		0x5f0e, 0xcf63, 0x872f, 0x283e, 0x87de, 0x8645, 0x3272, 0x63fd, 0x4c0f, 0xdaa8, 0x7a88, 0xb0ab,
+		0x1fa4, 0xc187, 0x7eb6, 0x0229, 0xc12e, 0x98b5, 0xf48b, 0x2b93, 0x4ef8, 0x2691, 0x5487, 0x5bb4,
+		0x5cf6, 0x7ad5, 0x4065, 0x872d, 0x6292, 0x3a5f, 0x9f0e, 0x3aa9, 0x6a09, 0xd478, 0xe20d, 0xca2e,
+		0x9e88, 0x61bf, 0xe1b0, 0xbf9a, 0x4db5, 0x3f81, 0xbb20, 0x87dc, 0x869c, 0xe16a, 0x491d, 0x9a5a,
+		0x2240, 0xbd81, 0xd9ea, 0x2779, 0x4161, 0x84de, 0xa028, 0x8291, 0x1e81, 0xb494, 0x4b4c, 0x20ad,
+		0xda6b, 0xc79b, 0xe6a4, 0x9021, 0xa104, 0x74d7, 0xd93a, 0xf8af, 0x0b6f, 0x2fe1, 0x452a, 0xf965,
+		0xa250, 0x72bd, 0xb9d5, 0xb85e, 0x4ba4, 0xddc6, 0x7636, 0x6f17, 0xfd22, 0xc520, 0x781c, 0x7af3,
+		0x7540, 0xd7f5, 0xd1b5, 0xae0e, 0x185a, 0xd113, 0x88ee, 0x51f7, 0x28de, 0x832c, 0xfa0a, 0x2491,
+		0xf3c5, 0xbb70, 0x7f7e, 0x8886, 0x4fde, 0x482b, 0x7036, 0x3163, 0x994c, 0xfe7f, 0x1f51, 0x1a9a,
+		0xe540, 0xd19b, 0xa69c, 0x6dd2, 0x0ad3, 0x3a06, 0x35e2, 0xe729, 0xc4a2, 0x4ca0, 0x6a8d, 0xfa89,
+		0xa2f4, 0x1dc9, 0xc826, 0x1158, 0xb200, 0xd0d0, 0x91dc, 0x522f, 0x6464, 0xdae3, 0xe37d, 0x3c17,
+		0x479a, 0xa525, 0x16d9, 0xb3f6, 0x9f53, 0xd274, 0xbdee, 0xc602, 0xb2eb, 0xcaa6, 0x8645, 0x4dbf,
+		0xf297, 0xba9d, 0x5004, 0x32b9, 0xdbbb, 0xa684, 0x4171, 0x6173, 0x624f, 0x897b, 0xea6d, 0x7bf5,
+		0x3c23, 0x6741, 0x1a97, 0x0962, 0xb3a9, 0x9840, 0x9468, 0x564e, 0xde22, 0xaa5a, 0x9991, 0xc6c0,
+		0x8bed, 0x61c1, 0x9032, 0x084c, 0x68e3, 0x4d47, 0xcc10, 0xf623, 0xb3d0, 0x43a4, 0x8422, 0x9f3a,
+		0x1374, 0x9aee, 0x6efd, 0x27f1, 0xd705, 0x3a42, 0x0298, 0x82bb, 0x28b2, 0xa002, 0x4630, 0xb646,
+		0xded9, 0xaa77, 0xff68, 0x50d2, 0x4f64, 0xba0e, 0xe54a, 0xcbdf, 0x59b4, 0x5197, 0xcd2b, 0xa695,
+		0x0286, 0x72f9, 0x5e81, 0xb506, 0x238b, 0xbcb4, 0x6e74, 0xe343, 0x291c, 0xc7c8, 0xc72f, 0x5fcf,
+		0x6f0a, 0x31d0, 0xcf9f, 0x90f8, 0x18e4, 0x895c, 0x2744, 0x4550, 0xf8cf, 0xe376, 0xc88b, 0xfee1,
+		0xfe67, 0xe085, 0xcace, 0x2702, 0x0e72, 0x0a5a, 0xd01c, 0x3ede, 0xd99a, 0xf9ac, 0xec72, 0x8f6b,
+		0x2050, 0x24fb, 0xcae7, 0x653e, 0xf3eb, 0x8b2b, 0x5290, 0x5e23, 0x33b8, 0x4fa0, 0xfc04, 0xc30d,
+		0x4e66, 0x142e, 0x15c7, 0x9cf1, 0x0d15, 0x2c0e, 0x5514, 0x2358, 0x3e33, 0xadda, 0xfb0c, 0xc7b2,
+		0xc734, 0xcc16, 0x6c05, 0x128b, 0x1de4, 0x82e4, 0xf972, 0xce3c, 0x29f4, 0xa720, 0x5025, 0x74e5,
+		0x4bb7, 0xa157, 0xed9b, 0xbfbd, 0x3aa4, 0x5b10, 0xfda9, 0xd763, 0xac2b, 0x2862, 0x4d3f, 0x31ee,
+		0x0941, 0x2fb2, 0x3310, 0xf6b5, 0x33c3, 0x45ab, 0xf271, 0xdb11, 0xca11, 0x5b87, 0xb1d1, 0xd824,
+		0xfa81, 0x359b, 0xb95d, 0xaad7, 0x05a8, 0xc0ee, 0x5eba, 0x889b, 0xefe0, 0x8570, 0xb3d2, 0xe974,
+		0x110d, 0xcba9, 0xfcf5, 0x54bc, 0x46d9, 0x3a79, 0x6c17, 0xf786, 0x103c, 0x42d6, 0x6a7f, 0x7b57,
+		0x6200, 0x0308, 0x49a7, 0xd0e7, 0xb127, 0xedc2, 0x3b6c, 0xd777, 0xd6ae, 0x3b23, 0xdcaa, 0xb531,
+		0x3a93, 0x91d4, 0x56b7, 0xeadd, 0xc7f5, 0x5a7c, 0x0aaf, 0xe0a1, 0x3e32, 0x7dac, 0x60c4, 0x0c18,
+		0x9cb4, 0xf206, 0x1d6a, 0x62e5, 0xa70f, 0xac9d, 0x3d70, 0x6abe, 0xc356, 0x8e7c, 0x01c6, 0xde20,
+		0xb903, 0x83d4, 0x124e, 0x9ef6, 0xe189, 0x698d, 0xf56c, 0xb8cf, 0x3f36, 0xa0c7, 0x82b9, 0xe06a,
+		0x3f73, 0x3ac9, 0x7c6b, 0xed36, 0xf10d, 0x72af, 0xf28b, 0x7e67, 0xac8b, 0x3157, 0xa556, 0x9347,
+		0xa32e, 0xc541, 0x1110, 0x2091, 0x6096, 0xd193, 0xcdf0, 0xbaa6, 0x4299, 0x8ed9, 0xda59, 0xe68c,
+		0x4250, 0x3b45, 0x3e5d, 0xbb86, 0x26ab, 0x6d70, 0x63ee, 0x81c4, 0xfcc1, 0xe4cc, 0x2de2, 0xa604,
+		0x36d3, 0xa403, 0x898c, 0x8446, 0x6d50, 0xd3fa, 0xc5d0, 0xc8df, 0xe7ac, 0x39cb, 0x2f96, 0xc532,
+		0x333b, 0xcea0, 0x5cbf, 0x246e, 0x526f, 0x1ccb, 0x58aa, 0x07be, 0xfc65, 0x780a, 0x34ec, 0x16b9,
+		0x90c4, 0xf579, 0x272d, 0x7507, 0x738d, 0x9ea2, 0x4dfe, 0xc86c, 0x1564, 0x84ae, 0xa0ba, 0xbe53,
+		0x61f8, 0x7eeb, 0xf0ab, 0x23e5, 0x086b, 0xfa4a, 0x09f0, 0x961f, 0xd80c, 0x038e, 0xa764, 0x367f,
+		0x70e3, 0xd2ce, 0xf125, 0xdd41, 0x2611, 0x71d5, 0x443e, 0x7933, 0xfd36, 0x91f0, 0xe2e8, 0x59cf,
+		0x9588, 0x4605, 0x9667, 0xb1fb, 0xd10d, 0xc49b, 0xde09, 0xd0a8, 0x2e7a, 0x81f6, 0x6889, 0x2dee,
+		0x7cb9, 0x49a0, 0x3a03, 0xe7d1, 0xd651, 0x4f5c, 0xa478, 0x9144, 0x2182, 0x3d44, 0x3f19, 0x81c7,
+		0xdba2, 0x5a53, 0xc87e, 0xef78, 0xda7a, 0x53d1, 0xc2e5, 0x8a5d, 0x0527, 0x1d96, 0x7172, 0x5a9f,
+		0xff9e, 0x2057, 0x66fe, 0x81f6, 0x57e9, 0x4802, 0x80eb, 0x0c12, 0xd4cd, 0xa04d, 0x71e8, 0x6a35,
+		0x4505, 0x871c, 0xf3d9, 0x2606, 0x47d8, 0xbdf5, 0xe573, 0xfebd, 0x69e4, 0x9d2a, 0xd973, 0x13f6,
+		0x8985, 0xa513, 0x6aff, 0xe244, 0xbf4c, 0x93f3, 0x2e8c, 0x6723, 0xf6c4, 0x4172, 0xa3e8, 0x52dc,
+		0x3b66, 0xb399, 0x8be8, 0x9758, 0x9ba1, 0x3336, 0xebd7, 0x0ab8, 0x5851, 0x4e7b, 0x2449, 0x14f1,
+		0x763f, 0xed9c, 0x51e5, 0x6a18, 0xf080, 0x1e1f, 0x5348, 0x5dcf, 0x18db, 0x1b06, 0x9e7d, 0x6f1f,
+		0xafa9, 0x161b, 0x1355, 0x7c62, 0x148f, 0x8fb0, 0x6e97, 0x61ba, 0x4818, 0xf979, 0x3b6e, 0x930d,
+		0x96ac, 0x2d4f, 0xb36a, 0x457a, 0x98b9, 0x530b, 0xcb86, 0x4804, 0x1d46, 0xee94, 0x83c2, 0x281c,
+		0xd7ad, 0xa070, 0x493d, 0x41b2, 0x8064, 0x75f3, 0x1194, 0x26ec, 0xbcf1, 0xe7fb, 0x73cd, 0x09ed,
+		0x12a6, 0x7563, 0x4abc, 0x313a, 0x2ef5, 0xf9e1, 0x91c6, 0xba11, 0xf9d2, 0x623b, 0x2c40, 0x865e,
+		0xfff0, 0x337b, 0x6834, 0xece5, 0x89b6, 0xdb5e, 0x52a1, 0x4042, 0xd7fc, 0xdc44, 0x990f, 0x81d5,
+		0xbf5c, 0x817f, 0x3cfa, 0x871b, 0xcdc2, 0x6994, 0x7072, 0xc64e, 0x7b85, 0xd687, 0xdc0f, 0x5cc0,
+		0x89bd, 0xc698, 0x821d, 0xf717, 0xdba6, 0x0320, 0xab16, 0x2253, 0x46e8, 0x9374, 0x421b, 0x64f7,
+		0x7ff3, 0x31b1, 0x49a1, 0xcd6e, 0xa53a, 0x06e2, 0xf28f, 0x5e31, 0xde84, 0x6619, 0xca45, 0x579b,
+		0x7192, 0x86ea, 0xf8a4, 0xd5a2, 0x1ad7, 0xe456, 0xf936, 0xb679, 0x90c2, 0xd942, 0x12d8, 0xb8ff,
+		0x7ff8, 0x1def, 0x56e9, 0x2350, 0xbc32, 0x356c, 0x3e33, 0xf5ad, 0xd9d2, 0x946e, 0x3993, 0x6216,
+		0x73e3, 0xac8a, 0x7b96, 0xb6d3, 0x2d9b, 0x4605, 0x4145, 0xf4aa, 0x59d9, 0xd4e4, 0x27c6, 0xde9b,
+		0x485b, 0x3d4a, 0x0efe, 0x2c66, 0x1004, 0x2e18, 0xcaa8, 0x1cf1, 0x9d95, 0x4c23, 0x1227, 0x67bb,
+		0xb9d7, 0xd8f3, 0xd904, 0xe7ce, 0x1d14, 0xd6ac, 0xf799, 0xe633, 0x1477, 0x03a8, 0x55ca, 0x6c8f,
+		0x7131, 0x5dae, 0xbe48, 0x015e, 0x8573, 0x9e80, 0x0c50, 0xb555, 0xe170, 0xa642, 0x8dfe, 0xbe56,
+		0x8219, 0x4931, 0x2e5e, 0x9ef4, 0xca15, 0x18b1, 0xef89, 0x0381, 0x8a2a, 0xa2b4, 0x7c37, 0x0bd3,
+		0x4616, 0x0df1, 0x81aa, 0x9735, 0x4625, 0x20ff, 0x8fa7, 0xbf46, 0x93f9, 0x244a, 0xb0a9, 0x83bf,
+		0x8c05, 0xf7a6, 0x7187, 0xf632, 0x2e3a, 0x568a, 0x4ed0, 0xb238, 0x6261, 0x8160, 0x87bb, 0x4ca5,
+		0xfa87, 0x5b48, 0xfd29, 0x31d6, 0xd96a, 0x66fa, 0xf692, 0xa63a, 0xe422, 0xbeed, 0x5c63, 0x4cf3,
+		0x2e6f, 0x26d7, 0x197d, 0xed02, 0xc93f, 0x5408, 0x6194, 0x54a9, 0x3013, 0x2d05, 0x709a, 0xf617,
+		0xaef5, 0x3a43, 0x62f3, 0x49d1, 0x9910, 0x2ff7, 0xca0e, 0xb82a, 0xc27d, 0xd86c, 0xa338, 0xc95c,
+		0x66e5, 0x9f99, 0xe282, 0x80c6, 0x156d, 0x3d90, 0x3bad, 0x31d5, 0x9ac6, 0x4bd0, 0x2a0b, 0x5900,
+		0x36a1, 0xacea, 0x4837, 0x4dcd, 0x81a2, 0xbfd5, 0x4731, 0x365f, 0x5976, 0x6efa, 0x5937, 0x861b,
+		0x580c, 0x1fa8, 0x8d7b, 0x401f, 0x3547, 0x6df2, 0x5dec, 0x4cf6, 0x8a6e, 0xf237, 0x7435, 0x771c,
+		0xf3ce, 0x0e35, 0x6e0c, 0x43dc, 0x0ecb, 0xadd1, 0x17fb, 0x8b49, 0x239e, 0x1660, 0x13d8, 0xf86c,
+		0xa1a5, 0x4b6c, 0x922b, 0xd769, 0x9331, 0x8fea, 0x7892, 0xce54, 0xd08a, 0x0048, 0x1786, 0x2564,
+		0xcd9b, 0x94dc, 0xb946, 0xfc46, 0x15e4, 0x4454, 0x6b17, 0x2401, 0xadd7, 0x48db, 0x04ee, 0x9889,
+		0x50f7, 0xcd27, 0x8a59, 0x2757, 0xaba7, 0x6b87, 0x18e9, 0xb1f2, 0x9ab6, 0xbdac, 0x2d67, 0x4439,
+		0x4a5f, 0x57d2, 0xa1b0, 0x2458, 0xae4c, 0xb433, 0x946a, 0xcfc8, 0x20ab, 0xc916, 0x7cbb, 0x4d8b,
+		0x80c3, 0xbe6d, 0x1983, 0x110e, 0xf1e8, 0x9f5d, 0xee98, 0x20ab, 0x5e5d, 0x62dc, 0x9042, 0xe1f9,
+		0x4a13, 0xd4a7, 0x1edb, 0x0d42, 0xa133, 0x9869, 0x454f, 0x5ec6, 0x30dd, 0x4dc1, 0x4e10, 0x9f17,
+		0x52d2, 0xb5d1, 0x3676, 0xaafc, 0x6138, 0x94c3, 0xee27, 0x46cb, 0x51ee, 0x6eee, 0x9395, 0x0877,
+		0x1a99, 0x0dc6, 0x89fd, 0x55eb, 0x951c, 0x9986, 0xe6c9, 0xfd62, 0x0519, 0xf99d, 0x2f40, 0x006c,
+		0x066a, 0x9ef2, 0x0289, 0x7ffd, 0x51da, 0xf1e7, 0x4472, 0x0d04, 0xc8b6, 0x2938, 0xbd75, 0xcc81,
+		0xd648, 0x2813, 0xa92e, 0x3d94, 0xd804, 0x614c, 0x92fd, 0x0676, 0x99c0, 0xf078, 0xd10b, 0xe11f,
+		0xaffe, 0x6907, 0x66ac, 0xee41, 0x78dd, 0x5c6c, 0x1b22, 0x456a, 0x7b92, 0x9b69, 0xf334, 0x2b4f,
+		0xb60c, 0xa436, 0xfd33, 0x89e3, 0xe310, 0x27ba, 0xdf14, 0x1804, 0x45bc, 0xfbef, 0xa36d, 0xd0e8,
+		0xbd54, 0xbb48, 0xca68, 0x82df, 0x4995, 0xfb9c, 0x8d76, 0xadc8, 0x5162, 0x11ab, 0x68d4, 0x71df,
+		0x9899, 0x5b04, 0x8c81, 0x0f80, 0xc173, 0x2c03, 0xf991, 0x74f3, 0x339c, 0x2320, 0xb56d, 0xcf77,
+		0x4180, 0x2c0c, 0xbdb8, 0x040b, 0xcd90, 0xf5ba, 0x49a8, 0xb795, 0x5a7a, 0x4a1d, 0x6b15, 0x91ce,
+		0x29c3, 0x4605, 0x8498, 0x1329, 0xa5d4, 0x17e4, 0x91d7, 0x85ba, 0xdeef, 0xfd54, 0xaea0, 0x28c7,
+		0xf71c, 0x1994, 0x2122, 0xf129, 0xa95f, 0x143c, 0xee97, 0xeec2, 0xa5a2, 0x6290, 0x8ebd, 0x36f3,
+		0x7a15, 0xd559, 0xc0af, 0x1eb6, 0xce93, 0x3709, 0xd806, 0x7a94, 0x5748, 0x66da, 0x2a6e, 0x5676,
+		0xc86c, 0x0f6e, 0xd102, 0xc137, 0x941c, 0xc4e1, 0x3cfb, 0x35d6, 0xbcee, 0xeaf2, 0xc14c, 0x4c62,
+		0x8bf7, 0x5025, 0xb0e1, 0x49de, 0x4b77, 0xc4cc, 0xe5b2, 0xb795, 0x24b8, 0xf18a, 0xfcbe, 0x1fa7,
+		0xd005, 0x497e, 0x560a, 0x7ff3, 0xa2f4, 0xaccb, 0x6189, 0x24c5, 0x6ffb, 0xe102, 0xae65, 0xf788,
+		0xc5d2, 0x93dc, 0x18b7, 0xb314, 0x804b, 0x6ad6, 0x1909, 0xedaa, 0xf6b4, 0x4973, 0x744f, 0x674a,
+		0xe7f5, 0xbd6c, 0x3de7, 0xb621, 0x6195, 0xede9, 0xd879, 0x8c8d, 0xb754, 0x8538, 0xc66b, 0x08f2,
+		0x6de5, 0xc66f, 0x44eb, 0xeec7, 0x9626, 0x722f, 0x52a5, 0x436b, 0xd774, 0x0056, 0x0dd3, 0x8777,
+		0xa22c, 0xcf3b, 0xc2c0, 0x3898, 0xa075, 0xe161, 0xdb61, 0xb457, 0x9ccc, 0x88aa, 0xbfa9, 0x4c3f,
+		0x7124, 0xf3e9, 0x110a, 0x40f4, 0x523b, 0x2762, 0x8090, 0xf53a, 0xbcc2, 0xbe8f, 0x8430, 0xb873,
+		0x0f6b, 0x2e53, 0xaa4d, 0x1f48, 0x0f48, 0xbd5c, 0xdf95, 0xe02a, 0xa9d8, 0x8621, 0xad01, 0xb130,
+		0xff83, 0xe1ff, 0x187e, 0x79cd, 0xef87, 0x23e3, 0x2b83, 0x0231, 0xfca2, 0xed0f, 0xddac, 0x9845,
+		0x7d5d, 0xcfa9, 0xd7ac, 0x13f1, 0x5935, 0x9c56, 0x3362, 0x5599, 0xbc9e, 0x22ec, 0x3cb0, 0xd287,
+		0x9a78, 0x6048, 0xfb64, 0xddb2, 0x453a, 0x110b, 0x353e, 0xc9fe, 0x42ff, 0x1aaa, 0xc0bb, 0x56cf,
+		0x23a7, 0x2190, 0x4121, 0x3e80, 0x9a07, 0x661e, 0x4556, 0x9656, 0xab10, 0xc3c3, 0xdc04, 0xc5cc,
+		0x848d, 0xa800, 0x7252, 0x18f5, 0x300a, 0x090f, 0x5db0, 0x1a33, 0x85ce, 0x7021, 0x1f02, 0xe051,
+		0x1036, 0x228c, 0x9850, 0xf437, 0xa34b, 0x24c8, 0x6ca0, 0x8406, 0xa1ef, 0x3aa2, 0x20ec, 0x0574,
+		0xb31a, 0xeef3, 0xa86d, 0x89af, 0xd29b, 0xb3b9, 0xe129, 0x4772, 0xe81b, 0x11ba, 0xe37d, 0x4036,
+		0x1c53, 0xd8f5, 0xc876, 0x4823, 0x19c6, 0x70bd, 0x8e5c, 0x88fb, 0x7846, 0xe74e, 0x3530, 0x6782,
+		0xd665, 0x95c8, 0xa4e9, 0x54b2, 0xc879, 0x1e66, 0x947a, 0x0f7a, 0xa844, 0xf3bc, 0x33f4, 0x2919,
+		0xf2fe, 0xbe2b, 0x0f76, 0xde37, 0x7f7f, 0x5d27, 0x7428, 0x2b1c, 0x0369, 0xcb1d, 0xba41, 0x5342,
+		0x717b, 0x09fd, 0xb9ad, 0x43dc, 0x7d80, 0x218a, 0xe11a, 0xe94b, 0x052c, 0x52a7, 0x9ef5, 0x3115,
+		0x8dc0, 0x300a, 0x8105, 0x207a, 0x6fce, 0x01b0, 0x27b1, 0x393e, 0xc660, 0xb42b, 0xe58c, 0xeea8,
+		0x2ecc, 0x7501, 0xcf35, 0xe0a2, 0x0115, 0x9c91, 0xcbe4, 0xe0d1, 0xff68, 0xd345, 0x9549, 0x7606,
+		0x78b6, 0x959a, 0xe0b1, 0x752e, 0x6d4e, 0x078a, 0x3529, 0xac37, 0x8630, 0xcd6f, 0x74b3, 0xbd88,
+		0xec44, 0x1b83, 0x1347, 0x5e7e, 0x329b, 0xa049, 0x4fac, 0x8b81, 0xd469, 0xc074, 0x0d21, 0xe74c,
+		0x5dbb, 0x40a8, 0x5460, 0x01fc, 0xc008, 0xde0f, 0x726e, 0xf56d, 0xd687, 0xafac, 0xd30a, 0x638c,
+		0xd9e6, 0x0e1d, 0x111c, 0x85a9, 0x09ee, 0x32d7, 0xe101, 0x621f, 0x717c, 0x58aa, 0xc51a, 0x5f71,
+		0x4129, 0x0703, 0x971d, 0x6b46, 0xd004, 0x9df6, 0x09b6, 0xdb2b, 0x2f6a, 0x3c8d, 0x028b, 0x8c4f,
+		0x8e5c, 0xe77c, 0x79fb, 0x2532, 0x6183, 0x5d30, 0xd4c4, 0xc218, 0xb0e9, 0x7d8c, 0xc824, 0xa121,
+		0x96be, 0x6b5f, 0x0789, 0x6319, 0x3b37, 0xf240, 0x676d, 0x7990, 0x7f27, 0x428b, 0x7b03, 0x678d,
+		0xc63d, 0xd5c9, 0x4a2c, 0x995a, 0x97e0, 0xf5fa, 0x1998, 0x03df, 0x9180, 0xb9c2, 0x2e7d, 0x935b,
+		0xe2db, 0x34eb, 0x20c4, 0x7b76, 0x7806, 0x92c6, 0xae93, 0x687c, 0x1fb7, 0xb01d, 0x9a45, 0x63fc,
+		0xc924, 0xeb8f, 0xb3e4, 0x8bfb, 0x8570, 0x9372, 0xfdd2, 0xa7f9, 0x5d5f, 0xe1da, 0xbb1b, 0x344d,
+		0xf6bd, 0x4a50, 0x0c19, 0x1b49, 0xa1c7, 0x977a, 0x83d4, 0xc5f0, 0x1766, 0x1beb, 0xbee6, 0x6f51,
+		0xe772, 0xde08, 0x8062, 0xbea9, 0x11d0, 0xd56f, 0xb6d6, 0xcd2c, 0x7deb, 0x7151, 0x16c7, 0x7f4c,
+		0x2891, 0x3d41, 0x944f, 0xa670, 0xe730, 0x5de7, 0x3560, 0xafb1, 0x7171, 0xfd30, 0x149f, 0xd491,
+		0x424d, 0xc66d, 0xd716, 0x2c0f, 0x8adc, 0x2e87, 0x675a, 0xf582, 0xb7b9, 0xb1bc, 0x3a9a, 0x1149,
+		0xc3d2, 0xa6db, 0xd18c, 0x0491, 0x2eaa, 0x8f86, 0xa7b5, 0x0bea, 0x24df, 0xac57, 0x42c3, 0x1be3,
+		0x8165, 0xb00b, 0xc900, 0x77d5, 0xbf57, 0x6c4c, 0xc842, 0x71c6, 0xebc5, 0x7da3, 0x70fb, 0xfe0a,
+		0xaa2d, 0x412e, 0x969f, 0xfded, 0xffee, 0x22bd, 0x7986, 0x9a27, 0x067d, 0x4f9b, 0x18c5, 0x19ea,
+		0xf890, 0x3924, 0xa13c, 0x04b1, 0x943f, 0x230a, 0x5915, 0x49eb, 0xae9d, 0x0b99, 0xb799, 0xe194,
+		0x11fe, 0xa8f6, 0x4374, 0x69cb, 0xa44e, 0xb861, 0x3270, 0x16c9, 0x2111, 0xd97f, 0xc474, 0x76f4,
+		0x7515, 0x93e7, 0xa533, 0x0303, 0xbf1a, 0xe27a, 0xa3be, 0x78e5, 0xcd3d, 0xed0a, 0xa8d0, 0x64fb,
+		0x6c68, 0xef7d, 0x6bb8, 0xb8ba, 0xcef3, 0x0c0c, 0x3c28, 0x0841, 0x1de9, 0x965f, 0xecc7, 0x9679,
+		0x78ce, 0xc49d, 0xb5c0, 0x81da, 0x638b, 0xbe38, 0xd3b9, 0x3517, 0x1c48, 0xbf5e, 0x39d3, 0x0305,
+		0x2ca2, 0x4aa1, 0x5518, 0x58fc, 0x9e1c, 0xb788, 0x4bfe, 0x79f0, 0xf52e, 0x5ed3, 0xbe89, 0xd9e2,
+		0xa221, 0xc6f6, 0xb7cc, 0xd081, 0x36ff, 0xece9, 0x56ae, 0x9907, 0x8832, 0x3018, 0x5b9d, 0xfd70,
+		0x5f2a, 0xeb18, 0x99ca, 0x45f2, 0xd1a5, 0x89c2, 0x6749, 0x97fa, 0xdd82, 0x9705, 0xdffc, 0xc81f,
+		0xe20b, 0x10f6, 0x87f7, 0x47c9, 0xcbb1, 0xf1a1, 0xdd23, 0xc251, 0x12e6, 0xe0d6, 0x4da2, 0xeeb9,
+		0xb6b1, 0x9cb2, 0x8229, 0x67d2, 0x41f2, 0xd1ca, 0x5487, 0x404d, 0x4253, 0xad08, 0xa6cd, 0x8223,
+		0x2012, 0x4ef5, 0x9da1, 0xa6ae, 0x0b5a, 0xa24f, 0x99a3, 0x3399, 0xc2a1, 0x23e6, 0x3e20, 0x530d,
+		0xff8e, 0x447e, 0xf86d, 0x171a, 0x3b9d, 0xa046, 0xe424, 0x6f92, 0xf76e, 0x3a67, 0x5ae3, 0x4764,
+		0xdc9c, 0x2b10, 0xad2a, 0x67d9, 0x8516, 0xee05, 0x4fad, 0x522d, 0x2d5d, 0x47f6, 0x1cc6, 0xa09f,
+		0x4734, 0x9caf, 0xa139, 0xa15b, 0x1b0d, 0xc21e, 0x8a01, 0xd310, 0x545d, 0x2223, 0x9d7e, 0x5f2b,
+		0x4f3a, 0xbc51, 0x6248, 0xd1b0, 0xa119, 0x9014, 0xd381, 0x85ca, 0x346e, 0x6140, 0x3542, 0xe233,
+		0xfe64, 0xb3a0, 0x8d0b, 0x7edb, 0x261a, 0xc237, 0xb81e, 0x2ce8, 0x6074, 0xa97a, 0x5083, 0xdcbe,
+		0x28a8, 0x8015, 0xdd17, 0x9a05, 0xa472, 0xde68, 0x4513, 0x558a, 0x67f1, 0xeb13, 0x77ae, 0x81ae,
+		0x5d2a, 0xa3aa, 0x6429, 0xf4ae, 0x022d, 0xe857, 0x0405, 0x1056, 0xea15, 0xf1e5, 0xed4a, 0x7a6a,
+		0x5e5f, 0x0635, 0xcc11, 0x5fe8, 0xb87d, 0x8ef1, 0x77bd, 0x59b7, 0x07cc, 0x9630, 0x9e0e, 0x9ef5,
+		0x3e39, 0xb57b, 0xc64c, 0xd1a8, 0xe905, 0x503e, 0x0400, 0x86b0, 0x65ae, 0x81ca, 0xfdd6, 0x2b35,
+		0xf4f6, 0x7d0c, 0x8fc7, 0x0106, 0xdfd9, 0xa540, 0x9aee, 0x913d, 0x9db9, 0xd52e, 0x0795, 0x7607,
+		0xa7b8, 0xa480, 0x6df7, 0x5764, 0xe92f, 0xc582, 0xd252, 0x8870, 0x8905, 0x9905, 0xf8f6, 0xbead,
+		0x8dac, 0xc5be, 0x403b, 0x85ed, 0x4b88, 0x828a, 0xa7ae, 0xc152, 0x741c, 0xfa83, 0x1ec7, 0x5870,
+		0x0f9b, 0xc01a, 0x3d51, 0xabaa, 0x10bc, 0x8fa1, 0x44a8, 0x3055, 0xb599, 0x1846, 0x2415, 0x569c,
+		0xa98f, 0x3a5d, 0x1537, 0x2b58, 0x13da, 0x4d8b, 0xd1be, 0x36fc, 0x8f78, 0x0865, 0x6849, 0x44d3,
+		0xa621, 0x95b9, 0x9c13, 0x51fe, 0x255c, 0x186a, 0x86fb, 0x2464, 0x4d7c, 0x1298, 0x04ea, 0x9412,
+		0xdc8c, 0x0f31, 0x8894, 0xb06a, 0xb467, 0x53df, 0x24d3, 0xad8a, 0x8e79, 0x15b1, 0x1cd0, 0xcb4f,
+		0x6900, 0x8657, 0x8cd5, 0x6c4f, 0xd0d9, 0xec45, 0xdd4b, 0xfd73, 0x1b99, 0xa1cb, 0x53af, 0x5748,
+		0xc6db, 0xfea1, 0x8283, 0x7134, 0xb79a, 0xe356, 0xa532, 0xaa14, 0x8885, 0x6987, 0x59ec, 0xd6ce,
+		0x42fb, 0xf63f, 0xb105, 0xd58a, 0x3a71, 0x7a85, 0x07ef, 0x3fb0, 0x35c2, 0x8f92, 0xafc3, 0x8850,
+		0x61ae, 0x08cc, 0x7d2c, 0x28db, 0xc4df, 0x9c66, 0xe54a, 0x6a09, 0x7249, 0x1ea6, 0x5b54, 0xe43e,
+		0xbe59, 0x6808, 0x57ba, 0x7771, 0x565e, 0x3294, 0x5002, 0x39ac, 0x8d72, 0x77e1, 0xbc54, 0xb5d9,
+		0x0d9e, 0xf597, 0x5df6, 0xc709, 0x998a, 0xd287, 0x3284, 0x306e, 0xe372, 0x00f8, 0x45b3, 0x31c5,
+		0x375f, 0xe947, 0xf7b4, 0x7f84, 0x8642, 0xcdd1, 0x10a0, 0xdac8, 0x49c2, 0x9aee, 0x5806, 0x5b19,
+		0x566e, 0x22a3, 0xb8af, 0xd024, 0x34bd, 0x5b90, 0x86ad, 0x6c05, 0xafbc, 0xcd49, 0x2ce5, 0x2e27,
+		0x5862, 0xcf88, 0x0b44, 0x0ba7, 0x77b6, 0x1971, 0xd287, 0x2de1, 0xfb06, 0x6019, 0x3f44, 0x1678,
+		0x19bf, 0x7fb4, 0xb8b7, 0xcb40, 0x214c, 0x5773, 0xae60, 0x5796, 0x826f, 0xc322, 0x1bed, 0x88b3,
+		0x794f, 0xd270, 0x5206, 0x7937, 0x6a8f, 0x9ea1, 0x9f19, 0xee86, 0x5e28, 0x0a34, 0x0df0, 0xc571,
+		0x347d, 0xe052, 0x03d0, 0x7f14, 0xf5a9, 0x6a3a, 0x49e9, 0x0568, 0x50cb, 0x5fdd, 0xf512, 0x6565,
+		0x5141, 0xd1c4, 0xdce9, 0x8a08, 0x359f, 0xdf3c, 0x9afa, 0xb416, 0x76cd, 0x9dea, 0x176c, 0x0162,
+		0xe116, 0x2489, 0xa856, 0x9b92, 0x8aa6, 0x8ba9, 0xe454, 0x6db1, 0x353e, 0x2be4, 0xa01e, 0xad91,
+		0x0f23, 0x5e62, 0x60b1, 0x25ea, 0xda1c, 0xdaf4, 0x3142, 0xd756, 0xfcaa, 0xe092, 0xe3bd, 0x3326,
+		0x1df2, 0x207a, 0x5dcb, 0x121a, 0x1302, 0xc02c, 0xe91b, 0xdd08, 0x670a, 0x259c, 0x5cd1, 0x843e,
+		0x04df, 0xb4d4, 0x7ac9, 0xb0e6, 0x26af, 0x4612, 0x3f59, 0x3e33, 0x0334, 0x16f6, 0xafed, 0x8ee7,
+		0x4298, 0x3ab0, 0x68c2, 0x3fad, 0x1822, 0xc2dd, 0xf18c, 0x94d2, 0xbe5a, 0x2ef2, 0x45cb, 0xed39,
+		0x2687, 0x1f1e, 0xe7f6, 0x1905, 0xe183, 0x3d02, 0xa0b9, 0xbf58, 0xe4e4, 0x4119, 0x08c8, 0x45bb,
+		0x27d0, 0x3275, 0x477e, 0xfbd3, 0x65d6, 0xf39b, 0x31a6, 0x8fc5, 0xc156, 0x88ae, 0xb2ef, 0xa83f,
+		0x636e, 0xbaf6, 0x441a, 0xf6d6, 0x8287, 0x831d, 0x60a0, 0x24cd, 0x5b99, 0xb07a, 0x1b94, 0x64d2,
+		0x9939, 0xb2a1, 0x239f, 0x5b2c, 0x64b1, 0xbda1, 0x1040, 0xbf29, 0xfc54, 0x881c, 0x4185, 0xcd8f,
+		0x80d6, 0xfb5c, 0xd0d3, 0x5c47, 0xc8c0, 0x64e1, 0x4384, 0x60bd, 0x1ea5, 0xc854, 0x31d1, 0x3d76,
+		0xce53, 0xb116, 0x02b3, 0x4ffa, 0x5a4b, 0x1df2, 0xdb1e, 0x05fd, 0x6bbc, 0xe346, 0xed64, 0x1498,
+		0xfa02, 0x59f9, 0x1463, 0x8d7e, 0xe800, 0x6019, 0x5616, 0x6c8a, 0xea92, 0x4db5, 0x4121, 0xb183,
+		0xf729, 0xf392, 0x4611, 0x3e3a, 0x9572, 0x20c2, 0x89a5, 0x64d4, 0xd200, 0x64cb, 0xe82d, 0xc33c,
+		0x9e02, 0x56b3, 0x49ea, 0xf3c4, 0x9473, 0xb105, 0xd54a, 0xf795, 0x5c3c, 0x37de, 0x8f37, 0xc972,
+		0x484c, 0xc023, 0xd49f, 0xda32, 0xa0ba, 0xf4e9, 0x914a, 0x340c, 0x33a8, 0x45bd, 0x96fd, 0x03d0,
+		0xcc74, 0x2198, 0x0e3d, 0xe1e4, 0x391d, 0x5e80, 0x8369, 0x6060, 0x5f79, 0xa5f2, 0xfd13, 0x9017,
+		0xcc55, 0x6383, 0xafbd, 0xc39a, 0x01b3, 0xa3ae, 0x58af, 0x3e59, 0x747d, 0x4bd8, 0xb57b, 0xc8d7,
+		0x88dc, 0xd379, 0x04f9, 0x0201, 0x8f03, 0xf3bc, 0xab7f, 0x3305, 0xe667, 0x1200, 0xb56b, 0x8be4,
+		0xec05, 0xc41f, 0x92f7, 0x4561, 0xb7f7, 0x9361, 0x66bb, 0xc861, 0x6142, 0x4ff5, 0x6748, 0x98e1,
+		0x0f6d, 0x6fec, 0x9e53, 0xa660, 0xf55f, 0xbf2d, 0x9ffe, 0x161d, 0x2151, 0x35aa, 0xaf36, 0xd06f,
+		0x8d32, 0xac8a, 0xf743, 0x4133, 0xeec5, 0xbc65, 0xffda, 0xf869, 0xa0f0, 0xc177, 0x9916, 0xd672,
+		0xd743, 0x49d7, 0x269d, 0xf55f, 0xf980, 0xf51e, 0xc82c, 0x91d3, 0x52da, 0x9a1c, 0x2c6f, 0x4aa9,
+		0x2a77, 0x02d2, 0xb2e1, 0xd457, 0x845d, 0x5a13, 0x5c13, 0x50fd, 0x0e66, 0xbf0f, 0x1600, 0x9a17,
+		0xcab2, 0x4116, 0x276e, 0xa11e, 0x2076, 0x9d41, 0x0720, 0x88b4, 0x109c, 0x397e, 0x9b87, 0x030e,
+		0xb7be, 0xc405, 0x21dc, 0x9dca, 0x819e, 0x4996, 0xea11, 0x3066, 0x6b52, 0x184d, 0x6a66, 0x85dc,
+		0xe273, 0xac2e, 0x7d1b, 0xe9f2, 0xc620, 0xe566, 0x7651, 0x7ce5, 0x8bc5, 0x5d66, 0x4db8, 0x670f,
+		0x1936, 0x1bd9, 0x3c64, 0xd6ac, 0x73df, 0x428e, 0xe15a, 0xace0, 0x1993, 0x1ed8, 0xe879, 0x781a,
+		0x42bf, 0x06d3, 0x68f8, 0x503e, 0x7ec4, 0x3fbb, 0xc9d7, 0x1d0c, 0x8287, 0x7735, 0x2370, 0xe5c2,
+		0x65a6, 0x5193, 0x1a18, 0xc9a0, 0x1548, 0xcc8e, 0x6d63, 0x696c, 0x1c1b, 0xdbc4, 0x8f0a, 0xf9de,
+		0x33df, 0xa7fa, 0x6da9, 0x259f, 0x31a2, 0xd905, 0x2290, 0xf074, 0x4c10, 0xd9c9, 0x86c9, 0xe937,
+		0x04ef, 0x6cd9, 0x4f6f, 0x416c, 0x0c6c, 0x3788, 0x0e09, 0x7dba, 0x36c1, 0xdd2d, 0x09e6, 0x1a4e,
+		0x166d, 0xd5a8, 0xf6ba, 0xd5d5, 0xa754, 0xb8af, 0xdf99, 0xd7ce, 0xe717, 0x1537, 0x0978, 0xb23e,
+		0x63dd, 0x5c9f, 0x3721, 0x9e0e, 0x340e, 0x4c72, 0x10e4, 0x0f82, 0xa24a, 0xd327, 0x862d, 0x08b8,
+		0xd6be, 0x8fcb, 0xac85, 0x613b, 0x831b, 0x757e, 0xe729, 0x11a1, 0xbed2, 0x1d46, 0xd6d1, 0x6780,
+		0xe8e4, 0xefdb, 0x5aef, 0x3003, 0x06b2, 0x6765, 0x22c0, 0xe802, 0x7905, 0xf98f, 0x361d, 0xda73,
+		0x5a9b, 0x0d53, 0x921a, 0x7f5b, 0x08a2, 0x4349, 0x437f, 0x34a2, 0xb12d, 0x4635, 0x1881, 0xabe1,
+		0xc812, 0x6be8, 0x2a80, 0x4da7, 0xf756, 0x60ef, 0x8519, 0x6af7, 0x8621, 0x4dc8, 0x3d3c, 0xa3fa,
+		0x7af5, 0xfd3b, 0x5911, 0x3835, 0x25b9, 0x7399, 0x5a6c, 0xc6a9, 0xe52b, 0xf9bc, 0x6a8b, 0xbf1a,
+		0x1778, 0x5514, 0xe8e4, 0xc222, 0xa633, 0xf7ce, 0xd453, 0xf292, 0x4501, 0x959e, 0x4cb1, 0x0b22,
+		0xb859, 0xacd6, 0x4859, 0xfba6, 0xf2e3, 0xb130, 0x97d3, 0x84fd, 0xf53e, 0x1a3e, 0xf503, 0x75d9,
+		0x5a76, 0x36f5, 0x9c88, 0x8b6e, 0x3d25, 0x7d9e, 0x92ba, 0x6da8, 0xfd36, 0x5efd, 0x6c21, 0xafe2,
+		0x5bba, 0x2a56, 0x1a46, 0x93ec, 0x65d3, 0xb95b, 0x1228, 0xacb0, 0xf35e, 0x672c, 0x1859, 0xfec5,
+		0x73e8, 0x46ba, 0xfc5a, 0x57af, 0x4480, 0xae5a, 0x3903, 0x0cec, 0x801c, 0xa739, 0x9f4e, 0xa64a,
+		0x7802, 0x28a8, 0x6e9e, 0x4512, 0xc41d, 0xd562, 0x9dbf, 0x1cea, 0x3060, 0xf2db, 0xd9c3, 0x46f4,
+		0x91e7, 0x09ad, 0xf5d7, 0xd1e1, 0xb25b, 0x5639, 0x4b8e, 0x3306, 0x742d, 0xb076, 0x1644, 0x22f0,
+		0xa392, 0xc137, 0xaaba, 0xdc40, 0xa161, 0x34ad, 0x0e1d, 0x2015, 0x7ddd, 0x8d4a, 0x3142, 0xf1d1,
+		0xba6f, 0x0cb7, 0xfbf4, 0x6c3f, 0x9c8e, 0xbc0d, 0x825b, 0x9bcd, 0xac25, 0x0f36, 0x698e, 0xafe1,
+		0xb246, 0x8224, 0x1ba2, 0xec58, 0xb924, 0x5f0f, 0x103f, 0x0f29, 0x92d2, 0x845e, 0x1b05, 0xec3a,
+		0x4cf6, 0x71d2, 0x496c, 0xee32, 0x5bd2, 0x581c, 0x6d6b, 0x542a, 0xe9ed, 0xa46e, 0xf2c8, 0xea6d,
+		0x8e65, 0xcde4, 0x5419, 0xa4ff, 0xad61, 0x9985, 0xb2fd, 0x2545, 0x1fe3, 0x85d3, 0x997d, 0xd211,
+		0x95a6, 0x62dd, 0x69e3, 0xe914, 0xc5ff, 0x9255, 0x19bc, 0x6130, 0x1ac4, 0x24d1, 0x8dc2, 0x6449,
+		0xa585, 0x959b, 0x42cf, 0x49bb, 0xd557, 0xae4c, 0x8873, 0x63b7, 0xbb59, 0x326a, 0x9ca7, 0x3f85,
+		0xf0a7, 0xd7cf, 0xc7c4, 0xeec0, 0x3eb1, 0xee2b, 0x4f0b, 0xb226, 0xc64b, 0xeec4, 0xd5c8, 0x4066,
+		0xbb38, 0xc48f, 0xe412, 0xdee9, 0x76fb, 0x9322, 0xb926, 0x97c1, 0x73e5, 0x8e12, 0xdfe9, 0xcb32,
+		0xa6c8, 0x93e2, 0xb803, 0x33be, 0xc76c, 0x29b9, 0xf740, 0xd180, 0xa278, 0xb02f, 0x532e, 0xb8d2,
+		0x82be, 0x674e, 0x938c, 0xeeb5, 0x2566, 0x84ff, 0xedc9, 0x78d0, 0x9a2b, 0x8d32, 0xc166, 0x2040,
+		0xefd9, 0x64ad, 0x7a99, 0x7e13, 0x283b, 0x76b4, 0x385e, 0x9042, 0x3e54, 0xce6f, 0xcdb6, 0x7066,
+		0x5132, 0x71ff, 0x87d5, 0x7e61, 0x40d2, 0x939a, 0x1932, 0x3165, 0x1df2, 0x1d02, 0x82bf, 0x8576,
+		0xbf4d, 0x6ed6, 0xc49d, 0x959d, 0x8b5c, 0xc3d2, 0x81de, 0x311b, 0xcae4, 0xf557, 0x10a7, 0x43d5,
+		0xb0f2, 0x62b2, 0xdb2f, 0x8f01, 0x65f2, 0xcf9b, 0x2e21, 0xe2db, 0x87da, 0x7fa6, 0x9062, 0x3d1f,
+		0x8a8f, 0x44cb, 0x6d37, 0x6d99, 0xf9a7, 0x2db8, 0xa9c3, 0xd996, 0xfe25, 0xc2d3, 0xb200, 0x20c9,
+		0xfac9, 0x78ad, 0xee91, 0x77d0, 0xee05, 0x2ded, 0xa5b7, 0xcacd, 0x68f2, 0x8d96, 0x5fcb, 0x76f2,
+		0x77d3, 0x5081, 0x541c, 0x9c49, 0x4715, 0x2bce, 0x90f9, 0x5884, 0x7c76, 0xb0fe, 0xe7ce, 0x69aa,
+		0x0e6d, 0x0e9c, 0xc73f, 0x06de, 0x78f2, 0xd734, 0xcc8e, 0x834b, 0x453d, 0xb9d6, 0x45f2, 0xc596,
+		0x3bf1, 0x51cf, 0x191b, 0xdf17, 0x27df, 0x983f, 0xfed1, 0x7581, 0xcb7c, 0x7f4e, 0x168a, 0x2269,
+		0xf10b, 0xcaa5, 0x4bf8, 0x7e80, 0xc8c0, 0x584b, 0x6f44, 0x9a6e, 0x2df6, 0x6051, 0x8a02, 0xa900,
+		0xac74, 0xd8c9, 0x9480, 0x581e, 0xb664, 0x31d7, 0x851d, 0x5fde, 0x512a, 0x663b, 0x0aaf, 0x48ea,
+		0x5be3, 0x0259, 0x0fd6, 0x2f5e, 0x13ee, 0x6042, 0x601c, 0xfff3, 0x30b2, 0x86b2, 0x9bb0, 0x4e87,
+		0x6625, 0xd89b, 0x2a89, 0x3cba, 0xcd32, 0x6aee, 0x3de2, 0xe2ce, 0x19ec, 0x316f, 0x8bc8, 0x26d7,
+		0x74bc, 0xa32d, 0x827c, 0x1030, 0x695f, 0xdffe, 0x6bfc, 0xb1a8, 0x98e7, 0x03d5, 0x7132, 0x3ad6,
+		0x310e, 0xdc5b, 0xb521, 0xd9b9, 0x8e6d, 0x48b1, 0x2df0, 0xc33d, 0x8fdc, 0xd687, 0xf68c, 0x409b,
+		0x9c35, 0xcba5, 0x4556, 0x3329, 0x96ca, 0xa90a, 0x7576, 0x8504, 0xa53c, 0xee05, 0x8278, 0x7aad,
+		0x70f5, 0xb31e, 0x39e5, 0x0e4f, 0x8ff7, 0x7050, 0x9980, 0x122b, 0x33d5, 0x3605, 0x3a95, 0xac14,
+		0x90d2, 0x74cd, 0x7b25, 0xb2cb, 0x1923, 0x08eb, 0x3634, 0x4f83, 0x0320, 0x6c2c, 0xb346, 0x00f3,
+		0x7a18, 0x199d, 0x37f6, 0xe6e7, 0x13e5, 0xffdc, 0x30b1, 0xdc41, 0x10ff, 0xe04e, 0x3308, 0x0d95,
+		0x3437, 0x7c83, 0x75d8, 0xd4a6, 0x9d94, 0xb646, 0xc54a, 0x5b9d, 0x843b, 0x8eac, 0x0eaa, 0x0f02,
+		0x35f6, 0x5caf, 0x4e0d, 0x0a50, 0x08fc, 0xb457, 0x984c, 0x167e, 0x6f48, 0x687b, 0x540d, 0xd76a,
+		0x10a9, 0xdefb, 0xb198, 0xf097, 0x391a, 0x41d6, 0xacdf, 0xc0e1, 0xf0b9, 0x4a9b, 0x7682, 0x7b5e,
+		0x82f8, 0x6e8d, 0x5436, 0x1a54, 0x09ca, 0x07a8, 0x236a, 0x8692, 0xbdb4, 0x874d, 0x0a98, 0xe060,
+		0x0a07, 0xb478, 0x9651, 0xedac, 0x55f0, 0xf46f, 0x5a97, 0x967e, 0x5961, 0x4956, 0x07c0, 0x61b8,
+		0xb2f5, 0xa585, 0x5b20, 0x0829, 0x3681, 0xe073, 0x39bd, 0xc16d, 0x9a03, 0xe04c, 0xb864, 0xf2da,
+		0x594d, 0xc6e4, 0x454a, 0x14aa, 0x9c48, 0xcd8f, 0x3651, 0xf5dd, 0x9e68, 0x6c4d, 0x6a03, 0x68c4,
+		0x25c1, 0x1992, 0xcc06, 0x767d, 0x541a, 0xaa80, 0xe86b, 0x60d6, 0xe04d, 0x3ce6, 0x16ca, 0x7472,
+		0x934f, 0x2d6a, 0x0653, 0x8063, 0x340b, 0x0c8e, 0x4ecf, 0xf737, 0x8236, 0xb75a, 0x4e3e, 0xa623,
+		0x200c, 0xb70a, 0xbb7b, 0x76ff, 0x5c98, 0x7c3b, 0x7fcd, 0x508e, 0xde54, 0xad54, 0x2b93, 0x6581,
+		0x6b87, 0x0a70, 0xec3b, 0xee44, 0x094b, 0x7d05, 0x6023, 0x9cd1, 0xd498, 0xbf65, 0x826e, 0x67c0,
+		0x582f, 0x1692, 0x68c6, 0x4df3, 0xd483, 0xd044, 0x79fb, 0x1f2c, 0x7a43, 0xa155, 0xfadc, 0x257f,
+		0x1f91, 0xad0f, 0xa050, 0x8bf5, 0x69b8, 0x698a, 0x329c, 0x6f9d, 0x53d9, 0xfc1a, 0x2fba, 0x614c,
+		0x3547, 0x9e55, 0xea17, 0xd683, 0x7d8c, 0x531a, 0x8437, 0xb836, 0xb656, 0x24e9, 0xc02e, 0x830f,
+		0x16d4, 0xb4c8, 0xecdc, 0x48c5, 0x3f83, 0xedad, 0x1f72, 0x5d35, 0xbe0e, 0x5527, 0xef68, 0xbab8,
+		0x90d5, 0xcf02, 0xb446, 0x4915, 0x4ed3, 0xa5f0, 0xcd32, 0x3b12, 0xf24a, 0x6153, 0xd9b5, 0xe851,
+		0xa159, 0x9edd, 0xc4d3, 0x9974, 0x01a2, 0x18cc, 0xb0b0, 0xa77c, 0xf3e8, 0x59ff, 0x5812, 0xe9c1,
+		0x9f7d, 0x1408, 0x2c80, 0x99b4, 0x3077, 0xf3d9, 0xfadf, 0xc1fd, 0x8a59, 0x176e, 0x4fb5, 0x36ab,
+		0xbdbb, 0x7921, 0xf639, 0x281f, 0x6a3f, 0x4f97, 0x81a7, 0x26f0, 0xf51c, 0xf743, 0xc00c, 0xc2eb,
+		0x4d39, 0x0d31, 0x2a79, 0xdae7, 0xf736, 0x1c6d, 0xf58b, 0x6ab8, 0xf878, 0x8075, 0xb79c, 0xf5c1,
+		0x870c, 0x2b43, 0x3964, 0x29c8, 0x4b9f, 0xe432, 0x6eb3, 0xb545, 0x9a05, 0x01b5, 0x1f3f, 0x2f0f,
+		0x4d6c, 0xd6f0, 0x181e, 0xae07, 0xd253, 0x834c, 0xf2d2, 0xf889, 0xbad8, 0xe16c, 0x149e, 0x0e00,
+		0x4b38, 0x8a48, 0x0c7c, 0xbf14, 0xca2d, 0xd2d0, 0x79b6, 0x1202, 0x10d9, 0xec5c, 0x1a6b, 0xd9c1,
+		0xd503, 0xfd9a, 0xa1c1, 0x81c7, 0x89b5, 0xa9da, 0x4dbe, 0xb606, 0x4eb0, 0xcea4, 0xd1b3, 0xf19e,
+		0xcc9c, 0x98f7, 0x52f2, 0xfef9, 0x4bd7, 0xc1fa, 0x2e87, 0xcb76, 0x4638, 0xb5b4, 0xdf9d, 0xa2c6,
+		0x7c8b, 0x03d1, 0xf7c7, 0x5ac6, 0x82bb, 0xe447, 0x5dd0, 0xff9e, 0x66d7, 0x27c3, 0x1a9f, 0x6dfd,
+		0x0343, 0xdae2, 0x00ba, 0x2ca7, 0xa1ce, 0x9d1e, 0xc349, 0x480c, 0xf385, 0x9b65, 0xc568, 0xc920,
+		0x65b3, 0x3d83, 0xbb73, 0x05a2, 0x3440, 0x5123, 0xf19f, 0x8b79, 0xb2a4, 0x911d, 0xc9f0, 0x719d,
+		0x3fc2, 0x59ee, 0x5c20, 0xa762, 0x1ab4, 0x7c15, 0x385a, 0x9272, 0xc306, 0xf4e1, 0x840b, 0x4ccc,
+		0x08bf, 0xbb1d, 0x2fe1, 0x68f6, 0xc8c0, 0xa242, 0x6326, 0xda72, 0x42a1, 0x8c50, 0x6512, 0xdc5f,
+		0x6687, 0x3d3f, 0x5487, 0x7f13, 0x90b0, 0x8635, 0xc8bf, 0xa80a, 0x151c, 0xf59f, 0x0e62, 0x81d9,
+		0x8732, 0x4443, 0x805e, 0xbd29, 0xe486, 0x61a4, 0xfae5, 0xd02e, 0xc648, 0xb394, 0x24bd, 0xad25,
+		0x3663, 0x496e, 0xd6dc, 0x94c0, 0x94f5, 0x703c, 0xc6e7, 0xaa53, 0x87ff, 0x6119, 0xce74, 0xec48,
+		0x3a1b, 0x61fb, 0x5bef, 0x59b5, 0x1f2f, 0x2b06, 0xe04f, 0xd8c3, 0xb8ea, 0x990a, 0x62fb, 0xe14a,
+		0x6655, 0xd76a, 0xa3b9, 0xfdb6, 0x0826, 0x76d4, 0x542d, 0xc887, 0xf0c8, 0x784a, 0x55b9, 0x7167,
+		0x37d0, 0x9722, 0xe9b5, 0xea12, 0x83cf, 0xd6a2, 0x9683, 0x484d, 0xe46f, 0xf5aa, 0x29e7, 0xf10d,
+		0x2884, 0x42c4, 0xf59e, 0xf235, 0x3be0, 0x3eab, 0xf97f, 0xf802, 0x396b, 0xe8b8, 0xeb53, 0x531a,
+		0x97c4, 0x96ed, 0x9ebd, 0x7f9b, 0x2582, 0x981c, 0xb6f6, 0x4854, 0x488d, 0x0e98, 0xe725, 0x0436,
+		0x0744, 0xcd45, 0x8d79, 0x50fb, 0x0dc7, 0x27ba, 0x6ffd, 0x8349, 0xaade, 0xeee7, 0xa5e3, 0xb08e,
+		0xbdf4, 0xd7b1, 0x6ec8, 0x98e3, 0xbce3, 0xb500, 0x9727, 0xb0cd, 0x927f, 0x2574, 0x43e8, 0x8f80,
+		0x20dc, 0x7e9c, 0xa620, 0x467b, 0xf245, 0x97f8, 0x083c, 0xfcfb, 0xf84e, 0x0018, 0x4561, 0x9982,
+		0xf190, 0x1827, 0x604c, 0x0079, 0xb821, 0xef19, 0x9205, 0xb8eb, 0xd2b6, 0xcc91, 0xdf88, 0x41ea,
+		0xb28b, 0x2adf, 0x05a2, 0xf8be, 0x574e, 0xcfbc, 0xc037, 0x1282, 0x33a6, 0xd21f, 0x1156, 0x6d46,
+		0x4c82, 0x3ec5, 0x8c62, 0x6ed2, 0x4900, 0x43ac, 0xe9ed, 0xc7b1, 0x5eb8, 0xbab5, 0xd2d5, 0x3caa,
+		0x10ba, 0x6e66, 0x7acb, 0x7fcd, 0x47a1, 0xd9f3, 0x3253, 0x50dd, 0xdc99, 0xc074, 0xae02, 0xdc2e,
+		0x3b4c, 0xfd98, 0x1f25, 0xed7b, 0xce33, 0x28f3, 0x8be8, 0xd81d, 0x3a5c, 0x6296, 0x1ad4, 0x2375,
+		0x46f8, 0x0ea0, 0x9cc0, 0x2b59, 0x3038, 0x8c75, 0x81ac, 0xd3ab, 0xebb2, 0xce62, 0xc32c, 0x55da,
+		0x1604, 0xea34, 0x658e, 0x0a08, 0x54a0, 0x1d7d, 0xd4b9, 0x5bfa, 0xee7f, 0x095c, 0x9245, 0x2e16,
+		0x108e, 0xbbcb, 0x7b46, 0xf796, 0xc57a, 0xb446, 0xed28, 0x98a3, 0x5293, 0x1156, 0x281a, 0x853c,
+		0xc1d9, 0x30bf, 0x7341, 0x7075, 0x4b6f, 0xc180, 0xdea2, 0x33cb, 0x9857, 0x0311, 0x0fe8, 0x992a,
+		0x4f13, 0x1302, 0x6749, 0x2ffc, 0xa05c, 0x18a3, 0x05dc, 0x0516, 0xbcb1, 0xc0e4, 0xa7eb, 0xb177,
+		0x4735, 0x53b6, 0x420a, 0xc4aa, 0x7940, 0x0c62, 0xea0a, 0x4580, 0x159d, 0x14ff, 0xf77b, 0x72db,
+		0x6558, 0x6fb3, 0x61f0, 0x5437, 0xb49f, 0x22be, 0xd4b1, 0x3b05, 0x69be, 0xb150, 0x4b9b, 0x4246,
+		0xc49a, 0xb355, 0xa14f, 0x7d76, 0x6821, 0x530c, 0x8ddb, 0x067e, 0xa8c5, 0x7a0a, 0xfcbd, 0x7279,
+		0xe420, 0x99a4, 0xe079, 0xe15d, 0x25aa, 0x72a3, 0x121f, 0xa110, 0x4b67, 0x4c9c, 0x0e2e, 0x4ccc,
+		0x090d, 0x00a4, 0x07a2, 0xa7a1, 0x0c07, 0x395e, 0x39c3, 0xbbb6, 0xe514, 0xa527, 0x36d2, 0x3157,
+		0x2101, 0x817e, 0x8c9d, 0x953c, 0x94ea, 0xfa9f, 0x8c69, 0x761b, 0x9cf0, 0x4061, 0x1e2a, 0xed23,
+		0x818a, 0x5483, 0x48b1, 0xce7f, 0x3345, 0x0a47, 0x8bba, 0xdd56, 0x4a12, 0x3924, 0x0990, 0x31a3,
+		0xe6a3, 0xeb28, 0x639f, 0x6e68, 0x719a, 0x15ff, 0x7aa8, 0xae8a, 0x82d6, 0xb12e, 0xfd58, 0xb3f5,
+		0x0ff2, 0xfd36, 0x002c, 0xf541, 0xcb8e, 0x30c2, 0x5162, 0x40c9, 0x335b, 0x49e2, 0x9ade, 0xa556,
+		0x2f3a, 0xffd0, 0x5e1a, 0x906d, 0x8a08, 0x77e3, 0xedc0, 0x1c16, 0x0a07, 0x0dca, 0x87dc, 0xc8c8,
+		0x4ff5, 0x5474, 0x41fb, 0x6fd2, 0x58d2, 0x8cae, 0x7303, 0x83a7, 0x3955, 0x07f7, 0xe37b, 0xcc83,
+		0x40a6, 0x015a, 0x88ad, 0xf113, 0x793f, 0xb8bf, 0xe34d, 0xa808, 0xd1aa, 0x6d25, 0xcce8, 0xfce9,
+		0xaf88, 0x3917, 0x29e8, 0x63a1, 0x1d0c, 0x5bb3, 0x6103, 0x6963, 0x21ca, 0x0789, 0xb640, 0x14c6,
+		0xd8be, 0xa29c, 0x6758, 0x8f66, 0x8572, 0x958e, 0xc108, 0x8ea4, 0x1438, 0x7393, 0x52fd, 0xe634,
+		0xaa35, 0xf9a8, 0x34a2, 0x4d73, 0xddbf, 0xd2ef, 0x269f, 0x8162, 0x4fc6, 0x2700, 0x2799, 0x300f,
+		0x2df3, 0xf6dd, 0x1167, 0xa71f, 0x3555, 0x04be, 0x3a2b, 0xf1e0, 0xecbd, 0xdae1, 0xc438, 0x70d0,
+		0xfda5, 0xa141, 0x5642, 0xc2b4, 0xfc63, 0x551b, 0x9df0, 0x35d4, 0x988c, 0x6a08, 0xbe32, 0x1413,
+		0x873c, 0xdebf, 0x838b, 0x9f60, 0x1f6d, 0x8c81, 0xc0ad, 0x4fd1, 0x7ba9, 0x9c1e, 0xafa5, 0xab58,
+		0x0839, 0x521c, 0x5aab, 0x830b, 0xafb8, 0x641e, 0x320f, 0x1286, 0xa17e, 0x8a4c, 0xd0df, 0x0b2b,
+		0x40d7, 0xcc52, 0x7e37, 0xf39b, 0xa9e4, 0xd5d4, 0x3eb0, 0x1fad, 0x6df2, 0xe19b, 0x0329, 0xef22,
+		0xa29c, 0x2ff3, 0x0e6e, 0x3e22, 0x37df, 0xfe77, 0x8af6, 0xa711, 0x3e0b, 0xa7e4, 0x3d71, 0x80ad,
+		0x3584, 0xa6a1, 0x47a1, 0xece6, 0x7583, 0x20aa, 0x69f0, 0x8b6c, 0xd0ab, 0xbfba, 0x1b85, 0x73ea,
+		0x646b, 0xc7c3, 0x7312, 0xdbe3, 0x19f4, 0xdb42, 0xcac2, 0xa049, 0x7c43, 0xd1ab, 0x43e7, 0x93ab,
+		0x350c, 0x17bf, 0xbd5e, 0x0c92, 0x292e, 0x7501, 0x2679, 0xfa08, 0xc6df, 0xd881, 0x890d, 0x12e7,
+		0x1795, 0xb794, 0x0710, 0x77bf, 0xa4dc, 0xbe10, 0xe7ba, 0x41c9, 0x1db0, 0xe628, 0x8156, 0xbcbd,
+		0xd8f5, 0x24d9, 0xae55, 0x4b23, 0x9337, 0x839e, 0x439f, 0x228e, 0xd756, 0x5b2b, 0x3326, 0xc8c0,
+		0xe0be, 0x1234, 0x62a9, 0xe76b, 0x23d4, 0xec04, 0xcd0b, 0x7394, 0xeac3, 0xb865, 0x8e5f, 0xd384,
+		0xcd95, 0x8b2e, 0x902c, 0x1605, 0x6d4e, 0x0116, 0x740c, 0xe3ae, 0x5f5f, 0x247d, 0x97e3, 0x4fd2,
+		0xa1e6, 0x90c8, 0x2a29, 0x65b6, 0x3e5a, 0xe7b9, 0x71f9, 0xbadd, 0x7a1e, 0x55e6, 0x5557, 0xa264,
+		0x8370, 0xeeac, 0x5af4, 0xb611, 0xfbcd, 0x1789, 0x8e3b, 0xffba, 0x538d, 0x6ae4, 0xf750, 0xf9df,
+		0x78c6, 0xa8e4, 0xb284, 0x8ae9, 0x8852, 0x75b4, 0x0775, 0xfc19, 0xd03d, 0xdd5c, 0x44c9, 0xd6c1,
+		0xc36e, 0x60b6, 0x3949, 0x60e1, 0xd164, 0xd773, 0x60b7, 0x6b5c, 0xcfe8, 0xf84b, 0xdbbc, 0x5c24,
+		0x4448, 0x4ecf, 0x1699, 0xbacd, 0xf53a, 0xfeb1, 0xaac5, 0xbc5e, 0x3364, 0x283d, 0xfe73, 0x9e03,
+		0x33a8, 0x74c8, 0xe45e, 0xae95, 0x8a81, 0x5e25, 0xa6d3, 0xd9a2, 0x2ee5, 0xb107, 0xc662, 0x14d5,
+		0x767e, 0x75e8, 0xa832, 0x410b, 0xef65, 0xe150, 0x7908, 0x3019, 0x9b45, 0xeedb, 0xe854, 0xb37b,
+		0xaa11, 0x2c91, 0xa26f, 0x2453, 0xd725, 0x468c, 0x27c7, 0xe4c5, 0xde25, 0x8480, 0x11d3, 0x4313,
+		0xcffc, 0x76a6, 0x9733, 0xd163, 0x72f0, 0xca4e, 0x7bd4, 0x7f97, 0xb911, 0x29bd, 0x9be7, 0xb130,
+		0x74c2, 0xcbae, 0xad05, 0x5594, 0x77f6, 0xb296, 0x42c0, 0x98cb, 0xa3d2, 0xc0a0, 0x23a0, 0x33c2,
+		0x8c3f, 0xdd75, 0xdc55, 0x1760, 0xb5c9, 0xc3e6, 0x46b4, 0xb700, 0xc6d8, 0x1fea, 0x55f3, 0x1630,
+		0x5958, 0xb500, 0x5df7, 0xa05c, 0xc086, 0xf8df, 0xb1c9, 0x6248, 0x74f6, 0x30b8, 0x45fd, 0x33b8,
+		0x6a5f, 0x7b98, 0xbadc, 0xf242, 0xd9e6, 0x7933, 0xe7d0, 0xf5dc, 0x82c2, 0x4be8, 0xe441, 0x63d3,
+		0x6c12, 0xa6db, 0xeb9f, 0x2532, 0x89f1, 0xa47c, 0x71d6, 0x1568, 0x12de, 0xa3b2, 0x4cf6, 0xb09d,
+		0x57c6, 0xcd2c, 0x8a1c, 0x6f4d, 0xb221, 0xb57e, 0xc9ca, 0xa77e, 0x89ea, 0x4792, 0x81ee, 0x1ec3,
+		0xf004, 0xabb4, 0xe06e, 0x2ca5, 0xb99d, 0x23f7, 0xf725, 0x7b6d, 0xf375, 0xea84, 0x19d7, 0xb5d9,
+		0x81de, 0x316f, 0x82f1, 0x5c64, 0xc9a4, 0xd2b6, 0xba52, 0xb58d, 0x3d18, 0x845a, 0x49d2, 0xb796,
+		0xf649, 0xe6dc, 0x8426, 0xb24e, 0x33c0, 0x623b, 0x4a05, 0xd916, 0xf373, 0x3613, 0x5b53, 0x4950,
+		0xe4e0, 0x9de8, 0x3172, 0xd687, 0xc534, 0x04b1, 0x3088, 0x1f10, 0x86cb, 0x8a03, 0x09d9, 0x0635,
+		0xe9a3, 0x27ef, 0x46ed, 0x969c, 0xebf9, 0xfa19, 0x8a2a, 0xc063, 0x47a9, 0x6df8, 0xd6ca, 0x5616,
+		0xaf42, 0x9060, 0x9399, 0x2366, 0xce99, 0x3b1f, 0xf149, 0x5c28, 0x12dd, 0x2c6e, 0xffee, 0x6b1a,
+		0xe3e6, 0x65ca, 0xcb1d, 0xe37d, 0x5f79, 0x58f2, 0x7674, 0xfdb7, 0x51f6, 0x7846, 0x4704, 0x33be,
+		0xddae, 0x0bc8, 0x9826, 0x0c83, 0x45f3, 0x889c, 0xf8cf, 0xf0ef, 0xea60, 0x131b, 0x33c4, 0xbed2,
+		0x5bfd, 0xb33c, 0xc77d, 0x6312, 0x454a, 0x0e5c, 0x2188, 0x5f28, 0x8d4e, 0xf6b3, 0xaf8a, 0xd287,
+		0x8ed5, 0xa298, 0xceec, 0xe6bd, 0xb3b0, 0xc6f0, 0x968f, 0x302f, 0xc985, 0xbabe, 0x6954, 0xe9ef,
+		0x7be1, 0x1c7f, 0x2279, 0x6812, 0xb17a, 0x3ed0, 0xd77e, 0xc462, 0x5c81, 0xe100, 0x4d02, 0xc294,
+		0xc867, 0x7c52, 0x6313, 0x673c, 0xdb93, 0xf7d8, 0xe0eb, 0x6460, 0x9770, 0xad88, 0x708f, 0xe83f,
+		0x7b29, 0x8bd2, 0xc7f4, 0x9f3b, 0x0e30, 0xeffb, 0x4c62, 0x03fb, 0xcb23, 0xb42b, 0x826e, 0x68d1,
+		0xb74a, 0x9ad6, 0x8287, 0xf8e8, 0xf423, 0x0a7e, 0x24d6, 0xd50e, 0x9d45, 0xa811, 0x0150, 0xab5f,
+		0x0f88, 0x4b1d, 0xd9ae, 0x7876, 0x931e, 0x334d, 0x545d, 0xd401, 0x31f8, 0x16b0, 0x2a07, 0xb1e2,
+		0xf566, 0xbcab, 0x6d68, 0x50cc, 0x7b88, 0x6383, 0x9daa, 0xf3d6, 0x75d3, 0x079a, 0x688d, 0xd95b,
+		0x9fc7, 0xd682, 0xa633, 0x6423, 0x0113, 0x106c, 0x633b, 0x732b, 0xa7ca, 0x9577, 0xd87e, 0x6abe,
+		0x392e, 0xeca1, 0x097c, 0xe358, 0xd8f6, 0xb64c, 0xc96d, 0x624c, 0x3352, 0xddc7, 0x3b48, 0x20e8,
+		0xc49e, 0x5e8a, 0x5787, 0xf3f8, 0x48a3, 0xb93f, 0x2695, 0x7ac7, 0x884a, 0x0b5a, 0x9021, 0xca5d,
+		0x3374, 0xb3ae, 0x4994, 0x00d0, 0x57bf, 0x1f63, 0xbbb9, 0xaaaa, 0xaf2c, 0x7167, 0xc86e, 0x84f7,
+		0x12d2, 0xa6bb, 0x4ea6, 0xf6d9, 0xa496, 0x286c, 0x979c, 0xa9b3, 0x4473, 0x31fa, 0xfa80, 0x3a68,
+		0xf6b9, 0x53da, 0x35d5, 0x66dc, 0x1ea7, 0xf5fe, 0xe95d, 0xd5e5, 0xbf74, 0xcf92, 0x5db2, 0x1a8a,
+		0x879d, 0x75b7, 0x6429, 0x703d, 0x0f34, 0xc21a, 0xfeb7, 0xb54a, 0x1edf, 0xfd3f, 0x9c8f, 0xc1e8,
+		0xc860, 0x5dc4, 0x6781, 0xa086, 0x06de, 0x4ca0, 0xd592, 0x4e85, 0x8d8d, 0x11ec, 0xc62a, 0x3072,
+		0x5f57, 0xdbb4, 0xb7d8, 0x3670, 0x7cdb, 0x51bc, 0x9a05, 0xd1f4, 0xddfd, 0xa6ab, 0x3d23, 0x1db8,
+		0xf63d, 0x1827, 0xb93e, 0x760f, 0x54e0, 0x6d17, 0xf9fe, 0x41f9, 0x38ef, 0x1537, 0x3925, 0xbf05,
+		0x3ca7, 0x9096, 0x3847, 0xae18, 0x8e98, 0xc159, 0xa0ed, 0x2d5c, 0x7d07, 0x0a0f, 0x7da1, 0xbc1a,
+		0x6802, 0x11c4, 0xacf0, 0x67b5, 0x72a0, 0x4faa, 0xf655, 0xe3ad, 0xbf1e, 0xca5d, 0xe195, 0x861f,
+		0xe3a9, 0x4e61, 0xb67f, 0xb77e, 0x32a3, 0x64db, 0xebbe, 0x4761, 0x172e, 0x1353, 0x48b4, 0xa7f9,
+		0x0e78, 0x52bf, 0x7289, 0x1ab8, 0x5840, 0x3882, 0x0616, 0xa46b, 0x4859, 0x8819, 0x9a14, 0x4dff,
+		0xf5df, 0xfe73, 0xf131, 0x585a, 0x4aaf, 0x6665, 0x1bbf, 0x9bea, 0x7f9f, 0x9bf9, 0xba1e, 0x032b,
+		0x6659, 0x2db5, 0x2a70, 0x6d9c, 0x3e8d, 0x8506, 0x2b21, 0x37b7, 0x48c3, 0x32ea, 0xe32b, 0x77d5,
+		0x5bd6, 0x5898, 0x4bce, 0xd8d8, 0x7ba4, 0xdd0a, 0xc93f, 0x0a1d, 0x9e75, 0x9f10, 0x025b, 0x9045,
+		0x28ea, 0x68f8, 0x4963, 0x9687, 0xf145, 0x7c51, 0x4e34, 0xdd4d, 0x7207, 0x8304, 0xf92a, 0xa865,
+		0x3f57, 0x057c, 0xd409, 0x3e33, 0x2259, 0x0831, 0xefeb, 0xbd41, 0x0b92, 0xaf86, 0x4189, 0x51e9,
+		0x389e, 0x9ffe, 0xcee9, 0x25cc, 0x0ce4, 0xb177, 0xc898, 0x0961, 0x2024, 0x29de, 0xdac7, 0x3220,
+		0x5cc8, 0xaec9, 0x578f, 0xca4f, 0x96e5, 0x5038, 0x4e37, 0xeaf2, 0x658d, 0xaace, 0x0817, 0x8b66,
+		0x4468, 0x293b, 0xb678, 0x37ff, 0x0888, 0xc9d3, 0xd825, 0x90d7, 0x43b9, 0x1ebb, 0x0e03, 0xe79c,
+		0x91e9, 0x45a6, 0x78ec, 0xed8c, 0x135f, 0xf259, 0x4f8a, 0x45d2, 0xb300, 0xc8c0, 0x8a5b, 0xa707,
+		0xe018, 0xd78f, 0xa85f, 0x15be, 0x0dc5, 0x7124, 0xe9ab, 0x7d46, 0xe09a, 0xc8a8, 0x2058, 0x41ac,
+		0x8c09, 0x98d8, 0x46d0, 0x6872, 0xc51f, 0x8846, 0xd915, 0x46e4, 0x310b, 0x631c, 0x70c9, 0x860f,
+		0x4fb5, 0x4639, 0xe4b9, 0xc970, 0x67f8, 0xd963, 0x87ec, 0xd7ca, 0x6466, 0x080b, 0x5906, 0x9953,
+		0x6616, 0x73b9, 0xd7fa, 0xccaa, 0xbacc, 0x97fa, 0x3468, 0x5dd8, 0xc7a6, 0xba46, 0x63ad, 0x7a04,
+		0x1605, 0x08b5, 0xb794, 0xdcc4, 0x7517, 0xc005, 0x1c0d, 0x4390, 0x34ea, 0x4bad, 0x16a7, 0x18e6,
+		0xaeff, 0xd43f, 0x4aff, 0x65fe, 0x2942, 0x083b, 0xc6b7, 0xac7b, 0xbf59, 0xdd1e, 0x2e87, 0x712d,
+		0xb89e, 0x99e8, 0xcf3d, 0x45d3, 0x07fe, 0xc8c9, 0x7d4d, 0x6e11, 0x9e30, 0xd49c, 0x74e9, 0xfeda,
+		0x6b53, 0x24b1, 0x3675, 0x094c, 0xddef, 0x058a, 0x40a7, 0xc66c, 0x6565, 0x2a82, 0x80e4, 0x0902,
+		0xee05, 0x37b8, 0x118e, 0x81ee, 0xe62f, 0xe818, 0xf229, 0xedb7, 0x330a, 0xb903, 0x86e2, 0x2855,
+		0x82f0, 0xeb75, 0x0f96, 0xda0a, 0x6436, 0xb6ab, 0x0513, 0x38f6, 0xe854, 0x991a, 0x04d4, 0x97ca,
+		0x34ce, 0xe1c1, 0x5c84, 0x5d46, 0xe292, 0xd27c, 0xc683, 0x20d9, 0x760b, 0x87b9, 0xc54c, 0x94ef,
+		0xd287, 0x6a79, 0xd0e2, 0x14a6, 0x913a, 0x78c7, 0x56f8, 0x9dc4, 0xbc41, 0x8810, 0x782f, 0xecd2,
+		0x2770, 0x322c, 0x34e7, 0x439c, 0xf50a, 0x1d5b, 0xc5dc, 0x5f1f, 0x1504, 0x1b25, 0xa3b1, 0x78f7,
+		0x6233, 0x7c38, 0xd866, 0x2e78, 0x4ab4, 0xd767, 0x8738, 0x0c6d, 0x6ad6, 0xdd75, 0x3ce8, 0xb5d7,
+		0xa120, 0x2b0b, 0x0ff8, 0xcc58, 0xf527, 0xd894, 0x283a, 0x3c17, 0xaa59, 0xab13, 0x1939, 0xf919,
+		0xd8e0, 0x2cbc, 0x170e, 0x05ba, 0x21f9, 0x3f3e, 0x2c7b, 0x5841, 0xdd3e, 0xe03d, 0x5dde, 0xe74c,
+		0x67a0, 0x5133, 0xb898, 0x9233, 0x3638, 0x57df, 0xc70e, 0x5ac3, 0xdc43, 0x0894, 0x507e, 0x6869,
+		0x6902, 0x551c, 0x3beb, 0x5bcb, 0x5490, 0x4678, 0x7dbd, 0xcda3, 0xe133, 0x7e31, 0xbd63, 0xbd79,
+		0xe256, 0x1a5d, 0x0f4a, 0x5ff7, 0x3c71, 0xc7b5, 0x2fb6, 0x97d7, 0xa8bc, 0x80a5, 0xc7fe, 0x7da3,
+		0x95a0, 0x970d, 0x3148, 0x5855, 0xdf2d, 0x68bf, 0x0fb6, 0xc828, 0x61e9, 0x3a1e, 0x7cf5, 0x58a9,
+		0x2968, 0x0069, 0x1bbd, 0x4394, 0x1109, 0x9a2f, 0xce93, 0x894f, 0x8a59, 0x3705, 0x161f, 0x4b61,
+		0xb551, 0x6b25, 0x9497, 0xa6ce, 0xbe22, 0x46f9, 0x09ba, 0xac33, 0x9952, 0xc88e, 0x7be8, 0xfac6,
+		0x83ba, 0xd0f5, 0xe278, 0xa38e, 0x8288, 0x7b0e, 0xdf73, 0x0099, 0xd826, 0x73e4, 0x817e, 0x2f7b,
+		0xf6c7, 0x4872, 0xf78c, 0x5d6d, 0xb56d, 0xf7bb, 0x9c72, 0xa1ae, 0x95ed, 0x3bf4, 0x6dad, 0xaa1d,
+		0x1742, 0x1849, 0xd8dd, 0x28c1, 0xdc57, 0x72db, 0xa969, 0xa3ef, 0x67d3, 0x9dd2, 0x33b8, 0x208f,
+		0x7004, 0xc709, 0x49fc, 0xca59, 0x59f9, 0x1a6c, 0x446c, 0x1365, 0xbcc9, 0x91e1, 0x33da, 0x73c8,
+		0xf7a7, 0x55d0, 0x2b19, 0xb95f, 0x04a7, 0xdb3c, 0xd86c, 0x6fb9, 0xbf0b, 0x925e, 0x68c2, 0x8e48,
+		0x5e08, 0xdc6a, 0x7fe8, 0xebef, 0xae89, 0x5c7c, 0x3ffe, 0x74ca, 0xeaa9, 0x8080, 0xf48f, 0x707c,
+		0x01d4, 0xf3cd, 0xa690, 0x8828, 0x6193, 0x7d41, 0x0568, 0x671d, 0xd45c, 0x1915, 0xe8a5, 0x9eb9,
+		0xa4c5, 0x9f9c, 0xd917, 0x4956, 0xb4d7, 0x0a78, 0x88e2, 0xd687, 0x91d3, 0x0562, 0x869b, 0x09fd,
+		0xb517, 0xc6cd, 0xf6c0, 0x2c49, 0x8fd5, 0xaf1e, 0xb5b7, 0xddda, 0x3565, 0xc63c, 0xc34e, 0x1c9c,
+		0x4b77, 0x7d0f, 0x8595, 0xa2e2, 0x454a, 0x9237, 0x75fc, 0xee05, 0x15c8, 0xda6d, 0xce7a, 0x05aa,
+		0x810a, 0xd602, 0x0323, 0xa408, 0x3c28, 0x693e, 0x3cd0, 0x7bbc, 0x9771, 0xa865, 0x30cd, 0xbdc4,
+		0xc058, 0xa0f2, 0xe57b, 0x92a1, 0x3b5f, 0xae4d, 0x2fbb, 0x0fff, 0xa62f, 0xaf45, 0xb79a, 0x02c9,
+		0x8dbe, 0x839c, 0xb26a, 0x43e0, 0xb2db, 0xd959, 0x264e, 0x96f4, 0x08b5, 0xe487, 0xf4b6, 0xa56d,
+		0xcbb9, 0x9482, 0xdafe, 0x6591, 0x1986, 0x8fe8, 0xc015, 0xea79, 0x53fb, 0x3a53, 0x3626, 0x5b49,
+		0x69d7, 0x3246, 0x0566, 0xafbb, 0xee92, 0xdbdc, 0xabe7, 0x4f1b, 0x8250, 0x4e5e, 0xa918, 0x0d01,
+		0xba13, 0xe7f0, 0x0a74, 0xde8a, 0x4d17, 0xc3c1, 0xaefd, 0x20f9, 0x78df, 0xd1ef, 0xe33a, 0xa574,
+		0x8995, 0x6177, 0xe3ed, 0x965c, 0x73fb, 0xc915, 0xbf7c, 0xd790, 0x624e, 0x3d0e, 0x0c91, 0xf08e,
+		0x2284, 0xd34b, 0xc80f, 0x23ce, 0x5980, 0x3310, 0xb071, 0xe8d2, 0x8ca1, 0x9e25, 0xa3c3, 0x2e3d,
+		0x48fb, 0x9672, 0xe94c, 0xd45d, 0x09b3, 0x5b81, 0x031c, 0xfddd, 0x285c, 0x4a27, 0xdf70, 0xbf31,
+		0x8fd6, 0x2a98, 0xcae6, 0xe970, 0x2ad3, 0x2c69, 0x7d17, 0xcfc9, 0xbcb6, 0x24cd, 0x0bea, 0x8645,
+		0x4c5c, 0x4b3d, 0x2fd0, 0x4aa5, 0x216b, 0xe300, 0x0f88, 0xc7fd, 0xbc83, 0x99e2, 0x1259, 0x6c43,
+		0x4397, 0x07f0, 0x694e, 0xffa2, 0xb830, 0xe335, 0x37c8, 0x6721, 0xc617, 0xe61d, 0x8570, 0x01ab,
+		0x1490, 0xc0a2, 0x59fc, 0xba57, 0x5173, 0xce0a, 0x4fe9, 0x3248, 0xc136, 0x20fb, 0xbbf5, 0x4290,
+		0xb6c7, 0x142a, 0x32d5, 0xf9b8, 0x53eb, 0x6802, 0xc41e, 0xff4d, 0x7deb, 0xdebe, 0x54af, 0x8469,
+		0xdee3, 0x4410, 0x7015, 0x579a, 0xfc60, 0xa0aa, 0xab08, 0x9d3b, 0x106b, 0xad23, 0x4134, 0x81ae,
+		0x25af, 0xb1be, 0xf6f6, 0xc20a, 0x6d5b, 0x32bf, 0x865f, 0x92a9, 0x08d3, 0x55c6, 0xb3d8, 0x70a6,
+		0x2e5d, 0x6947, 0x4e41, 0x3728, 0x60ad, 0x56c3, 0x45d3, 0xf605, 0xd495, 0x1bb0, 0xcd3a, 0xe885,
+		0x8bd3, 0x715b, 0x33d3, 0x101c, 0xbd1e, 0xeff4, 0xd46a, 0x23cc, 0x23a5, 0x86d6, 0x915f, 0xf21c,
+		0xc503, 0x1134, 0x264a, 0xf283, 0x66e6, 0x5ad2, 0x6fcb, 0x0e57, 0x727a, 0xebd0, 0xf0a1, 0xa02a,
+		0x6d37, 0x9a88, 0x90b9, 0x0f64, 0x1c97, 0x30cf, 0x52b6, 0x056a, 0x9bc4, 0x65f7, 0x6d00, 0x8caa,
+		0x30f7, 0xb1eb, 0xd771, 0xdaf4, 0x19d3, 0x0bde, 0x7b28, 0x0248, 0xdcd3, 0x35a0, 0xad24, 0x0d0f,
+		0xea12, 0x27cb, 0xaa49, 0xfcdb, 0xc03c, 0x50cf, 0x64d1, 0x3325, 0x92d4, 0xf65a, 0x2938, 0xc7cd,
+		0x9947, 0xcd5e, 0x43fe, 0x4049, 0x29c2, 0x4833, 0xc074, 0x8d62, 0x68a7, 0x0dad, 0xcdff, 0x7586,
+		0x10e1, 0xd788, 0x27d0, 0x6b23, 0xd09f, 0x7531, 0xc59e, 0xea4b, 0x6663, 0x5b84, 0x12a0, 0xb7db,
+		0xf1c3, 0x0720, 0x6621, 0x5164, 0x5ef3, 0x27df, 0xacaf, 0x7552, 0x2567, 0xb37f, 0x43a1, 0xa8d7,
+		0x72f6, 0x2e44, 0xfbb9, 0x76cd, 0xbd57, 0x9b09, 0x33f9, 0x67a3, 0x2923, 0x7110, 0xc906, 0xbe0c,
+		0x4058, 0xa460, 0x6f27, 0xde87, 0x539f, 0xc1c9, 0x65eb, 0x4db5, 0xb064, 0xd046, 0x7307, 0x0f26,
+		0xdb10, 0xf9ac, 0x7776, 0x65e6, 0x46b7, 0x508f, 0x5c61, 0xe4aa, 0x080c, 0x1dc0, 0x2c7a, 0xb0c7,
+		0xea05, 0x101b, 0x6ea0, 0x1dd4, 0x3f99, 0x77ea, 0xa788, 0x6a2e, 0xcaaa, 0xe3ac, 0x2f87, 0x6a42,
+		0x590b, 0x436e, 0xe62a, 0x60bb, 0xb490, 0xe1fc, 0xd3b0, 0x52ca, 0x202d, 0xd212, 0x6452, 0xc7a2,
+		0xf745, 0xdd13, 0xee05, 0x8199, 0xcbae, 0x6e26, 0xf627, 0x30b9, 0x2200, 0xfde8, 0x5089, 0x9147,
+		0xba17, 0xcaa9, 0x7a82, 0x97de, 0xcac7, 0x97c8, 0xd200, 0x61b9, 0x914d, 0x8afd, 0x01df, 0x621d,
+		0x6a52, 0x9f9c, 0x6819, 0x60d3, 0xfdb6, 0x565c, 0xa949, 0xddfa, 0x3993, 0xd409, 0x2240, 0x9ac1,
+		0xb09a, 0xb3ee, 0xfdb4, 0xf34a, 0xc883, 0x497f, 0x4162, 0x88b1, 0x06c2, 0x357c, 0x59ff, 0x70b7,
+		0x7adc, 0x305c, 0x890e, 0x3385, 0xaac7, 0xa6a7, 0xfce1, 0x0edf, 0x1581, 0x6ec7, 0x3f24, 0x62b6,
+		0xe593, 0x4ed2, 0xea8f, 0xe210, 0xe19c, 0xfaff, 0xe6ac, 0x68ec, 0x21a4, 0xb221, 0xe5f0, 0x1f5d,
+		0xafd2, 0x32e0, 0xce0d, 0x9e62, 0x1a59, 0xe13c, 0x453e, 0x0d67, 0x5851, 0x933a, 0xf664, 0xb3ad,
+		0xcc9e, 0xc1ad, 0x24f3, 0x9cde, 0x4aa0, 0x8876, 0xd5e4, 0x1fc2, 0xef08, 0xd3e0, 0x89dd, 0x6399,
+		0x99c6, 0xfbdc, 0x3c64, 0xbcda, 0xb750, 0x754f, 0x320f, 0x8860, 0xa701, 0x215e, 0xb55c, 0x265d,
+		0x21a5, 0x8913, 0x1370, 0x1ff7, 0xb5a4, 0x179e, 0x38f9, 0x7de6, 0x046b, 0xf15b, 0x4216, 0x19b6,
+		0x59b2, 0x305e, 0xc0f9, 0x3c03, 0xea51, 0x7364, 0xa38b, 0xd0b2, 0xda8b, 0x65a4, 0xa2ab, 0xdbd5,
+		0x49e4, 0xf36b, 0x3adf, 0xa711, 0x430c, 0x73a2, 0xe778, 0xf3f9, 0x8d72, 0x0f9e, 0x6749, 0x23ce,
+		0x4a14, 0x9562, 0x8b4e, 0x0344, 0x3d44, 0x1c59, 0xa766, 0x066c, 0x5add, 0x89e1, 0xccf6, 0xa308,
+		0xbd31, 0x4f04, 0xc0c5, 0xab3f, 0x5c3f, 0x1bfc, 0xc596, 0x8962, 0xb385, 0xe616, 0xd665, 0x295d,
+		0x5cb2, 0x28d5, 0x56bc, 0x3c3f, 0x37e0, 0xb74a, 0xb519, 0x25b9, 0x30b9, 0x0673, 0x7a10, 0xe11d,
+		0x1bce, 0x8a87, 0xb204, 0x5d1b, 0x76b8, 0xbad5, 0x7686, 0x50c3, 0x25a2, 0xfeaf, 0xa82c, 0x27a1,
+		0xf530, 0xafc3, 0x585f, 0xbd4b, 0xaf06, 0x4c23, 0xacb4, 0xf9b8, 0x17e1, 0x96c9, 0xf1f5, 0x4f50,
+		0xe7f5, 0x07c2, 0xf753, 0xd2c1, 0xd742, 0x6a9e, 0x378c, 0x0c53, 0x9645, 0x0795, 0xbb61, 0xdcdf,
+		0x08a0, 0x1437, 0x6bc5, 0xf230, 0xf1fc, 0x44ab, 0x41e8, 0x0710, 0x2103, 0x89e4, 0xf350, 0xaec0,
+		0x8c80, 0x9cf5, 0x9be3, 0x0263, 0xc6b7, 0x7c44, 0x55bc, 0x32e9, 0xac47, 0x593b, 0xaa95, 0xf2f1,
+		0x8b35, 0xa96e, 0x47a4, 0xe8cc, 0x0712, 0x7dc0, 0xd1b0, 0x9b0a, 0x3301, 0xb83a, 0xc70a, 0x7317,
+		0x71ae, 0x71f2, 0x1bb6, 0xe0a7, 0x5e28, 0x67b9, 0x7524, 0xc0ff, 0x1537, 0x5259, 0xab88, 0x13a9,
+		0xb463, 0xaf68, 0x2310, 0x4e34, 0x47f5, 0x08e6, 0x3c1e, 0x69f8, 0x2db6, 0x9d83, 0x2e4c, 0x82e4,
+		0xcaaa, 0x3288, 0x7e85, 0x48d3, 0x8d18, 0xa3ff, 0xc5f7, 0x7c4a, 0x5707, 0xfa73, 0x5a6f, 0x1996,
+		0xb40d, 0xf905, 0xaaf2, 0x92d9, 0x8cf6, 0x7de5, 0x682c, 0x9815, 0x5a6d, 0x4c00, 0xfe82, 0xf572,
+		0x35cf, 0x523c, 0xff31, 0x3d14, 0x8287, 0x4b8c, 0xe58e, 0x90e5, 0xd9c6, 0xc710, 0x5adc, 0x8dfd,
+		0x5236, 0xe496, 0x5098, 0xef71, 0xa173, 0x04bf, 0x087d, 0xf2b5, 0xe54f, 0x9f90, 0xd654, 0xa17b,
+		0x8725, 0xe756, 0x40d6, 0x914a, 0xab08, 0x4a38, 0x350c, 0x3009, 0x6a80, 0x7299, 0x0c34, 0xc619,
+		0xb698, 0x32d5, 0x809c, 0x5e57, 0x17a6, 0xa0b3, 0x6d7d, 0x9114, 0x8879, 0xa4bd, 0xde97, 0xd4d0,
+		0x2ee5, 0x91ae, 0x7caa, 0x5898, 0xd871, 0x5572, 0x6b11, 0xdce3, 0x4d68, 0xd77b, 0xe8ef, 0x4e76,
+		0xecc4, 0x362d, 0xde89, 0x8cd2, 0x1537, 0x8367, 0x97b6, 0x21ec, 0xe36c, 0xe40e, 0x7ca8, 0xd428,
+		0x60d0, 0x44bf, 0x7d12, 0x0167, 0x3700, 0xc36f, 0x2d15, 0x3f22, 0xf400, 0xe170, 0x99d6, 0xc1e3,
+		0x5de8, 0x55be, 0x859b, 0x6d7f, 0x2316, 0x920f, 0xc517, 0x6309, 0x67a6, 0xe633, 0xa2b9, 0xb3b4,
+		0xcab8, 0xf829, 0x1f44, 0xb061, 0x2d85, 0x13cf, 0xeed3, 0x17ec, 0xa9e0, 0xf282, 0x97a3, 0xb7fb,
+		0xe0a9, 0x985c, 0x4c56, 0x78c1, 0x6812, 0x800e, 0x6694, 0x0472, 0xf681, 0x8823, 0x8819, 0xf448,
+		0x0db8, 0x8308, 0xf13e, 0x4634, 0x7245, 0x5eb8, 0x5a0d, 0x6dab, 0x74c9, 0xc2ec, 0x7646, 0x8436,
+		0x7b86, 0xa44b, 0x3eee, 0x3666, 0x50e1, 0x03c0, 0xeeb8, 0xce87, 0x9b99, 0x9af5, 0x9939, 0x4ea9,
+		0xff6d, 0x706f, 0xcdd0, 0xe0f4, 0x83f9, 0xe12e, 0xdeea, 0xc9d7, 0xe178, 0x22cf, 0x35f7, 0xac59,
+		0x9097, 0x91d5, 0x4028, 0xb8e3, 0x66ef, 0x60fa, 0xdc46, 0x23f4, 0x8684, 0xffef, 0x8080, 0xdd01,
+		0xa433, 0xd942, 0xdaa6, 0x2ec1, 0xfe4d, 0xadfc, 0xe83c, 0x4709, 0xf157, 0xac76, 0x2fd0, 0x8ff8,
+		0x30e8, 0xc542, 0x6a80, 0x85d5, 0x41ce, 0x9c17, 0xbf4a, 0x7bb5, 0x9bbd, 0x452e, 0x7bc2, 0x431d,
+		0x4ddf, 0x6106, 0xb5cb, 0x7c34, 0x9dce, 0xe63f, 0x664b, 0x5d11, 0xaf2a, 0x31ee, 0x83bb, 0xd84d,
+		0xaef5, 0x81e8, 0xb3c7, 0xcf13, 0x4176, 0xc789, 0x58df, 0x4a13, 0x0595, 0xc7cd, 0xcf5b, 0x0a0e,
+		0xb9d5, 0x280a, 0x2619, 0x5a86, 0x139a, 0xff12, 0x4f06, 0xb93c, 0xae62, 0x7e90, 0xf68a, 0x7509,
+		0xdfe1, 0xdd17, 0x84c4, 0x4e8e, 0x60de, 0xf1ef, 0xaaae, 0x9212, 0xd928, 0xc8f8, 0x3137, 0x9e18,
+		0x0614, 0xc20a, 0x52ca, 0x7396, 0xc9b2, 0x6c05, 0x2cd8, 0x4ba4, 0x6680, 0xa19e, 0x7c0e, 0xdc19,
+		0x5261, 0x288e, 0x5b1c, 0xc159, 0x584e, 0x7caf, 0x327b, 0xc583, 0x8b81, 0xb1a1, 0x5158, 0x0422,
+		0xc136, 0xa9ee, 0xa459, 0xba30, 0x7fff, 0x7983, 0x7632, 0x4aff, 0x7230, 0x1d15, 0xef6b, 0x9307,
+		0xee7a, 0x4d10, 0x59bc, 0x831c, 0xc738, 0x7b91, 0xa887, 0x331d, 0xfe26, 0x38de, 0xed14, 0x24b1,
+		0x7c86, 0x0a2e, 0x9631, 0xd217, 0x4536, 0x6039, 0x367e, 0x0f60, 0x0bc0, 0x2ce0, 0xb386, 0x9cbf,
+		0x5560, 0x0f7a, 0xf8c2, 0x75b2, 0x8cf2, 0xb024, 0xf305, 0x200c, 0x72b8, 0x93cd, 0x13bf, 0x6757,
+		0x5f2e, 0x74f9, 0x1da2, 0x83ec, 0xe37c, 0x29ce, 0x5b30, 0x8a44, 0x66fb, 0x85cd, 0x9f4c, 0x70ae,
+		0xcea0, 0x1eeb, 0x5329, 0x07e4, 0x4781, 0x78ab, 0xed8e, 0xdc04, 0x6a3f, 0x1999, 0xfb98, 0x9e7f,
+		0x6380, 0xb203, 0x70b7, 0x2a0c, 0x5b5c, 0x8936, 0x29b3, 0x112d, 0xc4bd, 0x2636, 0x2e51, 0xfb64,
+		0xf749, 0x3b47, 0xefdc, 0x93b6, 0xe915, 0xd252, 0x6c34, 0x62f0, 0x9e5e, 0x46f3, 0xdd3d, 0x7d36,
+		0xad83, 0xa7b0, 0xa888, 0x6e87, 0x2d66, 0xed8d, 0x7eb2, 0x5e59, 0x2026, 0x9194, 0x2bba, 0xdc36,
+		0x7b46, 0x620b, 0xc061, 0xb809, 0x3d7f, 0x91be, 0x0a67, 0x7fc7, 0xb1cd, 0xeff6, 0x3d05, 0xf803,
+		0xc574, 0x55b1, 0x34e5, 0x72ed, 0xde41, 0xb2cd, 0x9a37, 0xcd26, 0xc41b, 0x955c, 0x5294, 0x6f2f,
+		0xe45c, 0x5487, 0xef6a, 0x0529, 0x87fc, 0x9f6f, 0xe8cd, 0xa81e, 0x51fe, 0xcb56, 0x06a9, 0x49e0,
+		0x2113, 0xa588, 0xc1c9, 0x5b2b, 0x873d, 0xf9ae, 0x0815, 0x9c0d, 0x2b25, 0x3f49, 0x5386, 0xb005,
+		0xf896, 0x8135, 0x56ba, 0xec57, 0x362e, 0x16aa, 0xf60f, 0xeae0, 0x004b, 0x1491, 0xc690, 0x1571,
+		0xd87b, 0xced8, 0x3ddb, 0x9007, 0x050e, 0xe870, 0x512f, 0xe2f8, 0x3dd7, 0xd06c, 0x9249, 0xc137,
+		0x1ffe, 0x4425, 0x9cae, 0x52ed, 0x2bd7, 0xb3d3, 0x5864, 0x4415, 0x8b47, 0x63a0, 0xf88e, 0x2d7e,
+		0x2aef, 0x11d3, 0x12ad, 0x3902, 0x141d, 0xd9a7, 0xb8fe, 0x7cf1, 0x1edb, 0xdcff, 0x2119, 0xaf35,
+		0xfd32, 0xdf76, 0xc38d, 0x300e, 0x7eda, 0x5d34, 0x16e0, 0x1ff3, 0xec0e, 0x8a4d, 0x747a, 0x4589,
+		0x44db, 0xcd7a, 0x9430, 0x5ffb, 0xdd47, 0x6d97, 0xee70, 0x834d, 0xa32d, 0x67ff, 0x835e, 0xefd9,
+		0xd312, 0xe146, 0x8db2, 0xa47c, 0x0266, 0x42df, 0xddc2, 0xfe61, 0x52cd, 0x66be, 0x1bad, 0x9e6a,
+		0xa729, 0xbd1a, 0x453d, 0x7e88, 0x6c50, 0x1d30, 0x1ebb, 0x17ff, 0xff3a, 0x874a, 0x5457, 0xa202,
+		0x36b8, 0x12b7, 0x9ba9, 0xc1c7, 0x89ec, 0x6dc1, 0xb774, 0x8b92, 0xa122, 0x7d16, 0xecdf, 0xe26a,
+		0xde2f, 0xba21, 0x042a, 0xb27b, 0xf600, 0x1f23, 0x5be4, 0x2247, 0x6e4d, 0xcd5e, 0x9233, 0x9a36,
+		0x853b, 0x6288, 0xdaf0, 0xf8f6, 0xecd6, 0xa5b2, 0xeef7, 0x9fa7, 0xd558, 0x17bc, 0xdba0, 0xc242,
+		0xa839, 0xe97a, 0xc2d2, 0xb1a3, 0x34fd, 0xe1ef, 0xaa35, 0x3abc, 0x1e6a, 0xcbd8, 0x46fa, 0xbfbe,
+		0x2da8, 0x962c, 0x1128, 0x69ee, 0x2a95, 0xfaca, 0x27e3, 0x46f0, 0xffa2, 0x6b1e, 0xa2ce, 0x9e64,
+		0xb7b4, 0x10c6, 0x96ae, 0xdf21, 0x8dc5, 0x703e, 0xc564, 0x4179, 0x9906, 0x7524, 0xa55f, 0x5715,
+		0x1b12, 0x6fce, 0xb24e, 0x003c, 0x5909, 0x1214, 0xa09d, 0x4979, 0x0cec, 0x68e4, 0x59f4, 0x474a,
+		0x3659, 0xaf5a, 0x7deb, 0x6a17, 0xe7e8, 0xf9e5, 0x0240, 0xa159, 0x945b, 0x346c, 0x27cd, 0xb8ad,
+		0xba0b, 0x4c64, 0x35b7, 0xc52f, 0xd865, 0x6ec1, 0x732e, 0xa333, 0xe66d, 0x5f7a, 0x8bfa, 0xf986,
+		0x792e, 0x40a7, 0x0243, 0xb364, 0x0ca1, 0x0c1d, 0x7d05, 0xc09d, 0x9360, 0x303d, 0xe440, 0xdb79,
+		0x2c85, 0x2bbe, 0x4e9c, 0x0208, 0x8392, 0x2a9d, 0x4393, 0x2dc4, 0x0bbb, 0x73bf, 0x5ec8, 0x3db3,
+		0xc74e, 0x5118, 0xfcec, 0x8fd7, 0x829f, 0x8429, 0x884c, 0x6fb2, 0x74ce, 0xa50a, 0x5e91, 0x4a51,
+		0x5021, 0x4d62, 0x17bb, 0xcf6c, 0x27f2, 0x22c8, 0x6670, 0x16f0, 0x0b80, 0xf5d1, 0xb35d, 0xa4ab,
+		0xf633, 0xe99f, 0x9014, 0xf94d, 0x193a, 0x4349, 0x8b2c, 0xbb3c, 0x624b, 0x415b, 0xe408, 0xa8b2,
+		0x52a4, 0x4482, 0x4ddf, 0x03b2, 0x3b26, 0xe2d3, 0xf6b7, 0x0f70, 0xde05, 0xd003, 0xfe4d, 0x4771,
+		0x2201, 0xeae9, 0x12a7, 0xd0d4, 0xc432, 0xdcad, 0x7b5f, 0xf432, 0xd1f7, 0x9913, 0xdc84, 0xe526,
+		0x8c66, 0xb60f, 0x0d00, 0xd44f, 0x72cd, 0x9efe, 0xf569, 0xf311, 0x9e91, 0xa9f6, 0x63b0, 0x96fd,
+		0x2a9d, 0x56d2, 0x395f, 0xc307, 0x5b1b, 0x77cf, 0x31e2, 0xb4ab, 0x3f92, 0x0e40, 0xa20e, 0x2e54,
+		0xc442, 0x8827, 0x2736, 0x4900, 0xf3b6, 0x14bd, 0xafea, 0x8741, 0x7b98, 0xc4ab, 0x4e3f, 0xa3d2,
+		0x24b7, 0x501e, 0x1833, 0x44a9, 0xf6f2, 0xe315, 0xe8da, 0xc2a5, 0xb859, 0x38f5, 0x2ea8, 0x2815,
+		0xafa7, 0x9571, 0xc5f2, 0xe093, 0x8e2e, 0xf0e9, 0x2457, 0x9aee, 0x4605, 0x220d, 0x1016, 0x7b1b,
+		0x52c4, 0x6da6, 0x00cd, 0x118c, 0x5e2e, 0xc83d, 0xfcf7, 0xce27, 0x55e4, 0xb5dd, 0xff10, 0x3442,
+		0xe470, 0xb1ff, 0x9753, 0x9735, 0xcec6, 0xee95, 0x591f, 0xe68a, 0xe948, 0xed97, 0xa366, 0x855c,
+		0xadb7, 0x6433, 0x78ce, 0x3903, 0xdb10, 0xf604, 0x655e, 0xef66, 0xd287, 0x43b7, 0x6cee, 0x9ceb,
+		0x421b, 0x55e4, 0x2ffe, 0xc6b7, 0x6fd8, 0x5487, 0x56bd, 0x75f9, 0x4c07, 0x90d9, 0x13bd, 0x6a96,
+		0x5136, 0x8fdc, 0xce27, 0x3763, 0xe7f7, 0x4d91, 0xce87, 0x468f, 0x3a6c, 0xe31d, 0x52c8, 0xa99d,
+		0xde3c, 0x65b2, 0x4cbf, 0x725c, 0xcc15, 0x8d4f, 0x998d, 0xcd91, 0x408c, 0x5557, 0x222c, 0x7600,
+		0xf6eb, 0xa329, 0x4936, 0xe525, 0x6e5d, 0x017b, 0x1126, 0x63c2, 0xdbbc, 0xbb7b, 0x31e3, 0xf422,
+		0x3baa, 0x1acc, 0x6fc1, 0xce24, 0x7d91, 0xc079, 0xec87, 0xd1ae, 0x63a1, 0xc620, 0xff74, 0x144d,
+		0x3654, 0x8276, 0x2870, 0x6ff0, 0x8e8f, 0x958f, 0x9074, 0x52ba, 0x5756, 0x0cee, 0x30a1, 0x5591,
+		0x5157, 0x95e1, 0xc896,
+	},
+	entries: []stemEntry{
+		{"chateaubriand", "chateaubriand"},
+		{"ascertaining", "ascertain"},
+		{"behave", "behav"},
+		{"tale", "tale"},
+		{"repaid", "repaid"},
+		{"wonder", "wonder"},
+		{"embraces", "embrac"},
+		{"inevitably", "inevit"},
+		{"retaliate", "retali"},
+		{"folds", "fold"},
+		{"gives", "give"},
+		{"attends", "attend"},
+		{"dismayed", "dismai"},
+		{"cigar", "cigar"},
+		{"result", "result"},
+		{"evolution", "evolut"},
+		{"sternum", "sternum"},
+		{"colloid", "colloid"},
+		{"ivanovna", "ivanovna"},
+		{"glioma", "glioma"},
+		{"freemen", "freemen"},
+		{"reconstruction", "reconstruct"},
+		{"breadth", "breadth"},
+		{"retreating", "retreat"},
+		{"speaker", "speaker"},
+		{"fremont", "fremont"},
+		{"sources", "sourc"},
+		{"conform", "conform"},
+		{"lui", "lui"},
+		{"granary", "granari"},
+		{"man", "man"},
+		{"escorted", "escort"},
+		{"beaten", "beaten"},
+		{"shocked", "shock"},
+		{"wave", "wave"},
+		{"headache", "headach"},
+		{"infrequent", "infrequ"},
+		{"breathed", "breath"},
+		{"sprouting", "sprout"},
+		{"winds", "wind"},
+		{"borodino", "borodino"},
+		{"sokolnitz", "sokolnitz"},
+		{"staphylococci", "staphylococci"},
+		{"harding", "hard"},
+		{"carried", "carri"},
+		{"clauses", "claus"},
+		{"request", "request"},
+		{"asking", "ask"},
+		{"started", "start"},
+		{"blankets", "blanket"},
+		{"protein", "protein"},
+		{"gently", "gentli"},
+		{"cheeks", "cheek"},
+		{"confer", "confer"},
+		{"marvelous", "marvel"},
+		{"possessing", "possess"},
+		{"imply", "impli"},
+		{"towns", "town"},
+		{"ignore", "ignor"},
+		{"freeholders", "freehold"},
+		{"enthusiastically", "enthusiast"},
+		{"gospel", "gospel"},
+		{"prohibition", "prohibit"},
+		{"intends", "intend"},
+		{"sir", "sir"},
+		{"warsaw", "warsaw"},
+		{"sleeps", "sleep"},
+		{"hilt", "hilt"},
+		{"heeding", "heed"},
+		{"shopman", "shopman"},
+		{"congress", "congress"},
+		{"bossing", "boss"},
+		{"accumulations", "accumul"},
+		{"contrary", "contrari"},
+		{"tributaries", "tributari"},
+		{"corpses", "corps"},
+		{"anatole", "anatol"},
+		{"nullify", "nullifi"},
+		{"ablaze", "ablaz"},
+		{"transactions", "transact"},
+		{"smoothed", "smooth"},
+		{"nodding", "nod"},
+		{"bradstreet", "bradstreet"},
+		{"abolish", "abolish"},
+		{"delegates", "deleg"},
+		{"fired", "fire"},
+		{"agreeable", "agreeabl"},
+		{"investigations", "investig"},
+		{"mckinley", "mckinlei"},
+		{"corded", "cord"},
+		{"verses", "vers"},
+		{"murderous", "murder"},
+		{"fourthly", "fourthli"},
+		{"holes", "hole"},
+		{"delays", "delai"},
+		{"leaps", "leap"},
+		{"dies", "di"},
+		{"stairs", "stair"},
+		{"originate", "origin"},
+		{"sacred", "sacr"},
+		{"ending", "end"},
+		{"tie", "tie"},
+		{"whirled", "whirl"},
+		{"landing", "land"},
+		{"ergot", "ergot"},
+		{"rigor", "rigor"},
+		{"delayed", "delai"},
+		{"patches", "patch"},
+		{"ligation", "ligat"},
+		{"routed", "rout"},
+		{"undoubted", "undoubt"},
+		{"friend", "friend"},
+		{"sulphate", "sulphat"},
+		{"produced", "produc"},
+		{"retract", "retract"},
+		{"tilsit", "tilsit"},
+		{"quincy", "quinci"},
+		{"glowed", "glow"},
+		{"ejaculation", "ejacul"},
+		{"rocket", "rocket"},
+		{"remainder", "remaind"},
+		{"vistula", "vistula"},
+		{"righteousness", "righteous"},
+		{"reserve", "reserv"},
+		{"valley", "vallei"},
+		{"inns", "inn"},
+		{"equinoctial", "equinocti"},
+		{"fleecy", "fleeci"},
+		{"astounding", "astound"},
+		{"filarial", "filari"},
+		{"juncture", "junctur"},
+		{"brief", "brief"},
+		{"influencing", "influenc"},
+		{"gather", "gather"},
+		{"montgomery", "montgomeri"},
+		{"plump", "plump"},
+		{"formal", "formal"},
+		{"blackguard", "blackguard"},
+		{"aldrich", "aldrich"},
+		{"kindliness", "kindli"},
+		{"leisure", "leisur"},
+		{"husky", "huski"},
+		{"furnishing", "furnish"},
+		{"tormasov", "tormasov"},
+		{"noticeable", "notic"},
+		{"puckered", "pucker"},
+		{"usually", "usual"},
+		{"bandages", "bandag"},
+		{"roads", "road"},
+		{"jones", "jone"},
+		{"denominations", "denomin"},
+		{"oozing", "ooz"},
+		{"thickenings", "thicken"},
+		{"images", "imag"},
+		{"grekov", "grekov"},
+		{"solemnity", "solemn"},
+		{"settles", "settl"},
+		{"disappointing", "disappoint"},
+		{"delivered", "deliv"},
+		{"momentous", "moment"},
+		{"exposure", "exposur"},
+		{"takings", "take"},
+		{"distributed", "distribut"},
+		{"retorted", "retort"},
+		{"extending", "extend"},
+		{"convince", "convinc"},
+		{"fewer", "fewer"},
+		{"leaped", "leap"},
+		{"vernon", "vernon"},
+		{"sutherland", "sutherland"},
+		{"pistol", "pistol"},
+		{"fingers", "finger"},
+		{"response", "respons"},
+		{"notices", "notic"},
+		{"sacrifices", "sacrific"},
+		{"convicted", "convict"},
+		{"chamberlain", "chamberlain"},
+		{"olive", "oliv"},
+		{"extent", "extent"},
+		{"genital", "genit"},
+		{"veterinary", "veterinari"},
+		{"chattanooga", "chattanooga"},
+		{"diabetic", "diabet"},
+		{"pictured", "pictur"},
+		{"sprain", "sprain"},
+		{"proud", "proud"},
+		{"knuckle", "knuckl"},
+		{"sorrow", "sorrow"},
+		{"swarm", "swarm"},
+		{"prices", "price"},
+		{"linked", "link"},
+		{"thrilling", "thrill"},
+		{"dogs", "dog"},
+		{"walnut", "walnut"},
+		{"crippling", "crippl"},
+		{"energy", "energi"},
+		{"constant", "constant"},
+		{"congressmen", "congressmen"},
+		{"eburnation", "eburn"},
+		{"captain", "captain"},
+		{"lelya", "lelya"},
+		{"sclerosed", "scleros"},
+		{"munition", "munit"},
+		{"inaccessible", "inaccess"},
+		{"lean", "lean"},
+		{"liver", "liver"},
+		{"restlessly", "restlessli"},
+		{"sigh", "sigh"},
+		{"annette", "annett"},
+		{"consideration", "consider"},
+		{"testament", "testament"},
+		{"tooth", "tooth"},
+		{"acquainted", "acquaint"},
+		{"corporation", "corpor"},
+		{"trees", "tree"},
+		{"counter", "counter"},
+		{"cleanliness", "cleanli"},
+		{"confiscated", "confisc"},
+		{"negative", "neg"},
+		{"kalb", "kalb"},
+		{"bathed", "bath"},
+		{"radicals", "radic"},
+		{"aversion", "avers"},
+		{"correspondingly", "correspondingli"},
+		{"amounting", "amount"},
+		{"camp", "camp"},
+		{"germ", "germ"},
+		{"the", "the"},
+		{"alkaline", "alkalin"},
+		{"mansion", "mansion"},
+		{"unobservant", "unobserv"},
+		{"troitsa", "troitsa"},
+		{"articulating", "articul"},
+		{"travail", "travail"},
+		{"tendinitis", "tendin"},
+		{"starve", "starv"},
+		{"devoured", "devour"},
+		{"mindful", "mind"},
+		{"mobilized", "mobil"},
+		{"montreal", "montreal"},
+		{"tilted", "tilt"},
+		{"dorokhov", "dorokhov"},
+		{"zero", "zero"},
+		{"cork", "cork"},
+		{"wandering", "wander"},
+		{"descended", "descend"},
+		{"platoche", "platoch"},
+		{"aggressive", "aggress"},
+		{"dinner", "dinner"},
+		{"review", "review"},
+		{"mucoid", "mucoid"},
+		{"madrid", "madrid"},
+		{"armies", "armi"},
+		{"subjective", "subject"},
+		{"outer", "outer"},
+		{"shining", "shine"},
+		{"malasha", "malasha"},
+		{"shrivelled", "shrivel"},
+		{"feverish", "feverish"},
+		{"commission", "commiss"},
+		{"clattered", "clatter"},
+		{"cincinnati", "cincinnati"},
+		{"hawkers", "hawker"},
+		{"cause", "caus"},
+		{"buckle", "buckl"},
+		{"explore", "explor"},
+		{"strictly", "strictli"},
+		{"wedge", "wedg"},
+		{"metatarso", "metatarso"},
+		{"miles", "mile"},
+		{"waistcoat", "waistcoat"},
+		{"marietta", "marietta"},
+		{"compact", "compact"},
+		{"manifestations", "manifest"},
+		{"throughout", "throughout"},
+		{"grenville", "grenvil"},
+		{"constituent", "constitu"},
+		{"awkward", "awkward"},
+		{"cost", "cost"},
+		{"inclined", "inclin"},
+		{"concentrate", "concentr"},
+		{"bend", "bend"},
+		{"omitting", "omit"},
+		{"not", "not"},
+		{"pitted", "pit"},
+		{"overt", "overt"},
+		{"persevered", "persev"},
+		{"disturb", "disturb"},
+		{"larynx", "larynx"},
+		{"female", "femal"},
+		{"biscuits", "biscuit"},
+		{"pittsburgh", "pittsburgh"},
+		{"sulphur", "sulphur"},
+		{"grandee", "grande"},
+		{"similarly", "similarli"},
+		{"surround", "surround"},
+		{"logs", "log"},
+		{"process", "process"},
+		{"manuscript", "manuscript"},
+		{"economics", "econom"},
+		{"defensive", "defens"},
+		{"heels", "heel"},
+		{"coldly", "coldli"},
+		{"desire", "desir"},
+		{"writes", "write"},
+		{"lightly", "lightli"},
+		{"flashes", "flash"},
+		{"hordes", "hord"},
+		{"instantaneously", "instantan"},
+		{"pools", "pool"},
+		{"canals", "canal"},
+		{"thrust", "thrust"},
+		{"locomotor", "locomotor"},
+		{"likhachev", "likhachev"},
+		{"citizenship", "citizenship"},
+		{"multiply", "multipli"},
+		{"minims", "minim"},
+		{"latch", "latch"},
+		{"colleges", "colleg"},
+		{"grades", "grade"},
+		{"dancers", "dancer"},
+		{"dimmed", "dim"},
+		{"tula", "tula"},
+		{"errors", "error"},
+		{"latin", "latin"},
+		{"cardiac", "cardiac"},
+		{"plums", "plum"},
+		{"starry", "starri"},
+		{"loose", "loos"},
+		{"rete", "rete"},
+		{"federalist", "federalist"},
+		{"painstaking", "painstak"},
+		{"enfranchisement", "enfranchis"},
+		{"trails", "trail"},
+		{"disintegration", "disintegr"},
+		{"exists", "exist"},
+		{"granddad", "granddad"},
+		{"liquor", "liquor"},
+		{"deduction", "deduct"},
+		{"clean", "clean"},
+		{"textile", "textil"},
+		{"supplanted", "supplant"},
+		{"gleam", "gleam"},
+		{"suspicions", "suspicion"},
+		{"partially", "partial"},
+		{"horace", "horac"},
+		{"perspired", "perspir"},
+		{"scattering", "scatter"},
+		{"enraptured", "enraptur"},
+		{"mavra", "mavra"},
+		{"adorer", "ador"},
+		{"espied", "espi"},
+		{"keenest", "keenest"},
+		{"struggle", "struggl"},
+		{"smote", "smote"},
+		{"needlessly", "needlessli"},
+		{"quiver", "quiver"},
+		{"surprise", "surpris"},
+		{"court", "court"},
+		{"unexplored", "unexplor"},
+		{"bowing", "bow"},
+		{"rib", "rib"},
+		{"evolved", "evolv"},
+		{"jaws", "jaw"},
+		{"folly", "folli"},
+		{"empereur", "empereur"},
+		{"kidney", "kidnei"},
+		{"seekers", "seeker"},
+		{"submit", "submit"},
+		{"persistence", "persist"},
+		{"montagu", "montagu"},
+		{"honored", "honor"},
+		{"bigger", "bigger"},
+		{"subtle", "subtl"},
+		{"cortex", "cortex"},
+		{"bulgaria", "bulgaria"},
+		{"scene", "scene"},
+		{"approval", "approv"},
+		{"denunciation", "denunci"},
+		{"broom", "broom"},
+		{"dish", "dish"},
+		{"resultant", "result"},
+		{"make", "make"},
+		{"storm", "storm"},
+		{"alcohol", "alcohol"},
+		{"forefinger", "forefing"},
+		{"wherever", "wherev"},
+		{"cloaks", "cloak"},
+		{"undermining", "undermin"},
+		{"institutions", "institut"},
+		{"own", "own"},
+		{"sway", "swai"},
+		{"grimesby", "grimesbi"},
+		{"lashes", "lash"},
+		{"bedchamber", "bedchamb"},
+		{"hip", "hip"},
+		{"thickly", "thickli"},
+		{"accord", "accord"},
+		{"unions", "union"},
+		{"disquieting", "disquiet"},
+		{"magnanimous", "magnanim"},
+		{"canvas", "canva"},
+		{"narrative", "narr"},
+		{"figs", "fig"},
+		{"clamor", "clamor"},
+		{"prone", "prone"},
+		{"oakshott", "oakshott"},
+		{"incising", "incis"},
+		{"snort", "snort"},
+		{"gravely", "grave"},
+		{"arguments", "argument"},
+		{"fisheries", "fisheri"},
+		{"outgrowth", "outgrowth"},
+		{"telescope", "telescop"},
+		{"mishka", "mishka"},
+		{"shippers", "shipper"},
+		{"diplomatist", "diplomatist"},
+		{"progressing", "progress"},
+		{"daresay", "daresai"},
+		{"farm", "farm"},
+		{"deign", "deign"},
+		{"was", "wa"},
+		{"superseded", "supersed"},
+		{"need", "need"},
+		{"approvingly", "approvingli"},
+		{"dominate", "domin"},
+		{"disillusionment", "disillusion"},
+		{"apocalypse", "apocalyps"},
+		{"responsibilities", "respons"},
+		{"spoke", "spoke"},
+		{"comminution", "comminut"},
+		{"regiments", "regiment"},
+		{"actuated", "actuat"},
+		{"sheets", "sheet"},
+		{"reference", "refer"},
+		{"swelling", "swell"},
+		{"necrotic", "necrot"},
+		{"committing", "commit"},
+		{"scarlatinal", "scarlatin"},
+		{"constantly", "constantli"},
+		{"cavities", "caviti"},
+		{"pal", "pal"},
+		{"tiptoe", "tipto"},
+		{"glans", "glan"},
+		{"eighty", "eighti"},
+		{"preobrazhensk", "preobrazhensk"},
+		{"exposition", "exposit"},
+		{"noticeably", "notic"},
+		{"indies", "indi"},
+		{"depressed", "depress"},
+		{"accomplish", "accomplish"},
+		{"influence", "influenc"},
+		{"tom", "tom"},
+		{"concord", "concord"},
+		{"constricting", "constrict"},
+		{"implied", "impli"},
+		{"granulation", "granul"},
+		{"toxaemia", "toxaemia"},
+		{"fault", "fault"},
+		{"observing", "observ"},
+		{"bacterium", "bacterium"},
+		{"correspond", "correspond"},
+		{"hydatid", "hydatid"},
+		{"bridegroom", "bridegroom"},
+		{"sharpened", "sharpen"},
+		{"waddling", "waddl"},
+		{"provision", "provis"},
+		{"adjourned", "adjourn"},
+		{"enclosed", "enclos"},
+		{"whitney", "whitnei"},
+		{"avec", "avec"},
+		{"thriving", "thrive"},
+		{"caressed", "caress"},
+		{"inhaling", "inhal"},
+		{"hey", "hei"},
+		{"enforce", "enforc"},
+		{"tenderly", "tenderli"},
+		{"meat", "meat"},
+		{"obscurity", "obscur"},
+		{"venezuelan", "venezuelan"},
+		{"youngest", "youngest"},
+		{"grunth", "grunth"},
+		{"pronouncing", "pronounc"},
+		{"making", "make"},
+		{"unwounded", "unwound"},
+		{"subcutaneously", "subcutan"},
+		{"actress", "actress"},
+		{"ascertain", "ascertain"},
+		{"huts", "hut"},
+		{"disorganized", "disorgan"},
+		{"gay", "gai"},
+		{"briony", "brioni"},
+		{"benefited", "benefit"},
+		{"him", "him"},
+		{"smoked", "smoke"},
+		{"orator", "orat"},
+		{"vewy", "vewi"},
+		{"spite", "spite"},
+		{"offset", "offset"},
+		{"utility", "util"},
+		{"papular", "papular"},
+		{"marriages", "marriag"},
+		{"wrote", "wrote"},
+		{"manipulations", "manipul"},
+		{"flex", "flex"},
+		{"overhear", "overhear"},
+		{"ambitions", "ambit"},
+		{"haversian", "haversian"},
+		{"commanders", "command"},
+		{"volley", "vollei"},
+		{"riot", "riot"},
+		{"versailles", "versail"},
+		{"hurried", "hurri"},
+		{"intimating", "intim"},
+		{"temptation", "temptat"},
+		{"adjacent", "adjac"},
+		{"trabecular", "trabecular"},
+		{"sized", "size"},
+		{"bloodthirsty", "bloodthirsti"},
+		{"highest", "highest"},
+		{"skirted", "skirt"},
+		{"shadow", "shadow"},
+		{"chanced", "chanc"},
+		{"emerge", "emerg"},
+		{"furniture", "furnitur"},
+		{"prestige", "prestig"},
+		{"adenitis", "aden"},
+		{"happen", "happen"},
+		{"defending", "defend"},
+		{"bush", "bush"},
+		{"characteristics", "characterist"},
+		{"soiree", "soire"},
+		{"rain", "rain"},
+		{"comical", "comic"},
+		{"missing", "miss"},
+		{"forts", "fort"},
+		{"minerals", "miner"},
+		{"waiter", "waiter"},
+		{"sketched", "sketch"},
+		{"yankee", "yanke"},
+		{"consul", "consul"},
+		{"maria", "maria"},
+		{"mikhaylovich", "mikhaylovich"},
+		{"micro", "micro"},
+		{"protests", "protest"},
+		{"quiescent", "quiescent"},
+		{"bilateral", "bilater"},
+		{"fought", "fought"},
+		{"embroidery", "embroideri"},
+		{"inhabitants", "inhabit"},
+		{"rico", "rico"},
+		{"actresses", "actress"},
+		{"threatened", "threaten"},
+		{"bulk", "bulk"},
+		{"pomp", "pomp"},
+		{"counted", "count"},
+		{"preside", "presid"},
+		{"stubborn", "stubborn"},
+		{"bonfire", "bonfir"},
+		{"metatarsal", "metatars"},
+		{"jay", "jai"},
+		{"noise", "nois"},
+		{"appearing", "appear"},
+		{"springfield", "springfield"},
+		{"latent", "latent"},
+		{"visit", "visit"},
+		{"relations", "relat"},
+		{"tartar", "tartar"},
+		{"adhere", "adher"},
+		{"standpoint", "standpoint"},
+		{"excises", "excis"},
+		{"bogart", "bogart"},
+		{"synostosis", "synostosi"},
+		{"embodied", "embodi"},
+		{"horrid", "horrid"},
+		{"whites", "white"},
+		{"students", "student"},
+		{"denial", "denial"},
+		{"captivity", "captiv"},
+		{"heartless", "heartless"},
+		{"pool", "pool"},
+		{"corium", "corium"},
+		{"traced", "trace"},
+		{"northwest", "northwest"},
+		{"satisfy", "satisfi"},
+		{"trousers", "trouser"},
+		{"ahead", "ahead"},
+		{"friendliness", "friendli"},
+		{"immigrants", "immigr"},
+		{"accept", "accept"},
+		{"landau", "landau"},
+		{"uncertainty", "uncertainti"},
+		{"fibroblasts", "fibroblast"},
+		{"deficiency", "defici"},
+		{"sensibility", "sensibl"},
+		{"boom", "boom"},
+		{"knives", "knive"},
+		{"felony", "feloni"},
+		{"allowed", "allow"},
+		{"rostopchin", "rostopchin"},
+		{"fame", "fame"},
+		{"leucocytosis", "leucocytosi"},
+		{"sophia", "sophia"},
+		{"myoma", "myoma"},
+		{"ami", "ami"},
+		{"platov", "platov"},
+		{"searches", "search"},
+		{"torsion", "torsion"},
+		{"advantages", "advantag"},
+		{"omentum", "omentum"},
+		{"physics", "physic"},
+		{"trampling", "trampl"},
+		{"singular", "singular"},
+		{"petty", "petti"},
+		{"hummed", "hum"},
+		{"clothes", "cloth"},
+		{"imposts", "impost"},
+		{"reality", "realiti"},
+		{"munitions", "munit"},
+		{"dense", "dens"},
+		{"northeast", "northeast"},
+		{"expel", "expel"},
+		{"reigned", "reign"},
+		{"panes", "pane"},
+		{"meekly", "meekli"},
+		{"tree", "tree"},
+		{"thought", "thought"},
+		{"program", "program"},
+		{"plucked", "pluck"},
+		{"paint", "paint"},
+		{"feet", "feet"},
+		{"omitted", "omit"},
+		{"dissect", "dissect"},
+		{"belova", "belova"},
+		{"lorgnette", "lorgnett"},
+		{"reabsorbed", "reabsorb"},
+		{"enns", "enn"},
+		{"fringes", "fring"},
+		{"retarded", "retard"},
+		{"preoccupied", "preoccupi"},
+		{"consigned", "consign"},
+		{"inquire", "inquir"},
+		{"bagration", "bagrat"},
+		{"redress", "redress"},
+		{"blunders", "blunder"},
+		{"sharp", "sharp"},
+		{"imparts", "impart"},
+		{"note", "note"},
+		{"media", "media"},
+		{"reigning", "reign"},
+		{"expanding", "expand"},
+		{"rather", "rather"},
+		{"build", "build"},
+		{"jena", "jena"},
+		{"specimen", "specimen"},
+		{"trephine", "trephin"},
+		{"break", "break"},
+		{"comb", "comb"},
+		{"panorama", "panorama"},
+		{"farewell", "farewel"},
+		{"sanctioned", "sanction"},
+		{"soil", "soil"},
+		{"staccato", "staccato"},
+		{"allow", "allow"},
+		{"general", "gener"},
+		{"elephantiasis", "elephantiasi"},
+		{"enraged", "enrag"},
+		{"impetuosity", "impetuos"},
+		{"frost", "frost"},
+		{"animal", "anim"},
+		{"marks", "mark"},
+		{"stores", "store"},
+		{"attorney", "attornei"},
+		{"differ", "differ"},
+		{"france", "franc"},
+		{"smuggled", "smuggl"},
+		{"privilege", "privileg"},
+		{"urging", "urg"},
+		{"salicylic", "salicyl"},
+		{"bruising", "bruis"},
+		{"salary", "salari"},
+		{"discoloration", "discolor"},
+		{"parched", "parch"},
+		{"lise", "lise"},
+		{"male", "male"},
+		{"progression", "progress"},
+		{"comparison", "comparison"},
+		{"longitudinal", "longitudin"},
+		{"destroys", "destroi"},
+		{"jacobin", "jacobin"},
+		{"stake", "stake"},
+		{"fastened", "fasten"},
+		{"overtake", "overtak"},
+		{"resemble", "resembl"},
+		{"infrequently", "infrequ"},
+		{"flight", "flight"},
+		{"plumber", "plumber"},
+		{"both", "both"},
+		{"vigilance", "vigil"},
+		{"ville", "vill"},
+		{"manifest", "manifest"},
+		{"smugglers", "smuggler"},
+		{"reserving", "reserv"},
+		{"multiple", "multipl"},
+		{"demarcation", "demarc"},
+		{"semilunar", "semilunar"},
+		{"nasi", "nasi"},
+		{"phenomena", "phenomena"},
+		{"melancholy", "melancholi"},
+		{"extravasations", "extravas"},
+		{"victim", "victim"},
+		{"diagnostic", "diagnost"},
+		{"merrier", "merrier"},
+		{"praising", "prais"},
+		{"sutlers", "sutler"},
+		{"adequate", "adequ"},
+		{"insults", "insult"},
+		{"wretch", "wretch"},
+		{"abbe", "abb"},
+		{"ready", "readi"},
+		{"courtier", "courtier"},
+		{"explaining", "explain"},
+		{"partly", "partli"},
+		{"advent", "advent"},
+		{"supervise", "supervis"},
+		{"merest", "merest"},
+		{"hebrew", "hebrew"},
+		{"south", "south"},
+		{"rein", "rein"},
+		{"chambers", "chamber"},
+		{"without", "without"},
+		{"air", "air"},
+		{"continents", "contin"},
+		{"finances", "financ"},
+		{"homeward", "homeward"},
+		{"thermometer", "thermomet"},
+		{"daily", "daili"},
+		{"xvi", "xvi"},
+		{"poetry", "poetri"},
+		{"survived", "surviv"},
+		{"intrigue", "intrigu"},
+		{"par", "par"},
+		{"relied", "reli"},
+		{"sense", "sens"},
+		{"bedside", "bedsid"},
+		{"familiarity", "familiar"},
+		{"moscow", "moscow"},
+		{"obstinacy", "obstinaci"},
+		{"sparkled", "sparkl"},
+		{"indifferent", "indiffer"},
+		{"ripe", "ripe"},
+		{"charmant", "charmant"},
+		{"landless", "landless"},
+		{"treat", "treat"},
+		{"question", "question"},
+		{"destructive", "destruct"},
+		{"really", "realli"},
+		{"bacteria", "bacteria"},
+		{"abstraction", "abstract"},
+		{"designation", "design"},
+		{"entries", "entri"},
+		{"charcot", "charcot"},
+		{"fissures", "fissur"},
+		{"drug", "drug"},
+		{"killing", "kill"},
+		{"oedema", "oedema"},
+		{"sentences", "sentenc"},
+		{"soften", "soften"},
+		{"greenish", "greenish"},
+		{"splashed", "splash"},
+		{"varix", "varix"},
+		{"knowledge", "knowledg"},
+		{"plain", "plain"},
+		{"trustees", "truste"},
+		{"safeguards", "safeguard"},
+		{"hostess", "hostess"},
+		{"african", "african"},
+		{"panama", "panama"},
+		{"trench", "trench"},
+		{"vanishing", "vanish"},
+		{"squadron", "squadron"},
+		{"miss", "miss"},
+		{"vena", "vena"},
+		{"imaginary", "imaginari"},
+		{"situation", "situat"},
+		{"tillage", "tillag"},
+		{"pernicious", "pernici"},
+		{"window", "window"},
+		{"eight", "eight"},
+		{"assembled", "assembl"},
+		{"reins", "rein"},
+		{"abroad", "abroad"},
+		{"illogical", "illog"},
+		{"indifferently", "indiffer"},
+		{"howl", "howl"},
+		{"settlements", "settlement"},
+		{"gift", "gift"},
+		{"pleas", "plea"},
+		{"finger", "finger"},
+		{"casually", "casual"},
+		{"promptly", "promptli"},
+		{"conquests", "conquest"},
+		{"labels", "label"},
+		{"uttering", "utter"},
+		{"leaking", "leak"},
+		{"interstate", "interst"},
+		{"novgorod", "novgorod"},
+		{"joint", "joint"},
+		{"ecchymosis", "ecchymosi"},
+		{"converting", "convert"},
+		{"are", "ar"},
+		{"plea", "plea"},
+		{"personality", "person"},
+		{"observant", "observ"},
+		{"discrete", "discret"},
+		{"audible", "audibl"},
+		{"yard", "yard"},
+		{"zherkov", "zherkov"},
+		{"alimentary", "alimentari"},
+		{"venom", "venom"},
+		{"kindled", "kindl"},
+		{"wolzogen", "wolzogen"},
+		{"decoration", "decor"},
+		{"departure", "departur"},
+		{"distended", "distend"},
+		{"amsterdam", "amsterdam"},
+		{"antichrist", "antichrist"},
+		{"indiana", "indiana"},
+		{"widely", "wide"},
+		{"youths", "youth"},
+		{"tabes", "tabe"},
+		{"presided", "presid"},
+		{"exclusively", "exclus"},
+		{"varicosity", "varicos"},
+		{"neurotic", "neurot"},
+		{"sire", "sire"},
+		{"griffe", "griff"},
+		{"sinners", "sinner"},
+		{"duration", "durat"},
+		{"dusty", "dusti"},
+		{"murmured", "murmur"},
+		{"piti", "piti"},
+		{"stimulants", "stimul"},
+		{"convinces", "convinc"},
+		{"softening", "soften"},
+		{"conversing", "convers"},
+		{"tapped", "tap"},
+		{"conspicuous", "conspicu"},
+		{"statutes", "statut"},
+		{"souls", "soul"},
+		{"distances", "distanc"},
+		{"separating", "separ"},
+		{"rear", "rear"},
+		{"knit", "knit"},
+		{"warships", "warship"},
+		{"contrat", "contrat"},
+		{"carcinoma", "carcinoma"},
+		{"feeble", "feebl"},
+		{"forceps", "forcep"},
+		{"eleven", "eleven"},
+		{"hungary", "hungari"},
+		{"great", "great"},
+		{"hinted", "hint"},
+		{"ditch", "ditch"},
+		{"properties", "properti"},
+		{"succeeded", "succeed"},
+		{"manorial", "manori"},
+		{"revived", "reviv"},
+		{"obscured", "obscur"},
+		{"writhed", "writh"},
+		{"magnanimity", "magnanim"},
+		{"centre", "centr"},
+		{"oven", "oven"},
+		{"onset", "onset"},
+		{"apprehension", "apprehens"},
+		{"dimness", "dim"},
+		{"clayton", "clayton"},
+		{"reformers", "reform"},
+		{"breathe", "breath"},
+		{"bondage", "bondag"},
+		{"jerk", "jerk"},
+		{"scores", "score"},
+		{"stammered", "stammer"},
+		{"literally", "liter"},
+		{"slur", "slur"},
+		{"stones", "stone"},
+		{"whom", "whom"},
+		{"cartilages", "cartilag"},
+		{"witnesses", "wit"},
+		{"lest", "lest"},
+		{"orgies", "orgi"},
+		{"harsh", "harsh"},
+		{"muskets", "musket"},
+		{"due", "due"},
+		{"warren", "warren"},
+		{"heaviness", "heavi"},
+		{"insulted", "insult"},
+		{"vigor", "vigor"},
+		{"spoiling", "spoil"},
+		{"nearer", "nearer"},
+		{"dorogomilov", "dorogomilov"},
+		{"heaping", "heap"},
+		{"brave", "brave"},
+		{"sebaceous", "sebac"},
+		{"detailed", "detail"},
+		{"mistaken", "mistaken"},
+		{"asleep", "asleep"},
+		{"line", "line"},
+		{"entrez", "entrez"},
+		{"agreed", "agre"},
+		{"absorption", "absorpt"},
+		{"voltaire", "voltair"},
+		{"babies", "babi"},
+		{"dress", "dress"},
+		{"attach", "attach"},
+		{"pips", "pip"},
+		{"confine", "confin"},
+		{"date", "date"},
+		{"debility", "debil"},
+		{"viscera", "viscera"},
+		{"waited", "wait"},
+		{"disregarding", "disregard"},
+		{"expressive", "express"},
+		{"arrived", "arriv"},
+		{"records", "record"},
+		{"museum", "museum"},
+		{"bricks", "brick"},
+		{"preying", "prei"},
+		{"origin", "origin"},
+		{"plains", "plain"},
+		{"agencies", "agenc"},
+		{"aching", "ach"},
+		{"partisan", "partisan"},
+		{"secretions", "secret"},
+		{"chilblains", "chilblain"},
+		{"adopted", "adopt"},
+		{"definitely", "definit"},
+		{"sleeplessness", "sleepless"},
+		{"terrible", "terribl"},
+		{"sclavo", "sclavo"},
+		{"vested", "vest"},
+		{"epiphysial", "epiphysi"},
+		{"vacantly", "vacantli"},
+		{"declaratory", "declaratori"},
+		{"nucleus", "nucleu"},
+		{"mean", "mean"},
+		{"clots", "clot"},
+		{"tenfold", "tenfold"},
+		{"reasoning", "reason"},
+		{"discussed", "discuss"},
+		{"chicago", "chicago"},
+		{"justifying", "justifi"},
+		{"funds", "fund"},
+		{"armaments", "armament"},
+		{"division", "divis"},
+		{"valued", "valu"},
+		{"throne", "throne"},
+		{"opponent", "oppon"},
+		{"acquaintances", "acquaint"},
+		{"tied", "ti"},
+		{"aren", "aren"},
+		{"actually", "actual"},
+		{"slapped", "slap"},
+		{"home", "home"},
+		{"indignant", "indign"},
+		{"argue", "argu"},
+		{"hesitating", "hesit"},
+		{"affecting", "affect"},
+		{"embarrassment", "embarrass"},
+		{"philosophic", "philosoph"},
+		{"float", "float"},
+		{"growths", "growth"},
+		{"terenty", "terenti"},
+		{"northwestern", "northwestern"},
+		{"special", "special"},
+		{"eccentric", "eccentr"},
+		{"view", "view"},
+		{"disadvantages", "disadvantag"},
+		{"farcy", "farci"},
+		{"rejoin", "rejoin"},
+		{"diaz", "diaz"},
+		{"prohibitive", "prohibit"},
+		{"doubted", "doubt"},
+		{"scorn", "scorn"},
+		{"sound", "sound"},
+		{"structures", "structur"},
+		{"fog", "fog"},
+		{"key", "kei"},
+		{"melody", "melodi"},
+		{"below", "below"},
+		{"agitating", "agit"},
+		{"distance", "distanc"},
+		{"rending", "rend"},
+		{"entangled", "entangl"},
+		{"watson", "watson"},
+		{"incomes", "incom"},
+		{"simulate", "simul"},
+		{"deserved", "deserv"},
+		{"collision", "collis"},
+		{"likened", "liken"},
+		{"surly", "surli"},
+		{"apt", "apt"},
+		{"office", "offic"},
+		{"clinton", "clinton"},
+		{"aristocratic", "aristocrat"},
+		{"reporting", "report"},
+		{"insisting", "insist"},
+		{"admit", "admit"},
+		{"arisen", "arisen"},
+		{"portages", "portag"},
+		{"stick", "stick"},
+		{"valuevo", "valuevo"},
+		{"rushing", "rush"},
+		{"pharyngeal", "pharyng"},
+		{"consulting", "consult"},
+		{"pillage", "pillag"},
+		{"disapproved", "disapprov"},
+		{"gangrene", "gangren"},
+		{"dreary", "dreari"},
+		{"slopes", "slope"},
+		{"prolong", "prolong"},
+		{"herr", "herr"},
+		{"has", "ha"},
+		{"tyrannical", "tyrann"},
+		{"baked", "bake"},
+		{"exerted", "exert"},
+		{"derive", "deriv"},
+		{"ancient", "ancient"},
+		{"romantic", "romant"},
+		{"applicable", "applic"},
+		{"proportionate", "proportion"},
+		{"ossificans", "ossifican"},
+		{"characterised", "characteris"},
+		{"relaxation", "relax"},
+		{"loving", "love"},
+		{"whisper", "whisper"},
+		{"doubly", "doubli"},
+		{"wheat", "wheat"},
+		{"furiously", "furious"},
+		{"unnatural", "unnatur"},
+		{"affinity", "affin"},
+		{"checking", "check"},
+		{"baring", "bare"},
+		{"autobiography", "autobiographi"},
+		{"portmanteaus", "portmanteau"},
+		{"flow", "flow"},
+		{"undisturbed", "undisturb"},
+		{"predominate", "predomin"},
+		{"renounced", "renounc"},
+		{"abuse", "abus"},
+		{"occlude", "occlud"},
+		{"bacillus", "bacillu"},
+		{"moon", "moon"},
+		{"ring", "ring"},
+		{"rabies", "rabi"},
+		{"cathedral", "cathedr"},
+		{"shortening", "shorten"},
+		{"sanction", "sanction"},
+		{"apartment", "apart"},
+		{"toxin", "toxin"},
+		{"toll", "toll"},
+		{"pelageya", "pelageya"},
+		{"undertaking", "undertak"},
+		{"bark", "bark"},
+		{"inwardly", "inwardli"},
+		{"aaron", "aaron"},
+		{"intended", "intend"},
+		{"specific", "specif"},
+		{"crimson", "crimson"},
+		{"wives", "wive"},
+		{"fervor", "fervor"},
+		{"sucked", "suck"},
+		{"liquid", "liquid"},
+		{"bile", "bile"},
+		{"anxious", "anxiou"},
+		{"particle", "particl"},
+		{"follicles", "follicl"},
+		{"atmosphere", "atmospher"},
+		{"dehydrated", "dehydr"},
+		{"grabbed", "grab"},
+		{"tenn", "tenn"},
+		{"xxii", "xxii"},
+		{"filament", "filament"},
+		{"sullen", "sullen"},
+		{"polypus", "polypu"},
+		{"nationalism", "nation"},
+		{"occluding", "occlud"},
+		{"forced", "forc"},
+		{"southern", "southern"},
+		{"asia", "asia"},
+		{"applies", "appli"},
+		{"prepuce", "prepuc"},
+		{"timid", "timid"},
+		{"ought", "ought"},
+		{"doubtless", "doubtless"},
+		{"davydov", "davydov"},
+		{"triumphant", "triumphant"},
+		{"blame", "blame"},
+		{"soda", "soda"},
+		{"expand", "expand"},
+		{"picric", "picric"},
+		{"pretexts", "pretext"},
+		{"interruption", "interrupt"},
+		{"increase", "increas"},
+		{"counsels", "counsel"},
+		{"get", "get"},
+		{"gradual", "gradual"},
+		{"carolinas", "carolina"},
+		{"proved", "prove"},
+		{"icon", "icon"},
+		{"sometimes", "sometim"},
+		{"platoon", "platoon"},
+		{"range", "rang"},
+		{"laugh", "laugh"},
+		{"enter", "enter"},
+		{"wine", "wine"},
+		{"glimpses", "glimps"},
+		{"watch", "watch"},
+		{"bruised", "bruis"},
+		{"rooms", "room"},
+		{"suggest", "suggest"},
+		{"fusion", "fusion"},
+		{"appropriate", "appropri"},
+		{"commencing", "commenc"},
+		{"danced", "danc"},
+		{"moulded", "mould"},
+		{"tempting", "tempt"},
+		{"crude", "crude"},
+		{"china", "china"},
+		{"gloomy", "gloomi"},
+		{"cruisers", "cruiser"},
+		{"bitter", "bitter"},
+		{"painting", "paint"},
+		{"still", "still"},
+		{"bilibin", "bilibin"},
+		{"postpone", "postpon"},
+		{"unaltered", "unalt"},
+		{"another", "anoth"},
+		{"sounds", "sound"},
+		{"flower", "flower"},
+		{"obstinate", "obstin"},
+		{"thy", "thy"},
+		{"slums", "slum"},
+		{"injections", "inject"},
+		{"seize", "seiz"},
+		{"projecting", "project"},
+		{"from", "from"},
+		{"destination", "destin"},
+		{"plexiform", "plexiform"},
+		{"ovarian", "ovarian"},
+		{"committed", "commit"},
+		{"reversal", "revers"},
+		{"curses", "curs"},
+		{"epicritic", "epicrit"},
+		{"depend", "depend"},
+		{"restraint", "restraint"},
+		{"cheetah", "cheetah"},
+		{"repair", "repair"},
+		{"olecranon", "olecranon"},
+		{"theme", "theme"},
+		{"blushes", "blush"},
+		{"abandoned", "abandon"},
+		{"platon", "platon"},
+		{"adept", "adept"},
+		{"wring", "wring"},
+		{"cleared", "clear"},
+		{"proving", "prove"},
+		{"section", "section"},
+		{"paper", "paper"},
+		{"homely", "home"},
+		{"sorrowfully", "sorrowfulli"},
+		{"lowell", "lowel"},
+		{"particular", "particular"},
+		{"evil", "evil"},
+		{"admitting", "admit"},
+		{"loathing", "loath"},
+		{"consolidation", "consolid"},
+		{"lawyers", "lawyer"},
+		{"improvements", "improv"},
+		{"puppet", "puppet"},
+		{"bory", "bori"},
+		{"puncture", "punctur"},
+		{"ridiculed", "ridicul"},
+		{"assembling", "assembl"},
+		{"designs", "design"},
+		{"robbing", "rob"},
+		{"imitated", "imit"},
+		{"exhibiting", "exhibit"},
+		{"prophesied", "prophesi"},
+		{"remedies", "remedi"},
+		{"fist", "fist"},
+		{"bloom", "bloom"},
+		{"pet", "pet"},
+		{"berkshire", "berkshir"},
+		{"poorer", "poorer"},
+		{"increasingly", "increasingli"},
+		{"mind", "mind"},
+		{"enormously", "enorm"},
+		{"trigeminal", "trigemin"},
+		{"abstract", "abstract"},
+		{"conquest", "conquest"},
+		{"elaborate", "elabor"},
+		{"golden", "golden"},
+		{"limitation", "limit"},
+		{"glistening", "glisten"},
+		{"endemic", "endem"},
+		{"scratching", "scratch"},
+		{"commiseration", "commiser"},
+		{"moore", "moor"},
+		{"federate", "feder"},
+		{"colorado", "colorado"},
+		{"rushed", "rush"},
+		{"during", "dure"},
+		{"deeds", "deed"},
+		{"crackling", "crackl"},
+		{"renal", "renal"},
+		{"resigned", "resign"},
+		{"obstructing", "obstruct"},
+		{"lawful", "law"},
+		{"observe", "observ"},
+		{"banner", "banner"},
+		{"xxvii", "xxvii"},
+		{"duties", "duti"},
+		{"curious", "curiou"},
+		{"agitation", "agit"},
+		{"achillo", "achillo"},
+		{"ankylosis", "ankylosi"},
+		{"vulva", "vulva"},
+		{"for", "for"},
+		{"infinite", "infinit"},
+		{"lamented", "lament"},
+		{"houston", "houston"},
+		{"energetically", "energet"},
+		{"carousals", "carous"},
+		{"russo", "russo"},
+		{"francisco", "francisco"},
+		{"exports", "export"},
+		{"drilled", "drill"},
+		{"sure", "sure"},
+		{"conceive", "conceiv"},
+		{"horses", "hors"},
+		{"faded", "fade"},
+		{"completeness", "complet"},
+		{"argued", "argu"},
+		{"thinkers", "thinker"},
+		{"disguise", "disguis"},
+		{"iowa", "iowa"},
+		{"stern", "stern"},
+		{"infecting", "infect"},
+		{"bryce", "bryce"},
+		{"course", "cours"},
+		{"sustaining", "sustain"},
+		{"breckinridge", "breckinridg"},
+		{"scherbinin", "scherbinin"},
+		{"conceal", "conceal"},
+		{"anal", "anal"},
+		{"combine", "combin"},
+		{"brothers", "brother"},
+		{"systematic", "systemat"},
+		{"decides", "decid"},
+		{"nonsense", "nonsens"},
+		{"research", "research"},
+		{"vice", "vice"},
+		{"downfall", "downfal"},
+		{"centered", "center"},
+		{"staring", "stare"},
+		{"prescribed", "prescrib"},
+		{"ulnar", "ulnar"},
+		{"glanders", "glander"},
+		{"reverie", "reveri"},
+		{"event", "event"},
+		{"vanish", "vanish"},
+		{"cartoon", "cartoon"},
+		{"communication", "commun"},
+		{"exultantly", "exultantli"},
+		{"science", "scienc"},
+		{"measuring", "measur"},
+		{"adds", "add"},
+		{"posted", "post"},
+		{"writs", "writ"},
+		{"roosevelt", "roosevelt"},
+		{"shafter", "shafter"},
+		{"rhetor", "rhetor"},
+		{"robinson", "robinson"},
+		{"arbat", "arbat"},
+		{"doors", "door"},
+		{"melaena", "melaena"},
+		{"makarin", "makarin"},
+		{"seizure", "seizur"},
+		{"impaired", "impair"},
+		{"offenders", "offend"},
+		{"deciding", "decid"},
+		{"incredulously", "incredul"},
+		{"summarize", "summar"},
+		{"recall", "recal"},
+		{"horseflesh", "horseflesh"},
+		{"axis", "axi"},
+		{"aggravate", "aggrav"},
+		{"shamshevo", "shamshevo"},
+		{"detachment", "detach"},
+		{"cholestrol", "cholestrol"},
+		{"mouths", "mouth"},
+		{"organizations", "organ"},
+		{"repress", "repress"},
+		{"climbed", "climb"},
+		{"horrors", "horror"},
+		{"refreshment", "refresh"},
+		{"relieved", "reliev"},
+		{"aimed", "aim"},
+		{"languages", "languag"},
+		{"piercingly", "piercingli"},
+		{"sympathizers", "sympath"},
+		{"single", "singl"},
+		{"makarovna", "makarovna"},
+		{"glazed", "glaze"},
+		{"shouted", "shout"},
+		{"illuminated", "illumin"},
+		{"deception", "decept"},
+		{"reserved", "reserv"},
+		{"wasted", "wast"},
+		{"corners", "corner"},
+		{"actors", "actor"},
+		{"deposition", "deposit"},
+		{"stamp", "stamp"},
+		{"fonder", "fonder"},
+		{"nicaragua", "nicaragua"},
+		{"oeuvres", "oeuvr"},
+		{"herd", "herd"},
+		{"mare", "mare"},
+		{"determine", "determin"},
+		{"highways", "highwai"},
+		{"choice", "choic"},
+		{"councils", "council"},
+		{"coalition", "coalit"},
+		{"paine", "pain"},
+		{"emigration", "emigr"},
+		{"practical", "practic"},
+		{"order", "order"},
+		{"clenched", "clench"},
+		{"shod", "shod"},
+		{"sarcasm", "sarcasm"},
+		{"thyself", "thyself"},
+		{"exacting", "exact"},
+		{"waltz", "waltz"},
+		{"slain", "slain"},
+		{"admiration", "admir"},
+		{"dictated", "dictat"},
+		{"front", "front"},
+		{"averting", "avert"},
+		{"discharging", "discharg"},
+		{"inflammation", "inflamm"},
+		{"enable", "enabl"},
+		{"lusitania", "lusitania"},
+		{"sapraemia", "sapraemia"},
+		{"greedy", "greedi"},
+		{"hole", "hole"},
+		{"theodosia", "theodosia"},
+		{"overtaken", "overtaken"},
+		{"bet", "bet"},
+		{"load", "load"},
+		{"disobedience", "disobedi"},
+		{"excitability", "excit"},
+		{"booty", "booti"},
+		{"belief", "belief"},
+		{"haematuria", "haematuria"},
+		{"figured", "figur"},
+		{"behold", "behold"},
+		{"tranquil", "tranquil"},
+		{"sooner", "sooner"},
+		{"coasts", "coast"},
+		{"proceedings", "proceed"},
+		{"sensitive", "sensit"},
+		{"michaud", "michaud"},
+		{"armfeldt", "armfeldt"},
+		{"maidservant", "maidserv"},
+		{"peri", "peri"},
+		{"reluctantly", "reluctantli"},
+		{"canteen", "canteen"},
+		{"watchhouse", "watchhous"},
+		{"perilous", "peril"},
+		{"marshals", "marshal"},
+		{"isolation", "isol"},
+		{"enfeebled", "enfeebl"},
+		{"unparalleled", "unparallel"},
+		{"bounding", "bound"},
+		{"plantations", "plantat"},
+		{"toil", "toil"},
+		{"barber", "barber"},
+		{"peasant", "peasant"},
+		{"cervical", "cervic"},
+		{"reflections", "reflect"},
+		{"sentimental", "sentiment"},
+		{"liberation", "liber"},
+		{"summer", "summer"},
+		{"ing", "ing"},
+		{"whether", "whether"},
+		{"mines", "mine"},
+		{"perched", "perch"},
+		{"richest", "richest"},
+		{"lift", "lift"},
+		{"proprieties", "proprieti"},
+		{"distracted", "distract"},
+		{"established", "establish"},
+		{"nominal", "nomin"},
+		{"printed", "print"},
+		{"laying", "lai"},
+		{"rinsed", "rins"},
+		{"lashed", "lash"},
+		{"neighbors", "neighbor"},
+		{"lymphatic", "lymphat"},
+		{"varieties", "varieti"},
+		{"sweating", "sweat"},
+		{"edinburgh", "edinburgh"},
+		{"gleeful", "gleeful"},
+		{"consulted", "consult"},
+		{"seventeenth", "seventeenth"},
+		{"whereabouts", "whereabout"},
+		{"blowing", "blow"},
+		{"dancer", "dancer"},
+		{"purifying", "purifi"},
+		{"mercury", "mercuri"},
+		{"master", "master"},
+		{"resorted", "resort"},
+		{"angle", "angl"},
+		{"partition", "partit"},
+		{"assumed", "assum"},
+		{"offense", "offens"},
+		{"lincoln", "lincoln"},
+		{"gauze", "gauz"},
+		{"sero", "sero"},
+		{"dolorosa", "dolorosa"},
+		{"sheer", "sheer"},
+		{"interesting", "interest"},
+		{"patriots", "patriot"},
+		{"pieces", "piec"},
+		{"suggesting", "suggest"},
+		{"telegraph", "telegraph"},
+		{"generalization", "gener"},
+		{"muscle", "muscl"},
+		{"apparatus", "apparatu"},
+		{"unawares", "unawar"},
+		{"approach", "approach"},
+		{"daughter", "daughter"},
+		{"claw", "claw"},
+		{"steward", "steward"},
+		{"shoes", "shoe"},
+		{"impassive", "impass"},
+		{"slaughtered", "slaughter"},
+		{"closes", "close"},
+		{"station", "station"},
+		{"blocked", "block"},
+		{"horn", "horn"},
+		{"sell", "sell"},
+		{"button", "button"},
+		{"inaugural", "inaugur"},
+		{"begin", "begin"},
+		{"frontiers", "frontier"},
+		{"venezuela", "venezuela"},
+		{"organize", "organ"},
+		{"patch", "patch"},
+		{"manila", "manila"},
+		{"handkerchief", "handkerchief"},
+		{"epiphyses", "epiphys"},
+		{"mummy", "mummi"},
+		{"frank", "frank"},
+		{"ostrovna", "ostrovna"},
+		{"emitted", "emit"},
+		{"equal", "equal"},
+		{"clinking", "clink"},
+		{"anna", "anna"},
+		{"phrased", "phrase"},
+		{"arab", "arab"},
+		{"rejected", "reject"},
+		{"collided", "collid"},
+		{"flushed", "flush"},
+		{"grooves", "groov"},
+		{"inclination", "inclin"},
+		{"printers", "printer"},
+		{"inquired", "inquir"},
+		{"collective", "collect"},
+		{"maintain", "maintain"},
+		{"aspirated", "aspir"},
+		{"successes", "success"},
+		{"cure", "cure"},
+		{"drowned", "drown"},
+		{"buxhowden", "buxhowden"},
+		{"alteration", "alter"},
+		{"malignant", "malign"},
+		{"chilblain", "chilblain"},
+		{"clonic", "clonic"},
+		{"ointment", "ointment"},
+		{"profundity", "profund"},
+		{"ten", "ten"},
+		{"continual", "continu"},
+		{"comply", "compli"},
+		{"present", "present"},
+		{"technical", "technic"},
+		{"roundly", "roundli"},
+		{"engage", "engag"},
+		{"lids", "lid"},
+		{"hostile", "hostil"},
+		{"positions", "posit"},
+		{"protoplasm", "protoplasm"},
+		{"plants", "plant"},
+		{"advances", "advanc"},
+		{"announcement", "announc"},
+		{"mimi", "mimi"},
+		{"boomed", "boom"},
+		{"adraksin", "adraksin"},
+		{"caucus", "caucu"},
+		{"twisted", "twist"},
+		{"cease", "ceas"},
+		{"jets", "jet"},
+		{"exception", "except"},
+		{"neuralgia", "neuralgia"},
+		{"malleolus", "malleolu"},
+		{"thoughtfully", "thoughtfulli"},
+		{"afforded", "afford"},
+		{"mocking", "mock"},
+		{"lively", "live"},
+		{"follicle", "follicl"},
+		{"betrayed", "betrai"},
+		{"streptococcic", "streptococc"},
+		{"vindication", "vindic"},
+		{"painstakingly", "painstakingli"},
+		{"noting", "note"},
+		{"identified", "identifi"},
+		{"art", "art"},
+		{"finish", "finish"},
+		{"british", "british"},
+		{"copper", "copper"},
+		{"lad", "lad"},
+		{"spinati", "spinati"},
+		{"spokesman", "spokesman"},
+		{"coaches", "coach"},
+		{"puffy", "puffi"},
+		{"equivalent", "equival"},
+		{"jolly", "jolli"},
+		{"swung", "swung"},
+		{"flint", "flint"},
+		{"homestead", "homestead"},
+		{"accumulate", "accumul"},
+		{"vacant", "vacant"},
+		{"cupboards", "cupboard"},
+		{"lightning", "lightn"},
+		{"displace", "displac"},
+		{"serves", "serv"},
+		{"gray", "grai"},
+		{"housekeeper", "housekeep"},
+		{"participate", "particip"},
+		{"graceful", "grace"},
+		{"gravity", "graviti"},
+		{"invaluable", "invalu"},
+		{"madam", "madam"},
+		{"cigars", "cigar"},
+		{"articulation", "articul"},
+		{"lopukhin", "lopukhin"},
+		{"haematemesis", "haematemesi"},
+		{"recurring", "recur"},
+		{"uses", "us"},
+		{"evils", "evil"},
+		{"imploring", "implor"},
+		{"alms", "alm"},
+		{"branchial", "branchial"},
+		{"coachmen", "coachmen"},
+		{"western", "western"},
+		{"workbag", "workbag"},
+		{"adam", "adam"},
+		{"seventy", "seventi"},
+		{"penis", "peni"},
+		{"tiny", "tini"},
+		{"escort", "escort"},
+		{"invention", "invent"},
+		{"capsulatus", "capsulatu"},
+		{"opens", "open"},
+		{"overpowering", "overpow"},
+		{"cartilage", "cartilag"},
+		{"text", "text"},
+		{"delicious", "delici"},
+		{"evaporation", "evapor"},
+		{"mais", "mai"},
+		{"erie", "eri"},
+		{"emanations", "eman"},
+		{"beloved", "belov"},
+		{"anastomosis", "anastomosi"},
+		{"albums", "album"},
+		{"mails", "mail"},
+		{"bridging", "bridg"},
+		{"pointed", "point"},
+		{"task", "task"},
+		{"custom", "custom"},
+		{"engagements", "engag"},
+		{"turner", "turner"},
+		{"overcoats", "overcoat"},
+		{"stepfather", "stepfath"},
+		{"motive", "motiv"},
+		{"groan", "groan"},
+		{"mildness", "mild"},
+		{"even", "even"},
+		{"conflicting", "conflict"},
+		{"sergey", "sergei"},
+		{"maps", "map"},
+		{"sill", "sill"},
+		{"miloradovich", "miloradovich"},
+		{"structural", "structur"},
+		{"presses", "press"},
+		{"thereupon", "thereupon"},
+		{"drew", "drew"},
+		{"ascites", "ascit"},
+		{"yellow", "yellow"},
+		{"hardest", "hardest"},
+		{"reaches", "reach"},
+		{"vicomte", "vicomt"},
+		{"nightcap", "nightcap"},
+		{"consistence", "consist"},
+		{"originates", "origin"},
+		{"intercourse", "intercours"},
+		{"monograms", "monogram"},
+		{"selection", "select"},
+		{"numbness", "numb"},
+		{"slightly", "slightli"},
+		{"shipped", "ship"},
+		{"grazing", "graze"},
+		{"accurate", "accur"},
+		{"session", "session"},
+		{"sinus", "sinu"},
+		{"pricked", "prick"},
+		{"crew", "crew"},
+		{"shouts", "shout"},
+		{"ferry", "ferri"},
+		{"troubled", "troubl"},
+		{"vilna", "vilna"},
+		{"hold", "hold"},
+		{"fistulae", "fistula"},
+		{"longing", "long"},
+		{"rags", "rag"},
+		{"beer", "beer"},
+		{"submitting", "submit"},
+		{"fattened", "fatten"},
+		{"condemnation", "condemn"},
+		{"industry", "industri"},
+		{"faint", "faint"},
+		{"forcing", "forc"},
+		{"business", "busi"},
+		{"ostend", "ostend"},
+		{"inert", "inert"},
+		{"eastward", "eastward"},
+		{"occasions", "occas"},
+		{"cusack", "cusack"},
+		{"exhibits", "exhibit"},
+		{"crowing", "crow"},
+		{"twigs", "twig"},
+		{"cramps", "cramp"},
+		{"machine", "machin"},
+		{"talked", "talk"},
+		{"confided", "confid"},
+		{"locally", "local"},
+		{"childlike", "childlik"},
+		{"awed", "aw"},
+		{"series", "seri"},
+		{"aside", "asid"},
+		{"blood", "blood"},
+		{"quand", "quand"},
+		{"skirt", "skirt"},
+		{"tremendous", "tremend"},
+		{"perpetual", "perpetu"},
+		{"love", "love"},
+		{"confessor", "confessor"},
+		{"virile", "viril"},
+		{"watchful", "watch"},
+		{"artist", "artist"},
+		{"cuts", "cut"},
+		{"scored", "score"},
+		{"pitting", "pit"},
+		{"escapade", "escapad"},
+		{"orange", "orang"},
+		{"hush", "hush"},
+		{"defender", "defend"},
+		{"perils", "peril"},
+		{"vyazma", "vyazma"},
+		{"whiff", "whiff"},
+		{"freed", "freed"},
+		{"rugs", "rug"},
+		{"smack", "smack"},
+		{"jabez", "jabez"},
+		{"extrusion", "extrus"},
+		{"oesophagus", "oesophagu"},
+		{"impotence", "impot"},
+		{"genoa", "genoa"},
+		{"anatomy", "anatomi"},
+		{"victims", "victim"},
+		{"spoon", "spoon"},
+		{"everyone", "everyon"},
+		{"forbidden", "forbidden"},
+		{"meet", "meet"},
+		{"freemasonry", "freemasonri"},
+		{"assisted", "assist"},
+		{"protested", "protest"},
+		{"laden", "laden"},
+		{"hindrance", "hindranc"},
+		{"streams", "stream"},
+		{"entry", "entri"},
+		{"retained", "retain"},
+		{"ilyin", "ilyin"},
+		{"brush", "brush"},
+		{"indecision", "indecis"},
+		{"ownership", "ownership"},
+		{"willingly", "willingli"},
+		{"identify", "identifi"},
+		{"worried", "worri"},
+		{"echo", "echo"},
+		{"infantile", "infantil"},
+		{"discipline", "disciplin"},
+		{"whence", "whenc"},
+		{"expiration", "expir"},
+		{"pleasures", "pleasur"},
+		{"collect", "collect"},
+		{"pour", "pour"},
+		{"payne", "payn"},
+		{"drawn", "drawn"},
+		{"descriptions", "descript"},
+		{"ruefully", "ruefulli"},
+		{"sequestra", "sequestra"},
+		{"coat", "coat"},
+		{"thrombosed", "thrombos"},
+		{"impressment", "impress"},
+		{"demonstrate", "demonstr"},
+		{"genuine", "genuin"},
+		{"salts", "salt"},
+		{"cot", "cot"},
+		{"shipowners", "shipown"},
+		{"rescued", "rescu"},
+		{"maintaining", "maintain"},
+		{"midway", "midwai"},
+		{"expounded", "expound"},
+		{"continuing", "continu"},
+		{"serous", "serou"},
+		{"noblemen", "noblemen"},
+		{"cutis", "cuti"},
+		{"liberated", "liber"},
+		{"reproachful", "reproach"},
+		{"thither", "thither"},
+		{"backwards", "backward"},
+		{"fortune", "fortun"},
+		{"nataly", "natali"},
+		{"bursitis", "bursiti"},
+		{"uninterrupted", "uninterrupt"},
+		{"sacculated", "saccul"},
+		{"slung", "slung"},
+		{"snuff", "snuff"},
+		{"dowden", "dowden"},
+		{"two", "two"},
+		{"hoarfrost", "hoarfrost"},
+		{"bearings", "bear"},
+		{"neither", "neither"},
+		{"honeymoon", "honeymoon"},
+		{"jocular", "jocular"},
+		{"collapsed", "collaps"},
+		{"impeachment", "impeach"},
+		{"monuments", "monument"},
+		{"retention", "retent"},
+		{"translated", "translat"},
+		{"dazzled", "dazzl"},
+		{"coincidence", "coincid"},
+		{"footstep", "footstep"},
+		{"hind", "hind"},
+		{"stimulated", "stimul"},
+		{"bored", "bore"},
+		{"practised", "practis"},
+		{"outlawed", "outlaw"},
+		{"karagina", "karagina"},
+		{"transition", "transit"},
+		{"measure", "measur"},
+		{"staphylococcus", "staphylococcu"},
+		{"compatible", "compat"},
+		{"leaf", "leaf"},
+		{"overthrow", "overthrow"},
+		{"championed", "champion"},
+		{"astute", "astut"},
+		{"insisted", "insist"},
+		{"consolidated", "consolid"},
+		{"reform", "reform"},
+		{"furious", "furiou"},
+		{"powder", "powder"},
+		{"pierced", "pierc"},
+		{"skiagraphy", "skiagraphi"},
+		{"transmitted", "transmit"},
+		{"zhilinski", "zhilinski"},
+		{"crafty", "crafti"},
+		{"employed", "emploi"},
+		{"overheard", "overheard"},
+		{"medium", "medium"},
+		{"openly", "openli"},
+		{"sessions", "session"},
+		{"margin", "margin"},
+		{"reception", "recept"},
+		{"faltered", "falter"},
+		{"quench", "quench"},
+		{"springing", "spring"},
+		{"scabs", "scab"},
+		{"careless", "careless"},
+		{"intubation", "intub"},
+		{"diffuse", "diffus"},
+		{"morgan", "morgan"},
+		{"gully", "gulli"},
+		{"flame", "flame"},
+		{"bitch", "bitch"},
+		{"sorry", "sorri"},
+		{"burrow", "burrow"},
+		{"squatters", "squatter"},
+		{"columnar", "columnar"},
+		{"radiograms", "radiogram"},
+		{"distressed", "distress"},
+		{"affords", "afford"},
+		{"replied", "repli"},
+		{"exciting", "excit"},
+		{"prussia", "prussia"},
+		{"contraband", "contraband"},
+		{"sash", "sash"},
+		{"convalescent", "convalesc"},
+		{"armitage", "armitag"},
+		{"petit", "petit"},
+		{"bezukhov", "bezukhov"},
+		{"exostoses", "exostos"},
+		{"bye", "bye"},
+		{"joked", "joke"},
+		{"entailing", "entail"},
+		{"salute", "salut"},
+		{"folk", "folk"},
+		{"hospitable", "hospit"},
+		{"passionate", "passion"},
+		{"stretching", "stretch"},
+		{"manages", "manag"},
+		{"anisya", "anisya"},
+		{"dominion", "dominion"},
+		{"mustache", "mustach"},
+		{"indigestion", "indigest"},
+		{"diversity", "divers"},
+		{"grafting", "graft"},
+		{"morals", "moral"},
+		{"chance", "chanc"},
+		{"foreigners", "foreign"},
+		{"cedars", "cedar"},
+		{"intending", "intend"},
+		{"society", "societi"},
+		{"hussars", "hussar"},
+		{"bewildered", "bewild"},
+		{"recognisable", "recognis"},
+		{"conceited", "conceit"},
+		{"away", "awai"},
+		{"james", "jame"},
+		{"liveried", "liveri"},
+		{"pockmarked", "pockmark"},
+		{"deferred", "defer"},
+		{"possessions", "possess"},
+		{"quarters", "quarter"},
+		{"excite", "excit"},
+		{"overgrowths", "overgrowth"},
+		{"ultimate", "ultim"},
+		{"testis", "testi"},
+		{"upstairs", "upstair"},
+		{"sunlight", "sunlight"},
+		{"totally", "total"},
+		{"scientific", "scientif"},
+		{"suppose", "suppos"},
+		{"worst", "worst"},
+		{"ragged", "rag"},
+		{"sharpshooters", "sharpshoot"},
+		{"lilac", "lilac"},
+		{"fireside", "firesid"},
+		{"easier", "easier"},
+		{"chateau", "chateau"},
+		{"nomination", "nomin"},
+		{"capillary", "capillari"},
+		{"gumma", "gumma"},
+		{"flanks", "flank"},
+		{"vyazmitinov", "vyazmitinov"},
+		{"hyper", "hyper"},
+		{"sentinels", "sentinel"},
+		{"howe", "how"},
+		{"repulsion", "repuls"},
+		{"flat", "flat"},
+		{"covers", "cover"},
+		{"tool", "tool"},
+		{"recover", "recov"},
+		{"denser", "denser"},
+		{"rustle", "rustl"},
+		{"carrying", "carri"},
+		{"godfather", "godfath"},
+		{"soothing", "sooth"},
+		{"murdered", "murder"},
+		{"beautifully", "beautifulli"},
+		{"attain", "attain"},
+		{"bond", "bond"},
+		{"slave", "slave"},
+		{"danish", "danish"},
+		{"crepitus", "crepitu"},
+		{"sarah", "sarah"},
+		{"limb", "limb"},
+		{"parties", "parti"},
+		{"government", "govern"},
+		{"theatricals", "theatric"},
+		{"cutting", "cut"},
+		{"fortify", "fortifi"},
+		{"vanguard", "vanguard"},
+		{"jumping", "jump"},
+		{"sufferings", "suffer"},
+		{"borrowers", "borrow"},
+		{"buffoon", "buffoon"},
+		{"temporary", "temporari"},
+		{"gateway", "gatewai"},
+		{"chart", "chart"},
+		{"complications", "complic"},
+		{"chase", "chase"},
+		{"enjoys", "enjoi"},
+		{"courtyard", "courtyard"},
+		{"omit", "omit"},
+		{"heir", "heir"},
+		{"salvarsan", "salvarsan"},
+		{"dealer", "dealer"},
+		{"trials", "trial"},
+		{"rejoicing", "rejoic"},
+		{"propped", "prop"},
+		{"treating", "treat"},
+		{"face", "face"},
+		{"streaming", "stream"},
+		{"zealous", "zealou"},
+		{"crowds", "crowd"},
+		{"clearer", "clearer"},
+		{"neighbor", "neighbor"},
+		{"exceptions", "except"},
+		{"kiril", "kiril"},
+		{"roof", "roof"},
+		{"finest", "finest"},
+		{"remedying", "remedi"},
+		{"men", "men"},
+		{"hope", "hope"},
+		{"closing", "close"},
+		{"dred", "dred"},
+		{"mortier", "mortier"},
+		{"depraved", "deprav"},
+		{"sponged", "spong"},
+		{"detested", "detest"},
+		{"french", "french"},
+		{"sixty", "sixti"},
+		{"notions", "notion"},
+		{"buried", "buri"},
+		{"capitals", "capit"},
+		{"junot", "junot"},
+		{"administering", "administ"},
+		{"abdomen", "abdomen"},
+		{"boast", "boast"},
+		{"company", "compani"},
+		{"restrictive", "restrict"},
+		{"clergyman", "clergyman"},
+		{"brood", "brood"},
+		{"gallows", "gallow"},
+		{"eschar", "eschar"},
+		{"subscription", "subscript"},
+		{"chief", "chief"},
+		{"copse", "cops"},
+		{"putrid", "putrid"},
+		{"larger", "larger"},
+		{"films", "film"},
+		{"recurred", "recur"},
+		{"nowadays", "nowadai"},
+		{"far", "far"},
+		{"fleet", "fleet"},
+		{"induced", "induc"},
+		{"everted", "evert"},
+		{"garden", "garden"},
+		{"elsewhere", "elsewher"},
+		{"zubova", "zubova"},
+		{"discovering", "discov"},
+		{"son", "son"},
+		{"anglicans", "anglican"},
+		{"appreciated", "appreci"},
+		{"truly", "truli"},
+		{"thickening", "thicken"},
+		{"unto", "unto"},
+		{"dragoon", "dragoon"},
+		{"morocco", "morocco"},
+		{"internal", "intern"},
+		{"gaping", "gape"},
+		{"soot", "soot"},
+		{"calcified", "calcifi"},
+		{"crooked", "crook"},
+		{"greatness", "great"},
+		{"misery", "miseri"},
+		{"mexican", "mexican"},
+		{"chattered", "chatter"},
+		{"adolescence", "adolesc"},
+		{"unavoidable", "unavoid"},
+		{"acceptance", "accept"},
+		{"obeying", "obei"},
+		{"resolute", "resolut"},
+		{"strive", "strive"},
+		{"settlement", "settlement"},
+		{"intestine", "intestin"},
+		{"differential", "differenti"},
+		{"friant", "friant"},
+		{"unlimbered", "unlimb"},
+		{"undertake", "undertak"},
+		{"exercised", "exercis"},
+		{"relay", "relai"},
+		{"protocol", "protocol"},
+		{"necessitates", "necessit"},
+		{"craft", "craft"},
+		{"onto", "onto"},
+		{"topical", "topic"},
+		{"cologne", "cologn"},
+		{"jackdaw", "jackdaw"},
+		{"dawdling", "dawdl"},
+		{"confide", "confid"},
+		{"suspense", "suspens"},
+		{"encounters", "encount"},
+		{"belong", "belong"},
+		{"guineas", "guinea"},
+		{"discontented", "discont"},
+		{"subordinate", "subordin"},
+		{"puzzled", "puzzl"},
+		{"deane", "dean"},
+		{"strength", "strength"},
+		{"smiling", "smile"},
+		{"toilet", "toilet"},
+		{"humming", "hum"},
+		{"plus", "plu"},
+		{"trembled", "trembl"},
+		{"lacerated", "lacer"},
+		{"musicians", "musician"},
+		{"delegated", "deleg"},
+		{"rattle", "rattl"},
+		{"dressed", "dress"},
+		{"religion", "religion"},
+		{"events", "event"},
+		{"weaken", "weaken"},
+		{"hardy", "hardi"},
+		{"selfish", "selfish"},
+		{"lope", "lope"},
+		{"proceed", "proce"},
+		{"flames", "flame"},
+		{"miller", "miller"},
+		{"mourning", "mourn"},
+		{"dolokhov", "dolokhov"},
+		{"planes", "plane"},
+		{"redundant", "redund"},
+		{"simultaneous", "simultan"},
+		{"sherlock", "sherlock"},
+		{"labia", "labia"},
+		{"blown", "blown"},
+		{"revolt", "revolt"},
+		{"infiltrate", "infiltr"},
+		{"merchantmen", "merchantmen"},
+		{"ditches", "ditch"},
+		{"clemency", "clemenc"},
+		{"treaty", "treati"},
+		{"span", "span"},
+		{"delirium", "delirium"},
+		{"wonders", "wonder"},
+		{"access", "access"},
+		{"inferences", "infer"},
+		{"aristocracy", "aristocraci"},
+		{"boilers", "boiler"},
+		{"ground", "ground"},
+		{"cruelly", "cruelli"},
+		{"bohemian", "bohemian"},
+		{"contusions", "contus"},
+		{"tonight", "tonight"},
+		{"rebels", "rebel"},
+		{"loss", "loss"},
+		{"soon", "soon"},
+		{"rigors", "rigor"},
+		{"activity", "activ"},
+		{"plot", "plot"},
+		{"amused", "amus"},
+		{"arthropathy", "arthropathi"},
+		{"swell", "swell"},
+		{"wardrobe", "wardrob"},
+		{"drowsiness", "drowsi"},
+		{"spasms", "spasm"},
+		{"slowness", "slow"},
+		{"actinomycosis", "actinomycosi"},
+		{"epispasticus", "epispasticu"},
+		{"stepping", "step"},
+		{"humility", "humil"},
+		{"carpenters", "carpent"},
+		{"ear", "ear"},
+		{"cotterill", "cotteril"},
+		{"unlocking", "unlock"},
+		{"contracting", "contract"},
+		{"morse", "mors"},
+		{"staining", "stain"},
+		{"flour", "flour"},
+		{"components", "compon"},
+		{"schools", "school"},
+		{"orders", "order"},
+		{"saddle", "saddl"},
+		{"fundamental", "fundament"},
+		{"distinct", "distinct"},
+		{"heel", "heel"},
+		{"surest", "surest"},
+		{"diplomacy", "diplomaci"},
+		{"prayed", "prai"},
+		{"begged", "beg"},
+		{"newport", "newport"},
+		{"workingmen", "workingmen"},
+		{"jacket", "jacket"},
+		{"nursed", "nurs"},
+		{"run", "run"},
+		{"grin", "grin"},
+		{"transmit", "transmit"},
+		{"standards", "standard"},
+		{"boundary", "boundari"},
+		{"riverside", "riversid"},
+		{"collectors", "collector"},
+		{"crawford", "crawford"},
+		{"girths", "girth"},
+		{"sponging", "spong"},
+		{"portly", "portli"},
+		{"noticed", "notic"},
+		{"ones", "on"},
+		{"capitalists", "capitalist"},
+		{"pregnancies", "pregnanc"},
+		{"instincts", "instinct"},
+		{"neglect", "neglect"},
+		{"suturing", "sutur"},
+		{"burning", "burn"},
+		{"sprained", "sprain"},
+		{"fragility", "fragil"},
+		{"grant", "grant"},
+		{"discharges", "discharg"},
+		{"sang", "sang"},
+		{"belt", "belt"},
+		{"fowls", "fowl"},
+		{"impending", "impend"},
+		{"unites", "unit"},
+		{"dimpled", "dimpl"},
+		{"tens", "ten"},
+		{"ellsworth", "ellsworth"},
+		{"challenging", "challeng"},
+		{"commanding", "command"},
+		{"draught", "draught"},
+		{"expressing", "express"},
+		{"hawaii", "hawaii"},
+		{"fleeing", "flee"},
+		{"haemorrhagic", "haemorrhag"},
+		{"pursuing", "pursu"},
+		{"carriages", "carriag"},
+		{"punish", "punish"},
+		{"comme", "comm"},
+		{"want", "want"},
+		{"waste", "wast"},
+		{"thieves", "thiev"},
+		{"offers", "offer"},
+		{"navigation", "navig"},
+		{"lakes", "lake"},
+		{"outflank", "outflank"},
+		{"stouter", "stouter"},
+		{"hepburn", "hepburn"},
+		{"drivers", "driver"},
+		{"shaking", "shake"},
+		{"approaching", "approach"},
+		{"horseback", "horseback"},
+		{"bewilderment", "bewilder"},
+		{"explorations", "explor"},
+		{"vehicle", "vehicl"},
+		{"supple", "suppl"},
+		{"livelier", "liveli"},
+		{"empowered", "empow"},
+		{"discord", "discord"},
+		{"incalculable", "incalcul"},
+		{"matthew", "matthew"},
+		{"renounce", "renounc"},
+		{"modification", "modif"},
+		{"pronator", "pronat"},
+		{"citadel", "citadel"},
+		{"haemophilia", "haemophilia"},
+		{"best", "best"},
+		{"degeneration", "degener"},
+		{"dissemination", "dissemin"},
+		{"succumb", "succumb"},
+		{"loomed", "loom"},
+		{"surmounted", "surmount"},
+		{"keener", "keener"},
+		{"memory", "memori"},
+		{"hastened", "hasten"},
+		{"gentleness", "gentl"},
+		{"avowed", "avow"},
+		{"irregularity", "irregular"},
+		{"tortoise", "tortois"},
+		{"bancrofti", "bancrofti"},
+		{"coarse", "coars"},
+		{"sleeper", "sleeper"},
+		{"difficulty", "difficulti"},
+		{"greenback", "greenback"},
+		{"krems", "krem"},
+		{"convert", "convert"},
+		{"taft", "taft"},
+		{"sutured", "sutur"},
+		{"wilson", "wilson"},
+		{"life", "life"},
+		{"nest", "nest"},
+		{"grieved", "griev"},
+		{"hierarchy", "hierarchi"},
+		{"began", "began"},
+		{"contest", "contest"},
+		{"had", "had"},
+		{"plow", "plow"},
+		{"repeating", "repeat"},
+		{"skin", "skin"},
+		{"cod", "cod"},
+		{"comrade", "comrad"},
+		{"building", "build"},
+		{"roughness", "rough"},
+		{"snake", "snake"},
+		{"descried", "descri"},
+		{"jealousy", "jealousi"},
+		{"richard", "richard"},
+		{"arable", "arabl"},
+		{"cooee", "cooee"},
+		{"abbott", "abbott"},
+		{"zaymishche", "zaymishch"},
+		{"supplies", "suppli"},
+		{"whoever", "whoever"},
+		{"desiring", "desir"},
+		{"thickness", "thick"},
+		{"attained", "attain"},
+		{"clark", "clark"},
+		{"neutrophile", "neutrophil"},
+		{"nodulated", "nodul"},
+		{"sciences", "scienc"},
+		{"disapproval", "disapprov"},
+		{"drafting", "draft"},
+		{"keeper", "keeper"},
+		{"misleading", "mislead"},
+		{"cooled", "cool"},
+		{"decreed", "decre"},
+		{"exclaimed", "exclaim"},
+		{"secluded", "seclud"},
+		{"provincial", "provinci"},
+		{"sank", "sank"},
+		{"thickened", "thicken"},
+		{"intrusion", "intrus"},
+		{"discover", "discov"},
+		{"expense", "expens"},
+		{"anne", "ann"},
+		{"spheres", "sphere"},
+		{"boats", "boat"},
+		{"spain", "spain"},
+		{"habitual", "habitu"},
+		{"summoned", "summon"},
+		{"unofficial", "unoffici"},
+		{"smoking", "smoke"},
+		{"executed", "execut"},
+		{"globe", "globe"},
+		{"rascals", "rascal"},
+		{"beneath", "beneath"},
+		{"fruitless", "fruitless"},
+		{"loosened", "loosen"},
+		{"derma", "derma"},
+		{"false", "fals"},
+		{"drooping", "droop"},
+		{"fleas", "flea"},
+		{"off", "off"},
+		{"bitterness", "bitter"},
+		{"corradi", "corradi"},
+		{"rotation", "rotat"},
+		{"philippine", "philippin"},
+		{"dusky", "duski"},
+		{"neo", "neo"},
+		{"civil", "civil"},
+		{"accumulated", "accumul"},
+		{"obedience", "obedi"},
+		{"entrenchment", "entrench"},
+		{"canadian", "canadian"},
+		{"dismounted", "dismount"},
+		{"hate", "hate"},
+		{"hessian", "hessian"},
+		{"suburban", "suburban"},
+		{"arena", "arena"},
+		{"boldly", "boldli"},
+		{"clapping", "clap"},
+		{"attacked", "attack"},
+		{"white", "white"},
+		{"conservatory", "conservatori"},
+		{"cultures", "cultur"},
+		{"discarded", "discard"},
+		{"dismounting", "dismount"},
+		{"spirochaetes", "spirochaet"},
+		{"central", "central"},
+		{"fugitives", "fugit"},
+		{"panted", "pant"},
+		{"nobleman", "nobleman"},
+		{"credit", "credit"},
+		{"excepting", "except"},
+		{"chloral", "chloral"},
+		{"nominate", "nomin"},
+		{"appoint", "appoint"},
+		{"gummatous", "gummat"},
+		{"sumter", "sumter"},
+		{"dron", "dron"},
+		{"howitzers", "howitz"},
+		{"projectiles", "projectil"},
+		{"tempered", "temper"},
+		{"duke", "duke"},
+		{"trophies", "trophi"},
+		{"slighter", "slighter"},
+		{"conquerors", "conqueror"},
+		{"ligaments", "ligament"},
+		{"wax", "wax"},
+		{"clemenceau", "clemenceau"},
+		{"stroke", "stroke"},
+		{"ossified", "ossifi"},
+		{"calculated", "calcul"},
+		{"emotions", "emot"},
+		{"stroked", "stroke"},
+		{"uniform", "uniform"},
+		{"initiative", "initi"},
+		{"conscious", "consciou"},
+		{"sins", "sin"},
+		{"surrounds", "surround"},
+		{"sublimate", "sublim"},
+		{"faraway", "farawai"},
+		{"governor", "governor"},
+		{"downcast", "downcast"},
+		{"responding", "respond"},
+		{"slaves", "slave"},
+		{"indolent", "indol"},
+		{"gettysburg", "gettysburg"},
+		{"inferior", "inferior"},
+		{"prick", "prick"},
+		{"basket", "basket"},
+		{"follette", "follett"},
+		{"error", "error"},
+		{"pierce", "pierc"},
+		{"luck", "luck"},
+		{"proclamation", "proclam"},
+		{"chimneys", "chimnei"},
+		{"cars", "car"},
+		{"angry", "angri"},
+		{"madly", "madli"},
+		{"inoculated", "inocul"},
+		{"tensely", "tens"},
+		{"waning", "wane"},
+		{"diffusing", "diffus"},
+		{"community", "commun"},
+		{"ilya", "ilya"},
+		{"chattel", "chattel"},
+		{"traders", "trader"},
+		{"therefore", "therefor"},
+		{"unheard", "unheard"},
+		{"hydraulic", "hydraul"},
+		{"furnace", "furnac"},
+		{"communicates", "commun"},
+		{"woman", "woman"},
+		{"instructions", "instruct"},
+		{"says", "sai"},
+		{"warrior", "warrior"},
+		{"gardener", "garden"},
+		{"expeditions", "expedit"},
+		{"responsible", "respons"},
+		{"hide", "hide"},
+		{"sincerely", "sincer"},
+		{"radius", "radiu"},
+		{"remains", "remain"},
+		{"mode", "mode"},
+		{"how", "how"},
+		{"grouped", "group"},
+		{"dealers", "dealer"},
+		{"albumen", "albumen"},
+		{"ushered", "usher"},
+		{"picture", "pictur"},
+		{"daunted", "daunt"},
+		{"taxed", "tax"},
+		{"dollar", "dollar"},
+		{"contrived", "contriv"},
+		{"breaking", "break"},
+		{"irresolute", "irresolut"},
+		{"zealand", "zealand"},
+		{"density", "densiti"},
+		{"jury", "juri"},
+		{"overlap", "overlap"},
+		{"brushed", "brush"},
+		{"proposed", "propos"},
+		{"hat", "hat"},
+		{"huerta", "huerta"},
+		{"debtors", "debtor"},
+		{"armchairs", "armchair"},
+		{"cruelty", "cruelti"},
+		{"tense", "tens"},
+		{"calcareous", "calcar"},
+		{"daytime", "daytim"},
+		{"fatal", "fatal"},
+		{"lloyd", "lloyd"},
+		{"tikhon", "tikhon"},
+		{"locomotive", "locomot"},
+		{"wishes", "wish"},
+		{"sensibly", "sensibl"},
+		{"bled", "bled"},
+		{"medals", "medal"},
+		{"alas", "ala"},
+		{"gallant", "gallant"},
+		{"abduction", "abduct"},
+		{"colourless", "colourless"},
+		{"endure", "endur"},
+		{"kingdom", "kingdom"},
+		{"fragrant", "fragrant"},
+		{"nuisance", "nuisanc"},
+		{"versus", "versu"},
+		{"welcome", "welcom"},
+		{"frontiersmen", "frontiersmen"},
+		{"plantaris", "plantari"},
+		{"efforts", "effort"},
+		{"vain", "vain"},
+		{"ranches", "ranch"},
+		{"recruiting", "recruit"},
+		{"secreting", "secret"},
+		{"behalf", "behalf"},
+		{"eyford", "eyford"},
+		{"fugitive", "fugit"},
+		{"tenants", "tenant"},
+		{"bribed", "bribe"},
+		{"extirpation", "extirp"},
+		{"bankers", "banker"},
+		{"rivals", "rival"},
+		{"morning", "morn"},
+		{"fancied", "fanci"},
+		{"although", "although"},
+		{"jewish", "jewish"},
+		{"corns", "corn"},
+		{"precipitated", "precipit"},
+		{"madame", "madam"},
+		{"brace", "brace"},
+		{"houses", "hous"},
+		{"discredited", "discredit"},
+		{"uninjured", "uninjur"},
+		{"boroughs", "borough"},
+		{"improbable", "improb"},
+		{"reached", "reach"},
+		{"novels", "novel"},
+		{"sterling", "sterl"},
+		{"law", "law"},
+		{"atone", "aton"},
+		{"stuck", "stuck"},
+		{"toothless", "toothless"},
+		{"sokolov", "sokolov"},
+		{"melted", "melt"},
+		{"protects", "protect"},
+		{"paste", "past"},
+		{"bursae", "bursa"},
+		{"occipital", "occipit"},
+		{"answers", "answer"},
+		{"quarrel", "quarrel"},
+		{"hugging", "hug"},
+		{"something", "someth"},
+		{"wrinkled", "wrinkl"},
+		{"likewise", "likewis"},
+		{"dunyasha", "dunyasha"},
+		{"effigy", "effigi"},
+		{"whose", "whose"},
+		{"utter", "utter"},
+		{"dozens", "dozen"},
+		{"unknown", "unknown"},
+		{"cylinders", "cylind"},
+		{"jurisprudence", "jurisprud"},
+		{"lengthening", "lengthen"},
+		{"forty", "forti"},
+		{"foreign", "foreign"},
+		{"periphery", "peripheri"},
+		{"gale", "gale"},
+		{"locking", "lock"},
+		{"mattered", "matter"},
+		{"workmen", "workmen"},
+		{"progressive", "progress"},
+		{"sheaves", "sheav"},
+		{"endeavoured", "endeavour"},
+		{"frigid", "frigid"},
+		{"punishments", "punish"},
+		{"promotion", "promot"},
+		{"scarf", "scarf"},
+		{"undertakings", "undertak"},
+		{"policy", "polici"},
+		{"countermovement", "countermov"},
+		{"sections", "section"},
+		{"reluctance", "reluct"},
+		{"amiable", "amiabl"},
+		{"sabre", "sabr"},
+		{"flirt", "flirt"},
+		{"restrain", "restrain"},
+		{"transferring", "transfer"},
+		{"promoters", "promot"},
+		{"myositis", "myositi"},
+		{"forces", "forc"},
+		{"direct", "direct"},
+		{"dorogobuzh", "dorogobuzh"},
+		{"thrown", "thrown"},
+		{"moaning", "moan"},
+		{"physician", "physician"},
+		{"followed", "follow"},
+		{"drones", "drone"},
+		{"plunging", "plung"},
+		{"phthisis", "phthisi"},
+		{"tumbled", "tumbl"},
+		{"suffered", "suffer"},
+		{"affianced", "affianc"},
+		{"submarines", "submarin"},
+		{"caustics", "caustic"},
+		{"washington", "washington"},
+		{"pathology", "patholog"},
+		{"intonation", "inton"},
+		{"union", "union"},
+		{"papa", "papa"},
+		{"altering", "alter"},
+		{"carted", "cart"},
+		{"hemmed", "hem"},
+		{"conclusion", "conclus"},
+		{"transmitting", "transmit"},
+		{"according", "accord"},
+		{"dangers", "danger"},
+		{"possibility", "possibl"},
+		{"proceeding", "proceed"},
+		{"safety", "safeti"},
+		{"preserve", "preserv"},
+		{"pressure", "pressur"},
+		{"effort", "effort"},
+		{"political", "polit"},
+		{"resolution", "resolut"},
+		{"leppich", "leppich"},
+		{"beryl", "beryl"},
+		{"minutest", "minutest"},
+		{"generally", "gener"},
+		{"assassination", "assassin"},
+		{"tears", "tear"},
+		{"pinch", "pinch"},
+		{"tongue", "tongu"},
+		{"schafer", "schafer"},
+		{"robust", "robust"},
+		{"opposition", "opposit"},
+		{"treason", "treason"},
+		{"profound", "profound"},
+		{"cordially", "cordial"},
+		{"weaker", "weaker"},
+		{"vaseline", "vaselin"},
+		{"queenless", "queenless"},
+		{"vessel", "vessel"},
+		{"meditation", "medit"},
+		{"springs", "spring"},
+		{"syphilis", "syphili"},
+		{"fury", "furi"},
+		{"effective", "effect"},
+		{"armenian", "armenian"},
+		{"ports", "port"},
+		{"dues", "due"},
+		{"danilovna", "danilovna"},
+		{"addition", "addit"},
+		{"hard", "hard"},
+		{"bastille", "bastil"},
+		{"saratoga", "saratoga"},
+		{"audience", "audienc"},
+		{"unwilling", "unwil"},
+		{"cough", "cough"},
+		{"ledge", "ledg"},
+		{"jerusalem", "jerusalem"},
+		{"drafted", "draft"},
+		{"split", "split"},
+		{"flakes", "flake"},
+		{"cage", "cage"},
+		{"windigate", "windig"},
+		{"dumb", "dumb"},
+		{"guarded", "guard"},
+		{"regular", "regular"},
+		{"star", "star"},
+		{"choose", "choos"},
+		{"suicidal", "suicid"},
+		{"endorse", "endors"},
+		{"pomaded", "pomad"},
+		{"sporting", "sport"},
+		{"unhappy", "unhappi"},
+		{"shattered", "shatter"},
+		{"pendulous", "pendul"},
+		{"melyukovs", "melyukov"},
+		{"entitled", "entitl"},
+		{"dutch", "dutch"},
+		{"indistinct", "indistinct"},
+		{"neuromas", "neuroma"},
+		{"rigidity", "rigid"},
+		{"trismus", "trismu"},
+		{"underground", "underground"},
+		{"qualities", "qualiti"},
+		{"gastrocnemius", "gastrocnemiu"},
+		{"osteophytes", "osteophyt"},
+		{"brink", "brink"},
+		{"melting", "melt"},
+		{"rash", "rash"},
+		{"post", "post"},
+		{"arrangement", "arrang"},
+		{"save", "save"},
+		{"intonations", "inton"},
+		{"ensure", "ensur"},
+		{"actively", "activ"},
+		{"cocks", "cock"},
+		{"belated", "belat"},
+		{"convex", "convex"},
+		{"wit", "wit"},
+		{"grade", "grade"},
+		{"protection", "protect"},
+		{"moan", "moan"},
+		{"spleen", "spleen"},
+		{"motion", "motion"},
+		{"infra", "infra"},
+		{"car", "car"},
+		{"forge", "forg"},
+		{"isolated", "isol"},
+		{"urgency", "urgenc"},
+		{"hernia", "hernia"},
+		{"correspondence", "correspond"},
+		{"accepting", "accept"},
+		{"harbor", "harbor"},
+		{"chyle", "chyle"},
+		{"sarcastic", "sarcast"},
+		{"aim", "aim"},
+		{"adenoma", "adenoma"},
+		{"dislocated", "disloc"},
+		{"forcible", "forcibl"},
+		{"gross", "gross"},
+		{"passenger", "passeng"},
+		{"commonsense", "commonsens"},
+		{"membership", "membership"},
+		{"georgia", "georgia"},
+		{"greatest", "greatest"},
+		{"dorsal", "dorsal"},
+		{"sarcastically", "sarcast"},
+		{"shoe", "shoe"},
+		{"corresponded", "correspond"},
+		{"differs", "differ"},
+		{"nourished", "nourish"},
+		{"read", "read"},
+		{"shishkov", "shishkov"},
+		{"afternoon", "afternoon"},
+		{"intently", "intent"},
+		{"aneurysm", "aneurysm"},
+		{"disparity", "dispar"},
+		{"illumined", "illumin"},
+		{"arc", "arc"},
+		{"exuberant", "exuber"},
+		{"sportsman", "sportsman"},
+		{"sumner", "sumner"},
+		{"hear", "hear"},
+		{"reason", "reason"},
+		{"harris", "harri"},
+		{"superior", "superior"},
+		{"austrian", "austrian"},
+		{"apology", "apolog"},
+		{"distilled", "distil"},
+		{"raisers", "raiser"},
+		{"floor", "floor"},
+		{"sciatica", "sciatica"},
+		{"subsidized", "subsid"},
+		{"discussion", "discuss"},
+		{"hart", "hart"},
+		{"unmoved", "unmov"},
+		{"iogel", "iogel"},
+		{"ambition", "ambit"},
+		{"seek", "seek"},
+		{"skeleton", "skeleton"},
+		{"traumatism", "traumat"},
+		{"occupations", "occup"},
+		{"randolph", "randolph"},
+		{"moskva", "moskva"},
+		{"moonlight", "moonlight"},
+		{"rivaled", "rival"},
+		{"sickening", "sicken"},
+		{"blunt", "blunt"},
+		{"dependent", "depend"},
+		{"maintenance", "mainten"},
+		{"pickets", "picket"},
+		{"migration", "migrat"},
+		{"merriment", "merriment"},
+		{"submerged", "submerg"},
+		{"agitated", "agit"},
+		{"precedes", "preced"},
+		{"diable", "diabl"},
+		{"peg", "peg"},
+		{"impertinent", "impertin"},
+		{"shays", "shai"},
+		{"arrange", "arrang"},
+		{"irrefutable", "irrefut"},
+		{"vols", "vol"},
+		{"spirits", "spirit"},
+		{"constipation", "constip"},
+		{"proof", "proof"},
+		{"commence", "commenc"},
+		{"distinctness", "distinct"},
+		{"ducrey", "ducrei"},
+		{"suppression", "suppress"},
+		{"benton", "benton"},
+		{"penny", "penni"},
+		{"witness", "wit"},
+		{"memorable", "memor"},
+		{"correctly", "correctli"},
+		{"silvery", "silveri"},
+		{"startled", "startl"},
+		{"ruble", "rubl"},
+		{"spasmodic", "spasmod"},
+		{"foreseeing", "forese"},
+		{"adored", "ador"},
+		{"rank", "rank"},
+		{"hack", "hack"},
+		{"recounted", "recount"},
+		{"reduces", "reduc"},
+		{"bleeders", "bleeder"},
+		{"center", "center"},
+		{"leucopenia", "leucopenia"},
+		{"wittgenstein", "wittgenstein"},
+		{"shaggy", "shaggi"},
+		{"dissenting", "dissent"},
+		{"approve", "approv"},
+		{"grinning", "grin"},
+		{"speech", "speech"},
+		{"defeats", "defeat"},
+		{"molecular", "molecular"},
+		{"chip", "chip"},
+		{"tuberosity", "tuberos"},
+		{"empire", "empir"},
+		{"submissive", "submiss"},
+		{"ruled", "rule"},
+		{"fumbling", "fumbl"},
+		{"hair", "hair"},
+		{"brook", "brook"},
+		{"nineteen", "nineteen"},
+		{"kuzmich", "kuzmich"},
+		{"fragilitas", "fragilita"},
+		{"vindictive", "vindict"},
+		{"hessians", "hessian"},
+		{"ungrateful", "ungrat"},
+		{"prolonged", "prolong"},
+		{"tumour", "tumour"},
+		{"chances", "chanc"},
+		{"concentrated", "concentr"},
+		{"rioting", "riot"},
+		{"creeping", "creep"},
+		{"discs", "disc"},
+		{"whiskers", "whisker"},
+		{"napoleons", "napoleon"},
+		{"phlebitis", "phlebiti"},
+		{"powers", "power"},
+		{"unquestionably", "unquestion"},
+		{"clavichord", "clavichord"},
+		{"vary", "vari"},
+		{"idiot", "idiot"},
+		{"foka", "foka"},
+		{"blebs", "bleb"},
+		{"kazan", "kazan"},
+		{"advertisement", "advertis"},
+		{"darling", "darl"},
+		{"describing", "describ"},
+		{"suited", "suit"},
+		{"impatiently", "impati"},
+		{"countless", "countless"},
+		{"incorrect", "incorrect"},
+		{"diplomat", "diplomat"},
+		{"bizarre", "bizarr"},
+		{"shirts", "shirt"},
+		{"sail", "sail"},
+		{"sunken", "sunken"},
+		{"ascending", "ascend"},
+		{"fwiend", "fwiend"},
+		{"landowner", "landown"},
+		{"advance", "advanc"},
+		{"intrigues", "intrigu"},
+		{"revolutionary", "revolutionari"},
+		{"effect", "effect"},
+		{"screened", "screen"},
+		{"sticking", "stick"},
+		{"clients", "client"},
+		{"tomorrow", "tomorrow"},
+		{"incurred", "incur"},
+		{"abrupt", "abrupt"},
+		{"plague", "plagu"},
+		{"cumberland", "cumberland"},
+		{"akhrosimova", "akhrosimova"},
+		{"pad", "pad"},
+		{"moistened", "moisten"},
+		{"adjoining", "adjoin"},
+		{"puritans", "puritan"},
+		{"hands", "hand"},
+		{"dwarfing", "dwarf"},
+		{"garfield", "garfield"},
+		{"poles", "pole"},
+		{"strewn", "strewn"},
+		{"proposes", "propos"},
+		{"dig", "dig"},
+		{"going", "go"},
+		{"ophthalmic", "ophthalm"},
+		{"extend", "extend"},
+		{"soft", "soft"},
+		{"sheet", "sheet"},
+		{"sees", "see"},
+		{"unlocked", "unlock"},
+		{"meadows", "meadow"},
+		{"typewriting", "typewrit"},
+		{"herb", "herb"},
+		{"belligerents", "belliger"},
+		{"barring", "bar"},
+		{"classification", "classif"},
+		{"fomentation", "foment"},
+		{"angrier", "angrier"},
+		{"annandale", "annandal"},
+		{"foramen", "foramen"},
+		{"ramrod", "ramrod"},
+		{"thirty", "thirti"},
+		{"driven", "driven"},
+		{"emancipated", "emancip"},
+		{"jammed", "jam"},
+		{"agrafena", "agrafena"},
+		{"builders", "builder"},
+		{"crazy", "crazi"},
+		{"strolled", "stroll"},
+		{"frightful", "fright"},
+		{"relating", "relat"},
+		{"stimulus", "stimulu"},
+		{"dreams", "dream"},
+		{"favours", "favour"},
+		{"resembling", "resembl"},
+		{"phrenic", "phrenic"},
+		{"describes", "describ"},
+		{"liquefied", "liquefi"},
+		{"watery", "wateri"},
+		{"fraenkel", "fraenkel"},
+		{"greene", "green"},
+		{"dismay", "dismai"},
+		{"repudiated", "repudi"},
+		{"details", "detail"},
+		{"morel", "morel"},
+		{"topped", "top"},
+		{"adjourn", "adjourn"},
+		{"editorials", "editori"},
+		{"repetition", "repetit"},
+		{"moldavia", "moldavia"},
+		{"interfere", "interfer"},
+		{"permitting", "permit"},
+		{"untroubled", "untroubl"},
+		{"diverted", "divert"},
+		{"sample", "sampl"},
+		{"maine", "main"},
+		{"bushels", "bushel"},
+		{"weather", "weather"},
+		{"international", "intern"},
+		{"glade", "glade"},
+		{"stevens", "steven"},
+		{"poured", "pour"},
+		{"heard", "heard"},
+		{"tapping", "tap"},
+		{"conveyed", "convei"},
+		{"universal", "univers"},
+		{"represents", "repres"},
+		{"holmes", "holm"},
+		{"excessive", "excess"},
+		{"daring", "dare"},
+		{"acupuncture", "acupunctur"},
+		{"scandinavians", "scandinavian"},
+		{"fool", "fool"},
+		{"township", "township"},
+		{"incisions", "incis"},
+		{"irritability", "irrit"},
+		{"criticized", "critic"},
+		{"spent", "spent"},
+		{"growth", "growth"},
+		{"wrong", "wrong"},
+		{"administration", "administr"},
+		{"places", "place"},
+		{"pilgrim", "pilgrim"},
+		{"unstable", "unstabl"},
+		{"uvarka", "uvarka"},
+		{"escaping", "escap"},
+		{"interview", "interview"},
+		{"reaction", "reaction"},
+		{"adapted", "adapt"},
+		{"wizard", "wizard"},
+		{"countrymen", "countrymen"},
+		{"exceeding", "exceed"},
+		{"posterior", "posterior"},
+		{"recalled", "recal"},
+		{"weakness", "weak"},
+		{"item", "item"},
+		{"clearing", "clear"},
+		{"volumes", "volum"},
+		{"woodrow", "woodrow"},
+		{"ulyulyu", "ulyulyu"},
+		{"warrant", "warrant"},
+		{"axiom", "axiom"},
+		{"recently", "recent"},
+		{"foul", "foul"},
+		{"reticent", "retic"},
+		{"disrepute", "disreput"},
+		{"auditory", "auditori"},
+		{"awful", "aw"},
+		{"visiting", "visit"},
+		{"servant", "servant"},
+		{"medullated", "medul"},
+		{"accumulates", "accumul"},
+		{"ireland", "ireland"},
+		{"liberate", "liber"},
+		{"drums", "drum"},
+		{"gradually", "gradual"},
+		{"forehead", "forehead"},
+		{"whip", "whip"},
+		{"waged", "wage"},
+		{"reports", "report"},
+		{"cradle", "cradl"},
+		{"namely", "name"},
+		{"attracts", "attract"},
+		{"suffused", "suffus"},
+		{"carry", "carri"},
+		{"chap", "chap"},
+		{"frisco", "frisco"},
+		{"lame", "lame"},
+		{"feathers", "feather"},
+		{"chemistry", "chemistri"},
+		{"impetuously", "impetu"},
+		{"proviso", "proviso"},
+		{"lymphangitis", "lymphang"},
+		{"undertook", "undertook"},
+		{"ladies", "ladi"},
+		{"compasses", "compass"},
+		{"damaged", "damag"},
+		{"mortification", "mortif"},
+		{"radiogram", "radiogram"},
+		{"moist", "moist"},
+		{"patronage", "patronag"},
+		{"elemental", "element"},
+		{"barrels", "barrel"},
+		{"provoked", "provok"},
+		{"journeys", "journei"},
+		{"succor", "succor"},
+		{"helen", "helen"},
+		{"conversation", "convers"},
+		{"delegation", "deleg"},
+		{"share", "share"},
+		{"array", "arrai"},
+		{"gallery", "galleri"},
+		{"jonathan", "jonathan"},
+		{"opium", "opium"},
+		{"haemostatics", "haemostat"},
+		{"shone", "shone"},
+		{"servile", "servil"},
+		{"municipalities", "municip"},
+		{"constitutional", "constitut"},
+		{"levee", "leve"},
+		{"filled", "fill"},
+		{"doctrine", "doctrin"},
+		{"flattery", "flatteri"},
+		{"pyaemia", "pyaemia"},
+		{"skull", "skull"},
+		{"insert", "insert"},
+		{"fruits", "fruit"},
+		{"medicine", "medicin"},
+		{"imperfectly", "imperfectli"},
+		{"coats", "coat"},
+		{"peritonitis", "periton"},
+		{"ganglia", "ganglia"},
+		{"disruption", "disrupt"},
+		{"voices", "voic"},
+		{"mormons", "mormon"},
+		{"any", "ani"},
+		{"modest", "modest"},
+		{"noses", "nose"},
+		{"mushroom", "mushroom"},
+		{"securing", "secur"},
+		{"lucrative", "lucr"},
+		{"precedents", "preced"},
+		{"lowest", "lowest"},
+		{"learning", "learn"},
+		{"decidedly", "decidedli"},
+		{"october", "octob"},
+		{"traveler", "travel"},
+		{"ovary", "ovari"},
+		{"clubs", "club"},
+		{"magic", "magic"},
+		{"sacrificed", "sacrif"},
+		{"compromising", "compromis"},
+		{"disfigurement", "disfigur"},
+		{"punished", "punish"},
+		{"solomon", "solomon"},
+		{"indirect", "indirect"},
+		{"authorize", "author"},
+		{"unlikely", "unlik"},
+		{"inspires", "inspir"},
+		{"organisms", "organ"},
+		{"drive", "drive"},
+		{"sequestrum", "sequestrum"},
+		{"johnson", "johnson"},
+		{"descent", "descent"},
+		{"aid", "aid"},
+		{"utilities", "util"},
+		{"sung", "sung"},
+		{"tends", "tend"},
+		{"dispense", "dispens"},
+		{"phaeton", "phaeton"},
+		{"aged", "ag"},
+		{"viii", "viii"},
+		{"castle", "castl"},
+		{"war", "war"},
+		{"bill", "bill"},
+		{"recollection", "recollect"},
+		{"gape", "gape"},
+		{"emphasize", "emphas"},
+		{"handwriting", "handwrit"},
+		{"suitor", "suitor"},
+		{"delightful", "delight"},
+		{"flora", "flora"},
+		{"colors", "color"},
+		{"grievous", "grievou"},
+		{"limp", "limp"},
+		{"planets", "planet"},
+		{"superb", "superb"},
+		{"scruples", "scrupl"},
+		{"confederation", "confeder"},
+		{"saturday", "saturdai"},
+		{"heedless", "heedless"},
+		{"iodide", "iodid"},
+		{"furnishes", "furnish"},
+		{"riotous", "riotou"},
+		{"reasons", "reason"},
+		{"mentally", "mental"},
+		{"however", "howev"},
+		{"dash", "dash"},
+		{"caleches", "calech"},
+		{"mental", "mental"},
+		{"doubled", "doubl"},
+		{"ratify", "ratifi"},
+		{"fence", "fenc"},
+		{"entertained", "entertain"},
+		{"cornwallis", "cornwal"},
+		{"burdened", "burden"},
+		{"flurried", "flurri"},
+		{"auto", "auto"},
+		{"czar", "czar"},
+		{"submarine", "submarin"},
+		{"discretion", "discret"},
+		{"caught", "caught"},
+		{"stimuli", "stimuli"},
+		{"knot", "knot"},
+		{"grounds", "ground"},
+		{"federalists", "federalist"},
+		{"toes", "toe"},
+		{"andrew", "andrew"},
+		{"dundee", "dunde"},
+		{"tired", "tire"},
+		{"inspiration", "inspir"},
+		{"phalangeal", "phalang"},
+		{"exerting", "exert"},
+		{"cleveland", "cleveland"},
+		{"wisely", "wise"},
+		{"prelude", "prelud"},
+		{"exist", "exist"},
+		{"uhlan", "uhlan"},
+		{"balashev", "balashev"},
+		{"habeas", "habea"},
+		{"terminal", "termin"},
+		{"notorious", "notori"},
+		{"suicide", "suicid"},
+		{"abolition", "abolit"},
+		{"unduly", "unduli"},
+		{"conceived", "conceiv"},
+		{"hydrophobia", "hydrophobia"},
+		{"applied", "appli"},
+		{"electrode", "electrod"},
+		{"skilled", "skill"},
+		{"hangs", "hang"},
+		{"twinkling", "twinkl"},
+		{"occupied", "occupi"},
+		{"anterior", "anterior"},
+		{"unconstitutional", "unconstitut"},
+		{"hothouse", "hothous"},
+		{"occasion", "occas"},
+		{"fifteen", "fifteen"},
+		{"ballet", "ballet"},
+		{"juice", "juic"},
+		{"accustomed", "accustom"},
+		{"existed", "exist"},
+		{"redder", "redder"},
+		{"cherish", "cherish"},
+		{"tenor", "tenor"},
+		{"deficient", "defici"},
+		{"humor", "humor"},
+		{"tattoo", "tattoo"},
+		{"value", "valu"},
+		{"unnecessary", "unnecessari"},
+		{"bankrupt", "bankrupt"},
+		{"ilium", "ilium"},
+		{"biographies", "biographi"},
+		{"dried", "dri"},
+		{"subsided", "subsid"},
+		{"matrena", "matrena"},
+		{"applications", "applic"},
+		{"signing", "sign"},
+		{"karataev", "karataev"},
+		{"bursal", "bursal"},
+		{"frail", "frail"},
+		{"rapture", "raptur"},
+		{"constitutions", "constitut"},
+		{"frenchman", "frenchman"},
+		{"whispers", "whisper"},
+		{"devised", "devis"},
+		{"markedly", "markedli"},
+		{"demanded", "demand"},
+		{"night", "night"},
+		{"fibromas", "fibroma"},
+		{"written", "written"},
+		{"bursts", "burst"},
+		{"commonly", "commonli"},
+		{"charring", "char"},
+		{"dwink", "dwink"},
+		{"strychnin", "strychnin"},
+		{"judgment", "judgment"},
+		{"pyrexia", "pyrexia"},
+		{"politicians", "politician"},
+		{"aware", "awar"},
+		{"absorbed", "absorb"},
+		{"facts", "fact"},
+		{"close", "close"},
+		{"advocate", "advoc"},
+		{"bows", "bow"},
+		{"artificial", "artifici"},
+		{"nationality", "nation"},
+		{"create", "creat"},
+		{"osteoblasts", "osteoblast"},
+		{"spastic", "spastic"},
+		{"acre", "acr"},
+		{"messages", "messag"},
+		{"anyhow", "anyhow"},
+		{"berlin", "berlin"},
+		{"disappointed", "disappoint"},
+		{"reminding", "remind"},
+		{"big", "big"},
+		{"creditors", "creditor"},
+		{"inimical", "inim"},
+		{"chose", "chose"},
+		{"jerked", "jerk"},
+		{"temps", "temp"},
+		{"heavy", "heavi"},
+		{"nuclear", "nuclear"},
+		{"clear", "clear"},
+		{"evening", "even"},
+		{"ulcer", "ulcer"},
+		{"occupying", "occupi"},
+		{"exert", "exert"},
+		{"flaming", "flame"},
+		{"ardor", "ardor"},
+		{"skiagram", "skiagram"},
+		{"salon", "salon"},
+		{"parasitic", "parasit"},
+		{"supervenes", "superven"},
+		{"turkish", "turkish"},
+		{"identity", "ident"},
+		{"invades", "invad"},
+		{"costumes", "costum"},
+		{"expended", "expend"},
+		{"catheter", "cathet"},
+		{"hungarian", "hungarian"},
+		{"slackening", "slacken"},
+		{"exposures", "exposur"},
+		{"contract", "contract"},
+		{"minor", "minor"},
+		{"speculator", "specul"},
+		{"delay", "delai"},
+		{"fili", "fili"},
+		{"bridgehead", "bridgehead"},
+		{"forfeit", "forfeit"},
+		{"diligent", "dilig"},
+		{"conjectures", "conjectur"},
+		{"bodies", "bodi"},
+		{"prophylaxis", "prophylaxi"},
+		{"distorted", "distort"},
+		{"tract", "tract"},
+		{"galling", "gall"},
+		{"anew", "anew"},
+		{"rebellion", "rebellion"},
+		{"contaminated", "contamin"},
+		{"criminal", "crimin"},
+		{"missouri", "missouri"},
+		{"procedures", "procedur"},
+		{"sharpening", "sharpen"},
+		{"nosed", "nose"},
+		{"collateral", "collater"},
+		{"rifle", "rifl"},
+		{"numerical", "numer"},
+		{"cette", "cett"},
+		{"unevenly", "unevenli"},
+		{"threat", "threat"},
+		{"glycerine", "glycerin"},
+		{"attendant", "attend"},
+		{"unanimously", "unanim"},
+		{"strap", "strap"},
+		{"salver", "salver"},
+		{"estimate", "estim"},
+		{"often", "often"},
+		{"reluctant", "reluct"},
+		{"centres", "centr"},
+		{"compliments", "compliment"},
+		{"glances", "glanc"},
+		{"distraught", "distraught"},
+		{"unreasoning", "unreason"},
+		{"jamestown", "jamestown"},
+		{"motto", "motto"},
+		{"geographical", "geograph"},
+		{"flew", "flew"},
+		{"bag", "bag"},
+		{"endanger", "endang"},
+		{"resort", "resort"},
+		{"blocking", "block"},
+		{"nullification", "nullif"},
+		{"tumours", "tumour"},
+		{"sidelong", "sidelong"},
+		{"untrue", "untru"},
+		{"cornea", "cornea"},
+		{"hutchinson", "hutchinson"},
+		{"periosteal", "periost"},
+		{"setting", "set"},
+		{"cheered", "cheer"},
+		{"streptothrix", "streptothrix"},
+		{"protegee", "protege"},
+		{"studies", "studi"},
+		{"wife", "wife"},
+		{"nikolenka", "nikolenka"},
+		{"rapier", "rapier"},
+		{"terrifying", "terrifi"},
+		{"warmly", "warmli"},
+		{"mass", "mass"},
+		{"elevating", "elev"},
+		{"useless", "useless"},
+		{"highly", "highli"},
+		{"gelding", "geld"},
+		{"annoyance", "annoy"},
+		{"ugh", "ugh"},
+		{"crowding", "crowd"},
+		{"flood", "flood"},
+		{"lateral", "later"},
+		{"regulate", "regul"},
+		{"bestow", "bestow"},
+		{"reminder", "remind"},
+		{"burgesses", "burgess"},
+		{"rummaged", "rummag"},
+		{"quest", "quest"},
+		{"suez", "suez"},
+		{"hartford", "hartford"},
+		{"trigone", "trigon"},
+		{"apes", "ap"},
+		{"awarded", "award"},
+		{"existing", "exist"},
+		{"sanguine", "sanguin"},
+		{"dirty", "dirti"},
+		{"boasting", "boast"},
+		{"chatted", "chat"},
+		{"influences", "influenc"},
+		{"historical", "histor"},
+		{"rumble", "rumbl"},
+		{"understanding", "understand"},
+		{"fillmore", "fillmor"},
+		{"depreciation", "depreci"},
+		{"cardboard", "cardboard"},
+		{"developments", "develop"},
+		{"waking", "wake"},
+		{"glaring", "glare"},
+		{"negligible", "neglig"},
+		{"seamen", "seamen"},
+		{"covert", "covert"},
+		{"adieu", "adieu"},
+		{"unobserved", "unobserv"},
+		{"adoration", "ador"},
+		{"happened", "happen"},
+		{"adults", "adult"},
+		{"hemp", "hemp"},
+		{"lannes", "lann"},
+		{"infection", "infect"},
+		{"invisible", "invis"},
+		{"frantically", "frantic"},
+		{"argonne", "argonn"},
+		{"lurched", "lurch"},
+		{"tariffs", "tariff"},
+		{"stirring", "stir"},
+		{"merged", "merg"},
+		{"sweetheart", "sweetheart"},
+		{"gases", "gase"},
+		{"new", "new"},
+		{"rejoinder", "rejoind"},
+		{"author", "author"},
+		{"deltoid", "deltoid"},
+		{"flattened", "flatten"},
+		{"consequent", "consequ"},
+		{"contradictory", "contradictori"},
+		{"feel", "feel"},
+		{"drifting", "drift"},
+		{"diplomats", "diplomat"},
+		{"monetary", "monetari"},
+		{"manifested", "manifest"},
+		{"privateers", "privat"},
+		{"nursing", "nurs"},
+		{"waterways", "waterwai"},
+		{"expectant", "expect"},
+		{"frauds", "fraud"},
+		{"gravesend", "gravesend"},
+		{"intricate", "intric"},
+		{"awake", "awak"},
+		{"spirochaete", "spirochaet"},
+		{"handed", "hand"},
+		{"them", "them"},
+		{"lone", "lone"},
+		{"agents", "agent"},
+		{"taste", "tast"},
+		{"watchman", "watchman"},
+		{"ferment", "ferment"},
+		{"tyranny", "tyranni"},
+		{"cushions", "cushion"},
+		{"glimpse", "glimps"},
+		{"openshaw", "openshaw"},
+		{"ruts", "rut"},
+		{"shake", "shake"},
+		{"ordinarily", "ordinarili"},
+		{"decree", "decre"},
+		{"contributed", "contribut"},
+		{"conservatives", "conserv"},
+		{"vaccine", "vaccin"},
+		{"path", "path"},
+		{"king", "king"},
+		{"recurrent", "recurr"},
+		{"osteotomies", "osteotomi"},
+		{"unlucky", "unlucki"},
+		{"towards", "toward"},
+		{"gracious", "graciou"},
+		{"receive", "receiv"},
+		{"hollow", "hollow"},
+		{"bearers", "bearer"},
+		{"ardent", "ardent"},
+		{"elderly", "elderli"},
+		{"howling", "howl"},
+		{"obediently", "obedi"},
+		{"worked", "work"},
+		{"beatified", "beatifi"},
+		{"exemplary", "exemplari"},
+		{"hand", "hand"},
+		{"since", "sinc"},
+		{"fleshy", "fleshi"},
+		{"stayed", "stai"},
+		{"steppes", "stepp"},
+		{"infallible", "infal"},
+		{"mysteriously", "mysteri"},
+		{"kit", "kit"},
+		{"accompanying", "accompani"},
+		{"levy", "levi"},
+		{"undergone", "undergon"},
+		{"senators", "senat"},
+		{"median", "median"},
+		{"flax", "flax"},
+		{"sciatic", "sciatic"},
+		{"hunterian", "hunterian"},
+		{"safe", "safe"},
+		{"stormed", "storm"},
+		{"lap", "lap"},
+		{"subdivision", "subdivis"},
+		{"surgeons", "surgeon"},
+		{"anaphylaxis", "anaphylaxi"},
+		{"teach", "teach"},
+		{"pituitary", "pituitari"},
+		{"presidency", "presid"},
+		{"handicapped", "handicap"},
+		{"gatherings", "gather"},
+		{"puerperal", "puerper"},
+		{"honey", "honei"},
+		{"regiment", "regiment"},
+		{"tremens", "tremen"},
+		{"borrowing", "borrow"},
+		{"chessmen", "chessmen"},
+		{"julia", "julia"},
+		{"individuality", "individu"},
+		{"auersperg", "auersperg"},
+		{"embargo", "embargo"},
+		{"destiny", "destini"},
+		{"laminated", "lamin"},
+		{"jaw", "jaw"},
+		{"supinators", "supin"},
+		{"suspend", "suspend"},
+		{"inevitability", "inevit"},
+		{"down", "down"},
+		{"reeds", "reed"},
+		{"attitudes", "attitud"},
+		{"mademoiselle", "mademoisel"},
+		{"reviewed", "review"},
+		{"exhausted", "exhaust"},
+		{"echoed", "echo"},
+		{"lose", "lose"},
+		{"when", "when"},
+		{"piercing", "pierc"},
+		{"impatience", "impati"},
+		{"kindling", "kindl"},
+		{"pull", "pull"},
+		{"sickly", "sickli"},
+		{"kolocha", "kolocha"},
+		{"desert", "desert"},
+		{"endangered", "endang"},
+		{"vaska", "vaska"},
+		{"regarding", "regard"},
+		{"repressed", "repress"},
+		{"egg", "egg"},
+		{"thirteen", "thirteen"},
+		{"codes", "code"},
+		{"arrangements", "arrang"},
+		{"overseer", "overs"},
+		{"dreaming", "dream"},
+		{"displacing", "displac"},
+		{"peaceful", "peac"},
+		{"ironic", "iron"},
+		{"drinks", "drink"},
+		{"loves", "love"},
+		{"consisting", "consist"},
+		{"imperceptibly", "impercept"},
+		{"promote", "promot"},
+		{"addicted", "addict"},
+		{"installment", "instal"},
+		{"dread", "dread"},
+		{"engineers", "engin"},
+		{"sixteen", "sixteen"},
+		{"july", "juli"},
+		{"careful", "care"},
+		{"naughty", "naughti"},
+		{"locks", "lock"},
+		{"bolkonskaya", "bolkonskaya"},
+		{"trotted", "trot"},
+		{"writing", "write"},
+		{"quickening", "quicken"},
+		{"assail", "assail"},
+		{"vilest", "vilest"},
+		{"jesuit", "jesuit"},
+		{"eloquence", "eloqu"},
+		{"hiding", "hide"},
+		{"coincidently", "coincid"},
+		{"consent", "consent"},
+		{"professor", "professor"},
+		{"experiment", "experi"},
+		{"allowance", "allow"},
+		{"twentieth", "twentieth"},
+		{"parliamentary", "parliamentari"},
+		{"swiss", "swiss"},
+		{"perfecting", "perfect"},
+		{"lawrence", "lawrenc"},
+		{"stitched", "stitch"},
+		{"mischievous", "mischiev"},
+		{"maximum", "maximum"},
+		{"retain", "retain"},
+		{"mill", "mill"},
+		{"movements", "movement"},
+		{"weighing", "weigh"},
+		{"masha", "masha"},
+		{"frequented", "frequent"},
+		{"why", "why"},
+		{"semi", "semi"},
+		{"residing", "resid"},
+		{"neglecting", "neglect"},
+		{"sagacious", "sagaci"},
+		{"unconditional", "uncondit"},
+		{"humerus", "humeru"},
+		{"bondmen", "bondmen"},
+		{"santo", "santo"},
+		{"profession", "profess"},
+		{"rocked", "rock"},
+		{"wounding", "wound"},
+		{"merely", "mere"},
+		{"warmed", "warm"},
+		{"alveolar", "alveolar"},
+		{"manage", "manag"},
+		{"dine", "dine"},
+		{"frighten", "frighten"},
+		{"wounded", "wound"},
+		{"entrenchments", "entrench"},
+		{"carelessly", "carelessli"},
+		{"smaller", "smaller"},
+		{"hissing", "hiss"},
+		{"ram", "ram"},
+		{"fibrous", "fibrou"},
+		{"downwards", "downward"},
+		{"enrolled", "enrol"},
+		{"pouching", "pouch"},
+		{"ratification", "ratif"},
+		{"claiming", "claim"},
+		{"capacity", "capac"},
+		{"shapely", "shape"},
+		{"drill", "drill"},
+		{"tibia", "tibia"},
+		{"perry", "perri"},
+		{"itself", "itself"},
+		{"arrested", "arrest"},
+		{"locke", "lock"},
+		{"restore", "restor"},
+		{"stranger", "stranger"},
+		{"favour", "favour"},
+		{"bergs", "berg"},
+		{"pennsylvania", "pennsylvania"},
+		{"incapacity", "incapac"},
+		{"suitors", "suitor"},
+		{"economic", "econom"},
+		{"memorandum", "memorandum"},
+		{"wig", "wig"},
+		{"chanting", "chant"},
+		{"unanswerable", "unanswer"},
+		{"innumerable", "innumer"},
+		{"disclose", "disclos"},
+		{"commit", "commit"},
+		{"followers", "follow"},
+		{"conscripted", "conscript"},
+		{"banks", "bank"},
+		{"improperly", "improperli"},
+		{"reproachfully", "reproachfulli"},
+		{"privileges", "privileg"},
+		{"healing", "heal"},
+		{"forests", "forest"},
+		{"mortally", "mortal"},
+		{"palm", "palm"},
+		{"ecstatic", "ecstat"},
+		{"national", "nation"},
+		{"usual", "usual"},
+		{"margins", "margin"},
+		{"galloping", "gallop"},
+		{"untruth", "untruth"},
+		{"tsarevich", "tsarevich"},
+		{"leagues", "leagu"},
+		{"kneeling", "kneel"},
+		{"reciting", "recit"},
+		{"portions", "portion"},
+		{"unanimous", "unanim"},
+		{"performance", "perform"},
+		{"frenchmen", "frenchmen"},
+		{"guardianship", "guardianship"},
+		{"prevalent", "preval"},
+		{"lay", "lai"},
+		{"disreputable", "disreput"},
+		{"likeness", "like"},
+		{"least", "least"},
+		{"irish", "irish"},
+		{"significance", "signific"},
+		{"indictment", "indict"},
+		{"tillers", "tiller"},
+		{"upon", "upon"},
+		{"press", "press"},
+		{"southward", "southward"},
+		{"indoors", "indoor"},
+		{"penned", "pen"},
+		{"rested", "rest"},
+		{"pounced", "pounc"},
+		{"reprimanded", "reprimand"},
+		{"needling", "needl"},
+		{"staked", "stake"},
+		{"civilization", "civil"},
+		{"peterson", "peterson"},
+		{"unequally", "unequ"},
+		{"straining", "strain"},
+		{"project", "project"},
+		{"imagine", "imagin"},
+		{"practically", "practic"},
+		{"areas", "area"},
+		{"breathless", "breathless"},
+		{"influenced", "influenc"},
+		{"reader", "reader"},
+		{"magnate", "magnat"},
+		{"rarefying", "rarefi"},
+		{"vivat", "vivat"},
+		{"proliferating", "prolifer"},
+		{"tasted", "tast"},
+		{"isthmus", "isthmu"},
+		{"stoutly", "stoutli"},
+		{"fissured", "fissur"},
+		{"favorite", "favorit"},
+		{"apsheron", "apsheron"},
+		{"allayed", "allai"},
+		{"veldt", "veldt"},
+		{"creases", "creas"},
+		{"deprive", "depriv"},
+		{"pockets", "pocket"},
+		{"pancake", "pancak"},
+		{"debris", "debri"},
+		{"carries", "carri"},
+		{"through", "through"},
+		{"abundantly", "abundantli"},
+		{"prettier", "prettier"},
+		{"initiate", "initi"},
+		{"elder", "elder"},
+		{"anti", "anti"},
+		{"rang", "rang"},
+		{"medical", "medic"},
+		{"daggers", "dagger"},
+		{"rim", "rim"},
+		{"organised", "organis"},
+		{"sabine", "sabin"},
+		{"catgut", "catgut"},
+		{"reverse", "revers"},
+		{"smash", "smash"},
+		{"beats", "beat"},
+		{"agency", "agenc"},
+		{"systolic", "systol"},
+		{"salt", "salt"},
+		{"determines", "determin"},
+		{"benevolent", "benevol"},
+		{"conduct", "conduct"},
+		{"traveled", "travel"},
+		{"traitors", "traitor"},
+		{"thinker", "thinker"},
+		{"patrick", "patrick"},
+		{"chichagov", "chichagov"},
+		{"profile", "profil"},
+		{"betraying", "betrai"},
+		{"expanse", "expans"},
+		{"clavicle", "clavicl"},
+		{"simpler", "simpler"},
+		{"ilarionovich", "ilarionovich"},
+		{"consciousness", "conscious"},
+		{"deposits", "deposit"},
+		{"improper", "improp"},
+		{"buds", "bud"},
+		{"necessity", "necess"},
+		{"bandy", "bandi"},
+		{"briskly", "briskli"},
+		{"knapsack", "knapsack"},
+		{"hughes", "hugh"},
+		{"slashed", "slash"},
+		{"hercules", "hercul"},
+		{"william", "william"},
+		{"hawk", "hawk"},
+		{"themes", "theme"},
+		{"overcome", "overcom"},
+		{"suggests", "suggest"},
+		{"violent", "violent"},
+		{"stony", "stoni"},
+		{"moran", "moran"},
+		{"benefactor", "benefactor"},
+		{"feebly", "feebli"},
+		{"deafening", "deafen"},
+		{"mortgage", "mortgag"},
+		{"gadsden", "gadsden"},
+		{"utah", "utah"},
+		{"outstretched", "outstretch"},
+		{"outright", "outright"},
+		{"sweetly", "sweetli"},
+		{"seriously", "serious"},
+		{"volkonski", "volkonski"},
+		{"darted", "dart"},
+		{"orphan", "orphan"},
+		{"speranski", "speranski"},
+		{"packing", "pack"},
+		{"bees", "bee"},
+		{"ornaments", "ornament"},
+		{"instruments", "instrument"},
+		{"happy", "happi"},
+		{"help", "help"},
+		{"excused", "excus"},
+		{"dilatation", "dilat"},
+		{"disorder", "disord"},
+		{"begun", "begun"},
+		{"enormous", "enorm"},
+		{"combed", "comb"},
+		{"fascinating", "fascin"},
+		{"reticulated", "reticul"},
+		{"past", "past"},
+		{"withdrawn", "withdrawn"},
+		{"gaiety", "gaieti"},
+		{"fours", "four"},
+		{"ray", "rai"},
+		{"denouncing", "denounc"},
+		{"resulting", "result"},
+		{"presentation", "present"},
+		{"delaware", "delawar"},
+		{"nursery", "nurseri"},
+		{"served", "serv"},
+		{"relish", "relish"},
+		{"robbed", "rob"},
+		{"cowboy", "cowboi"},
+		{"returns", "return"},
+		{"illusory", "illusori"},
+		{"bequeathed", "bequeath"},
+		{"rectify", "rectifi"},
+		{"accompanies", "accompani"},
+		{"subsiding", "subsid"},
+		{"whining", "whine"},
+		{"worthy", "worthi"},
+		{"nonobservance", "nonobserv"},
+		{"maturity", "matur"},
+		{"bazin", "bazin"},
+		{"nikolievna", "nikolievna"},
+		{"arteries", "arteri"},
+		{"contemptuously", "contemptu"},
+		{"atrophy", "atrophi"},
+		{"capillaries", "capillari"},
+		{"adjournment", "adjourn"},
+		{"comforted", "comfort"},
+		{"unexplained", "unexplain"},
+		{"opponents", "oppon"},
+		{"rockies", "rocki"},
+		{"parietal", "pariet"},
+		{"corporal", "corpor"},
+		{"tearing", "tear"},
+		{"divorced", "divorc"},
+		{"lips", "lip"},
+		{"sacral", "sacral"},
+		{"colles", "coll"},
+		{"explored", "explor"},
+		{"stream", "stream"},
+		{"boulogne", "boulogn"},
+		{"ducts", "duct"},
+		{"secret", "secret"},
+		{"minnesingers", "minnesing"},
+		{"elasticity", "elast"},
+		{"lodging", "lodg"},
+		{"trial", "trial"},
+		{"transfer", "transfer"},
+		{"address", "address"},
+		{"xiv", "xiv"},
+		{"subungual", "subungu"},
+		{"chased", "chase"},
+		{"repulsed", "repuls"},
+		{"intelligence", "intellig"},
+		{"challenge", "challeng"},
+		{"effusion", "effus"},
+		{"rest", "rest"},
+		{"horns", "horn"},
+		{"childish", "childish"},
+		{"intima", "intima"},
+		{"tweed", "tweed"},
+		{"podgy", "podgi"},
+		{"built", "built"},
+		{"acquired", "acquir"},
+		{"bier", "bier"},
+		{"pupil", "pupil"},
+		{"bishops", "bishop"},
+		{"colonial", "coloni"},
+		{"putting", "put"},
+		{"notables", "notabl"},
+		{"scarlet", "scarlet"},
+		{"interrupting", "interrupt"},
+		{"vereshchagin", "vereshchagin"},
+		{"steady", "steadi"},
+		{"fulton", "fulton"},
+		{"attitude", "attitud"},
+		{"dermatitis", "dermat"},
+		{"agricultural", "agricultur"},
+		{"tack", "tack"},
+		{"cooperation", "cooper"},
+		{"papers", "paper"},
+		{"reappearance", "reappear"},
+		{"gardens", "garden"},
+		{"wording", "word"},
+		{"signed", "sign"},
+		{"glycogen", "glycogen"},
+		{"complain", "complain"},
+		{"tolerable", "toler"},
+		{"recommendation", "recommend"},
+		{"yield", "yield"},
+		{"accordance", "accord"},
+		{"momentum", "momentum"},
+		{"preached", "preach"},
+		{"baptists", "baptist"},
+		{"reelection", "reelect"},
+		{"thicker", "thicker"},
+		{"agitators", "agit"},
+		{"jovial", "jovial"},
+		{"efficacious", "efficaci"},
+		{"swabs", "swab"},
+		{"chin", "chin"},
+		{"banded", "band"},
+		{"answering", "answer"},
+		{"furrow", "furrow"},
+		{"choked", "choke"},
+		{"incoherent", "incoher"},
+		{"seemed", "seem"},
+		{"disturbances", "disturb"},
+		{"contractors", "contractor"},
+		{"embarrassed", "embarrass"},
+		{"busy", "busi"},
+		{"occurs", "occur"},
+		{"interested", "interest"},
+		{"bestowed", "bestow"},
+		{"solitary", "solitari"},
+		{"appointees", "appointe"},
+		{"surmises", "surmis"},
+		{"poet", "poet"},
+		{"decomposing", "decompos"},
+		{"meade", "mead"},
+		{"blend", "blend"},
+		{"indifference", "indiffer"},
+		{"deemed", "deem"},
+		{"recalcitrant", "recalcitr"},
+		{"precision", "precis"},
+		{"representative", "repres"},
+		{"bending", "bend"},
+		{"apex", "apex"},
+		{"accentuated", "accentu"},
+		{"recovers", "recov"},
+		{"worthless", "worthless"},
+		{"sits", "sit"},
+		{"kick", "kick"},
+		{"rat", "rat"},
+		{"metallic", "metal"},
+		{"now", "now"},
+		{"digested", "digest"},
+		{"interferes", "interfer"},
+		{"thronged", "throng"},
+		{"bezukhova", "bezukhova"},
+		{"multilocular", "multilocular"},
+		{"feasible", "feasibl"},
+		{"plied", "pli"},
+		{"doubt", "doubt"},
+		{"ivory", "ivori"},
+		{"susquehanna", "susquehanna"},
+		{"bible", "bibl"},
+		{"throw", "throw"},
+		{"glimmered", "glimmer"},
+		{"savagely", "savag"},
+		{"shelled", "shell"},
+		{"boy", "boi"},
+		{"daredevil", "daredevil"},
+		{"spokesmen", "spokesmen"},
+		{"augesd", "augesd"},
+		{"fantastic", "fantast"},
+		{"virginia", "virginia"},
+		{"cambridge", "cambridg"},
+		{"remote", "remot"},
+		{"ambassadors", "ambassador"},
+		{"democracy", "democraci"},
+		{"rises", "rise"},
+		{"natured", "natur"},
+		{"centuries", "centuri"},
+		{"rooted", "root"},
+		{"sensation", "sensat"},
+		{"belly", "belli"},
+		{"orthodox", "orthodox"},
+		{"shrine", "shrine"},
+		{"moulton", "moulton"},
+		{"owner", "owner"},
+		{"entreated", "entreat"},
+		{"lackey", "lackei"},
+		{"falling", "fall"},
+		{"constitution", "constitut"},
+		{"mist", "mist"},
+		{"snatch", "snatch"},
+		{"flowing", "flow"},
+		{"exertion", "exert"},
+		{"obtain", "obtain"},
+		{"likes", "like"},
+		{"accepts", "accept"},
+		{"sack", "sack"},
+		{"pity", "piti"},
+		{"disciplined", "disciplin"},
+		{"morris", "morri"},
+		{"paraplegia", "paraplegia"},
+		{"natasha", "natasha"},
+		{"ischial", "ischial"},
+		{"hardness", "hard"},
+		{"luminous", "lumin"},
+		{"trocar", "trocar"},
+		{"glimmer", "glimmer"},
+		{"someone", "someon"},
+		{"continues", "continu"},
+		{"magazines", "magazin"},
+		{"iberian", "iberian"},
+		{"fostered", "foster"},
+		{"contractions", "contract"},
+		{"subjects", "subject"},
+		{"eighteenth", "eighteenth"},
+		{"remnant", "remnant"},
+		{"composite", "composit"},
+		{"campfire", "campfir"},
+		{"running", "run"},
+		{"try", "try"},
+		{"madness", "mad"},
+		{"enrollment", "enrol"},
+		{"bowl", "bowl"},
+		{"marching", "march"},
+		{"inner", "inner"},
+		{"sacked", "sack"},
+		{"lots", "lot"},
+		{"unfortunately", "unfortun"},
+		{"concerning", "concern"},
+		{"clinging", "cling"},
+		{"venomous", "venom"},
+		{"experimental", "experiment"},
+		{"hamstrings", "hamstr"},
+		{"infective", "infect"},
+		{"taint", "taint"},
+		{"kremlin", "kremlin"},
+		{"exercises", "exercis"},
+		{"impetus", "impetu"},
+		{"populist", "populist"},
+		{"consciences", "conscienc"},
+		{"chester", "chester"},
+		{"gnawing", "gnaw"},
+		{"lysander", "lysand"},
+		{"consoling", "consol"},
+		{"especially", "especi"},
+		{"police", "polic"},
+		{"purchases", "purchas"},
+		{"perform", "perform"},
+		{"criticize", "critic"},
+		{"concession", "concess"},
+		{"notabilities", "notabl"},
+		{"tar", "tar"},
+		{"surrey", "surrei"},
+		{"commodore", "commodor"},
+		{"daybreak", "daybreak"},
+		{"usage", "usag"},
+		{"versa", "versa"},
+		{"awoke", "awok"},
+		{"cowpens", "cowpen"},
+		{"about", "about"},
+		{"scalds", "scald"},
+		{"failure", "failur"},
+		{"drain", "drain"},
+		{"magazine", "magazin"},
+		{"hurt", "hurt"},
+		{"silence", "silenc"},
+		{"interval", "interv"},
+		{"ledger", "ledger"},
+		{"devotion", "devot"},
+		{"example", "exampl"},
+		{"accommodation", "accommod"},
+		{"barrier", "barrier"},
+		{"consoled", "consol"},
+		{"naevi", "naevi"},
+		{"fibrosa", "fibrosa"},
+		{"flank", "flank"},
+		{"tic", "tic"},
+		{"concluded", "conclud"},
+		{"stratum", "stratum"},
+		{"sleepy", "sleepi"},
+		{"communicating", "commun"},
+		{"irregular", "irregular"},
+		{"contemplation", "contempl"},
+		{"wallet", "wallet"},
+		{"owing", "ow"},
+		{"shows", "show"},
+		{"schon", "schon"},
+		{"frock", "frock"},
+		{"attempting", "attempt"},
+		{"longer", "longer"},
+		{"meanly", "meanli"},
+		{"epi", "epi"},
+		{"externa", "externa"},
+		{"negotiating", "negoti"},
+		{"cool", "cool"},
+		{"povarskoy", "povarskoi"},
+		{"ropes", "rope"},
+		{"urope", "urop"},
+		{"independence", "independ"},
+		{"stale", "stale"},
+		{"imagines", "imagin"},
+		{"darting", "dart"},
+		{"noiseless", "noiseless"},
+		{"pictures", "pictur"},
+		{"band", "band"},
+		{"insoluble", "insolubl"},
+		{"intrusted", "intrust"},
+		{"ladder", "ladder"},
+		{"tormenting", "torment"},
+		{"jug", "jug"},
+		{"premiers", "premier"},
+		{"salicylates", "salicyl"},
+		{"iron", "iron"},
+		{"chimed", "chime"},
+		{"wrecked", "wreck"},
+		{"introduced", "introduc"},
+		{"hurriedly", "hurriedli"},
+		{"afterward", "afterward"},
+		{"dated", "date"},
+		{"inscription", "inscript"},
+		{"dramatic", "dramat"},
+		{"englishmen", "englishmen"},
+		{"chat", "chat"},
+		{"goes", "goe"},
+		{"unloaded", "unload"},
+		{"serve", "serv"},
+		{"feat", "feat"},
+		{"matters", "matter"},
+		{"metropolis", "metropoli"},
+		{"class", "class"},
+		{"tariff", "tariff"},
+		{"orlov", "orlov"},
+		{"visitor", "visitor"},
+		{"snatches", "snatch"},
+		{"differentiate", "differenti"},
+		{"evaded", "evad"},
+		{"indicate", "indic"},
+		{"crossed", "cross"},
+		{"imperative", "imper"},
+		{"beings", "be"},
+		{"repairs", "repair"},
+		{"hatherley", "hatherlei"},
+		{"callus", "callu"},
+		{"sixteenth", "sixteenth"},
+		{"gonococcus", "gonococcu"},
+		{"regarded", "regard"},
+		{"gunpowder", "gunpowd"},
+		{"encourage", "encourag"},
+		{"matas", "mata"},
+		{"borne", "born"},
+		{"poland", "poland"},
+		{"pudding", "pud"},
+		{"dwelt", "dwelt"},
+		{"cases", "case"},
+		{"crime", "crime"},
+		{"steps", "step"},
+		{"controlling", "control"},
+		{"inferred", "infer"},
+		{"govern", "govern"},
+		{"edition", "edit"},
+		{"weakening", "weaken"},
+		{"sleighs", "sleigh"},
+		{"regeneration", "regener"},
+		{"accidentally", "accident"},
+		{"characteristic", "characterist"},
+		{"favor", "favor"},
+		{"engaging", "engag"},
+		{"dimensions", "dimens"},
+		{"appalling", "appal"},
+		{"take", "take"},
+		{"contingencies", "conting"},
+		{"laughter", "laughter"},
+		{"oftener", "often"},
+		{"exalt", "exalt"},
+		{"mobs", "mob"},
+		{"wished", "wish"},
+		{"aided", "aid"},
+		{"bravo", "bravo"},
+		{"sunburned", "sunburn"},
+		{"forgive", "forgiv"},
+		{"dislocation", "disloc"},
+		{"prejudices", "prejudic"},
+		{"predisposes", "predispos"},
+		{"carrel", "carrel"},
+		{"muffled", "muffl"},
+		{"cowshed", "cowsh"},
+		{"bespattered", "bespatt"},
+		{"bursa", "bursa"},
+		{"deceit", "deceit"},
+		{"stout", "stout"},
+		{"increasing", "increas"},
+		{"osseous", "osseou"},
+		{"golitsyn", "golitsyn"},
+		{"inspect", "inspect"},
+		{"somebody", "somebodi"},
+		{"imperceptible", "impercept"},
+		{"sequence", "sequenc"},
+		{"maids", "maid"},
+		{"rejoined", "rejoin"},
+		{"deliberations", "deliber"},
+		{"teeth", "teeth"},
+		{"artery", "arteri"},
+		{"deserter", "desert"},
+		{"wondering", "wonder"},
+		{"sunny", "sunni"},
+		{"bowels", "bowel"},
+		{"cupping", "cup"},
+		{"haste", "hast"},
+		{"vasili", "vasili"},
+		{"dobroe", "dobro"},
+		{"commotion", "commot"},
+		{"painless", "painless"},
+		{"conceivable", "conceiv"},
+		{"stools", "stool"},
+		{"apsherons", "apsheron"},
+		{"transplantation", "transplant"},
+		{"perceived", "perceiv"},
+		{"relief", "relief"},
+		{"loyalists", "loyalist"},
+		{"offended", "offend"},
+		{"heritage", "heritag"},
+		{"carnegie", "carnegi"},
+		{"paler", "paler"},
+		{"merryweather", "merryweath"},
+		{"annulled", "annul"},
+		{"approbation", "approb"},
+		{"powerfully", "powerfulli"},
+		{"indurated", "indur"},
+		{"opportunities", "opportun"},
+		{"vertebrae", "vertebra"},
+		{"amusement", "amus"},
+		{"oxygenated", "oxygen"},
+		{"secretion", "secret"},
+		{"enjoyment", "enjoy"},
+		{"attractive", "attract"},
+		{"supply", "suppli"},
+		{"unending", "unend"},
+		{"physically", "physic"},
+		{"possession", "possess"},
+		{"continually", "continu"},
+		{"aorta", "aorta"},
+		{"auricular", "auricular"},
+		{"huge", "huge"},
+		{"chiefly", "chiefli"},
+		{"slightest", "slightest"},
+		{"saucer", "saucer"},
+		{"grandfather", "grandfath"},
+		{"altered", "alter"},
+		{"throats", "throat"},
+		{"urgently", "urgent"},
+		{"laughingly", "laughingli"},
+		{"peremptorily", "peremptorili"},
+		{"frieze", "friez"},
+		{"currency", "currenc"},
+		{"settlers", "settler"},
+		{"carpi", "carpi"},
+		{"girt", "girt"},
+		{"gastric", "gastric"},
+		{"reacted", "react"},
+		{"odd", "odd"},
+		{"shading", "shade"},
+		{"fit", "fit"},
+		{"doorway", "doorwai"},
+		{"refuge", "refug"},
+		{"pellets", "pellet"},
+		{"needs", "need"},
+		{"stifle", "stifl"},
+		{"spore", "spore"},
+		{"seizes", "seiz"},
+		{"smiths", "smith"},
+		{"yesterday", "yesterdai"},
+		{"zebulon", "zebulon"},
+		{"muddle", "muddl"},
+		{"hunted", "hunt"},
+		{"remarkable", "remark"},
+		{"dishonored", "dishonor"},
+		{"discern", "discern"},
+		{"havana", "havana"},
+		{"symmetrically", "symmetr"},
+		{"deadlock", "deadlock"},
+		{"ignorant", "ignor"},
+		{"gleefully", "gleefulli"},
+		{"revolutionists", "revolutionist"},
+		{"visibly", "visibl"},
+		{"solemnly", "solemnli"},
+		{"bits", "bit"},
+		{"distinguished", "distinguish"},
+		{"emptying", "empti"},
+		{"father", "father"},
+		{"sovereigns", "sovereign"},
+		{"dryness", "dryness"},
+		{"waiting", "wait"},
+		{"devices", "devic"},
+		{"snarled", "snarl"},
+		{"initiated", "initi"},
+		{"windows", "window"},
+		{"exactitude", "exactitud"},
+		{"vous", "vou"},
+		{"crossroads", "crossroad"},
+		{"narrow", "narrow"},
+		{"wheel", "wheel"},
+		{"quarrels", "quarrel"},
+		{"victor", "victor"},
+		{"devoted", "devot"},
+		{"across", "across"},
+		{"sleek", "sleek"},
+		{"modestly", "modestli"},
+		{"vodka", "vodka"},
+		{"korchevo", "korchevo"},
+		{"dream", "dream"},
+		{"together", "togeth"},
+		{"enumerated", "enumer"},
+		{"threads", "thread"},
+		{"merit", "merit"},
+		{"honneur", "honneur"},
+		{"mounting", "mount"},
+		{"originality", "origin"},
+		{"giving", "give"},
+		{"duchy", "duchi"},
+		{"porters", "porter"},
+		{"undo", "undo"},
+		{"wipe", "wipe"},
+		{"glio", "glio"},
+		{"violate", "violat"},
+		{"watched", "watch"},
+		{"hoard", "hoard"},
+		{"bitski", "bitski"},
+		{"stand", "stand"},
+		{"pectoral", "pector"},
+		{"ranger", "ranger"},
+		{"thrashing", "thrash"},
+		{"topics", "topic"},
+		{"stay", "stai"},
+		{"eaten", "eaten"},
+		{"sacks", "sack"},
+		{"guessing", "guess"},
+		{"blindfold", "blindfold"},
+		{"mal", "mal"},
+		{"president", "presid"},
+		{"unaffected", "unaffect"},
+		{"douching", "douch"},
+		{"cubic", "cubic"},
+		{"dries", "dri"},
+		{"curb", "curb"},
+		{"voted", "vote"},
+		{"sideboard", "sideboard"},
+		{"astray", "astrai"},
+		{"traffic", "traffic"},
+		{"relation", "relat"},
+		{"preventing", "prevent"},
+		{"nobility", "nobil"},
+		{"manufacturers", "manufactur"},
+		{"formalin", "formalin"},
+		{"laparotomy", "laparotomi"},
+		{"tavern", "tavern"},
+		{"outlet", "outlet"},
+		{"transylvania", "transylvania"},
+		{"disperse", "dispers"},
+		{"bruit", "bruit"},
+		{"walking", "walk"},
+		{"dental", "dental"},
+		{"dakota", "dakota"},
+		{"emboli", "emboli"},
+		{"natives", "nativ"},
+		{"brightly", "brightli"},
+		{"coherence", "coher"},
+		{"ordinance", "ordin"},
+		{"velvet", "velvet"},
+		{"goodness", "good"},
+		{"indiscretion", "indiscret"},
+		{"dinnertime", "dinnertim"},
+		{"vacated", "vacat"},
+		{"scherer", "scherer"},
+		{"around", "around"},
+		{"inspector", "inspector"},
+		{"sergeants", "sergeant"},
+		{"starts", "start"},
+		{"sequestrated", "sequestr"},
+		{"jest", "jest"},
+		{"clouds", "cloud"},
+		{"grains", "grain"},
+		{"entertain", "entertain"},
+		{"flopped", "flop"},
+		{"seventh", "seventh"},
+		{"extract", "extract"},
+		{"overtook", "overtook"},
+		{"bearded", "beard"},
+		{"illegally", "illeg"},
+		{"recruits", "recruit"},
+		{"filling", "fill"},
+		{"unpleasantly", "unpleasantli"},
+		{"crown", "crown"},
+		{"sodium", "sodium"},
+		{"xxiii", "xxiii"},
+		{"virchow", "virchow"},
+		{"gleamed", "gleam"},
+		{"infamous", "infam"},
+		{"braced", "brace"},
+		{"kinsman", "kinsman"},
+		{"unhappiness", "unhappi"},
+		{"bribery", "briberi"},
+		{"shed", "shed"},
+		{"documentary", "documentari"},
+		{"personalities", "person"},
+		{"atom", "atom"},
+		{"quickness", "quick"},
+		{"kentucky", "kentucki"},
+		{"tangible", "tangibl"},
+		{"doubting", "doubt"},
+		{"shiny", "shini"},
+		{"abscesses", "abscess"},
+		{"tell", "tell"},
+		{"coast", "coast"},
+		{"rays", "rai"},
+		{"cheerfulness", "cheer"},
+		{"stirrup", "stirrup"},
+		{"sleep", "sleep"},
+		{"harassed", "harass"},
+		{"awaited", "await"},
+		{"basis", "basi"},
+		{"indebted", "indebt"},
+		{"dirt", "dirt"},
+		{"impairment", "impair"},
+		{"hurrah", "hurrah"},
+		{"engaged", "engag"},
+		{"reassured", "reassur"},
+		{"vehemently", "vehement"},
+		{"strangulated", "strangul"},
+		{"rebuilding", "rebuild"},
+		{"handing", "hand"},
+		{"haiti", "haiti"},
+		{"amiss", "amiss"},
+		{"resolutely", "resolut"},
+		{"mad", "mad"},
+		{"abrasions", "abras"},
+		{"flourishing", "flourish"},
+		{"tendo", "tendo"},
+		{"cried", "cri"},
+		{"glottis", "glotti"},
+		{"proudly", "proudli"},
+		{"philosophers", "philosoph"},
+		{"enters", "enter"},
+		{"denote", "denot"},
+		{"fined", "fine"},
+		{"youth", "youth"},
+		{"bivouacs", "bivouac"},
+		{"influential", "influenti"},
+		{"power", "power"},
+		{"endeavor", "endeavor"},
+		{"utterly", "utterli"},
+		{"cents", "cent"},
+		{"phalanges", "phalang"},
+		{"secession", "secess"},
+		{"unfolding", "unfold"},
+		{"site", "site"},
+		{"deed", "deed"},
+		{"neuralgic", "neuralg"},
+		{"wreck", "wreck"},
+		{"arteritis", "arter"},
+		{"foreigner", "foreign"},
+		{"maimed", "maim"},
+		{"swept", "swept"},
+		{"appeals", "appeal"},
+		{"fete", "fete"},
+		{"fluids", "fluid"},
+		{"conveyance", "convey"},
+		{"execute", "execut"},
+		{"copies", "copi"},
+		{"auricle", "auricl"},
+		{"weapon", "weapon"},
+		{"spindle", "spindl"},
+		{"catch", "catch"},
+		{"annihilate", "annihil"},
+		{"inhalation", "inhal"},
+		{"confidently", "confid"},
+		{"fera", "fera"},
+		{"prudence", "prudenc"},
+		{"volunteers", "volunt"},
+		{"rights", "right"},
+		{"exit", "exit"},
+		{"delirious", "deliri"},
+		{"neutrality", "neutral"},
+		{"inches", "inch"},
+		{"insertion", "insert"},
+		{"enveloped", "envelop"},
+		{"hoping", "hope"},
+		{"supposed", "suppos"},
+		{"acts", "act"},
+		{"indissoluble", "indissolubl"},
+		{"lymphangioma", "lymphangioma"},
+		{"meanness", "mean"},
+		{"colleague", "colleagu"},
+		{"encouragement", "encourag"},
+		{"superintendent", "superintend"},
+		{"atlantic", "atlant"},
+		{"six", "six"},
+		{"almond", "almond"},
+		{"arizona", "arizona"},
+		{"recorded", "record"},
+		{"hurrying", "hurri"},
+		{"throat", "throat"},
+		{"push", "push"},
+		{"prospective", "prospect"},
+		{"report", "report"},
+		{"horner", "horner"},
+		{"fulfillment", "fulfil"},
+		{"incapable", "incap"},
+		{"amount", "amount"},
+		{"runaway", "runawai"},
+		{"skirmishing", "skirmish"},
+		{"climax", "climax"},
+		{"levied", "levi"},
+		{"presumably", "presum"},
+		{"paced", "pace"},
+		{"soup", "soup"},
+		{"prison", "prison"},
+		{"reverberated", "reverber"},
+		{"neuromatosa", "neuromatosa"},
+		{"wisp", "wisp"},
+		{"unit", "unit"},
+		{"enlargement", "enlarg"},
+		{"yakov", "yakov"},
+		{"kings", "king"},
+		{"infringed", "infring"},
+		{"smoke", "smoke"},
+		{"godfrey", "godfrei"},
+		{"luxurious", "luxuri"},
+		{"joseph", "joseph"},
+		{"fistula", "fistula"},
+		{"envy", "envi"},
+		{"duncan", "duncan"},
+		{"knocking", "knock"},
+		{"lawsuit", "lawsuit"},
+		{"hairs", "hair"},
+		{"rubbed", "rub"},
+		{"touching", "touch"},
+		{"organism", "organ"},
+		{"glancing", "glanc"},
+		{"vexation", "vexat"},
+		{"defense", "defens"},
+		{"rope", "rope"},
+		{"comforting", "comfort"},
+		{"insects", "insect"},
+		{"prayers", "prayer"},
+		{"arching", "arch"},
+		{"pause", "paus"},
+		{"constructed", "construct"},
+		{"ornament", "ornament"},
+		{"resect", "resect"},
+		{"foretold", "foretold"},
+		{"properly", "properli"},
+		{"elias", "elia"},
+		{"gladly", "gladli"},
+		{"toy", "toi"},
+		{"functionate", "function"},
+		{"heavenly", "heavenli"},
+		{"grandson", "grandson"},
+		{"villain", "villain"},
+		{"forged", "forg"},
+		{"stricter", "stricter"},
+		{"britannica", "britannica"},
+		{"tidy", "tidi"},
+		{"unconscious", "unconsci"},
+		{"grease", "greas"},
+		{"achievement", "achiev"},
+		{"captains", "captain"},
+		{"joined", "join"},
+		{"dotted", "dot"},
+		{"discussing", "discuss"},
+		{"wintzingerode", "wintzingerod"},
+		{"pegs", "peg"},
+		{"bosses", "boss"},
+		{"cher", "cher"},
+		{"gerasim", "gerasim"},
+		{"invited", "invit"},
+		{"continuous", "continu"},
+		{"botanist", "botanist"},
+		{"distract", "distract"},
+		{"imperials", "imperi"},
+		{"interest", "interest"},
+		{"appears", "appear"},
+		{"insurgents", "insurg"},
+		{"praise", "prais"},
+		{"fines", "fine"},
+		{"success", "success"},
+		{"prevented", "prevent"},
+		{"prevail", "prevail"},
+		{"sailed", "sail"},
+		{"quieter", "quieter"},
+		{"ligatures", "ligatur"},
+		{"oftenest", "oftenest"},
+		{"brass", "brass"},
+		{"lymphadenoma", "lymphadenoma"},
+		{"postmark", "postmark"},
+		{"injunctions", "injunct"},
+		{"omental", "oment"},
+		{"cartilaginous", "cartilagin"},
+		{"receipts", "receipt"},
+		{"yell", "yell"},
+		{"toward", "toward"},
+		{"eruption", "erupt"},
+		{"domo", "domo"},
+		{"boys", "boi"},
+		{"fibrin", "fibrin"},
+		{"taken", "taken"},
+		{"correct", "correct"},
+		{"tubular", "tubular"},
+		{"shoot", "shoot"},
+		{"coffee", "coffe"},
+		{"waggon", "waggon"},
+		{"throbbing", "throb"},
+		{"accidental", "accident"},
+		{"different", "differ"},
+		{"shelling", "shell"},
+		{"septa", "septa"},
+		{"conferring", "confer"},
+		{"virtuous", "virtuou"},
+		{"allusions", "allus"},
+		{"wretches", "wretch"},
+		{"hypertrophic", "hypertroph"},
+		{"grandeur", "grandeur"},
+		{"stab", "stab"},
+		{"rostova", "rostova"},
+		{"telephone", "telephon"},
+		{"hemisphere", "hemispher"},
+		{"defeat", "defeat"},
+		{"campaigns", "campaign"},
+		{"readiness", "readi"},
+		{"immortal", "immort"},
+		{"gestures", "gestur"},
+		{"gown", "gown"},
+		{"forgetting", "forget"},
+		{"tortured", "tortur"},
+		{"candles", "candl"},
+		{"coincides", "coincid"},
+		{"vanity", "vaniti"},
+		{"broussier", "broussier"},
+		{"straggling", "straggl"},
+		{"santa", "santa"},
+		{"brotherhoods", "brotherhood"},
+		{"mail", "mail"},
+		{"charges", "charg"},
+		{"jerky", "jerki"},
+		{"ferguson", "ferguson"},
+		{"unfastened", "unfasten"},
+		{"dominance", "domin"},
+		{"injected", "inject"},
+		{"distrusted", "distrust"},
+		{"confidant", "confid"},
+		{"radiated", "radiat"},
+		{"klapp", "klapp"},
+		{"interpreter", "interpret"},
+		{"cash", "cash"},
+		{"supplied", "suppli"},
+		{"satire", "satir"},
+		{"feeling", "feel"},
+		{"danube", "danub"},
+		{"genitals", "genit"},
+		{"despotism", "despot"},
+		{"wooden", "wooden"},
+		{"unable", "unabl"},
+		{"awfully", "awfulli"},
+		{"irreproachable", "irreproach"},
+		{"sitting", "sit"},
+		{"employees", "employe"},
+		{"guaranteeing", "guarante"},
+		{"objected", "object"},
+		{"vault", "vault"},
+		{"fellows", "fellow"},
+		{"anticipating", "anticip"},
+		{"reaped", "reap"},
+		{"views", "view"},
+		{"charm", "charm"},
+		{"rapturous", "raptur"},
+		{"appliances", "applianc"},
+		{"hitherto", "hitherto"},
+		{"renominated", "renomin"},
+		{"robert", "robert"},
+		{"sharing", "share"},
+		{"rebuild", "rebuild"},
+		{"palmaris", "palmari"},
+		{"wilderness", "wilder"},
+		{"trousseau", "trousseau"},
+		{"intimidation", "intimid"},
+		{"metaphysics", "metaphys"},
+		{"jar", "jar"},
+		{"tiresome", "tiresom"},
+		{"talents", "talent"},
+		{"sidorov", "sidorov"},
+		{"ravine", "ravin"},
+		{"pit", "pit"},
+		{"bar", "bar"},
+		{"illustration", "illustr"},
+		{"operation", "oper"},
+		{"worldliness", "worldli"},
+		{"tailed", "tail"},
+		{"privately", "privat"},
+		{"scissors", "scissor"},
+		{"brittle", "brittl"},
+		{"africa", "africa"},
+		{"wanting", "want"},
+		{"valleys", "vallei"},
+		{"orleans", "orlean"},
+		{"scatter", "scatter"},
+		{"censure", "censur"},
+		{"denying", "deni"},
+		{"quadriceps", "quadricep"},
+		{"accompaniment", "accompani"},
+		{"presentiment", "presenti"},
+		{"ruler", "ruler"},
+		{"daniel", "daniel"},
+		{"pleasantly", "pleasantli"},
+		{"probable", "probabl"},
+		{"artillery", "artilleri"},
+		{"streamed", "stream"},
+		{"tending", "tend"},
+		{"widening", "widen"},
+		{"courtiers", "courtier"},
+		{"reverence", "rever"},
+		{"beauty", "beauti"},
+		{"cadet", "cadet"},
+		{"hearers", "hearer"},
+		{"theater", "theater"},
+		{"bare", "bare"},
+		{"coupled", "coupl"},
+		{"intoxication", "intox"},
+		{"envelope", "envelop"},
+		{"restraining", "restrain"},
+		{"precede", "preced"},
+		{"wrongly", "wrongli"},
+		{"taras", "tara"},
+		{"underneath", "underneath"},
+		{"henry", "henri"},
+		{"acted", "act"},
+		{"dropped", "drop"},
+		{"redounded", "redound"},
+		{"forgiven", "forgiven"},
+		{"continued", "continu"},
+		{"selected", "select"},
+		{"radiantly", "radiantli"},
+		{"bottom", "bottom"},
+		{"equipment", "equip"},
+		{"climate", "climat"},
+		{"nine", "nine"},
+		{"walks", "walk"},
+		{"raevski", "raevski"},
+		{"soundness", "sound"},
+		{"friable", "friabl"},
+		{"results", "result"},
+		{"possibly", "possibl"},
+		{"smallest", "smallest"},
+		{"clock", "clock"},
+		{"doctors", "doctor"},
+		{"philosopher", "philosoph"},
+		{"seventeen", "seventeen"},
+		{"exudate", "exud"},
+		{"snorting", "snort"},
+		{"deviated", "deviat"},
+		{"radiance", "radianc"},
+		{"bridled", "bridl"},
+		{"salons", "salon"},
+		{"voters", "voter"},
+		{"scarred", "scar"},
+		{"resting", "rest"},
+		{"loaded", "load"},
+		{"simon", "simon"},
+		{"losses", "loss"},
+		{"plots", "plot"},
+		{"flexed", "flex"},
+		{"reminded", "remind"},
+		{"seething", "seeth"},
+		{"structure", "structur"},
+		{"tobacco", "tobacco"},
+		{"pinched", "pinch"},
+		{"christians", "christian"},
+		{"quebec", "quebec"},
+		{"announce", "announc"},
+		{"subject", "subject"},
+		{"apostles", "apostl"},
+		{"pleasure", "pleasur"},
+		{"regimental", "regiment"},
+		{"oblivious", "oblivi"},
+		{"variation", "variat"},
+		{"treatment", "treatment"},
+		{"worth", "worth"},
+		{"displaces", "displac"},
+		{"convulsions", "convuls"},
+		{"threshold", "threshold"},
+		{"earthwork", "earthwork"},
+		{"maxillary", "maxillari"},
+		{"channel", "channel"},
+		{"brazil", "brazil"},
+		{"akin", "akin"},
+		{"bunches", "bunch"},
+		{"incited", "incit"},
+		{"stir", "stir"},
+		{"floors", "floor"},
+		{"speaking", "speak"},
+		{"unnoticed", "unnot"},
+		{"widower", "widow"},
+		{"multitude", "multitud"},
+		{"blotted", "blot"},
+		{"irritation", "irrit"},
+		{"barmaid", "barmaid"},
+		{"christened", "christen"},
+		{"tradition", "tradit"},
+		{"embracing", "embrac"},
+		{"limbers", "limber"},
+		{"recent", "recent"},
+		{"cantharides", "cantharid"},
+		{"successors", "successor"},
+		{"splendidly", "splendidli"},
+		{"dimple", "dimpl"},
+		{"valvular", "valvular"},
+		{"pale", "pale"},
+		{"abraded", "abrad"},
+		{"spaces", "space"},
+		{"assemble", "assembl"},
+		{"urged", "urg"},
+		{"maiden", "maiden"},
+		{"reiterated", "reiter"},
+		{"interior", "interior"},
+		{"hollows", "hollow"},
+		{"bronchial", "bronchial"},
+		{"dolgorukov", "dolgorukov"},
+		{"imitate", "imit"},
+		{"per", "per"},
+		{"outskirts", "outskirt"},
+		{"sob", "sob"},
+		{"prepared", "prepar"},
+		{"gaunt", "gaunt"},
+		{"desk", "desk"},
+		{"mountains", "mountain"},
+		{"sage", "sage"},
+		{"poklonny", "poklonni"},
+		{"vozdvizhenka", "vozdvizhenka"},
+		{"plentiful", "plenti"},
+		{"blades", "blade"},
+		{"matter", "matter"},
+		{"climb", "climb"},
+		{"swarmed", "swarm"},
+		{"illuminism", "illumin"},
+		{"distress", "distress"},
+		{"pondered", "ponder"},
+		{"twy", "twy"},
+		{"ruffle", "ruffl"},
+		{"wealthy", "wealthi"},
+		{"patients", "patient"},
+		{"subsequent", "subsequ"},
+		{"preferably", "prefer"},
+		{"pairs", "pair"},
+		{"sparkle", "sparkl"},
+		{"pigeon", "pigeon"},
+		{"alluding", "allud"},
+		{"temporo", "temporo"},
+		{"medulla", "medulla"},
+		{"jeffersonian", "jeffersonian"},
+		{"beryls", "beryl"},
+		{"charleston", "charleston"},
+		{"admiring", "admir"},
+		{"surgical", "surgic"},
+		{"commences", "commenc"},
+		{"nearest", "nearest"},
+		{"converse", "convers"},
+		{"vii", "vii"},
+		{"haired", "hair"},
+		{"roar", "roar"},
+		{"biographical", "biograph"},
+		{"inexorable", "inexor"},
+		{"bordered", "border"},
+		{"hills", "hill"},
+		{"enchantress", "enchantress"},
+		{"bactericidal", "bactericid"},
+		{"inevitable", "inevit"},
+		{"coldness", "cold"},
+		{"blow", "blow"},
+		{"rid", "rid"},
+		{"fibroids", "fibroid"},
+		{"blazed", "blaze"},
+		{"concerns", "concern"},
+		{"amendment", "amend"},
+		{"parcels", "parcel"},
+		{"muzzle", "muzzl"},
+		{"screens", "screen"},
+		{"purchaser", "purchas"},
+		{"ostitis", "ostiti"},
+		{"osteo", "osteo"},
+		{"specially", "special"},
+		{"firmer", "firmer"},
+		{"claparede", "clapared"},
+		{"sequestrectomy", "sequestrectomi"},
+		{"filthy", "filthi"},
+		{"support", "support"},
+		{"foresaw", "foresaw"},
+		{"minnesota", "minnesota"},
+		{"differentiating", "differenti"},
+		{"counteract", "counteract"},
+		{"alone", "alon"},
+		{"crackings", "crack"},
+		{"quit", "quit"},
+		{"inequalities", "inequ"},
+		{"sick", "sick"},
+		{"polymorpho", "polymorpho"},
+		{"refused", "refus"},
+		{"solitude", "solitud"},
+		{"dilated", "dilat"},
+		{"publicly", "publicli"},
+		{"bears", "bear"},
+		{"epithelioid", "epithelioid"},
+		{"dad", "dad"},
+		{"embarrassing", "embarrass"},
+		{"dog", "dog"},
+		{"tore", "tore"},
+		{"persian", "persian"},
+		{"condemned", "condemn"},
+		{"pausing", "paus"},
+		{"jacob", "jacob"},
+		{"smolensk", "smolensk"},
+		{"deceased", "deceas"},
+		{"tutuila", "tutuila"},
+		{"sadly", "sadli"},
+		{"upside", "upsid"},
+		{"calamities", "calam"},
+		{"radial", "radial"},
+		{"wing", "wing"},
+		{"presidential", "presidenti"},
+		{"amputating", "amput"},
+		{"lifting", "lift"},
+		{"sorting", "sort"},
+		{"stops", "stop"},
+		{"aide", "aid"},
+		{"yakovlev", "yakovlev"},
+		{"anybody", "anybodi"},
+		{"reply", "repli"},
+		{"impartial", "imparti"},
+		{"intimacy", "intimaci"},
+		{"railings", "rail"},
+		{"occurrence", "occurr"},
+		{"kurakin", "kurakin"},
+		{"depicted", "depict"},
+		{"perfectly", "perfectli"},
+		{"squares", "squar"},
+		{"piteously", "piteous"},
+		{"shriveled", "shrivel"},
+		{"hudson", "hudson"},
+		{"molders", "molder"},
+		{"smuggling", "smuggl"},
+		{"injury", "injuri"},
+		{"irrepressible", "irrepress"},
+		{"invitations", "invit"},
+		{"reestablish", "reestablish"},
+		{"suitable", "suitabl"},
+		{"comedy", "comedi"},
+		{"twos", "two"},
+		{"averted", "avert"},
+		{"lonely", "lone"},
+		{"refuses", "refus"},
+		{"confidential", "confidenti"},
+		{"satisfactory", "satisfactori"},
+		{"rolled", "roll"},
+		{"show", "show"},
+		{"believing", "believ"},
+		{"spectators", "spectat"},
+		{"scirrhous", "scirrhou"},
+		{"benjamin", "benjamin"},
+		{"antiseptics", "antisept"},
+		{"gossip", "gossip"},
+		{"derived", "deriv"},
+		{"dreading", "dread"},
+		{"dismal", "dismal"},
+		{"negotiation", "negoti"},
+		{"streptococcus", "streptococcu"},
+		{"ilagin", "ilagin"},
+		{"amounts", "amount"},
+		{"respected", "respect"},
+		{"scanning", "scan"},
+		{"convey", "convei"},
+		{"duport", "duport"},
+		{"move", "move"},
+		{"protruded", "protrud"},
+		{"suggested", "suggest"},
+		{"impacted", "impact"},
+		{"annual", "annual"},
+		{"ruling", "rule"},
+		{"harmful", "harm"},
+		{"reckoned", "reckon"},
+		{"cloths", "cloth"},
+		{"doubts", "doubt"},
+		{"mixed", "mix"},
+		{"friday", "fridai"},
+		{"femoral", "femor"},
+		{"pupils", "pupil"},
+		{"resections", "resect"},
+		{"homme", "homm"},
+		{"lunatic", "lunat"},
+		{"separates", "separ"},
+		{"lands", "land"},
+		{"snug", "snug"},
+		{"trifle", "trifl"},
+		{"manifests", "manifest"},
+		{"yoke", "yoke"},
+		{"carpet", "carpet"},
+		{"purity", "puriti"},
+		{"harnessed", "har"},
+		{"deference", "defer"},
+		{"squadrons", "squadron"},
+		{"shop", "shop"},
+		{"enhance", "enhanc"},
+		{"attaching", "attach"},
+		{"iliac", "iliac"},
+		{"naryshkin", "naryshkin"},
+		{"inguinal", "inguin"},
+		{"beckoned", "beckon"},
+		{"drown", "drown"},
+		{"types", "type"},
+		{"sympathized", "sympath"},
+		{"dark", "dark"},
+		{"doses", "dose"},
+		{"oedematous", "oedemat"},
+		{"rumor", "rumor"},
+		{"unjustly", "unjustli"},
+		{"ascribe", "ascrib"},
+		{"minds", "mind"},
+		{"mentioning", "mention"},
+		{"abduct", "abduct"},
+		{"dignity", "digniti"},
+		{"paroxysm", "paroxysm"},
+		{"extermination", "extermin"},
+		{"hysterical", "hyster"},
+		{"ulna", "ulna"},
+		{"parts", "part"},
+		{"pavlograd", "pavlograd"},
+		{"irene", "iren"},
+		{"buckwheat", "buckwheat"},
+		{"perishing", "perish"},
+		{"guards", "guard"},
+		{"shrinks", "shrink"},
+		{"conversant", "convers"},
+		{"rapidly", "rapidli"},
+		{"whole", "whole"},
+		{"demonstrated", "demonstr"},
+		{"limbs", "limb"},
+		{"bridle", "bridl"},
+		{"receipt", "receipt"},
+		{"referable", "refer"},
+		{"shores", "shore"},
+		{"county", "counti"},
+		{"traitor", "traitor"},
+		{"pearls", "pearl"},
+		{"women", "women"},
+		{"veiled", "veil"},
+		{"consuls", "consul"},
+		{"magnifying", "magnifi"},
+		{"notre", "notr"},
+		{"prominent", "promin"},
+		{"leg", "leg"},
+		{"parliament", "parliament"},
+		{"description", "descript"},
+		{"day", "dai"},
+		{"editor", "editor"},
+		{"conceptions", "concept"},
+		{"samuel", "samuel"},
+		{"girlish", "girlish"},
+		{"tracheotomy", "tracheotomi"},
+		{"venerable", "vener"},
+		{"retirement", "retir"},
+		{"prevails", "prevail"},
+		{"unwritten", "unwritten"},
+		{"foggy", "foggi"},
+		{"widow", "widow"},
+		{"heads", "head"},
+		{"barefoot", "barefoot"},
+		{"convenience", "conveni"},
+		{"deriving", "deriv"},
+		{"poetic", "poetic"},
+		{"excision", "excis"},
+		{"index", "index"},
+		{"route", "rout"},
+		{"cysts", "cyst"},
+		{"konovnitsyn", "konovnitsyn"},
+		{"kostroma", "kostroma"},
+		{"hatred", "hatr"},
+		{"unreasonable", "unreason"},
+		{"customary", "customari"},
+		{"firewood", "firewood"},
+		{"anthrax", "anthrax"},
+		{"offering", "offer"},
+		{"butler", "butler"},
+		{"revised", "revis"},
+		{"vera", "vera"},
+		{"peroneal", "peron"},
+		{"agent", "agent"},
+		{"insufficient", "insuffici"},
+		{"tipsy", "tipsi"},
+		{"realized", "realiz"},
+		{"stains", "stain"},
+		{"resident", "resid"},
+		{"selling", "sell"},
+		{"caucuses", "caucus"},
+		{"uniforms", "uniform"},
+		{"afraid", "afraid"},
+		{"shown", "shown"},
+		{"cheek", "cheek"},
+		{"teaching", "teach"},
+		{"conserve", "conserv"},
+		{"staphylococcal", "staphylococc"},
+		{"mormon", "mormon"},
+		{"preference", "prefer"},
+		{"unforeseen", "unforeseen"},
+		{"beforehand", "beforehand"},
+		{"naively", "naiv"},
+		{"relapsed", "relaps"},
+		{"treatises", "treatis"},
+		{"fainter", "fainter"},
+		{"thoroughly", "thoroughli"},
+		{"seen", "seen"},
+		{"worcester", "worcest"},
+		{"chylous", "chylou"},
+		{"maryland", "maryland"},
+		{"bonaparte", "bonapart"},
+		{"confusing", "confus"},
+		{"grapeshot", "grapeshot"},
+		{"congenital", "congenit"},
+		{"estate", "estat"},
+		{"turpentine", "turpentin"},
+		{"columns", "column"},
+		{"justified", "justifi"},
+		{"withdraw", "withdraw"},
+		{"mystical", "mystic"},
+		{"teutonic", "teuton"},
+		{"rigidly", "rigidli"},
+		{"fourth", "fourth"},
+		{"impart", "impart"},
+		{"fashioned", "fashion"},
+		{"conkling", "conkl"},
+		{"canada", "canada"},
+		{"grudge", "grudg"},
+		{"fibrosum", "fibrosum"},
+		{"bluntly", "bluntli"},
+		{"pillaged", "pillag"},
+		{"suspect", "suspect"},
+		{"condensed", "condens"},
+		{"marriageable", "marriag"},
+		{"booming", "boom"},
+		{"studying", "studi"},
+		{"rousing", "rous"},
+		{"rightly", "rightli"},
+		{"communistic", "communist"},
+		{"obstructed", "obstruct"},
+		{"serum", "serum"},
+		{"conspired", "conspir"},
+		{"horse", "hors"},
+		{"permeation", "permeat"},
+		{"narrowing", "narrow"},
+		{"fills", "fill"},
+		{"decline", "declin"},
+		{"bigwigs", "bigwig"},
+		{"moreau", "moreau"},
+		{"eagerly", "eagerli"},
+		{"pleading", "plead"},
+		{"trinity", "triniti"},
+		{"scarves", "scarv"},
+		{"threshing", "thresh"},
+		{"injuries", "injuri"},
+		{"preoccupation", "preoccup"},
+		{"threateningly", "threateningli"},
+		{"thunder", "thunder"},
+		{"ballarat", "ballarat"},
+		{"heavier", "heavier"},
+		{"winked", "wink"},
+		{"obtains", "obtain"},
+		{"femme", "femm"},
+		{"lined", "line"},
+		{"named", "name"},
+		{"stumps", "stump"},
+		{"involuntarily", "involuntarili"},
+		{"mined", "mine"},
+		{"repented", "repent"},
+		{"ducked", "duck"},
+		{"pretty", "pretti"},
+		{"qualifications", "qualif"},
+		{"representation", "represent"},
+		{"immense", "immens"},
+		{"growing", "grow"},
+		{"electrical", "electr"},
+		{"structurally", "structur"},
+		{"revision", "revis"},
+		{"compulsion", "compuls"},
+		{"significant", "signific"},
+		{"shall", "shall"},
+		{"fused", "fuse"},
+		{"anglaise", "anglais"},
+		{"odessa", "odessa"},
+		{"idleness", "idl"},
+		{"anticipation", "anticip"},
+		{"proliferate", "prolifer"},
+		{"lumen", "lumen"},
+		{"clues", "clue"},
+		{"albert", "albert"},
+		{"proportions", "proport"},
+		{"ulcerate", "ulcer"},
+		{"metastatic", "metastat"},
+		{"finally", "final"},
+		{"identical", "ident"},
+		{"stimulating", "stimul"},
+		{"remarkably", "remark"},
+		{"picturesque", "picturesqu"},
+		{"quitted", "quit"},
+		{"brows", "brow"},
+		{"guerrillas", "guerrilla"},
+		{"always", "alwai"},
+		{"games", "game"},
+		{"thrombosis", "thrombosi"},
+		{"crackled", "crackl"},
+		{"integrity", "integr"},
+		{"examine", "examin"},
+		{"companies", "compani"},
+		{"ryefield", "ryefield"},
+		{"conquering", "conquer"},
+		{"sum", "sum"},
+		{"highway", "highwai"},
+		{"supplemented", "supplement"},
+		{"organisation", "organis"},
+		{"drinking", "drink"},
+		{"crippled", "crippl"},
+		{"characterized", "character"},
+		{"spoils", "spoil"},
+		{"kozlovski", "kozlovski"},
+		{"terrific", "terrif"},
+		{"stole", "stole"},
+		{"whirling", "whirl"},
+		{"warrants", "warrant"},
+		{"bleeding", "bleed"},
+		{"dissipation", "dissip"},
+		{"cupolas", "cupola"},
+		{"invade", "invad"},
+		{"roll", "roll"},
+		{"effected", "effect"},
+		{"supplement", "supplement"},
+		{"mugwumps", "mugwump"},
+		{"clefts", "cleft"},
+		{"loosely", "loos"},
+		{"monogram", "monogram"},
+		{"embolism", "embol"},
+		{"road", "road"},
+		{"establishments", "establish"},
+		{"ensues", "ensu"},
+		{"terribly", "terribl"},
+		{"petersburg", "petersburg"},
+		{"les", "le"},
+		{"thoughts", "thought"},
+		{"sparkling", "sparkl"},
+		{"inunction", "inunct"},
+		{"preussisch", "preussisch"},
+		{"incubation", "incub"},
+		{"consultation", "consult"},
+		{"foci", "foci"},
+		{"hall", "hall"},
+		{"necessitate", "necessit"},
+		{"disease", "diseas"},
+		{"reminders", "remind"},
+		{"december", "decemb"},
+		{"casting", "cast"},
+		{"crowned", "crown"},
+		{"coloration", "color"},
+		{"outstanding", "outstand"},
+		{"verdure", "verdur"},
+		{"surprising", "surpris"},
+		{"string", "string"},
+		{"triumphs", "triumph"},
+		{"gun", "gun"},
+		{"regardless", "regardless"},
+		{"fields", "field"},
+		{"considerable", "consider"},
+		{"threes", "three"},
+		{"size", "size"},
+		{"log", "log"},
+		{"wharves", "wharv"},
+		{"dessalles", "dessal"},
+		{"newly", "newli"},
+		{"collection", "collect"},
+		{"fashionable", "fashion"},
+		{"strengthened", "strengthen"},
+		{"rejoice", "rejoic"},
+		{"patellar", "patellar"},
+		{"severity", "sever"},
+		{"tragic", "tragic"},
+		{"charging", "charg"},
+		{"misshapen", "misshapen"},
+		{"brethren", "brethren"},
+		{"paralysed", "paralys"},
+		{"skiagrams", "skiagram"},
+		{"genuinely", "genuin"},
+		{"kindle", "kindl"},
+		{"melyukovka", "melyukovka"},
+		{"grieve", "griev"},
+		{"frequently", "frequent"},
+		{"pyaemic", "pyaemic"},
+		{"crosses", "cross"},
+		{"knights", "knight"},
+		{"timbers", "timber"},
+		{"rift", "rift"},
+		{"nurse", "nurs"},
+		{"reliance", "relianc"},
+		{"bedouin", "bedouin"},
+		{"females", "femal"},
+		{"concealing", "conceal"},
+		{"damp", "damp"},
+		{"breakfast", "breakfast"},
+		{"within", "within"},
+		{"fold", "fold"},
+		{"apposed", "appos"},
+		{"tissues", "tissu"},
+		{"materials", "materi"},
+		{"lust", "lust"},
+		{"backward", "backward"},
+		{"oppressive", "oppress"},
+		{"limping", "limp"},
+		{"aroused", "arous"},
+		{"yours", "your"},
+		{"tolly", "tolli"},
+		{"worn", "worn"},
+		{"afford", "afford"},
+		{"sportsmen", "sportsmen"},
+		{"syme", "syme"},
+		{"groaning", "groan"},
+		{"bold", "bold"},
+		{"hunt", "hunt"},
+		{"gompers", "gomper"},
+		{"holds", "hold"},
+		{"plane", "plane"},
+		{"outward", "outward"},
+		{"toils", "toil"},
+		{"appear", "appear"},
+		{"logical", "logic"},
+		{"nervously", "nervous"},
+		{"untimely", "untim"},
+		{"rewarding", "reward"},
+		{"confirm", "confirm"},
+		{"journeyed", "journei"},
+		{"formulated", "formul"},
+		{"completed", "complet"},
+		{"occasional", "occasion"},
+		{"petitioners", "petition"},
+		{"cursed", "curs"},
+		{"salicylate", "salicyl"},
+		{"mccarthys", "mccarthi"},
+		{"brutal", "brutal"},
+		{"involucrum", "involucrum"},
+		{"starting", "start"},
+		{"judges", "judg"},
+		{"uneasily", "uneasili"},
+		{"trend", "trend"},
+		{"jolting", "jolt"},
+		{"uneasy", "uneasi"},
+		{"permanent", "perman"},
+		{"distal", "distal"},
+		{"waving", "wave"},
+		{"intellect", "intellect"},
+		{"desirable", "desir"},
+		{"merits", "merit"},
+		{"razumovskis", "razumovski"},
+		{"carbolic", "carbol"},
+		{"construct", "construct"},
+		{"sour", "sour"},
+		{"suction", "suction"},
+		{"inquest", "inquest"},
+		{"divined", "divin"},
+		{"included", "includ"},
+		{"stuart", "stuart"},
+		{"shortly", "shortli"},
+		{"subside", "subsid"},
+		{"metals", "metal"},
+		{"likely", "like"},
+		{"professions", "profess"},
+		{"screening", "screen"},
+		{"taper", "taper"},
+		{"intrenched", "intrench"},
+		{"earth", "earth"},
+		{"parapet", "parapet"},
+		{"kharsivan", "kharsivan"},
+		{"illustrations", "illustr"},
+		{"protesting", "protest"},
+		{"eau", "eau"},
+		{"intensive", "intens"},
+		{"squatted", "squat"},
+		{"glittered", "glitter"},
+		{"peasants", "peasant"},
+		{"flock", "flock"},
+		{"quickly", "quickli"},
+		{"snapped", "snap"},
+		{"extinguished", "extinguish"},
+		{"appalachian", "appalachian"},
+		{"antietam", "antietam"},
+		{"disputes", "disput"},
+		{"astir", "astir"},
+		{"fixedly", "fixedli"},
+		{"journalists", "journalist"},
+		{"crows", "crow"},
+		{"controls", "control"},
+		{"subdued", "subdu"},
+		{"dew", "dew"},
+		{"workshops", "workshop"},
+		{"absolutely", "absolut"},
+		{"conflagrations", "conflagr"},
+		{"mechanism", "mechan"},
+		{"abstracted", "abstract"},
+		{"nourishment", "nourish"},
+		{"clavicular", "clavicular"},
+		{"recurs", "recur"},
+		{"deductions", "deduct"},
+		{"shave", "shave"},
+		{"heights", "height"},
+		{"dashing", "dash"},
+		{"drugs", "drug"},
+		{"excising", "excis"},
+		{"tight", "tight"},
+		{"immeasurably", "immeasur"},
+		{"movement", "movement"},
+		{"proclaiming", "proclaim"},
+		{"pavlovich", "pavlovich"},
+		{"wart", "wart"},
+		{"entirely", "entir"},
+		{"open", "open"},
+		{"seated", "seat"},
+		{"retaliation", "retali"},
+		{"sinking", "sink"},
+		{"epithelium", "epithelium"},
+		{"dmitri", "dmitri"},
+		{"stiff", "stiff"},
+		{"stripes", "stripe"},
+		{"construction", "construct"},
+		{"cossack", "cossack"},
+		{"aback", "aback"},
+		{"shortened", "shorten"},
+		{"justify", "justifi"},
+		{"dung", "dung"},
+		{"treated", "treat"},
+		{"birth", "birth"},
+		{"none", "none"},
+		{"palpable", "palpabl"},
+		{"imperialism", "imperi"},
+		{"safely", "safe"},
+		{"doom", "doom"},
+		{"conception", "concept"},
+		{"displaced", "displac"},
+		{"gulf", "gulf"},
+		{"abductor", "abductor"},
+		{"personage", "personag"},
+		{"intend", "intend"},
+		{"lake", "lake"},
+		{"feigned", "feign"},
+		{"summoning", "summon"},
+		{"volume", "volum"},
+		{"rascal", "rascal"},
+		{"cerebro", "cerebro"},
+		{"disuse", "disus"},
+		{"veteran", "veteran"},
+		{"pavlovna", "pavlovna"},
+		{"germantown", "germantown"},
+		{"perspiring", "perspir"},
+		{"terrestrial", "terrestri"},
+		{"bites", "bite"},
+		{"adjustment", "adjust"},
+		{"cabman", "cabman"},
+		{"metacarpo", "metacarpo"},
+		{"stretched", "stretch"},
+		{"uprising", "upris"},
+		{"campan", "campan"},
+		{"bedstead", "bedstead"},
+		{"faithful", "faith"},
+		{"growers", "grower"},
+		{"battle", "battl"},
+		{"erysipelas", "erysipela"},
+		{"changed", "chang"},
+		{"department", "depart"},
+		{"spur", "spur"},
+		{"accumulation", "accumul"},
+		{"forming", "form"},
+		{"suggestions", "suggest"},
+		{"lesions", "lesion"},
+		{"recollect", "recollect"},
+		{"town", "town"},
+		{"despair", "despair"},
+		{"desolation", "desol"},
+		{"manchester", "manchest"},
+		{"myelin", "myelin"},
+		{"lymphatics", "lymphat"},
+		{"gliomatous", "gliomat"},
+		{"mandibular", "mandibular"},
+		{"astonishment", "astonish"},
+		{"expiring", "expir"},
+		{"sniffed", "snif"},
+		{"investigation", "investig"},
+		{"sventsyani", "sventsyani"},
+		{"louis", "loui"},
+		{"theaters", "theater"},
+		{"fodder", "fodder"},
+		{"stirred", "stir"},
+		{"reined", "rein"},
+		{"operative", "oper"},
+		{"absently", "absent"},
+		{"chemical", "chemic"},
+		{"know", "know"},
+		{"greece", "greec"},
+		{"catherine", "catherin"},
+		{"arise", "aris"},
+		{"minded", "mind"},
+		{"immediate", "immedi"},
+		{"assemblies", "assembl"},
+		{"earrings", "ear"},
+		{"ignat", "ignat"},
+		{"flagged", "flag"},
+		{"simulated", "simul"},
+		{"wont", "wont"},
+		{"marshall", "marshal"},
+		{"xix", "xix"},
+		{"crimean", "crimean"},
+		{"lieutenant", "lieuten"},
+		{"federal", "feder"},
+		{"vertebral", "vertebr"},
+		{"goal", "goal"},
+		{"uplifted", "uplift"},
+		{"activities", "activ"},
+		{"independent", "independ"},
+		{"cracks", "crack"},
+		{"xviii", "xviii"},
+		{"microscopical", "microscop"},
+		{"wasting", "wast"},
+		{"democratic", "democrat"},
+		{"clinically", "clinic"},
+		{"died", "di"},
+		{"hampered", "hamper"},
+		{"forgot", "forgot"},
+		{"purulent", "purul"},
+		{"pershing", "persh"},
+		{"stroma", "stroma"},
+		{"lubyanka", "lubyanka"},
+		{"irrigated", "irrig"},
+		{"insurrections", "insurrect"},
+		{"smiles", "smile"},
+		{"signal", "signal"},
+		{"towel", "towel"},
+		{"sharply", "sharpli"},
+		{"shell", "shell"},
+		{"plumes", "plume"},
+		{"bordeaux", "bordeaux"},
+		{"sacro", "sacro"},
+		{"doesn", "doesn"},
+		{"overlying", "overli"},
+		{"copying", "copi"},
+		{"developed", "develop"},
+		{"emulsion", "emuls"},
+		{"calmly", "calmli"},
+		{"liverpool", "liverpool"},
+		{"stillness", "still"},
+		{"rhodes", "rhode"},
+		{"dispelled", "dispel"},
+		{"legislation", "legisl"},
+		{"blazing", "blaze"},
+		{"owed", "ow"},
+		{"makeev", "makeev"},
+		{"aunt", "aunt"},
+		{"diphtheritic", "diphtherit"},
+		{"rock", "rock"},
+		{"statesman", "statesman"},
+		{"reflection", "reflect"},
+		{"agile", "agil"},
+		{"stud", "stud"},
+		{"overestimated", "overestim"},
+		{"suppuration", "suppur"},
+		{"frame", "frame"},
+		{"toxic", "toxic"},
+		{"awakened", "awaken"},
+		{"ninth", "ninth"},
+		{"listeners", "listen"},
+		{"flaps", "flap"},
+		{"nature", "natur"},
+		{"caprice", "capric"},
+		{"intercede", "interced"},
+		{"obeyed", "obei"},
+		{"eloquently", "eloqu"},
+		{"boston", "boston"},
+		{"successful", "success"},
+		{"freshness", "fresh"},
+		{"excitedly", "excitedli"},
+		{"bless", "bless"},
+		{"poliomyelitis", "poliomyel"},
+		{"perceptible", "percept"},
+		{"contribute", "contribut"},
+		{"masonry", "masonri"},
+		{"oaks", "oak"},
+		{"sphere", "sphere"},
+		{"reconcile", "reconcil"},
+		{"brandy", "brandi"},
+		{"marking", "mark"},
+		{"capitalist", "capitalist"},
+		{"guide", "guid"},
+		{"nights", "night"},
+		{"attains", "attain"},
+		{"synod", "synod"},
+		{"corner", "corner"},
+		{"keys", "kei"},
+		{"hay", "hai"},
+		{"orsha", "orsha"},
+		{"childhood", "childhood"},
+		{"iii", "iii"},
+		{"hints", "hint"},
+		{"render", "render"},
+		{"agreements", "agreement"},
+		{"stationary", "stationari"},
+		{"drained", "drain"},
+		{"indignation", "indign"},
+		{"wears", "wear"},
+		{"ration", "ration"},
+		{"pouched", "pouch"},
+		{"gate", "gate"},
+		{"heading", "head"},
+		{"birthday", "birthdai"},
+		{"sinner", "sinner"},
+		{"sailor", "sailor"},
+		{"charters", "charter"},
+		{"accustom", "accustom"},
+		{"ballots", "ballot"},
+		{"aimless", "aimless"},
+		{"wrapping", "wrap"},
+		{"meant", "meant"},
+		{"mechanics", "mechan"},
+		{"such", "such"},
+		{"stealthily", "stealthili"},
+		{"monk", "monk"},
+		{"implantation", "implant"},
+		{"queen", "queen"},
+		{"gathering", "gather"},
+		{"chilliness", "chilli"},
+		{"chamois", "chamoi"},
+		{"jumped", "jump"},
+		{"framed", "frame"},
+		{"dermoid", "dermoid"},
+		{"jefferson", "jefferson"},
+		{"besuhof", "besuhof"},
+		{"morphin", "morphin"},
+		{"fig", "fig"},
+		{"professional", "profession"},
+		{"taxing", "tax"},
+		{"psoas", "psoa"},
+		{"nape", "nape"},
+		{"sorts", "sort"},
+		{"neuroma", "neuroma"},
+		{"provisions", "provis"},
+		{"victoria", "victoria"},
+		{"obliterate", "obliter"},
+		{"mikulino", "mikulino"},
+		{"coagulated", "coagul"},
+		{"writhing", "writh"},
+		{"deep", "deep"},
+		{"osteomalacia", "osteomalacia"},
+		{"trust", "trust"},
+		{"inflicted", "inflict"},
+		{"rage", "rage"},
+		{"kissing", "kiss"},
+		{"maid", "maid"},
+		{"cuffs", "cuff"},
+		{"bloodshot", "bloodshot"},
+		{"bridges", "bridg"},
+		{"glory", "glori"},
+		{"joys", "joi"},
+		{"reparative", "repar"},
+		{"lodge", "lodg"},
+		{"intriguing", "intrigu"},
+		{"relapsing", "relaps"},
+		{"praying", "prai"},
+		{"requires", "requir"},
+		{"recognize", "recogn"},
+		{"steamer", "steamer"},
+		{"disrupted", "disrupt"},
+		{"eager", "eager"},
+		{"port", "port"},
+		{"sobbed", "sob"},
+		{"zone", "zone"},
+		{"amazing", "amaz"},
+		{"mania", "mania"},
+		{"fishing", "fish"},
+		{"adjective", "adject"},
+		{"schoolroom", "schoolroom"},
+		{"civilian", "civilian"},
+		{"pope", "pope"},
+		{"mantelpiece", "mantelpiec"},
+		{"overcoming", "overcom"},
+		{"but", "but"},
+		{"choir", "choir"},
+		{"horseman", "horseman"},
+		{"economist", "economist"},
+		{"estrangement", "estrang"},
+		{"fears", "fear"},
+		{"congratulate", "congratul"},
+		{"unfit", "unfit"},
+		{"streptococci", "streptococci"},
+		{"foremost", "foremost"},
+		{"production", "product"},
+		{"availing", "avail"},
+		{"bath", "bath"},
+		{"swandam", "swandam"},
+		{"theory", "theori"},
+		{"vanishes", "vanish"},
+		{"tails", "tail"},
+		{"jersey", "jersei"},
+		{"gouty", "gouti"},
+		{"mentioned", "mention"},
+		{"erupt", "erupt"},
+		{"garment", "garment"},
+		{"promised", "promis"},
+		{"dawn", "dawn"},
+		{"bakers", "baker"},
+		{"compass", "compass"},
+		{"stages", "stage"},
+		{"preliminaries", "preliminari"},
+		{"combinations", "combin"},
+		{"sullenly", "sullenli"},
+		{"older", "older"},
+		{"impassioned", "impass"},
+		{"will", "will"},
+		{"train", "train"},
+		{"quarreled", "quarrel"},
+		{"slighted", "slight"},
+		{"asks", "ask"},
+		{"sinned", "sin"},
+		{"exploring", "explor"},
+		{"hour", "hour"},
+		{"living", "live"},
+		{"pouches", "pouch"},
+		{"barclay", "barclai"},
+		{"search", "search"},
+		{"clair", "clair"},
+		{"straps", "strap"},
+		{"attend", "attend"},
+		{"menace", "menac"},
+		{"survey", "survei"},
+		{"week", "week"},
+		{"enlarging", "enlarg"},
+		{"ulcerative", "ulcer"},
+		{"regulations", "regul"},
+		{"bundles", "bundl"},
+		{"numbering", "number"},
+		{"distinguish", "distinguish"},
+		{"smeared", "smear"},
+		{"black", "black"},
+		{"arch", "arch"},
+		{"organizing", "organ"},
+		{"thecal", "thecal"},
+		{"immensely", "immens"},
+		{"bolts", "bolt"},
+		{"gelatin", "gelatin"},
+		{"proceeds", "proce"},
+		{"color", "color"},
+		{"complaints", "complaint"},
+		{"pronounce", "pronounc"},
+		{"unexecuted", "unexecut"},
+		{"driver", "driver"},
+		{"suppurating", "suppur"},
+		{"littered", "litter"},
+		{"levying", "levi"},
+		{"fingered", "finger"},
+		{"shiver", "shiver"},
+		{"considerably", "consider"},
+		{"letting", "let"},
+		{"prairie", "prairi"},
+		{"cooperate", "cooper"},
+		{"burr", "burr"},
+		{"strikes", "strike"},
+		{"monthly", "monthli"},
+		{"stifled", "stifl"},
+		{"ney", "nei"},
+		{"cover", "cover"},
+		{"complex", "complex"},
+		{"imperial", "imperi"},
+		{"fertile", "fertil"},
+		{"assuring", "assur"},
+		{"eckmuhl", "eckmuhl"},
+		{"develop", "develop"},
+		{"tearful", "tear"},
+		{"albumosuria", "albumosuria"},
+		{"colonization", "colon"},
+		{"ankles", "ankl"},
+		{"polls", "poll"},
+		{"observations", "observ"},
+		{"follows", "follow"},
+		{"realize", "realiz"},
+		{"respects", "respect"},
+		{"participation", "particip"},
+		{"cursing", "curs"},
+		{"returning", "return"},
+		{"breath", "breath"},
+		{"mills", "mill"},
+		{"capsular", "capsular"},
+		{"cheerful", "cheer"},
+		{"bacon", "bacon"},
+		{"septum", "septum"},
+		{"diseases", "diseas"},
+		{"snakes", "snake"},
+		{"transports", "transport"},
+		{"impulses", "impuls"},
+		{"completely", "complet"},
+		{"gasping", "gasp"},
+		{"haemophilic", "haemophil"},
+		{"reflecting", "reflect"},
+		{"secretary", "secretari"},
+		{"slight", "slight"},
+		{"emotional", "emot"},
+		{"confined", "confin"},
+		{"against", "against"},
+		{"played", "plai"},
+		{"issuing", "issu"},
+		{"ointments", "ointment"},
+		{"gorki", "gorki"},
+		{"palpating", "palpat"},
+		{"chinese", "chines"},
+		{"withdrawal", "withdraw"},
+		{"dominions", "dominion"},
+		{"defeated", "defeat"},
+		{"emit", "emit"},
+		{"zheg", "zheg"},
+		{"metacarpal", "metacarp"},
+		{"tissue", "tissu"},
+		{"tickets", "ticket"},
+		{"stands", "stand"},
+		{"streets", "street"},
+		{"petya", "petya"},
+		{"undermined", "undermin"},
+		{"boring", "bore"},
+		{"occupy", "occupi"},
+		{"osteomas", "osteoma"},
+		{"deformity", "deform"},
+		{"vanished", "vanish"},
+		{"posts", "post"},
+		{"wagon", "wagon"},
+		{"blending", "blend"},
+		{"harmonious", "harmoni"},
+		{"efficacy", "efficaci"},
+		{"delivering", "deliv"},
+		{"pink", "pink"},
+		{"remained", "remain"},
+		{"metchnikoff", "metchnikoff"},
+		{"executioner", "execution"},
+		{"huguenots", "huguenot"},
+		{"delegate", "deleg"},
+		{"captured", "captur"},
+		{"shivering", "shiver"},
+		{"triumph", "triumph"},
+		{"cheat", "cheat"},
+		{"unfinished", "unfinish"},
+		{"aloof", "aloof"},
+		{"mustard", "mustard"},
+		{"embryonic", "embryon"},
+		{"aneurysmal", "aneurysm"},
+		{"ton", "ton"},
+		{"survives", "surviv"},
+		{"cardinal", "cardin"},
+		{"invaded", "invad"},
+		{"bedroom", "bedroom"},
+		{"peking", "peke"},
+		{"inn", "inn"},
+		{"plutarch", "plutarch"},
+		{"porridge", "porridg"},
+		{"memphis", "memphi"},
+		{"tinted", "tint"},
+		{"brain", "brain"},
+		{"unusual", "unusu"},
+		{"many", "mani"},
+		{"lightness", "light"},
+		{"regularly", "regularli"},
+		{"inquiringly", "inquiringli"},
+		{"loyalty", "loyalti"},
+		{"cubans", "cuban"},
+		{"osteoporosis", "osteoporosi"},
+		{"generalised", "generalis"},
+		{"introduce", "introduc"},
+		{"perceptibly", "percept"},
+		{"crepitant", "crepit"},
+		{"saltanov", "saltanov"},
+		{"smartly", "smartli"},
+		{"ranges", "rang"},
+		{"examination", "examin"},
+		{"michael", "michael"},
+		{"chivalry", "chivalri"},
+		{"perforated", "perfor"},
+		{"could", "could"},
+		{"strings", "string"},
+		{"cossacks", "cossack"},
+		{"gripped", "grip"},
+		{"clusters", "cluster"},
+		{"where", "where"},
+		{"shore", "shore"},
+		{"previous", "previou"},
+		{"antagonism", "antagon"},
+		{"duty", "duti"},
+		{"phase", "phase"},
+		{"thoroughbred", "thoroughbr"},
+		{"occupation", "occup"},
+		{"susan", "susan"},
+		{"descending", "descend"},
+		{"scanty", "scanti"},
+		{"befits", "befit"},
+		{"drissa", "drissa"},
+		{"friendship", "friendship"},
+		{"encyclopedia", "encyclopedia"},
+		{"consuming", "consum"},
+		{"respectful", "respect"},
+		{"unprecedented", "unpreced"},
+		{"compare", "compar"},
+		{"caesar", "caesar"},
+		{"speaks", "speak"},
+		{"appealed", "appeal"},
+		{"precaution", "precaut"},
+		{"immigration", "immigr"},
+		{"lingering", "linger"},
+		{"sprung", "sprung"},
+		{"sacrament", "sacrament"},
+		{"infections", "infect"},
+		{"copyright", "copyright"},
+		{"strongly", "strongli"},
+		{"budge", "budg"},
+		{"trick", "trick"},
+		{"grain", "grain"},
+		{"chimney", "chimnei"},
+		{"tended", "tend"},
+		{"overwhelm", "overwhelm"},
+		{"snapping", "snap"},
+		{"severe", "sever"},
+		{"vicinity", "vicin"},
+		{"resource", "resourc"},
+		{"ideals", "ideal"},
+		{"anaesthetic", "anaesthet"},
+		{"drunk", "drunk"},
+		{"someday", "somedai"},
+		{"grande", "grand"},
+		{"palpation", "palpat"},
+		{"tonic", "tonic"},
+		{"continuously", "continu"},
+		{"rearguard", "rearguard"},
+		{"penetrate", "penetr"},
+		{"supposing", "suppos"},
+		{"italian", "italian"},
+		{"nebraska", "nebraska"},
+		{"discovery", "discoveri"},
+		{"swaying", "swai"},
+		{"shutters", "shutter"},
+		{"decatur", "decatur"},
+		{"electors", "elector"},
+		{"unionism", "union"},
+		{"lazarev", "lazarev"},
+		{"awhile", "awhil"},
+		{"krasnoe", "krasno"},
+		{"historian", "historian"},
+		{"sums", "sum"},
+		{"battlefield", "battlefield"},
+		{"virus", "viru"},
+		{"brasdor", "brasdor"},
+		{"hare", "hare"},
+		{"deceived", "deceiv"},
+		{"specified", "specifi"},
+		{"astonished", "astonish"},
+		{"natural", "natur"},
+		{"practices", "practic"},
+		{"minutes", "minut"},
+		{"insensibility", "insens"},
+		{"conferred", "confer"},
+		{"broadest", "broadest"},
+		{"shaven", "shaven"},
+		{"non", "non"},
+		{"cancrum", "cancrum"},
+		{"absolute", "absolut"},
+		{"contempt", "contempt"},
+		{"sparks", "spark"},
+		{"cowardice", "cowardic"},
+		{"trash", "trash"},
+		{"palliative", "palli"},
+		{"homogeneous", "homogen"},
+		{"executing", "execut"},
+		{"determination", "determin"},
+		{"tumblers", "tumbler"},
+		{"disorderly", "disorderli"},
+		{"incompetent", "incompet"},
+		{"tub", "tub"},
+		{"lassitude", "lassitud"},
+		{"fine", "fine"},
+		{"dreadfully", "dreadfulli"},
+		{"innocence", "innoc"},
+		{"unload", "unload"},
+		{"patted", "pat"},
+		{"abridging", "abridg"},
+		{"top", "top"},
+		{"fateful", "fate"},
+		{"angels", "angel"},
+		{"proposals", "propos"},
+		{"tools", "tool"},
+		{"encouraged", "encourag"},
+		{"manipulation", "manipul"},
+		{"footpace", "footpac"},
+		{"jauntily", "jauntili"},
+		{"dealt", "dealt"},
+		{"separately", "separ"},
+		{"sentence", "sentenc"},
+		{"christ", "christ"},
+		{"diplococci", "diplococci"},
+		{"incised", "incis"},
+		{"elongated", "elong"},
+		{"saw", "saw"},
+		{"send", "send"},
+		{"marshal", "marshal"},
+		{"karp", "karp"},
+		{"veil", "veil"},
+		{"admirably", "admir"},
+		{"pro", "pro"},
+		{"surgery", "surgeri"},
+		{"pallida", "pallida"},
+		{"foolish", "foolish"},
+		{"leatherhead", "leatherhead"},
+		{"limitations", "limit"},
+		{"exclude", "exclud"},
+		{"obligations", "oblig"},
+		{"thrombo", "thrombo"},
+		{"prevents", "prevent"},
+		{"empires", "empir"},
+		{"enemies", "enemi"},
+		{"recommenced", "recommenc"},
+		{"replace", "replac"},
+		{"humble", "humbl"},
+		{"wright", "wright"},
+		{"bryan", "bryan"},
+		{"equality", "equal"},
+		{"sudden", "sudden"},
+		{"closure", "closur"},
+		{"khamovniki", "khamovniki"},
+		{"exceeds", "exce"},
+		{"demonetization", "demonet"},
+		{"generosity", "generos"},
+		{"circulate", "circul"},
+		{"reward", "reward"},
+		{"listless", "listless"},
+		{"pouring", "pour"},
+		{"hoar", "hoar"},
+		{"bullets", "bullet"},
+		{"flee", "flee"},
+		{"tranquillity", "tranquil"},
+		{"youthful", "youth"},
+		{"louder", "louder"},
+		{"concentric", "concentr"},
+		{"fir", "fir"},
+		{"passes", "pass"},
+		{"departments", "depart"},
+		{"costo", "costo"},
+		{"erect", "erect"},
+		{"repeat", "repeat"},
+		{"frill", "frill"},
+		{"serenely", "seren"},
+		{"confusion", "confus"},
+		{"descendants", "descend"},
+		{"terms", "term"},
+		{"myxoma", "myxoma"},
+		{"thorax", "thorax"},
+		{"beneficent", "benefic"},
+		{"oxygen", "oxygen"},
+		{"overflowed", "overflow"},
+		{"unsuitable", "unsuit"},
+		{"scrawled", "scrawl"},
+		{"baboon", "baboon"},
+		{"hyoid", "hyoid"},
+		{"wisest", "wisest"},
+		{"picking", "pick"},
+		{"easy", "easi"},
+		{"perish", "perish"},
+		{"eyebrows", "eyebrow"},
+		{"presidents", "presid"},
+		{"suis", "sui"},
+		{"sukharev", "sukharev"},
+		{"conventional", "convent"},
+		{"forgotten", "forgotten"},
+		{"firearms", "firearm"},
+		{"stubble", "stubbl"},
+		{"tremulous", "tremul"},
+		{"apply", "appli"},
+		{"rectum", "rectum"},
+		{"strengthen", "strengthen"},
+		{"tonics", "tonic"},
+		{"economy", "economi"},
+		{"disfavor", "disfavor"},
+		{"regularity", "regular"},
+		{"pint", "pint"},
+		{"article", "articl"},
+		{"granular", "granular"},
+		{"deceiving", "deceiv"},
+		{"regime", "regim"},
+		{"looted", "loot"},
+		{"typical", "typic"},
+		{"fixed", "fix"},
+		{"timokhin", "timokhin"},
+		{"rods", "rod"},
+		{"sealed", "seal"},
+		{"categories", "categori"},
+		{"sects", "sect"},
+		{"march", "march"},
+		{"gnarled", "gnarl"},
+		{"origins", "origin"},
+		{"newcomers", "newcom"},
+		{"territory", "territori"},
+		{"resembled", "resembl"},
+		{"popliteal", "poplit"},
+		{"tricks", "trick"},
+		{"subserve", "subserv"},
+		{"join", "join"},
+		{"consolation", "consol"},
+		{"disfranchisement", "disfranchis"},
+		{"knock", "knock"},
+		{"joliet", "joliet"},
+		{"femora", "femora"},
+		{"made", "made"},
+		{"supervene", "superven"},
+		{"cystic", "cystic"},
+		{"matured", "matur"},
+		{"pulsatile", "pulsatil"},
+		{"thanks", "thank"},
+		{"roughs", "rough"},
+		{"rates", "rate"},
+		{"muddy", "muddi"},
+		{"jurisdiction", "jurisdict"},
+		{"confining", "confin"},
+		{"shops", "shop"},
+		{"vehicles", "vehicl"},
+		{"blindman", "blindman"},
+		{"femoris", "femori"},
+		{"drawing", "draw"},
+		{"lure", "lure"},
+		{"valgus", "valgu"},
+		{"executions", "execut"},
+		{"bombardment", "bombard"},
+		{"remark", "remark"},
+		{"inverse", "invers"},
+		{"bower", "bower"},
+		{"liar", "liar"},
+		{"lane", "lane"},
+		{"pounds", "pound"},
+		{"chantry", "chantri"},
+		{"prerogative", "prerog"},
+		{"mimetic", "mimet"},
+		{"military", "militari"},
+		{"warranted", "warrant"},
+		{"clergy", "clergi"},
+		{"dresser", "dresser"},
+		{"contributions", "contribut"},
+		{"orderly", "orderli"},
+		{"cousins", "cousin"},
+		{"loud", "loud"},
+		{"refuting", "refut"},
+		{"reciprocity", "reciproc"},
+		{"mutually", "mutual"},
+		{"thinking", "think"},
+		{"cleansing", "cleans"},
+		{"unwise", "unwis"},
+		{"famished", "famish"},
+		{"agreeing", "agre"},
+		{"preferred", "prefer"},
+		{"sofa", "sofa"},
+		{"brandywine", "brandywin"},
+		{"sabretache", "sabretach"},
+		{"pie", "pie"},
+		{"registration", "registr"},
+		{"mercurial", "mercuri"},
+		{"resulted", "result"},
+		{"library", "librari"},
+		{"arrest", "arrest"},
+		{"rod", "rod"},
+		{"satisfactorily", "satisfactorili"},
+		{"luggage", "luggag"},
+		{"singing", "sing"},
+		{"patriotism", "patriot"},
+		{"profitable", "profit"},
+		{"corruption", "corrupt"},
+		{"stable", "stabl"},
+		{"chisel", "chisel"},
+		{"urinary", "urinari"},
+		{"belladonna", "belladonna"},
+		{"forbade", "forbad"},
+		{"geography", "geographi"},
+		{"secretly", "secretli"},
+		{"aime", "aim"},
+		{"holiday", "holidai"},
+		{"you", "you"},
+		{"afferent", "affer"},
+		{"emerged", "emerg"},
+		{"circular", "circular"},
+		{"tailor", "tailor"},
+		{"entreaty", "entreati"},
+		{"underlying", "underli"},
+		{"client", "client"},
+		{"mouton", "mouton"},
+		{"luxuries", "luxuri"},
+		{"revival", "reviv"},
+		{"briefly", "briefli"},
+		{"impelled", "impel"},
+		{"tests", "test"},
+		{"rice", "rice"},
+		{"observance", "observ"},
+		{"matvevna", "matvevna"},
+		{"escape", "escap"},
+		{"evoked", "evok"},
+		{"contracts", "contract"},
+		{"fairness", "fair"},
+		{"sentiment", "sentiment"},
+		{"expeditionary", "expeditionari"},
+		{"his", "hi"},
+		{"procedure", "procedur"},
+		{"batteries", "batteri"},
+		{"output", "output"},
+		{"aggregations", "aggreg"},
+		{"routes", "rout"},
+		{"cicatrisation", "cicatris"},
+		{"intriguer", "intrigu"},
+		{"permeated", "permeat"},
+		{"errand", "errand"},
+		{"electricity", "electr"},
+		{"explosives", "explos"},
+		{"petitioning", "petit"},
+		{"hunting", "hunt"},
+		{"marched", "march"},
+		{"book", "book"},
+		{"lipoma", "lipoma"},
+		{"web", "web"},
+		{"halfway", "halfwai"},
+		{"stuffed", "stuf"},
+		{"dakotas", "dakota"},
+		{"lighthearted", "lightheart"},
+		{"sailors", "sailor"},
+		{"conclude", "conclud"},
+		{"rail", "rail"},
+		{"theories", "theori"},
+		{"curves", "curv"},
+		{"antique", "antiqu"},
+		{"snoring", "snore"},
+		{"succession", "success"},
+		{"specifically", "specif"},
+		{"welcomed", "welcom"},
+		{"higher", "higher"},
+		{"indulge", "indulg"},
+		{"gloom", "gloom"},
+		{"willing", "will"},
+		{"nastasya", "nastasya"},
+		{"panic", "panic"},
+		{"parity", "pariti"},
+		{"queer", "queer"},
+		{"taller", "taller"},
+		{"redoute", "redout"},
+		{"chondromas", "chondroma"},
+		{"profit", "profit"},
+		{"manhood", "manhood"},
+		{"highness", "high"},
+		{"desperately", "desper"},
+		{"glands", "gland"},
+		{"listened", "listen"},
+		{"same", "same"},
+		{"grievance", "grievanc"},
+		{"products", "product"},
+		{"human", "human"},
+		{"admission", "admiss"},
+		{"virgin", "virgin"},
+		{"ashamed", "asham"},
+		{"ideville", "idevil"},
+		{"singers", "singer"},
+		{"jewels", "jewel"},
+		{"deals", "deal"},
+		{"inducing", "induc"},
+		{"docks", "dock"},
+		{"ballot", "ballot"},
+		{"mallei", "mallei"},
+		{"evacuation", "evacu"},
+		{"arouse", "arous"},
+		{"goodhearted", "goodheart"},
+		{"spare", "spare"},
+		{"upholding", "uphold"},
+		{"cicatrix", "cicatrix"},
+		{"mummification", "mummif"},
+		{"vienna", "vienna"},
+		{"flexures", "flexur"},
+		{"runners", "runner"},
+		{"distinctly", "distinctli"},
+		{"welfare", "welfar"},
+		{"mildly", "mildli"},
+		{"aridity", "arid"},
+		{"maxilla", "maxilla"},
+		{"impulsive", "impuls"},
+		{"brains", "brain"},
+		{"dwarf", "dwarf"},
+		{"imported", "import"},
+		{"ecossaise", "ecossais"},
+		{"exerts", "exert"},
+		{"bifurcation", "bifurc"},
+		{"plush", "plush"},
+		{"workshop", "workshop"},
+		{"pharynx", "pharynx"},
+		{"afterwards", "afterward"},
+		{"upset", "upset"},
+		{"reach", "reach"},
+		{"elson", "elson"},
+		{"hasten", "hasten"},
+		{"pus", "pu"},
+		{"instances", "instanc"},
+		{"attainder", "attaind"},
+		{"aet", "aet"},
+		{"orbit", "orbit"},
+		{"names", "name"},
+		{"avenues", "avenu"},
+		{"imports", "import"},
+		{"pensively", "pensiv"},
+		{"annulment", "annul"},
+		{"sloping", "slope"},
+		{"lodgment", "lodgment"},
+		{"tribute", "tribut"},
+		{"sac", "sac"},
+		{"adjusting", "adjust"},
+		{"bedrooms", "bedroom"},
+		{"hasty", "hasti"},
+		{"revealed", "reveal"},
+		{"dominant", "domin"},
+		{"represented", "repres"},
+		{"responsibility", "respons"},
+		{"beaver", "beaver"},
+		{"pack", "pack"},
+		{"igni", "igni"},
+		{"further", "further"},
+		{"crest", "crest"},
+		{"enlisted", "enlist"},
+		{"attachments", "attach"},
+		{"declared", "declar"},
+		{"bunker", "bunker"},
+		{"liberal", "liber"},
+		{"preceded", "preced"},
+		{"undefinable", "undefin"},
+		{"amelie", "ameli"},
+		{"violated", "violat"},
+		{"julie", "juli"},
+		{"informed", "inform"},
+		{"hypertrophied", "hypertrophi"},
+		{"compelling", "compel"},
+		{"blockaded", "blockad"},
+		{"uttermost", "uttermost"},
+		{"substituted", "substitut"},
+		{"assistance", "assist"},
+		{"armchair", "armchair"},
+		{"heirs", "heir"},
+		{"pursuits", "pursuit"},
+		{"conspiracy", "conspiraci"},
+		{"improve", "improv"},
+		{"follicular", "follicular"},
+		{"hairy", "hairi"},
+		{"charity", "chariti"},
+		{"helena", "helena"},
+		{"troykas", "troyka"},
+		{"murmurs", "murmur"},
+		{"alaska", "alaska"},
+		{"celebrating", "celebr"},
+		{"pantry", "pantri"},
+		{"sinful", "sin"},
+		{"thinks", "think"},
+		{"oblique", "obliqu"},
+		{"spindles", "spindl"},
+		{"lounging", "loung"},
+		{"lipomatosis", "lipomatosi"},
+		{"lagged", "lag"},
+		{"toe", "toe"},
+		{"start", "start"},
+		{"agreement", "agreement"},
+		{"coquettish", "coquettish"},
+		{"fast", "fast"},
+		{"exhaust", "exhaust"},
+		{"famous", "famou"},
+		{"conjecture", "conjectur"},
+		{"brute", "brute"},
+		{"indentured", "indentur"},
+		{"eusol", "eusol"},
+		{"jealousies", "jealousi"},
+		{"bovine", "bovin"},
+		{"looting", "loot"},
+		{"legitimate", "legitim"},
+		{"perhaps", "perhap"},
+		{"skirts", "skirt"},
+		{"along", "along"},
+		{"sincerity", "sincer"},
+		{"gypsies", "gypsi"},
+		{"pouch", "pouch"},
+		{"ehrlich", "ehrlich"},
+		{"ukraine", "ukrain"},
+		{"varnish", "varnish"},
+		{"disability", "disabl"},
+		{"over", "over"},
+		{"assent", "assent"},
+		{"were", "were"},
+		{"compressible", "compress"},
+		{"dresden", "dresden"},
+		{"write", "write"},
+		{"roylott", "roylott"},
+		{"reconciliation", "reconcili"},
+		{"shaping", "shape"},
+		{"significantly", "significantli"},
+		{"liquefaction", "liquefact"},
+		{"neglected", "neglect"},
+		{"bouquet", "bouquet"},
+		{"chloride", "chlorid"},
+		{"enacted", "enact"},
+		{"intent", "intent"},
+		{"phases", "phase"},
+		{"valiant", "valiant"},
+		{"symmetry", "symmetri"},
+		{"secrets", "secret"},
+		{"burke", "burk"},
+		{"coagulate", "coagul"},
+		{"brightened", "brighten"},
+		{"downward", "downward"},
+		{"sacs", "sac"},
+		{"morand", "morand"},
+		{"externally", "extern"},
+		{"california", "california"},
+		{"petticoat", "petticoat"},
+		{"sloboda", "sloboda"},
+		{"impression", "impress"},
+		{"altar", "altar"},
+		{"railways", "railwai"},
+		{"faithfully", "faithfulli"},
+		{"doomed", "doom"},
+		{"esteem", "esteem"},
+		{"repugnant", "repugn"},
+		{"beginning", "begin"},
+		{"midst", "midst"},
+		{"rheumatic", "rheumat"},
+		{"hussar", "hussar"},
+		{"gain", "gain"},
+		{"ceased", "ceas"},
+		{"noteworthy", "noteworthi"},
+		{"incline", "inclin"},
+		{"robberies", "robberi"},
+		{"discharge", "discharg"},
+		{"covent", "covent"},
+		{"work", "work"},
+		{"cauliflower", "cauliflow"},
+		{"coughed", "cough"},
+		{"ninety", "nineti"},
+		{"vitebsk", "vitebsk"},
+		{"rye", "rye"},
+		{"choroiditis", "choroid"},
+		{"scintillating", "scintil"},
+		{"peculiarly", "peculiarli"},
+		{"combining", "combin"},
+		{"lords", "lord"},
+		{"inflammations", "inflamm"},
+		{"meantime", "meantim"},
+		{"directions", "direct"},
+		{"sutler", "sutler"},
+		{"husband", "husband"},
+		{"islands", "island"},
+		{"monstrous", "monstrou"},
+		{"chere", "chere"},
+		{"lee", "lee"},
+		{"cloth", "cloth"},
+		{"bottles", "bottl"},
+		{"chorus", "choru"},
+		{"relieve", "reliev"},
+		{"truce", "truce"},
+		{"sustained", "sustain"},
+		{"fathers", "father"},
+		{"various", "variou"},
+		{"commoner", "common"},
+		{"pearl", "pearl"},
+		{"sisters", "sister"},
+		{"topsy", "topsi"},
+		{"lorrain", "lorrain"},
+		{"squatting", "squat"},
+		{"myself", "myself"},
+		{"crunching", "crunch"},
+		{"dictatorship", "dictatorship"},
+		{"hotly", "hotli"},
+		{"demean", "demean"},
+		{"fur", "fur"},
+		{"ask", "ask"},
+		{"hired", "hire"},
+		{"abashed", "abash"},
+		{"adduction", "adduct"},
+		{"vicissitudes", "vicissitud"},
+		{"bayonet", "bayonet"},
+		{"appendages", "appendag"},
+		{"cleverer", "clever"},
+		{"routine", "routin"},
+		{"disregard", "disregard"},
+		{"marked", "mark"},
+		{"confiding", "confid"},
+		{"hated", "hate"},
+		{"condescension", "condescens"},
+		{"carpenter", "carpent"},
+		{"fragrance", "fragranc"},
+		{"pipes", "pipe"},
+		{"understand", "understand"},
+		{"orb", "orb"},
+		{"mates", "mate"},
+		{"vetoed", "veto"},
+		{"score", "score"},
+		{"ours", "our"},
+		{"perineum", "perineum"},
+		{"pray", "prai"},
+		{"thinner", "thinner"},
+		{"suits", "suit"},
+		{"droop", "droop"},
+		{"narrowly", "narrowli"},
+		{"uniting", "unit"},
+		{"contained", "contain"},
+		{"alter", "alter"},
+		{"extraneous", "extran"},
+		{"here", "here"},
+		{"edmund", "edmund"},
+		{"farming", "farm"},
+		{"tut", "tut"},
+		{"striped", "stripe"},
+		{"penetrated", "penetr"},
+		{"exactly", "exactli"},
+		{"copied", "copi"},
+		{"admixture", "admixtur"},
+		{"severing", "sever"},
+		{"hereditary", "hereditari"},
+		{"wrinkles", "wrinkl"},
+		{"brim", "brim"},
+		{"comparable", "compar"},
+		{"mingling", "mingl"},
+		{"squeezed", "squeez"},
+		{"suburb", "suburb"},
+		{"almost", "almost"},
+		{"forearm", "forearm"},
+		{"spine", "spine"},
+		{"infantry", "infantri"},
+		{"slip", "slip"},
+		{"knees", "knee"},
+		{"remember", "rememb"},
+		{"fifteenth", "fifteenth"},
+		{"orifice", "orific"},
+		{"malignancy", "malign"},
+		{"princesses", "princess"},
+		{"civilized", "civil"},
+		{"west", "west"},
+		{"shrunk", "shrunk"},
+		{"adding", "ad"},
+		{"education", "educ"},
+		{"shafts", "shaft"},
+		{"necessary", "necessari"},
+		{"friedland", "friedland"},
+		{"house", "hous"},
+		{"paragraph", "paragraph"},
+		{"forth", "forth"},
+		{"abandonment", "abandon"},
+		{"faces", "face"},
+		{"longfellow", "longfellow"},
+		{"define", "defin"},
+		{"beaumarchais", "beaumarchai"},
+		{"crumpling", "crumpl"},
+		{"rails", "rail"},
+		{"confidentially", "confidenti"},
+		{"recourse", "recours"},
+		{"vanquished", "vanquish"},
+		{"dull", "dull"},
+		{"gulch", "gulch"},
+		{"seeds", "seed"},
+		{"candidate", "candid"},
+		{"monument", "monument"},
+		{"cloud", "cloud"},
+		{"catching", "catch"},
+		{"list", "list"},
+		{"bounds", "bound"},
+		{"royal", "royal"},
+		{"saliva", "saliva"},
+		{"favourite", "favourit"},
+		{"groans", "groan"},
+		{"frenchwoman", "frenchwoman"},
+		{"allied", "alli"},
+		{"apartments", "apart"},
+		{"constrained", "constrain"},
+		{"unloading", "unload"},
+		{"ago", "ago"},
+		{"martin", "martin"},
+		{"birches", "birch"},
+		{"tendons", "tendon"},
+		{"dunning", "dun"},
+		{"tidings", "tide"},
+		{"rapid", "rapid"},
+		{"crackle", "crackl"},
+		{"baggage", "baggag"},
+		{"membranes", "membran"},
+		{"mitchell", "mitchel"},
+		{"cord", "cord"},
+		{"inauguration", "inaugur"},
+		{"letter", "letter"},
+		{"alternate", "altern"},
+		{"youthfulness", "youth"},
+		{"architecture", "architectur"},
+		{"whips", "whip"},
+		{"punishment", "punish"},
+		{"rising", "rise"},
+		{"landed", "land"},
+		{"christianity", "christian"},
+		{"floridas", "florida"},
+		{"commenced", "commenc"},
+		{"firs", "fir"},
+		{"solved", "solv"},
+		{"fluttering", "flutter"},
+		{"alexandria", "alexandria"},
+		{"anaemic", "anaem"},
+		{"vague", "vagu"},
+		{"scab", "scab"},
+		{"forgets", "forget"},
+		{"loan", "loan"},
+		{"able", "abl"},
+		{"fiscal", "fiscal"},
+		{"arose", "aros"},
+		{"abuses", "abus"},
+		{"adopting", "adopt"},
+		{"collar", "collar"},
+		{"seacoast", "seacoast"},
+		{"risen", "risen"},
+		{"quicker", "quicker"},
+		{"alleys", "allei"},
+		{"boarding", "board"},
+		{"swiftness", "swift"},
+		{"dignified", "dignifi"},
+		{"parole", "parol"},
+		{"tattered", "tatter"},
+		{"intimately", "intim"},
+		{"ceases", "ceas"},
+		{"colonists", "colonist"},
+		{"wondered", "wonder"},
+		{"muttering", "mutter"},
+		{"purse", "purs"},
+		{"laughingstock", "laughingstock"},
+		{"lifeless", "lifeless"},
+		{"van", "van"},
+		{"bench", "bench"},
+		{"collisions", "collis"},
+		{"slogan", "slogan"},
+		{"bacteriological", "bacteriolog"},
+		{"obliterates", "obliter"},
+		{"normally", "normal"},
+		{"tradesman", "tradesman"},
+		{"guaranteed", "guarante"},
+		{"dependents", "depend"},
+		{"baron", "baron"},
+		{"rabbit", "rabbit"},
+		{"melanotic", "melanot"},
+		{"illinois", "illinoi"},
+		{"commissaries", "commissari"},
+		{"part", "part"},
+		{"dissolved", "dissolv"},
+		{"values", "valu"},
+		{"financial", "financi"},
+		{"scaffolding", "scaffold"},
+		{"buffalo", "buffalo"},
+		{"functions", "function"},
+		{"fungating", "fungat"},
+		{"ryazan", "ryazan"},
+		{"partisans", "partisan"},
+		{"stressing", "stress"},
+		{"franchises", "franchis"},
+		{"visited", "visit"},
+		{"paralyze", "paralyz"},
+		{"vanquish", "vanquish"},
+		{"governments", "govern"},
+		{"yourselves", "yourselv"},
+		{"tortuous", "tortuou"},
+		{"intercept", "intercept"},
+		{"wostov", "wostov"},
+		{"malpighii", "malpighii"},
+		{"arched", "arch"},
+		{"semenovsk", "semenovsk"},
+		{"printing", "print"},
+		{"firm", "firm"},
+		{"lawn", "lawn"},
+		{"bourienne", "bourienn"},
+		{"policeman", "policeman"},
+		{"southwest", "southwest"},
+		{"heaved", "heav"},
+		{"brisk", "brisk"},
+		{"ceremonies", "ceremoni"},
+		{"woke", "woke"},
+		{"heap", "heap"},
+		{"leech", "leech"},
+		{"transient", "transient"},
+		{"burying", "buri"},
+		{"pervaded", "pervad"},
+		{"disseminated", "dissemin"},
+		{"gouge", "goug"},
+		{"albuminuria", "albuminuria"},
+		{"breeches", "breech"},
+		{"umbilicus", "umbilicu"},
+		{"struggles", "struggl"},
+		{"fists", "fist"},
+		{"burnt", "burnt"},
+		{"breeze", "breez"},
+		{"calmed", "calm"},
+		{"planter", "planter"},
+		{"following", "follow"},
+		{"loffler", "loffler"},
+		{"arseno", "arseno"},
+		{"district", "district"},
+		{"balanced", "balanc"},
+		{"cities", "citi"},
+		{"took", "took"},
+		{"clouded", "cloud"},
+		{"appropriations", "appropri"},
+		{"inquiries", "inquiri"},
+		{"antiquity", "antiqu"},
+		{"protrusion", "protrus"},
+		{"funny", "funni"},
+		{"condemning", "condemn"},
+		{"cracked", "crack"},
+		{"stronger", "stronger"},
+		{"foreman", "foreman"},
+		{"thabor", "thabor"},
+		{"shirt", "shirt"},
+		{"tenantry", "tenantri"},
+		{"incendiaries", "incendiari"},
+		{"distractions", "distract"},
+		{"sheaths", "sheath"},
+		{"precedent", "preced"},
+		{"surprised", "surpris"},
+		{"apprehensive", "apprehens"},
+		{"padded", "pad"},
+		{"nation", "nation"},
+		{"invaders", "invad"},
+		{"expect", "expect"},
+		{"boon", "boon"},
+		{"bwing", "bwing"},
+		{"gains", "gain"},
+		{"tubes", "tube"},
+		{"repellent", "repel"},
+		{"serfs", "serf"},
+		{"quartering", "quarter"},
+		{"administrator", "administr"},
+		{"globular", "globular"},
+		{"sickness", "sick"},
+		{"newspaper", "newspap"},
+		{"handled", "handl"},
+		{"variable", "variabl"},
+		{"populations", "popul"},
+		{"scales", "scale"},
+		{"cooling", "cool"},
+		{"coveted", "covet"},
+		{"ash", "ash"},
+		{"role", "role"},
+		{"persuade", "persuad"},
+		{"erratic", "errat"},
+		{"peru", "peru"},
+		{"hill", "hill"},
+		{"tempted", "tempt"},
+		{"strong", "strong"},
+		{"closeness", "close"},
+		{"maternal", "matern"},
+		{"thirst", "thirst"},
+		{"hoch", "hoch"},
+		{"certain", "certain"},
+		{"wrangled", "wrangl"},
+		{"dissolution", "dissolut"},
+		{"readily", "readili"},
+		{"fitted", "fit"},
+		{"thoughtful", "thought"},
+		{"cabins", "cabin"},
+		{"recommended", "recommend"},
+		{"flogged", "flog"},
+		{"ethyl", "ethyl"},
+		{"arrivals", "arriv"},
+		{"cramp", "cramp"},
+		{"scoundrel", "scoundrel"},
+		{"favorites", "favorit"},
+		{"earlier", "earlier"},
+		{"immutable", "immut"},
+		{"spirituality", "spiritu"},
+		{"gingerbread", "gingerbread"},
+		{"decisive", "decis"},
+		{"corrected", "correct"},
+		{"trachea", "trachea"},
+		{"trigger", "trigger"},
+		{"modern", "modern"},
+		{"ever", "ever"},
+		{"sixth", "sixth"},
+		{"mud", "mud"},
+		{"enjoyed", "enjoi"},
+		{"picturing", "pictur"},
+		{"clot", "clot"},
+		{"contention", "content"},
+		{"fat", "fat"},
+		{"damage", "damag"},
+		{"held", "held"},
+		{"blockhead", "blockhead"},
+		{"labyrinth", "labyrinth"},
+		{"involuntary", "involuntari"},
+		{"assimilated", "assimil"},
+		{"talk", "talk"},
+		{"hyperaemic", "hyperaem"},
+		{"obliterated", "obliter"},
+		{"placed", "place"},
+		{"writ", "writ"},
+		{"manufacturing", "manufactur"},
+		{"restrained", "restrain"},
+		{"pills", "pill"},
+		{"pranced", "pranc"},
+		{"coroner", "coron"},
+		{"drank", "drank"},
+		{"eczema", "eczema"},
+		{"males", "male"},
+		{"relatively", "rel"},
+		{"kept", "kept"},
+		{"worrying", "worri"},
+		{"display", "displai"},
+		{"courts", "court"},
+		{"advisers", "advis"},
+		{"expressed", "express"},
+		{"reinforcements", "reinforc"},
+		{"members", "member"},
+		{"waylaid", "waylaid"},
+		{"occludes", "occlud"},
+		{"southerners", "southern"},
+		{"weren", "weren"},
+		{"petrovna", "petrovna"},
+		{"rubbing", "rub"},
+		{"dilute", "dilut"},
+		{"prominences", "promin"},
+		{"paternal", "patern"},
+		{"raging", "rage"},
+		{"winding", "wind"},
+		{"dry", "dry"},
+		{"permit", "permit"},
+		{"transformation", "transform"},
+		{"respect", "respect"},
+		{"sister", "sister"},
+		{"cicatrices", "cicatric"},
+		{"contradict", "contradict"},
+		{"persevering", "persev"},
+		{"horsecloths", "horsecloth"},
+		{"armee", "arme"},
+		{"wavered", "waver"},
+		{"measles", "measl"},
+		{"plausible", "plausibl"},
+		{"ken", "ken"},
+		{"searching", "search"},
+		{"imposing", "impos"},
+		{"buttock", "buttock"},
+		{"advises", "advis"},
+		{"enumeration", "enumer"},
+		{"pregnancy", "pregnanc"},
+		{"latter", "latter"},
+		{"gelatinous", "gelatin"},
+		{"rides", "ride"},
+		{"ancestors", "ancestor"},
+		{"merchants", "merchant"},
+		{"throng", "throng"},
+		{"cellular", "cellular"},
+		{"przebyszewski", "przebyszewski"},
+		{"grand", "grand"},
+		{"percussion", "percuss"},
+		{"steriliser", "sterilis"},
+		{"devastated", "devast"},
+		{"yet", "yet"},
+		{"reformation", "reform"},
+		{"studded", "stud"},
+		{"pointing", "point"},
+		{"jugular", "jugular"},
+		{"cubs", "cub"},
+		{"betrothal", "betroth"},
+		{"attached", "attach"},
+		{"pike", "pike"},
+		{"blamed", "blame"},
+		{"courier", "courier"},
+		{"scalp", "scalp"},
+		{"tasks", "task"},
+		{"hindquarters", "hindquart"},
+		{"holidays", "holidai"},
+		{"maxim", "maxim"},
+		{"acclamation", "acclam"},
+		{"courses", "cours"},
+		{"fowler", "fowler"},
+		{"upheld", "upheld"},
+		{"injecting", "inject"},
+		{"showing", "show"},
+		{"coiffure", "coiffur"},
+		{"emigrants", "emigr"},
+		{"vaginal", "vagin"},
+		{"bustle", "bustl"},
+		{"dissipated", "dissip"},
+		{"monotonous", "monoton"},
+		{"jail", "jail"},
+		{"floating", "float"},
+		{"supporter", "support"},
+		{"wonderfully", "wonderfulli"},
+		{"bonfires", "bonfir"},
+		{"sales", "sale"},
+		{"angles", "angl"},
+		{"admitted", "admit"},
+		{"associates", "associ"},
+		{"justification", "justif"},
+		{"rucastles", "rucastl"},
+		{"gauntlet", "gauntlet"},
+		{"suit", "suit"},
+		{"scolding", "scold"},
+		{"xiii", "xiii"},
+		{"therein", "therein"},
+		{"invent", "invent"},
+		{"napoleonic", "napoleon"},
+		{"askance", "askanc"},
+		{"weyrother", "weyroth"},
+		{"carpets", "carpet"},
+		{"situ", "situ"},
+		{"more", "more"},
+		{"sailing", "sail"},
+		{"horsham", "horsham"},
+		{"saphena", "saphena"},
+		{"intercostal", "intercost"},
+		{"avenue", "avenu"},
+		{"blotch", "blotch"},
+		{"mingle", "mingl"},
+		{"unfolded", "unfold"},
+		{"remaining", "remain"},
+		{"incredible", "incred"},
+		{"alpatych", "alpatych"},
+		{"shrill", "shrill"},
+		{"contending", "contend"},
+		{"goliath", "goliath"},
+		{"comfortable", "comfort"},
+		{"ejaculated", "ejacul"},
+		{"equitable", "equit"},
+		{"governmental", "government"},
+		{"guard", "guard"},
+		{"threatening", "threaten"},
+		{"transforming", "transform"},
+		{"torn", "torn"},
+		{"proliferative", "prolif"},
+		{"colonel", "colonel"},
+		{"curve", "curv"},
+		{"saluted", "salut"},
+		{"expressionless", "expressionless"},
+		{"vassar", "vassar"},
+		{"phrase", "phrase"},
+		{"inhabited", "inhabit"},
+		{"negotiations", "negoti"},
+		{"water", "water"},
+		{"marrying", "marri"},
+		{"saphenous", "saphen"},
+		{"relays", "relai"},
+		{"arthritic", "arthrit"},
+		{"liable", "liabl"},
+		{"reactive", "reactiv"},
+		{"indefinite", "indefinit"},
+		{"mother", "mother"},
+		{"panics", "panic"},
+		{"unintentionally", "unintention"},
+		{"battalions", "battalion"},
+		{"africans", "african"},
+		{"glossy", "glossi"},
+		{"groups", "group"},
+		{"expenditure", "expenditur"},
+		{"principally", "princip"},
+		{"mischief", "mischief"},
+		{"sharper", "sharper"},
+		{"iritis", "iriti"},
+		{"journalist", "journalist"},
+		{"cephalic", "cephal"},
+		{"coincided", "coincid"},
+		{"glass", "glass"},
+		{"million", "million"},
+		{"citizen", "citizen"},
+		{"appalled", "appal"},
+		{"disappears", "disappear"},
+		{"ryder", "ryder"},
+		{"immobilised", "immobilis"},
+		{"height", "height"},
+		{"gall", "gall"},
+		{"kirilovich", "kirilovich"},
+		{"unconsciously", "unconsci"},
+		{"carts", "cart"},
+		{"brazen", "brazen"},
+		{"callosity", "callos"},
+		{"firmness", "firm"},
+		{"avert", "avert"},
+		{"necessities", "necess"},
+		{"oblivion", "oblivion"},
+		{"immobility", "immobl"},
+		{"periosteum", "periosteum"},
+		{"fight", "fight"},
+		{"embarked", "embark"},
+		{"wischau", "wischau"},
+		{"scant", "scant"},
+		{"hyperaemia", "hyperaemia"},
+		{"clatter", "clatter"},
+		{"stray", "strai"},
+		{"deepened", "deepen"},
+		{"aiding", "aid"},
+		{"suite", "suit"},
+		{"gripping", "grip"},
+		{"fluctuation", "fluctuat"},
+		{"brown", "brown"},
+		{"attentively", "attent"},
+		{"resounded", "resound"},
+		{"uterine", "uterin"},
+		{"machinery", "machineri"},
+		{"energies", "energi"},
+		{"uniformly", "uniformli"},
+		{"hydrocele", "hydrocel"},
+		{"straighten", "straighten"},
+		{"repressing", "repress"},
+		{"confiscation", "confisc"},
+		{"numbers", "number"},
+		{"listerian", "listerian"},
+		{"domingo", "domingo"},
+		{"states", "state"},
+		{"enriched", "enrich"},
+		{"fortresses", "fortress"},
+		{"blonde", "blond"},
+		{"steadily", "steadili"},
+		{"harm", "harm"},
+		{"grizzled", "grizzl"},
+		{"frankly", "frankli"},
+		{"interrupt", "interrupt"},
+		{"spreads", "spread"},
+		{"cargoes", "cargo"},
+		{"appropriately", "appropri"},
+		{"ridden", "ridden"},
+		{"porto", "porto"},
+		{"brownish", "brownish"},
+		{"akimbo", "akimbo"},
+		{"acetabulum", "acetabulum"},
+		{"exclaiming", "exclaim"},
+		{"pilgrims", "pilgrim"},
+		{"instructive", "instruct"},
+		{"thomas", "thoma"},
+		{"treasonable", "treason"},
+		{"sing", "sing"},
+		{"sobs", "sob"},
+		{"risks", "risk"},
+		{"tireless", "tireless"},
+		{"desperate", "desper"},
+		{"intestinal", "intestin"},
+		{"bones", "bone"},
+		{"indolence", "indol"},
+		{"crowed", "crow"},
+		{"ismail", "ismail"},
+		{"using", "us"},
+		{"von", "von"},
+		{"elbow", "elbow"},
+		{"caribbean", "caribbean"},
+		{"boned", "bone"},
+		{"retires", "retir"},
+		{"innkeeper", "innkeep"},
+		{"witty", "witti"},
+		{"defenders", "defend"},
+		{"sundays", "sundai"},
+		{"fortnight", "fortnight"},
+		{"pre", "pre"},
+		{"pillars", "pillar"},
+		{"dishonor", "dishonor"},
+		{"threatens", "threaten"},
+		{"brixton", "brixton"},
+		{"officially", "offici"},
+		{"flints", "flint"},
+		{"ulcerates", "ulcer"},
+		{"investing", "invest"},
+		{"resented", "resent"},
+		{"henceforth", "henceforth"},
+		{"lodgings", "lodg"},
+		{"catarrh", "catarrh"},
+		{"theresa", "theresa"},
+		{"corn", "corn"},
+		{"expenditures", "expenditur"},
+		{"wound", "wound"},
+		{"declarations", "declar"},
+		{"clasping", "clasp"},
+		{"apiece", "apiec"},
+		{"merrily", "merrili"},
+		{"horsemen", "horsemen"},
+		{"grating", "grate"},
+		{"among", "among"},
+		{"shouting", "shout"},
+		{"homes", "home"},
+		{"torticollis", "torticolli"},
+		{"expansion", "expans"},
+		{"bull", "bull"},
+		{"knitted", "knit"},
+		{"earnings", "earn"},
+		{"lighting", "light"},
+		{"crystal", "crystal"},
+		{"edged", "edg"},
+		{"apple", "appl"},
+		{"caseous", "caseou"},
+		{"recommend", "recommend"},
+		{"receives", "receiv"},
+		{"pushed", "push"},
+		{"planned", "plan"},
+		{"amputation", "amput"},
+		{"hollabrunn", "hollabrunn"},
+		{"silent", "silent"},
+		{"distrust", "distrust"},
+		{"patting", "pat"},
+		{"poorest", "poorest"},
+		{"besought", "besought"},
+		{"combination", "combin"},
+		{"parents", "parent"},
+		{"congested", "congest"},
+		{"spontaneously", "spontan"},
+		{"livingston", "livingston"},
+		{"turmoil", "turmoil"},
+		{"net", "net"},
+		{"lengthened", "lengthen"},
+		{"causative", "caus"},
+		{"canon", "canon"},
+		{"varies", "vari"},
+		{"flaccid", "flaccid"},
+		{"sam", "sam"},
+		{"june", "june"},
+		{"scandal", "scandal"},
+		{"hears", "hear"},
+		{"joyous", "joyou"},
+		{"forgave", "forgav"},
+		{"vest", "vest"},
+		{"badge", "badg"},
+		{"wheeler", "wheeler"},
+		{"rob", "rob"},
+		{"apart", "apart"},
+		{"troubles", "troubl"},
+		{"signs", "sign"},
+		{"expresses", "express"},
+		{"foetal", "foetal"},
+		{"easily", "easili"},
+		{"colour", "colour"},
+		{"screaming", "scream"},
+		{"stephen", "stephen"},
+		{"wake", "wake"},
+		{"attention", "attent"},
+		{"bivouac", "bivouac"},
+		{"planted", "plant"},
+		{"geese", "gees"},
+		{"romance", "romanc"},
+		{"purport", "purport"},
+		{"brought", "brought"},
+		{"necklace", "necklac"},
+		{"richer", "richer"},
+		{"inheritance", "inherit"},
+		{"attendants", "attend"},
+		{"aperture", "apertur"},
+		{"admiral", "admir"},
+		{"awkwardness", "awkward"},
+		{"reforms", "reform"},
+		{"filipino", "filipino"},
+		{"dispersing", "dispers"},
+		{"defined", "defin"},
+		{"fasciae", "fascia"},
+		{"marauders", "maraud"},
+		{"tingling", "tingl"},
+		{"fruit", "fruit"},
+		{"communities", "commun"},
+		{"undoing", "undo"},
+		{"candid", "candid"},
+		{"composing", "compos"},
+		{"punctured", "punctur"},
+		{"appointed", "appoint"},
+		{"surpassed", "surpass"},
+		{"malvintseva", "malvintseva"},
+		{"creatures", "creatur"},
+		{"rodent", "rodent"},
+		{"chapter", "chapter"},
+		{"perforating", "perfor"},
+		{"vividly", "vividli"},
+		{"turkey", "turkei"},
+		{"gaze", "gaze"},
+		{"bunch", "bunch"},
+		{"contamination", "contamin"},
+		{"continuity", "continu"},
+		{"reproduced", "reproduc"},
+		{"absorb", "absorb"},
+		{"carious", "cariou"},
+		{"walls", "wall"},
+		{"serratus", "serratu"},
+		{"islets", "islet"},
+		{"judging", "judg"},
+		{"emphasized", "emphas"},
+		{"discoveries", "discoveri"},
+		{"abandon", "abandon"},
+		{"politics", "polit"},
+		{"rivers", "river"},
+		{"vent", "vent"},
+		{"narrated", "narrat"},
+		{"agitator", "agit"},
+		{"rotten", "rotten"},
+		{"lends", "lend"},
+		{"injustice", "injustic"},
+		{"lump", "lump"},
+		{"clearness", "clear"},
+		{"footsteps", "footstep"},
+		{"wrongs", "wrong"},
+		{"good", "good"},
+		{"virulence", "virul"},
+		{"generation", "gener"},
+		{"yes", "ye"},
+		{"ability", "abil"},
+		{"undiluted", "undilut"},
+		{"lymphoid", "lymphoid"},
+		{"tragedy", "tragedi"},
+		{"beggar", "beggar"},
+		{"stair", "stair"},
+		{"wars", "war"},
+		{"montana", "montana"},
+		{"insidiously", "insidi"},
+		{"kerchief", "kerchief"},
+		{"irritable", "irrit"},
+		{"tucking", "tuck"},
+		{"bustling", "bustl"},
+		{"wattle", "wattl"},
+		{"deported", "deport"},
+		{"roofed", "roof"},
+		{"propaganda", "propaganda"},
+		{"sale", "sale"},
+		{"harvests", "harvest"},
+		{"impaction", "impact"},
+		{"repel", "repel"},
+		{"sheath", "sheath"},
+		{"lome", "lome"},
+		{"debates", "debat"},
+		{"slipped", "slip"},
+		{"tallow", "tallow"},
+		{"wore", "wore"},
+		{"lavrushka", "lavrushka"},
+		{"winking", "wink"},
+		{"fill", "fill"},
+		{"theirs", "their"},
+		{"gout", "gout"},
+		{"flatter", "flatter"},
+		{"punctuation", "punctuat"},
+		{"allies", "alli"},
+		{"oppress", "oppress"},
+		{"distinction", "distinct"},
+		{"cunning", "cun"},
+		{"appropriated", "appropri"},
+		{"twisting", "twist"},
+		{"riches", "rich"},
+		{"called", "call"},
+		{"disordered", "disord"},
+		{"proximal", "proxim"},
+		{"bound", "bound"},
+		{"colleagues", "colleagu"},
+		{"quoting", "quot"},
+		{"liveliness", "liveli"},
+		{"impossible", "imposs"},
+		{"rigid", "rigid"},
+		{"drone", "drone"},
+		{"aniska", "aniska"},
+		{"milky", "milki"},
+		{"awards", "award"},
+		{"affirmatively", "affirm"},
+		{"fait", "fait"},
+		{"sherren", "sherren"},
+		{"heavens", "heaven"},
+		{"shrugged", "shrug"},
+		{"dagger", "dagger"},
+		{"bandage", "bandag"},
+		{"cultivated", "cultiv"},
+		{"blade", "blade"},
+		{"moderation", "moder"},
+		{"puddles", "puddl"},
+		{"memoirs", "memoir"},
+		{"fuse", "fuse"},
+		{"gum", "gum"},
+		{"adverse", "advers"},
+		{"martyr", "martyr"},
+		{"actor", "actor"},
+		{"amongst", "amongst"},
+		{"act", "act"},
+		{"remind", "remind"},
+		{"swimming", "swim"},
+		{"problems", "problem"},
+		{"gait", "gait"},
+		{"founders", "founder"},
+		{"shabby", "shabbi"},
+		{"emphysema", "emphysema"},
+		{"detective", "detect"},
+		{"tuned", "tune"},
+		{"orations", "orat"},
+		{"qualified", "qualifi"},
+		{"dresses", "dress"},
+		{"lamp", "lamp"},
+		{"sherman", "sherman"},
+		{"regulation", "regul"},
+		{"becoming", "becom"},
+		{"pays", "pai"},
+		{"lympho", "lympho"},
+		{"cancellous", "cancel"},
+		{"adhesions", "adhes"},
+		{"excitement", "excit"},
+		{"illustrious", "illustri"},
+		{"length", "length"},
+		{"overcoat", "overcoat"},
+		{"matted", "mat"},
+		{"mountain", "mountain"},
+		{"signatures", "signatur"},
+		{"went", "went"},
+		{"westward", "westward"},
+		{"klebs", "kleb"},
+		{"glycerin", "glycerin"},
+		{"conical", "conic"},
+		{"nickname", "nicknam"},
+		{"genet", "genet"},
+		{"marauder", "maraud"},
+		{"effused", "effus"},
+		{"seeks", "seek"},
+		{"deliverance", "deliver"},
+		{"preparation", "prepar"},
+		{"faults", "fault"},
+		{"repaired", "repair"},
+		{"chapel", "chapel"},
+		{"baltimore", "baltimor"},
+		{"sought", "sought"},
+		{"hubbub", "hubbub"},
+		{"helps", "help"},
+		{"gambler", "gambler"},
+		{"reviews", "review"},
+		{"terrified", "terrifi"},
+		{"eligible", "elig"},
+		{"beat", "beat"},
+		{"melt", "melt"},
+		{"brighter", "brighter"},
+		{"pages", "page"},
+		{"edge", "edg"},
+		{"diameter", "diamet"},
+		{"anteroom", "anteroom"},
+		{"concentrating", "concentr"},
+		{"spectacle", "spectacl"},
+		{"arsenic", "arsen"},
+		{"meaningless", "meaningless"},
+		{"wills", "will"},
+		{"muscular", "muscular"},
+		{"jews", "jew"},
+		{"attentions", "attent"},
+		{"gave", "gave"},
+		{"administer", "administ"},
+		{"communicate", "commun"},
+		{"revenge", "reveng"},
+		{"emphatic", "emphat"},
+		{"uneasiness", "uneasi"},
+		{"quietly", "quietli"},
+		{"tremor", "tremor"},
+		{"borzois", "borzoi"},
+		{"grate", "grate"},
+		{"persons", "person"},
+		{"hue", "hue"},
+		{"framers", "framer"},
+		{"undecided", "undecid"},
+		{"transfusion", "transfus"},
+		{"halting", "halt"},
+		{"klan", "klan"},
+		{"regulars", "regular"},
+		{"looking", "look"},
+		{"subversive", "subvers"},
+		{"porter", "porter"},
+		{"salaries", "salari"},
+		{"worsted", "worst"},
+		{"tend", "tend"},
+		{"distinguishing", "distinguish"},
+		{"epitheliomas", "epithelioma"},
+		{"flows", "flow"},
+		{"bravest", "bravest"},
+		{"artilleryman", "artilleryman"},
+		{"toombs", "toomb"},
+		{"robbery", "robberi"},
+		{"seed", "seed"},
+		{"myeloma", "myeloma"},
+		{"stared", "stare"},
+		{"eyelids", "eyelid"},
+		{"egotism", "egot"},
+		{"wage", "wage"},
+		{"northern", "northern"},
+		{"dronushka", "dronushka"},
+		{"ohio", "ohio"},
+		{"blanket", "blanket"},
+		{"remembers", "rememb"},
+		{"employer", "employ"},
+		{"epoch", "epoch"},
+		{"hospital", "hospit"},
+		{"tenth", "tenth"},
+		{"enjoying", "enjoi"},
+		{"tender", "tender"},
+		{"expectation", "expect"},
+		{"unemployment", "unemploy"},
+		{"quotas", "quota"},
+		{"tilling", "till"},
+		{"row", "row"},
+		{"knelt", "knelt"},
+		{"betray", "betrai"},
+		{"manors", "manor"},
+		{"saxon", "saxon"},
+		{"led", "led"},
+		{"friction", "friction"},
+		{"entreaties", "entreati"},
+		{"exalted", "exalt"},
+		{"tricked", "trick"},
+		{"severed", "sever"},
+		{"resumed", "resum"},
+		{"plans", "plan"},
+		{"thoughtless", "thoughtless"},
+		{"extreme", "extrem"},
+		{"manager", "manag"},
+		{"affairs", "affair"},
+		{"ounce", "ounc"},
+		{"swelled", "swell"},
+		{"swells", "swell"},
+		{"twilight", "twilight"},
+		{"issuance", "issuanc"},
+		{"coalescence", "coalesc"},
+		{"odor", "odor"},
+		{"lighter", "lighter"},
+		{"shelter", "shelter"},
+		{"vill", "vill"},
+		{"wreaths", "wreath"},
+		{"osteopsathyrosis", "osteopsathyrosi"},
+		{"corneum", "corneum"},
+		{"relying", "reli"},
+		{"opened", "open"},
+		{"scared", "scare"},
+		{"cleverness", "clever"},
+		{"boards", "board"},
+		{"domain", "domain"},
+		{"joyfully", "joyfulli"},
+		{"conflagration", "conflagr"},
+		{"mortality", "mortal"},
+		{"lesion", "lesion"},
+		{"migrated", "migrat"},
+		{"miracle", "miracl"},
+		{"straits", "strait"},
+		{"extensor", "extensor"},
+		{"authorizing", "author"},
+		{"mile", "mile"},
+		{"forcibly", "forcibl"},
+		{"gaseous", "gaseou"},
+		{"coin", "coin"},
+		{"borders", "border"},
+		{"axilla", "axilla"},
+		{"unhealthy", "unhealthi"},
+		{"grandmother", "grandmoth"},
+		{"those", "those"},
+		{"cava", "cava"},
+		{"scowling", "scowl"},
+		{"religious", "religi"},
+		{"philippines", "philippin"},
+		{"erupts", "erupt"},
+		{"hammering", "hammer"},
+		{"flags", "flag"},
+		{"rubles", "rubl"},
+		{"guitar", "guitar"},
+		{"wholly", "wholli"},
+		{"induces", "induc"},
+		{"fiery", "fieri"},
+		{"berg", "berg"},
+		{"obliteration", "obliter"},
+		{"broadly", "broadli"},
+		{"husbands", "husband"},
+		{"mortgages", "mortgag"},
+		{"decisions", "decis"},
+		{"diplomatic", "diplomat"},
+		{"papilloma", "papilloma"},
+		{"leadenhall", "leadenhal"},
+		{"specialized", "special"},
+		{"release", "releas"},
+		{"compartment", "compart"},
+		{"reconciled", "reconcil"},
+		{"bells", "bell"},
+		{"scotch", "scotch"},
+		{"advancing", "advanc"},
+		{"splash", "splash"},
+		{"invariably", "invari"},
+		{"headwaters", "headwat"},
+		{"mate", "mate"},
+		{"searchingly", "searchingli"},
+		{"evanescent", "evanesc"},
+		{"balk", "balk"},
+		{"crisis", "crisi"},
+		{"lack", "lack"},
+		{"landlord", "landlord"},
+		{"reddish", "reddish"},
+		{"hansom", "hansom"},
+		{"accent", "accent"},
+		{"wavering", "waver"},
+		{"sedition", "sedit"},
+		{"ross", "ross"},
+		{"danger", "danger"},
+		{"increases", "increas"},
+		{"constriction", "constrict"},
+		{"cabinet", "cabinet"},
+		{"incomprehensible", "incomprehens"},
+		{"holders", "holder"},
+		{"parchment", "parchment"},
+		{"extensors", "extensor"},
+		{"yeomen", "yeomen"},
+		{"ivan", "ivan"},
+		{"titles", "titl"},
+		{"buzzing", "buzz"},
+		{"spreading", "spread"},
+		{"person", "person"},
+		{"impracticable", "impractic"},
+		{"motile", "motil"},
+		{"discussions", "discuss"},
+		{"eloquent", "eloqu"},
+		{"vegetables", "veget"},
+		{"morality", "moral"},
+		{"ache", "ach"},
+		{"retiring", "retir"},
+		{"plunder", "plunder"},
+		{"spectacled", "spectacl"},
+		{"testicle", "testicl"},
+		{"angeles", "angel"},
+		{"benign", "benign"},
+		{"enchanted", "enchant"},
+		{"ceremonial", "ceremoni"},
+		{"erosion", "eros"},
+		{"albus", "albu"},
+		{"notebook", "notebook"},
+		{"brigands", "brigand"},
+		{"steel", "steel"},
+		{"similar", "similar"},
+		{"enamel", "enamel"},
+		{"workman", "workman"},
+		{"artillerymen", "artillerymen"},
+		{"regicide", "regicid"},
+		{"puritanism", "puritan"},
+		{"regretted", "regret"},
+		{"pallidum", "pallidum"},
+		{"nearness", "near"},
+		{"families", "famili"},
+		{"purchasers", "purchas"},
+		{"traction", "traction"},
+		{"injure", "injur"},
+		{"ultimately", "ultim"},
+		{"conceded", "conced"},
+		{"loaf", "loaf"},
+		{"bushes", "bush"},
+		{"traveling", "travel"},
+		{"ingratitude", "ingratitud"},
+		{"empress", "empress"},
+		{"pins", "pin"},
+		{"seward", "seward"},
+		{"rome", "rome"},
+		{"populace", "populac"},
+		{"embers", "ember"},
+		{"frantic", "frantic"},
+		{"fibres", "fibr"},
+		{"dissenters", "dissent"},
+		{"mariners", "marin"},
+		{"raw", "raw"},
+		{"thrombus", "thrombu"},
+		{"swerved", "swerv"},
+		{"braved", "brave"},
+		{"modesty", "modesti"},
+		{"admits", "admit"},
+		{"aliens", "alien"},
+		{"gliding", "glide"},
+		{"savior", "savior"},
+		{"disappearing", "disappear"},
+		{"hilton", "hilton"},
+		{"enterprising", "enterpris"},
+		{"prosecution", "prosecut"},
+		{"anecdote", "anecdot"},
+		{"tushin", "tushin"},
+		{"warts", "wart"},
+		{"mouse", "mous"},
+		{"examining", "examin"},
+		{"guardhouse", "guardhous"},
+		{"vexed", "vex"},
+		{"bulky", "bulki"},
+		{"encounter", "encount"},
+		{"wise", "wise"},
+		{"blaze", "blaze"},
+		{"senior", "senior"},
+		{"encapsulated", "encapsul"},
+		{"confound", "confound"},
+		{"pitied", "piti"},
+		{"ceded", "cede"},
+		{"features", "featur"},
+		{"propose", "propos"},
+		{"inspired", "inspir"},
+		{"phalanx", "phalanx"},
+		{"moscovites", "moscovit"},
+		{"mornings", "morn"},
+		{"satisfying", "satisfi"},
+		{"bell", "bell"},
+		{"stark", "stark"},
+		{"crockett", "crockett"},
+		{"sutures", "sutur"},
+		{"picked", "pick"},
+		{"quarter", "quarter"},
+		{"plainer", "plainer"},
+		{"spun", "spun"},
+		{"fearless", "fearless"},
+		{"spilled", "spill"},
+		{"astonish", "astonish"},
+		{"archduke", "archduk"},
+		{"visits", "visit"},
+		{"voter", "voter"},
+		{"convenient", "conveni"},
+		{"niece", "niec"},
+		{"insurrection", "insurrect"},
+		{"interference", "interfer"},
+		{"wholesome", "wholesom"},
+		{"antecedent", "anteced"},
+		{"devoid", "devoid"},
+		{"ill", "ill"},
+		{"confirmed", "confirm"},
+		{"achieve", "achiev"},
+		{"flexible", "flexibl"},
+		{"force", "forc"},
+		{"enforced", "enforc"},
+		{"enthusiastic", "enthusiast"},
+		{"hoarsely", "hoars"},
+		{"decorations", "decor"},
+		{"dazed", "daze"},
+		{"suffer", "suffer"},
+		{"arborescent", "arboresc"},
+		{"blackguards", "blackguard"},
+		{"satisfaction", "satisfact"},
+		{"phagocytosis", "phagocytosi"},
+		{"steamship", "steamship"},
+		{"amenable", "amen"},
+		{"incision", "incis"},
+		{"sorrowful", "sorrow"},
+		{"honest", "honest"},
+		{"drawings", "draw"},
+		{"sounding", "sound"},
+		{"majesty", "majesti"},
+		{"shcherbinin", "shcherbinin"},
+		{"pretense", "pretens"},
+		{"hidden", "hidden"},
+		{"pig", "pig"},
+		{"box", "box"},
+		{"lament", "lament"},
+		{"sole", "sole"},
+		{"foetid", "foetid"},
+		{"disgrace", "disgrac"},
+		{"frozen", "frozen"},
+		{"disgusting", "disgust"},
+		{"struggling", "struggl"},
+		{"torch", "torch"},
+		{"cannula", "cannula"},
+		{"reflected", "reflect"},
+		{"cicatricial", "cicatrici"},
+		{"cheap", "cheap"},
+		{"australian", "australian"},
+		{"hayes", "hay"},
+		{"rabble", "rabbl"},
+		{"declares", "declar"},
+		{"moving", "move"},
+		{"every", "everi"},
+		{"fro", "fro"},
+		{"creating", "creat"},
+		{"keep", "keep"},
+		{"insurance", "insur"},
+		{"working", "work"},
+		{"incessantly", "incessantli"},
+		{"debated", "debat"},
+		{"drubetskoy", "drubetskoi"},
+		{"offered", "offer"},
+		{"expired", "expir"},
+		{"corporate", "corpor"},
+		{"experiences", "experi"},
+		{"unusually", "unusu"},
+		{"divine", "divin"},
+		{"curly", "curli"},
+		{"disheveled", "dishevel"},
+		{"endeavouring", "endeavour"},
+		{"eminent", "emin"},
+		{"operate", "oper"},
+		{"spirited", "spirit"},
+		{"zinc", "zinc"},
+		{"projections", "project"},
+		{"furthermore", "furthermor"},
+		{"missed", "miss"},
+		{"keenly", "keenli"},
+		{"journey", "journei"},
+		{"sickle", "sickl"},
+		{"discriminating", "discrimin"},
+		{"frenzy", "frenzi"},
+		{"vital", "vital"},
+		{"chasseurs", "chasseur"},
+		{"lazy", "lazi"},
+		{"musketry", "musketri"},
+		{"time", "time"},
+		{"garments", "garment"},
+		{"diminished", "diminish"},
+		{"say", "sai"},
+		{"puzzle", "puzzl"},
+		{"fuller", "fuller"},
+		{"expectancy", "expect"},
+		{"resected", "resect"},
+		{"cancerous", "cancer"},
+		{"mistresses", "mistress"},
+		{"aspects", "aspect"},
+		{"secondarily", "secondarili"},
+		{"focus", "focu"},
+		{"rumyantsev", "rumyantsev"},
+		{"profits", "profit"},
+		{"sophie", "sophi"},
+		{"mane", "mane"},
+		{"cudgel", "cudgel"},
+		{"harvard", "harvard"},
+		{"opportune", "opportun"},
+		{"naivete", "naivet"},
+		{"invite", "invit"},
+		{"requisite", "requisit"},
+		{"statistics", "statist"},
+		{"noiselessly", "noiselessli"},
+		{"reasonableness", "reason"},
+		{"uphold", "uphold"},
+		{"insincere", "insincer"},
+		{"owned", "own"},
+		{"attracted", "attract"},
+		{"legislatures", "legislatur"},
+		{"blandly", "blandli"},
+		{"atheroma", "atheroma"},
+		{"yorktown", "yorktown"},
+		{"function", "function"},
+		{"importation", "import"},
+		{"pyocyaneus", "pyocyaneu"},
+		{"unaccustomed", "unaccustom"},
+		{"suspected", "suspect"},
+		{"pakhra", "pakhra"},
+		{"producers", "produc"},
+		{"reasoned", "reason"},
+		{"pacification", "pacif"},
+		{"brachialis", "brachiali"},
+		{"foundation", "foundat"},
+		{"elaborated", "elabor"},
+		{"fulfilled", "fulfil"},
+		{"absurd", "absurd"},
+		{"regained", "regain"},
+		{"weal", "weal"},
+		{"wait", "wait"},
+		{"free", "free"},
+		{"constituents", "constitu"},
+		{"manufacturer", "manufactur"},
+		{"references", "refer"},
+		{"schoolhouses", "schoolhous"},
+		{"squamous", "squamou"},
+		{"passing", "pass"},
+		{"connecting", "connect"},
+		{"meetings", "meet"},
+		{"contemporary", "contemporari"},
+		{"becomes", "becom"},
+		{"practicable", "practic"},
+		{"deny", "deni"},
+		{"despot", "despot"},
+		{"subsequently", "subsequ"},
+		{"crawled", "crawl"},
+		{"neuropathic", "neuropath"},
+		{"plume", "plume"},
+		{"fear", "fear"},
+		{"governing", "govern"},
+		{"unseemly", "unseemli"},
+		{"manifesto", "manifesto"},
+		{"commercial", "commerci"},
+		{"petted", "pet"},
+		{"laterally", "later"},
+		{"mummers", "mummer"},
+		{"visitors", "visitor"},
+		{"ungainly", "ungainli"},
+		{"limit", "limit"},
+		{"children", "children"},
+		{"moles", "mole"},
+		{"unfavourable", "unfavour"},
+		{"definition", "definit"},
+		{"guerrilla", "guerrilla"},
+		{"quality", "qualiti"},
+		{"saviour", "saviour"},
+		{"whiteness", "white"},
+		{"bladder", "bladder"},
+		{"diarrhoea", "diarrhoea"},
+		{"tsarevo", "tsarevo"},
+		{"radiant", "radiant"},
+		{"coach", "coach"},
+		{"saint", "saint"},
+		{"cooked", "cook"},
+		{"bucharest", "bucharest"},
+		{"other", "other"},
+		{"battalion", "battalion"},
+		{"endurance", "endur"},
+		{"ratified", "ratifi"},
+		{"politician", "politician"},
+		{"antagonist", "antagonist"},
+		{"ridge", "ridg"},
+		{"bribes", "bribe"},
+		{"much", "much"},
+		{"battleships", "battleship"},
+		{"page", "page"},
+		{"profited", "profit"},
+		{"sting", "sting"},
+		{"buonaparte", "buonapart"},
+		{"penn", "penn"},
+		{"disastrous", "disastr"},
+		{"riding", "ride"},
+		{"varied", "vari"},
+		{"franklin", "franklin"},
+		{"notwithstanding", "notwithstand"},
+		{"finance", "financ"},
+		{"tolerated", "toler"},
+		{"erroneous", "erron"},
+		{"softness", "soft"},
+		{"examinations", "examin"},
+		{"beg", "beg"},
+		{"norton", "norton"},
+		{"radialis", "radiali"},
+		{"casket", "casket"},
+		{"ends", "end"},
+		{"crystals", "crystal"},
+		{"gentleman", "gentleman"},
+		{"bruce", "bruce"},
+		{"see", "see"},
+		{"contests", "contest"},
+		{"blundered", "blunder"},
+		{"fund", "fund"},
+		{"proprietors", "proprietor"},
+		{"fighting", "fight"},
+		{"ward", "ward"},
+		{"invested", "invest"},
+		{"fibro", "fibro"},
+		{"shrugging", "shrug"},
+		{"mirror", "mirror"},
+		{"trotter", "trotter"},
+		{"corrupt", "corrupt"},
+		{"exile", "exil"},
+		{"flashing", "flash"},
+		{"kamenka", "kamenka"},
+		{"ominous", "omin"},
+		{"players", "player"},
+		{"scrape", "scrape"},
+		{"raising", "rais"},
+		{"flaring", "flare"},
+		{"altogether", "altogeth"},
+		{"displeased", "displeas"},
+		{"connective", "connect"},
+		{"some", "some"},
+		{"drubetskaya", "drubetskaya"},
+		{"pass", "pass"},
+		{"taught", "taught"},
+		{"tips", "tip"},
+		{"before", "befor"},
+		{"charmer", "charmer"},
+		{"loads", "load"},
+		{"victors", "victor"},
+		{"buttons", "button"},
+		{"chronicle", "chronicl"},
+		{"countries", "countri"},
+		{"suppers", "supper"},
+		{"tubercles", "tubercl"},
+		{"shcherbaty", "shcherbati"},
+		{"thirteenth", "thirteenth"},
+		{"absorbent", "absorb"},
+		{"kissed", "kiss"},
+		{"command", "command"},
+		{"breathing", "breath"},
+		{"spermatic", "spermat"},
+		{"papules", "papul"},
+		{"trace", "trace"},
+		{"composure", "composur"},
+		{"employ", "emploi"},
+		{"sleigh", "sleigh"},
+		{"chanter", "chanter"},
+		{"falcon", "falcon"},
+		{"sighed", "sigh"},
+		{"blue", "blue"},
+		{"nodule", "nodul"},
+		{"defects", "defect"},
+		{"plaster", "plaster"},
+		{"undertaken", "undertaken"},
+		{"barbarism", "barbar"},
+		{"terentich", "terentich"},
+		{"pine", "pine"},
+		{"loop", "loop"},
+		{"removing", "remov"},
+		{"mastery", "masteri"},
+		{"opulence", "opul"},
+		{"stiffly", "stiffli"},
+		{"exchange", "exchang"},
+		{"clamped", "clamp"},
+		{"pokrovsk", "pokrovsk"},
+		{"impressed", "impress"},
+		{"amply", "ampli"},
+		{"shaft", "shaft"},
+		{"interfered", "interf"},
+		{"whispered", "whisper"},
+		{"trodden", "trodden"},
+		{"chagrin", "chagrin"},
+		{"foetus", "foetu"},
+		{"seat", "seat"},
+		{"experience", "experi"},
+		{"gastro", "gastro"},
+		{"piece", "piec"},
+		{"acting", "act"},
+		{"fade", "fade"},
+		{"dates", "date"},
+		{"peering", "peer"},
+		{"grossly", "grossli"},
+		{"poly", "poli"},
+		{"tetanus", "tetanu"},
+		{"diarsenol", "diarsenol"},
+		{"twirled", "twirl"},
+		{"microscope", "microscop"},
+		{"obstinately", "obstin"},
+		{"businesslike", "businesslik"},
+		{"sweetness", "sweet"},
+		{"localisation", "localis"},
+		{"blisters", "blister"},
+		{"farthest", "farthest"},
+		{"irresistibly", "irresist"},
+		{"footman", "footman"},
+		{"ran", "ran"},
+		{"wailing", "wail"},
+		{"sympathies", "sympathi"},
+		{"rite", "rite"},
+		{"holding", "hold"},
+		{"into", "into"},
+		{"cleanse", "cleans"},
+		{"ammunition", "ammunit"},
+		{"artificially", "artifici"},
+		{"boisterous", "boister"},
+		{"cared", "care"},
+		{"nearly", "nearli"},
+		{"file", "file"},
+		{"fails", "fail"},
+		{"tippecanoe", "tippecano"},
+		{"laceration", "lacer"},
+		{"insist", "insist"},
+		{"pursue", "pursu"},
+		{"perplexing", "perplex"},
+		{"bewitching", "bewitch"},
+		{"concerted", "concert"},
+		{"commissions", "commiss"},
+		{"deadly", "deadli"},
+		{"check", "check"},
+		{"sugar", "sugar"},
+		{"passions", "passion"},
+		{"little", "littl"},
+		{"checked", "check"},
+		{"hesitation", "hesit"},
+		{"carpeted", "carpet"},
+		{"revolutions", "revolut"},
+		{"death", "death"},
+		{"philanthropy", "philanthropi"},
+		{"retreated", "retreat"},
+		{"soap", "soap"},
+		{"dearly", "dearli"},
+		{"emergency", "emerg"},
+		{"resources", "resourc"},
+		{"comprehension", "comprehens"},
+		{"quartermasters", "quartermast"},
+		{"linen", "linen"},
+		{"teno", "teno"},
+		{"mortified", "mortifi"},
+		{"karagins", "karagin"},
+		{"liberties", "liberti"},
+		{"obscure", "obscur"},
+		{"song", "song"},
+		{"fulfilling", "fulfil"},
+		{"catholics", "cathol"},
+		{"examined", "examin"},
+		{"brother", "brother"},
+		{"manly", "manli"},
+		{"governors", "governor"},
+		{"angina", "angina"},
+		{"treasure", "treasur"},
+		{"intervals", "interv"},
+		{"nominated", "nomin"},
+		{"viennese", "viennes"},
+		{"trochlear", "trochlear"},
+		{"partiality", "partial"},
+		{"fraser", "fraser"},
+		{"circumflex", "circumflex"},
+		{"attribute", "attribut"},
+		{"university", "univers"},
+		{"lent", "lent"},
+		{"assist", "assist"},
+		{"betrothed", "betroth"},
+		{"acquiring", "acquir"},
+		{"concealed", "conceal"},
+		{"filed", "file"},
+		{"committees", "committe"},
+		{"arresting", "arrest"},
+		{"vasilich", "vasilich"},
+		{"dreadful", "dread"},
+		{"underwood", "underwood"},
+		{"enfranchised", "enfranchis"},
+		{"walked", "walk"},
+		{"trivial", "trivial"},
+		{"puts", "put"},
+		{"carefully", "carefulli"},
+		{"true", "true"},
+		{"rack", "rack"},
+		{"shortness", "short"},
+		{"forest", "forest"},
+		{"mcculloch", "mcculloch"},
+		{"avenge", "aveng"},
+		{"deformed", "deform"},
+		{"invincible", "invinc"},
+		{"alienated", "alien"},
+		{"dodd", "dodd"},
+		{"michel", "michel"},
+		{"formalities", "formal"},
+		{"pathological", "patholog"},
+		{"labor", "labor"},
+		{"secondly", "secondli"},
+		{"refusing", "refus"},
+		{"additional", "addit"},
+		{"lie", "lie"},
+		{"hundreds", "hundr"},
+		{"prusse", "pruss"},
+		{"thee", "thee"},
+		{"refrain", "refrain"},
+		{"gloved", "glove"},
+		{"cries", "cri"},
+		{"monastery", "monasteri"},
+		{"missionaries", "missionari"},
+		{"generals", "gener"},
+		{"subordination", "subordin"},
+		{"platforms", "platform"},
+		{"perfect", "perfect"},
+		{"insight", "insight"},
+		{"campaign", "campaign"},
+		{"obstruction", "obstruct"},
+		{"inactive", "inact"},
+		{"tories", "tori"},
+		{"chicken", "chicken"},
+		{"walpole", "walpol"},
+		{"scoundrels", "scoundrel"},
+		{"kind", "kind"},
+		{"don", "don"},
+		{"oats", "oat"},
+		{"functional", "function"},
+		{"youngster", "youngster"},
+		{"losing", "lose"},
+		{"refractory", "refractori"},
+		{"timber", "timber"},
+		{"conjectured", "conjectur"},
+		{"outgrowths", "outgrowth"},
+		{"mute", "mute"},
+		{"polynuclear", "polynuclear"},
+		{"plainness", "plain"},
+		{"revenue", "revenu"},
+		{"tver", "tver"},
+		{"capsule", "capsul"},
+		{"rugay", "rugai"},
+		{"calibre", "calibr"},
+		{"store", "store"},
+		{"ordered", "order"},
+		{"long", "long"},
+		{"behaving", "behav"},
+		{"coins", "coin"},
+		{"eyed", "ei"},
+		{"arts", "art"},
+		{"supplying", "suppli"},
+		{"occasionally", "occasion"},
+		{"meshwork", "meshwork"},
+		{"rarefaction", "rarefact"},
+		{"mobility", "mobil"},
+		{"bronzes", "bronz"},
+		{"valets", "valet"},
+		{"exophthalmos", "exophthalmo"},
+		{"abreast", "abreast"},
+		{"exposing", "expos"},
+		{"speed", "speed"},
+		{"segments", "segment"},
+		{"wire", "wire"},
+		{"nicely", "nice"},
+		{"induce", "induc"},
+		{"shorter", "shorter"},
+		{"execution", "execut"},
+		{"chairman", "chairman"},
+		{"sympathize", "sympath"},
+		{"podolsk", "podolsk"},
+		{"democrat", "democrat"},
+		{"people", "peopl"},
+		{"prizes", "prize"},
+		{"measured", "measur"},
+		{"rule", "rule"},
+		{"cruel", "cruel"},
+		{"horror", "horror"},
+		{"cakes", "cake"},
+		{"deliberation", "deliber"},
+		{"privation", "privat"},
+		{"thrusting", "thrust"},
+		{"angered", "anger"},
+		{"snorted", "snort"},
+		{"disputing", "disput"},
+		{"beard", "beard"},
+		{"scandals", "scandal"},
+		{"uselessly", "uselessli"},
+		{"debilitated", "debilit"},
+		{"courage", "courag"},
+		{"alarming", "alarm"},
+		{"correction", "correct"},
+		{"perceive", "perceiv"},
+		{"fringe", "fring"},
+		{"opposite", "opposit"},
+		{"himself", "himself"},
+		{"sexual", "sexual"},
+		{"lessened", "lessen"},
+		{"evident", "evid"},
+		{"equity", "equiti"},
+		{"deprived", "depriv"},
+		{"alcoholism", "alcohol"},
+		{"convict", "convict"},
+		{"utitsa", "utitsa"},
+		{"authority", "author"},
+		{"decomposition", "decomposit"},
+		{"officer", "offic"},
+		{"awry", "awri"},
+		{"entering", "enter"},
+		{"compunction", "compunct"},
+		{"absurdity", "absurd"},
+		{"tokyo", "tokyo"},
+		{"xvii", "xvii"},
+		{"seven", "seven"},
+		{"implicate", "implic"},
+		{"secretaries", "secretari"},
+		{"waging", "wage"},
+		{"semenovna", "semenovna"},
+		{"travel", "travel"},
+		{"earners", "earner"},
+		{"comte", "comt"},
+		{"malevolence", "malevol"},
+		{"hastily", "hastili"},
+		{"grave", "grave"},
+		{"kochubey", "kochubei"},
+		{"price", "price"},
+		{"taxpayers", "taxpay"},
+		{"theorist", "theorist"},
+		{"sunk", "sunk"},
+		{"erythema", "erythema"},
+		{"lapse", "laps"},
+		{"pratzen", "pratzen"},
+		{"axes", "ax"},
+		{"deserves", "deserv"},
+		{"ample", "ampl"},
+		{"germs", "germ"},
+		{"conquer", "conquer"},
+		{"acquire", "acquir"},
+		{"implanting", "implant"},
+		{"conservation", "conserv"},
+		{"disapprovingly", "disapprovingli"},
+		{"irritably", "irrit"},
+		{"midnight", "midnight"},
+		{"charmante", "charmant"},
+		{"speedy", "speedi"},
+		{"all", "all"},
+		{"choking", "choke"},
+		{"facilities", "facil"},
+		{"buchanan", "buchanan"},
+		{"gaining", "gain"},
+		{"alarmed", "alarm"},
+		{"impenetrable", "impenetr"},
+		{"eugene", "eugen"},
+		{"tugged", "tug"},
+		{"selenium", "selenium"},
+		{"affirmative", "affirm"},
+		{"typhoid", "typhoid"},
+		{"nose", "nose"},
+		{"occupant", "occup"},
+		{"sarcomatous", "sarcomat"},
+		{"pronounced", "pronounc"},
+		{"propriety", "proprieti"},
+		{"hours", "hour"},
+		{"rectal", "rectal"},
+		{"repealed", "repeal"},
+		{"irregularly", "irregularli"},
+		{"shrieks", "shriek"},
+		{"banished", "banish"},
+		{"association", "associ"},
+		{"hail", "hail"},
+		{"fluffy", "fluffi"},
+		{"dam", "dam"},
+		{"links", "link"},
+		{"rational", "ration"},
+		{"tripping", "trip"},
+		{"silly", "silli"},
+		{"innovations", "innov"},
+		{"compassion", "compass"},
+		{"lives", "live"},
+		{"deduce", "deduc"},
+		{"egypt", "egypt"},
+		{"displeasure", "displeasur"},
+		{"strained", "strain"},
+		{"approximation", "approxim"},
+		{"poisoning", "poison"},
+		{"fun", "fun"},
+		{"cockroaches", "cockroach"},
+		{"securely", "secur"},
+		{"racing", "race"},
+		{"highroad", "highroad"},
+		{"vaccines", "vaccin"},
+		{"sanious", "saniou"},
+		{"attachment", "attach"},
+		{"executive", "execut"},
+		{"skill", "skill"},
+		{"prowess", "prowess"},
+		{"hearty", "hearti"},
+		{"bear", "bear"},
+		{"slept", "slept"},
+		{"poverty", "poverti"},
+		{"tunica", "tunica"},
+		{"redness", "red"},
+		{"footnotes", "footnot"},
+		{"prior", "prior"},
+		{"compromise", "compromis"},
+		{"wicked", "wick"},
+		{"mcclellan", "mcclellan"},
+		{"thin", "thin"},
+		{"bean", "bean"},
+		{"herein", "herein"},
+		{"volatile", "volatil"},
+		{"realise", "realis"},
+		{"reckless", "reckless"},
+		{"starving", "starv"},
+		{"luring", "lure"},
+		{"unhappily", "unhappili"},
+		{"clearly", "clearli"},
+		{"unattended", "unattend"},
+		{"velocity", "veloc"},
+		{"returned", "return"},
+		{"concert", "concert"},
+		{"reactionary", "reactionari"},
+		{"dissensions", "dissens"},
+		{"animated", "anim"},
+		{"halted", "halt"},
+		{"consented", "consent"},
+		{"encountered", "encount"},
+		{"stagnant", "stagnant"},
+		{"suvorovs", "suvorov"},
+		{"meddle", "meddl"},
+		{"pretend", "pretend"},
+		{"enterprises", "enterpris"},
+		{"residents", "resid"},
+		{"undesirable", "undesir"},
+		{"player", "player"},
+		{"disengaged", "disengag"},
+		{"approving", "approv"},
+		{"precisely", "precis"},
+		{"forward", "forward"},
+		{"weary", "weari"},
+		{"overborne", "overborn"},
+		{"plaited", "plait"},
+		{"soaked", "soak"},
+		{"philosophy", "philosophi"},
+		{"quivering", "quiver"},
+		{"wildly", "wildli"},
+		{"milder", "milder"},
+		{"beating", "beat"},
+		{"scouting", "scout"},
+		{"heightened", "heighten"},
+		{"debts", "debt"},
+		{"cures", "cure"},
+		{"tendencies", "tendenc"},
+		{"mission", "mission"},
+		{"recite", "recit"},
+		{"facility", "facil"},
+		{"lip", "lip"},
+		{"venture", "ventur"},
+		{"possessed", "possess"},
+		{"chalk", "chalk"},
+		{"multiplying", "multipli"},
+		{"wholesale", "wholesal"},
+		{"vasilyevich", "vasilyevich"},
+		{"trappers", "trapper"},
+		{"truth", "truth"},
+		{"indicating", "indic"},
+		{"tough", "tough"},
+		{"audacity", "audac"},
+		{"matchmaking", "matchmak"},
+		{"they", "thei"},
+		{"wendell", "wendel"},
+		{"gleaming", "gleam"},
+		{"proprietor", "proprietor"},
+		{"genius", "geniu"},
+		{"flash", "flash"},
+		{"shyness", "shyness"},
+		{"mazurka", "mazurka"},
+		{"conjunction", "conjunct"},
+		{"described", "describ"},
+		{"superficial", "superfici"},
+		{"intellectual", "intellectu"},
+		{"chlorine", "chlorin"},
+		{"unimportance", "unimport"},
+		{"brightness", "bright"},
+		{"whistled", "whistl"},
+		{"collecting", "collect"},
+		{"associations", "associ"},
+		{"straw", "straw"},
+		{"chewing", "chew"},
+		{"lumbricals", "lumbric"},
+		{"cream", "cream"},
+		{"claws", "claw"},
+		{"drams", "dram"},
+		{"rules", "rule"},
+		{"caution", "caution"},
+		{"blind", "blind"},
+		{"correspondent", "correspond"},
+		{"baker", "baker"},
+		{"raynaud", "raynaud"},
+		{"guardsman", "guardsman"},
+		{"saddles", "saddl"},
+		{"strip", "strip"},
+		{"symptom", "symptom"},
+		{"pleasant", "pleasant"},
+		{"freezing", "freez"},
+		{"ogg", "ogg"},
+		{"weeping", "weep"},
+		{"discuss", "discuss"},
+		{"marches", "march"},
+		{"savages", "savag"},
+		{"abused", "abus"},
+		{"unlawful", "unlaw"},
+		{"stimulate", "stimul"},
+		{"bottle", "bottl"},
+		{"dictating", "dictat"},
+		{"vicious", "viciou"},
+		{"dorsum", "dorsum"},
+		{"confinement", "confin"},
+		{"womanly", "womanli"},
+		{"ischaemia", "ischaemia"},
+		{"unintelligible", "unintellig"},
+		{"spy", "spy"},
+		{"benefits", "benefit"},
+		{"misfortunes", "misfortun"},
+		{"releasing", "releas"},
+		{"pricking", "prick"},
+		{"habits", "habit"},
+		{"jealous", "jealou"},
+		{"acid", "acid"},
+		{"epithet", "epithet"},
+		{"flamed", "flame"},
+		{"maneuvers", "maneuv"},
+		{"bleed", "bleed"},
+		{"evidently", "evid"},
+		{"monroe", "monro"},
+		{"harried", "harri"},
+		{"verse", "vers"},
+		{"understands", "understand"},
+		{"brow", "brow"},
+		{"remorse", "remors"},
+		{"ruptures", "ruptur"},
+		{"narrator", "narrat"},
+		{"preliminary", "preliminari"},
+		{"spinal", "spinal"},
+		{"pullman", "pullman"},
+		{"hath", "hath"},
+		{"forsaken", "forsaken"},
+		{"trading", "trade"},
+		{"publication", "public"},
+		{"emphysematous", "emphysemat"},
+		{"malacia", "malacia"},
+		{"felt", "felt"},
+		{"fossa", "fossa"},
+		{"trough", "trough"},
+		{"redeem", "redeem"},
+		{"chess", "chess"},
+		{"financiers", "financi"},
+		{"occurred", "occur"},
+		{"causes", "caus"},
+		{"overlapping", "overlap"},
+		{"except", "except"},
+		{"perforation", "perfor"},
+		{"dishonorable", "dishonor"},
+		{"culture", "cultur"},
+		{"worms", "worm"},
+		{"bride", "bride"},
+		{"george", "georg"},
+		{"macaulay", "macaulai"},
+		{"lafayette", "lafayett"},
+		{"valuev", "valuev"},
+		{"possible", "possibl"},
+		{"vocation", "vocat"},
+		{"outposts", "outpost"},
+		{"strangest", "strangest"},
+		{"assizes", "assiz"},
+		{"falsehood", "falsehood"},
+		{"sermons", "sermon"},
+		{"gage", "gage"},
+		{"socket", "socket"},
+		{"helping", "help"},
+		{"financed", "financ"},
+		{"melon", "melon"},
+		{"injured", "injur"},
+		{"grim", "grim"},
+		{"claims", "claim"},
+		{"lights", "light"},
+		{"stature", "statur"},
+		{"shook", "shook"},
+		{"suddenly", "suddenli"},
+		{"lymphadenitis", "lymphaden"},
+		{"comrades", "comrad"},
+		{"content", "content"},
+		{"complacent", "complac"},
+		{"digits", "digit"},
+		{"stated", "state"},
+		{"rumania", "rumania"},
+		{"militia", "militia"},
+		{"beautiful", "beauti"},
+		{"probing", "probe"},
+		{"rate", "rate"},
+		{"latterly", "latterli"},
+		{"mysteries", "mysteri"},
+		{"bacterial", "bacteri"},
+		{"cooper", "cooper"},
+		{"alleghanies", "alleghani"},
+		{"broadsheet", "broadsheet"},
+		{"adjutant", "adjut"},
+		{"ink", "ink"},
+		{"misunderstanding", "misunderstand"},
+		{"causing", "caus"},
+		{"careworn", "careworn"},
+		{"rendered", "render"},
+		{"vertical", "vertic"},
+		{"ventilator", "ventil"},
+		{"facial", "facial"},
+		{"shoving", "shove"},
+		{"hypothesis", "hypothesi"},
+		{"partnerships", "partnership"},
+		{"excited", "excit"},
+		{"heal", "heal"},
+		{"purified", "purifi"},
+		{"title", "titl"},
+		{"grm", "grm"},
+		{"rhine", "rhine"},
+		{"flattening", "flatten"},
+		{"squeaked", "squeak"},
+		{"obey", "obei"},
+		{"retired", "retir"},
+		{"brachial", "brachial"},
+		{"reminiscences", "reminisc"},
+		{"saturated", "satur"},
+		{"spanish", "spanish"},
+		{"ridiculous", "ridicul"},
+		{"regard", "regard"},
+		{"loved", "love"},
+		{"thread", "thread"},
+		{"jaunty", "jaunti"},
+		{"embittered", "embitt"},
+		{"benches", "bench"},
+		{"recollections", "recollect"},
+		{"badly", "badli"},
+		{"kidneys", "kidnei"},
+		{"consequences", "consequ"},
+		{"haughty", "haughti"},
+		{"meek", "meek"},
+		{"speculators", "specul"},
+		{"strode", "strode"},
+		{"subclavian", "subclavian"},
+		{"core", "core"},
+		{"retro", "retro"},
+		{"referred", "refer"},
+		{"wrenching", "wrench"},
+		{"cropped", "crop"},
+		{"pyogenes", "pyogen"},
+		{"merci", "merci"},
+		{"ambulance", "ambul"},
+		{"femur", "femur"},
+		{"oppression", "oppress"},
+		{"grumbled", "grumbl"},
+		{"puffs", "puff"},
+		{"headlong", "headlong"},
+		{"calf", "calf"},
+		{"end", "end"},
+		{"existence", "exist"},
+		{"claimed", "claim"},
+		{"lighten", "lighten"},
+		{"observed", "observ"},
+		{"ligament", "ligament"},
+		{"solid", "solid"},
+		{"village", "villag"},
+		{"lemon", "lemon"},
+		{"drunken", "drunken"},
+		{"bedding", "bed"},
+		{"jelly", "jelli"},
+		{"cap", "cap"},
+		{"fits", "fit"},
+		{"approved", "approv"},
+		{"animosity", "animos"},
+		{"social", "social"},
+		{"cane", "cane"},
+		{"fission", "fission"},
+		{"restrictions", "restrict"},
+		{"novel", "novel"},
+		{"beaming", "beam"},
+		{"gram", "gram"},
+		{"funeral", "funer"},
+		{"individual", "individu"},
+		{"vascular", "vascular"},
+		{"formality", "formal"},
+		{"leaving", "leav"},
+		{"pulp", "pulp"},
+		{"inlaid", "inlaid"},
+		{"dowager", "dowag"},
+		{"politeness", "polit"},
+		{"tribes", "tribe"},
+		{"pardons", "pardon"},
+		{"persistently", "persist"},
+		{"newer", "newer"},
+		{"depression", "depress"},
+		{"gangrenous", "gangren"},
+		{"application", "applic"},
+		{"includes", "includ"},
+		{"huntsmen", "huntsmen"},
+		{"hive", "hive"},
+		{"complicated", "complic"},
+		{"rag", "rag"},
+		{"tribunals", "tribun"},
+		{"samovar", "samovar"},
+		{"intensity", "intens"},
+		{"orators", "orat"},
+		{"thermo", "thermo"},
+		{"cleaned", "clean"},
+		{"swallow", "swallow"},
+		{"twenty", "twenti"},
+		{"trafalgar", "trafalgar"},
+		{"courtly", "courtli"},
+		{"naval", "naval"},
+		{"crittenden", "crittenden"},
+		{"importance", "import"},
+		{"uphill", "uphil"},
+		{"pathogenic", "pathogen"},
+		{"distributing", "distribut"},
+		{"accomplishments", "accomplish"},
+		{"handicrafts", "handicraft"},
+		{"protect", "protect"},
+		{"champions", "champion"},
+		{"nuclei", "nuclei"},
+		{"nicknamed", "nicknam"},
+		{"seasoned", "season"},
+		{"lymphangioplasty", "lymphangioplasti"},
+		{"lamenting", "lament"},
+		{"nor", "nor"},
+		{"feast", "feast"},
+		{"gesture", "gestur"},
+		{"firmly", "firmli"},
+		{"italy", "itali"},
+		{"pleurodynia", "pleurodynia"},
+		{"rupia", "rupia"},
+		{"newspapers", "newspap"},
+		{"until", "until"},
+		{"mistakes", "mistak"},
+		{"warm", "warm"},
+		{"assuming", "assum"},
+		{"niemen", "niemen"},
+		{"bazaar", "bazaar"},
+		{"root", "root"},
+		{"blessed", "bless"},
+		{"oder", "oder"},
+		{"arcola", "arcola"},
+		{"spurs", "spur"},
+		{"sufferer", "suffer"},
+		{"unoccupied", "unoccupi"},
+		{"tibial", "tibial"},
+		{"joining", "join"},
+		{"attributes", "attribut"},
+		{"verdict", "verdict"},
+		{"calcium", "calcium"},
+		{"moral", "moral"},
+		{"chaos", "chao"},
+		{"intoxicated", "intox"},
+		{"speeches", "speech"},
+		{"violating", "violat"},
+		{"position", "posit"},
+		{"frequent", "frequent"},
+		{"enough", "enough"},
+		{"weak", "weak"},
+		{"node", "node"},
+		{"schoss", "schoss"},
+		{"ham", "ham"},
+		{"skylight", "skylight"},
+		{"freemason", "freemason"},
+		{"repeatedly", "repeatedli"},
+		{"vesicles", "vesicl"},
+		{"verbal", "verbal"},
+		{"plainly", "plainli"},
+		{"alterations", "alter"},
+		{"cavalrymen", "cavalrymen"},
+		{"groom", "groom"},
+		{"theatrical", "theatric"},
+		{"rameau", "rameau"},
+		{"clause", "claus"},
+		{"shortsighted", "shortsight"},
+		{"stricken", "stricken"},
+		{"shopkeepers", "shopkeep"},
+		{"expanded", "expand"},
+		{"points", "point"},
+		{"oleate", "oleat"},
+		{"holdings", "hold"},
+		{"lauriston", "lauriston"},
+		{"hiss", "hiss"},
+		{"feelings", "feel"},
+		{"reckoning", "reckon"},
+		{"frontier", "frontier"},
+		{"wander", "wander"},
+		{"reasonably", "reason"},
+		{"conversion", "convers"},
+		{"ukase", "ukas"},
+		{"pitch", "pitch"},
+		{"devise", "devis"},
+		{"plasma", "plasma"},
+		{"grape", "grape"},
+		{"letters", "letter"},
+		{"grey", "grei"},
+		{"rely", "reli"},
+		{"hostilities", "hostil"},
+		{"wiping", "wipe"},
+		{"pustules", "pustul"},
+		{"set", "set"},
+		{"palms", "palm"},
+		{"market", "market"},
+		{"reconsidered", "reconsid"},
+		{"impair", "impair"},
+		{"wrested", "wrest"},
+		{"done", "done"},
+		{"conscience", "conscienc"},
+		{"fright", "fright"},
+		{"renouncing", "renounc"},
+		{"rows", "row"},
+		{"ekonomov", "ekonomov"},
+		{"oppressed", "oppress"},
+		{"coagulum", "coagulum"},
+		{"fibrinous", "fibrin"},
+		{"purplish", "purplish"},
+		{"exceptional", "except"},
+		{"influx", "influx"},
+		{"excursions", "excurs"},
+		{"hips", "hip"},
+		{"convicts", "convict"},
+		{"runs", "run"},
+		{"dispirited", "dispirit"},
+		{"whilst", "whilst"},
+		{"embraced", "embrac"},
+		{"dieu", "dieu"},
+		{"siege", "sieg"},
+		{"motor", "motor"},
+		{"etiology", "etiolog"},
+		{"lets", "let"},
+		{"intention", "intent"},
+		{"unbuttoned", "unbutton"},
+		{"commonplace", "commonplac"},
+		{"mostly", "mostli"},
+		{"kitchen", "kitchen"},
+		{"hood", "hood"},
+		{"honestly", "honestli"},
+		{"amendments", "amend"},
+		{"doings", "do"},
+		{"knight", "knight"},
+		{"perch", "perch"},
+		{"myxo", "myxo"},
+		{"expansile", "expansil"},
+		{"rivalry", "rivalri"},
+		{"madison", "madison"},
+		{"state", "state"},
+		{"perished", "perish"},
+		{"libraries", "librari"},
+		{"semicircle", "semicircl"},
+		{"kaluga", "kaluga"},
+		{"harrison", "harrison"},
+		{"warned", "warn"},
+		{"fifth", "fifth"},
+		{"disagreements", "disagr"},
+		{"publishers", "publish"},
+		{"torzhok", "torzhok"},
+		{"surrender", "surrend"},
+		{"murders", "murder"},
+		{"combs", "comb"},
+		{"census", "censu"},
+		{"upland", "upland"},
+		{"intervention", "intervent"},
+		{"ashburton", "ashburton"},
+		{"produce", "produc"},
+		{"pistols", "pistol"},
+		{"injection", "inject"},
+		{"hatty", "hatti"},
+		{"rode", "rode"},
+		{"hypertrophy", "hypertrophi"},
+		{"latest", "latest"},
+		{"pioneering", "pioneer"},
+		{"disconnected", "disconnect"},
+		{"alive", "aliv"},
+		{"affectionate", "affection"},
+		{"outpost", "outpost"},
+		{"essentially", "essenti"},
+		{"statecraft", "statecraft"},
+		{"entanglements", "entangl"},
+		{"novocain", "novocain"},
+		{"investment", "invest"},
+		{"draw", "draw"},
+		{"combating", "combat"},
+		{"livelihood", "livelihood"},
+		{"fee", "fee"},
+		{"softer", "softer"},
+		{"bump", "bump"},
+		{"inaugurated", "inaugur"},
+		{"seaboard", "seaboard"},
+		{"dissented", "dissent"},
+		{"fragment", "fragment"},
+		{"fifty", "fifti"},
+		{"overturned", "overturn"},
+		{"pas", "pa"},
+		{"rhythmic", "rhythmic"},
+		{"yielding", "yield"},
+		{"ribs", "rib"},
+		{"kent", "kent"},
+		{"clutches", "clutch"},
+		{"suffocation", "suffoc"},
+		{"this", "thi"},
+		{"renting", "rent"},
+		{"alongside", "alongsid"},
+		{"travels", "travel"},
+		{"convinced", "convinc"},
+		{"bared", "bare"},
+		{"angio", "angio"},
+		{"total", "total"},
+		{"villous", "villou"},
+		{"suture", "sutur"},
+		{"malo", "malo"},
+		{"early", "earli"},
+		{"keratitis", "kerat"},
+		{"hauling", "haul"},
+		{"dispute", "disput"},
+		{"ways", "wai"},
+		{"relapses", "relaps"},
+		{"extensive", "extens"},
+		{"draft", "draft"},
+		{"tinel", "tinel"},
+		{"occupies", "occupi"},
+		{"comfortably", "comfort"},
+		{"steppe", "stepp"},
+		{"amuse", "amus"},
+		{"adviser", "advis"},
+		{"burnwell", "burnwel"},
+		{"twice", "twice"},
+		{"reclamation", "reclam"},
+		{"memorials", "memori"},
+		{"fling", "fling"},
+		{"liability", "liabil"},
+		{"waves", "wave"},
+		{"hugged", "hug"},
+		{"emptied", "empti"},
+		{"fatally", "fatal"},
+		{"agonising", "agonis"},
+		{"perfumed", "perfum"},
+		{"bland", "bland"},
+		{"neighbours", "neighbour"},
+		{"intemperance", "intemper"},
+		{"unity", "uniti"},
+		{"fierce", "fierc"},
+		{"elements", "element"},
+		{"nicholas", "nichola"},
+		{"branded", "brand"},
+		{"withstand", "withstand"},
+		{"prove", "prove"},
+		{"conservative", "conserv"},
+		{"alternative", "altern"},
+		{"host", "host"},
+		{"sausage", "sausag"},
+		{"prefer", "prefer"},
+		{"zakhar", "zakhar"},
+		{"widespread", "widespread"},
+		{"believe", "believ"},
+		{"resided", "resid"},
+		{"blessing", "bless"},
+		{"voluntary", "voluntari"},
+		{"drama", "drama"},
+		{"violet", "violet"},
+		{"adult", "adult"},
+		{"tall", "tall"},
+		{"uhlans", "uhlan"},
+		{"menacing", "menac"},
+		{"wagram", "wagram"},
+		{"bright", "bright"},
+		{"unvexed", "unvex"},
+		{"nashville", "nashvil"},
+		{"year", "year"},
+		{"eats", "eat"},
+		{"circumstance", "circumst"},
+		{"whipped", "whip"},
+		{"pathetic", "pathet"},
+		{"townshend", "townshend"},
+		{"handful", "hand"},
+		{"succeeding", "succeed"},
+		{"senile", "senil"},
+		{"discordant", "discord"},
+		{"monsieur", "monsieur"},
+		{"shrink", "shrink"},
+		{"one", "on"},
+		{"cloak", "cloak"},
+		{"constituting", "constitut"},
+		{"barque", "barqu"},
+		{"frightened", "frighten"},
+		{"conscientious", "conscienti"},
+		{"poem", "poem"},
+		{"catastrophe", "catastroph"},
+		{"criticism", "critic"},
+		{"licensing", "licens"},
+		{"enterprise", "enterpris"},
+		{"apparently", "appar"},
+		{"ruins", "ruin"},
+		{"quill", "quill"},
+		{"oneself", "oneself"},
+		{"chilly", "chilli"},
+		{"walk", "walk"},
+		{"vote", "vote"},
+		{"neatly", "neatli"},
+		{"genealogical", "genealog"},
+		{"album", "album"},
+		{"require", "requir"},
+		{"uncompromising", "uncompromis"},
+		{"quorum", "quorum"},
+		{"promoting", "promot"},
+		{"ichthyol", "ichthyol"},
+		{"factory", "factori"},
+		{"emergence", "emerg"},
+		{"jostled", "jostl"},
+		{"festering", "fester"},
+		{"sovereignty", "sovereignti"},
+		{"ferments", "ferment"},
+		{"hinges", "hing"},
+		{"maneuver", "maneuv"},
+		{"works", "work"},
+		{"whitewashed", "whitewash"},
+		{"church", "church"},
+		{"venesection", "venesect"},
+		{"magistrate", "magistr"},
+		{"finds", "find"},
+		{"shoulder", "shoulder"},
+		{"argument", "argument"},
+		{"commissariat", "commissariat"},
+		{"handsome", "handsom"},
+		{"tested", "test"},
+		{"yelled", "yell"},
+		{"oaths", "oath"},
+		{"second", "second"},
+		{"literature", "literatur"},
+		{"erb", "erb"},
+		{"dollars", "dollar"},
+		{"lathe", "lath"},
+		{"laborers", "labor"},
+		{"joke", "joke"},
+		{"providing", "provid"},
+		{"chadwick", "chadwick"},
+		{"moves", "move"},
+		{"cheese", "chees"},
+		{"slice", "slice"},
+		{"assured", "assur"},
+		{"sending", "send"},
+		{"creaked", "creak"},
+		{"dryly", "dryli"},
+		{"reviewing", "review"},
+		{"trifling", "trifl"},
+		{"leaflets", "leaflet"},
+		{"calcaneus", "calcaneu"},
+		{"bay", "bai"},
+		{"acrid", "acrid"},
+		{"advocates", "advoc"},
+		{"splashing", "splash"},
+		{"convulsive", "convuls"},
+		{"pens", "pen"},
+		{"adventure", "adventur"},
+		{"major", "major"},
+		{"morose", "moros"},
+		{"elections", "elect"},
+		{"referring", "refer"},
+		{"paulucci", "paulucci"},
+		{"stating", "state"},
+		{"tubercle", "tubercl"},
+		{"rachitis", "rachiti"},
+		{"balalayka", "balalayka"},
+		{"remounts", "remount"},
+		{"schmidt", "schmidt"},
+		{"wight", "wight"},
+		{"ideal", "ideal"},
+		{"lata", "lata"},
+		{"mexico", "mexico"},
+		{"innervation", "innerv"},
+		{"license", "licens"},
+		{"comparatively", "compar"},
+		{"terminate", "termin"},
+		{"grants", "grant"},
+		{"smashed", "smash"},
+		{"teachers", "teacher"},
+		{"accomplishment", "accomplish"},
+		{"fencing", "fenc"},
+		{"minimum", "minimum"},
+		{"issued", "issu"},
+		{"eyeball", "eyebal"},
+		{"republicanism", "republican"},
+		{"sensations", "sensat"},
+		{"ballroom", "ballroom"},
+		{"cauterised", "cauteris"},
+		{"lays", "lai"},
+		{"gunner", "gunner"},
+		{"vomiting", "vomit"},
+		{"spurring", "spur"},
+		{"barren", "barren"},
+		{"prerogatives", "prerog"},
+		{"disadvantage", "disadvantag"},
+		{"smell", "smell"},
+		{"tugendbund", "tugendbund"},
+		{"advancement", "advanc"},
+		{"travelers", "travel"},
+		{"ensued", "ensu"},
+		{"angler", "angler"},
+		{"property", "properti"},
+		{"ease", "eas"},
+		{"deigned", "deign"},
+		{"ulnaris", "ulnari"},
+		{"gas", "ga"},
+		{"essence", "essenc"},
+		{"tackle", "tackl"},
+		{"degrees", "degre"},
+		{"interosseous", "inteross"},
+		{"lacked", "lack"},
+		{"guise", "guis"},
+		{"flushing", "flush"},
+		{"savelich", "savelich"},
+		{"vehement", "vehement"},
+		{"story", "stori"},
+		{"chafed", "chafe"},
+		{"eliminating", "elimin"},
+		{"coagulates", "coagul"},
+		{"cocaine", "cocain"},
+		{"municipality", "municip"},
+		{"northward", "northward"},
+		{"soldier", "soldier"},
+		{"confederacy", "confederaci"},
+		{"daughters", "daughter"},
+		{"obtainable", "obtain"},
+		{"tail", "tail"},
+		{"chaffing", "chaf"},
+		{"breach", "breach"},
+		{"epaulets", "epaulet"},
+		{"tenotomy", "tenotomi"},
+		{"smooth", "smooth"},
+		{"scrap", "scrap"},
+		{"forgiving", "forgiv"},
+		{"hopes", "hope"},
+		{"wyoming", "wyom"},
+		{"contour", "contour"},
+		{"denied", "deni"},
+		{"mysterious", "mysteri"},
+		{"harrow", "harrow"},
+		{"shameful", "shame"},
+		{"typewriter", "typewrit"},
+		{"that", "that"},
+		{"persists", "persist"},
+		{"lighted", "light"},
+		{"protrude", "protrud"},
+		{"eventually", "eventu"},
+		{"devils", "devil"},
+		{"first", "first"},
+		{"snowy", "snowi"},
+		{"circumstantial", "circumstanti"},
+		{"forgiveness", "forgiv"},
+		{"information", "inform"},
+		{"element", "element"},
+		{"harvesting", "harvest"},
+		{"controlled", "control"},
+		{"volkmann", "volkmann"},
+		{"villains", "villain"},
+		{"lurking", "lurk"},
+		{"punitive", "punit"},
+		{"clothing", "cloth"},
+		{"ensue", "ensu"},
+		{"elect", "elect"},
+		{"tinge", "ting"},
+		{"junction", "junction"},
+		{"lower", "lower"},
+		{"hissed", "hiss"},
+		{"exaggeration", "exagger"},
+		{"duel", "duel"},
+		{"texas", "texa"},
+		{"sparrow", "sparrow"},
+		{"spoiled", "spoil"},
+		{"infancy", "infanc"},
+		{"worship", "worship"},
+		{"enlarge", "enlarg"},
+		{"struck", "struck"},
+		{"battery", "batteri"},
+		{"clerks", "clerk"},
+		{"ride", "ride"},
+		{"fibromatosis", "fibromatosi"},
+		{"guarding", "guard"},
+		{"clay", "clai"},
+		{"stars", "star"},
+		{"crack", "crack"},
+		{"use", "us"},
+		{"indication", "indic"},
+		{"peritoneal", "periton"},
+		{"tattooing", "tattoo"},
+		{"blaine", "blain"},
+		{"convened", "conven"},
+		{"tightened", "tighten"},
+		{"adrenalin", "adrenalin"},
+		{"blush", "blush"},
+		{"kaiser", "kaiser"},
+		{"hearted", "heart"},
+		{"separate", "separ"},
+		{"republican", "republican"},
+		{"struggled", "struggl"},
+		{"spies", "spi"},
+		{"receded", "reced"},
+		{"degraded", "degrad"},
+		{"aponeurosis", "aponeurosi"},
+		{"generous", "gener"},
+		{"pew", "pew"},
+		{"instrument", "instrument"},
+		{"accepted", "accept"},
+		{"aptitude", "aptitud"},
+		{"vileness", "vile"},
+		{"ossea", "ossea"},
+		{"covered", "cover"},
+		{"plantar", "plantar"},
+		{"jobert", "jobert"},
+		{"shove", "shove"},
+		{"absent", "absent"},
+		{"strangers", "stranger"},
+		{"threw", "threw"},
+		{"diligently", "dilig"},
+		{"naevoid", "naevoid"},
+		{"anthony", "anthoni"},
+		{"cricket", "cricket"},
+		{"smith", "smith"},
+		{"sensitiveness", "sensit"},
+		{"largely", "larg"},
+		{"induction", "induct"},
+		{"wurttemberg", "wurttemberg"},
+		{"entreat", "entreat"},
+		{"escapes", "escap"},
+		{"frequency", "frequenc"},
+		{"lying", "ly"},
+		{"implies", "impli"},
+		{"axillary", "axillari"},
+		{"blessedness", "blessed"},
+		{"purposely", "purpos"},
+		{"louisiana", "louisiana"},
+		{"continental", "continent"},
+		{"apraksina", "apraksina"},
+		{"jewel", "jewel"},
+		{"headquarters", "headquart"},
+		{"provides", "provid"},
+		{"pyogenic", "pyogen"},
+		{"kutaysov", "kutaysov"},
+		{"gems", "gem"},
+		{"vascularity", "vascular"},
+		{"side", "side"},
+		{"feverishly", "feverishli"},
+		{"instituted", "institut"},
+		{"inaudibly", "inaud"},
+		{"connection", "connect"},
+		{"sublime", "sublim"},
+		{"violence", "violenc"},
+		{"scenes", "scene"},
+		{"grasping", "grasp"},
+		{"fascia", "fascia"},
+		{"oxen", "oxen"},
+		{"ilio", "ilio"},
+		{"experiments", "experi"},
+		{"sat", "sat"},
+		{"lining", "line"},
+		{"stubbornly", "stubbornli"},
+		{"nikolaevich", "nikolaevich"},
+		{"pond", "pond"},
+		{"pollicis", "pollici"},
+		{"spread", "spread"},
+		{"consultations", "consult"},
+		{"contrasts", "contrast"},
+		{"became", "becam"},
+		{"occlusion", "occlus"},
+		{"filaments", "filament"},
+		{"bounties", "bounti"},
+		{"legs", "leg"},
+		{"amnesty", "amnesti"},
+		{"unpleasant", "unpleas"},
+		{"founded", "found"},
+		{"triangle", "triangl"},
+		{"seal", "seal"},
+		{"fetched", "fetch"},
+		{"favorably", "favor"},
+		{"assimilation", "assimil"},
+		{"lestrade", "lestrad"},
+		{"purchasing", "purchas"},
+		{"harmony", "harmoni"},
+		{"unconsciousness", "unconsci"},
+		{"anyone", "anyon"},
+		{"laughed", "laugh"},
+		{"penza", "penza"},
+		{"difficult", "difficult"},
+		{"drying", "dry"},
+		{"document", "document"},
+		{"undermine", "undermin"},
+		{"ice", "ic"},
+		{"factions", "faction"},
+		{"oldest", "oldest"},
+		{"lazily", "lazili"},
+		{"blasphemous", "blasphem"},
+		{"editors", "editor"},
+		{"instructing", "instruct"},
+		{"resisted", "resist"},
+		{"objects", "object"},
+		{"undone", "undon"},
+		{"glare", "glare"},
+		{"effectively", "effect"},
+		{"assertion", "assert"},
+		{"decisively", "decis"},
+		{"expedition", "expedit"},
+		{"prisoner", "prison"},
+		{"abnormally", "abnorm"},
+		{"attenuated", "attenu"},
+		{"diploe", "diplo"},
+		{"irritant", "irrit"},
+		{"reserves", "reserv"},
+		{"replies", "repli"},
+		{"introduction", "introduct"},
+		{"moonshine", "moonshin"},
+		{"painfully", "painfulli"},
+		{"conflicts", "conflict"},
+		{"resolve", "resolv"},
+		{"outlines", "outlin"},
+		{"operated", "oper"},
+		{"endothelioma", "endothelioma"},
+		{"dorsiflexed", "dorsiflex"},
+		{"scar", "scar"},
+		{"methylated", "methyl"},
+		{"stepmother", "stepmoth"},
+		{"wrenched", "wrench"},
+		{"sighing", "sigh"},
+		{"pains", "pain"},
+		{"adherents", "adher"},
+		{"pooh", "pooh"},
+		{"dexterous", "dexter"},
+		{"patronizing", "patron"},
+		{"screwing", "screw"},
+		{"protective", "protect"},
+		{"pursing", "purs"},
+		{"pioneer", "pioneer"},
+		{"number", "number"},
+		{"term", "term"},
+		{"stool", "stool"},
+		{"suppurative", "suppur"},
+		{"neutrals", "neutral"},
+		{"kuragins", "kuragin"},
+		{"probably", "probabl"},
+		{"waters", "water"},
+		{"century", "centuri"},
+		{"declaration", "declar"},
+		{"havoc", "havoc"},
+		{"thrice", "thrice"},
+		{"makes", "make"},
+		{"disagreement", "disagr"},
+		{"federation", "feder"},
+		{"god", "god"},
+		{"anarchists", "anarchist"},
+		{"disfigured", "disfigur"},
+		{"peronskaya", "peronskaya"},
+		{"concur", "concur"},
+		{"approximately", "approxim"},
+		{"drift", "drift"},
+		{"indulgence", "indulg"},
+		{"incidents", "incid"},
+		{"fetch", "fetch"},
+		{"job", "job"},
+		{"sordid", "sordid"},
+		{"stomach", "stomach"},
+		{"bases", "base"},
+		{"lady", "ladi"},
+		{"domination", "domin"},
+		{"reductions", "reduct"},
+		{"carlton", "carlton"},
+		{"rattled", "rattl"},
+		{"magnates", "magnat"},
+		{"addressing", "address"},
+		{"proverb", "proverb"},
+		{"sundial", "sundial"},
+		{"bodily", "bodili"},
+		{"connections", "connect"},
+		{"thoracic", "thorac"},
+		{"tables", "tabl"},
+		{"anatomical", "anatom"},
+		{"enforcement", "enforc"},
+		{"bushel", "bushel"},
+		{"lotion", "lotion"},
+		{"glandular", "glandular"},
+		{"else", "els"},
+		{"staircase", "staircas"},
+		{"explosions", "explos"},
+		{"slippers", "slipper"},
+		{"election", "elect"},
+		{"scorched", "scorch"},
+		{"masters", "master"},
+		{"confirms", "confirm"},
+		{"wavy", "wavi"},
+		{"broke", "broke"},
+		{"shrines", "shrine"},
+		{"bonapartist", "bonapartist"},
+		{"unchanged", "unchang"},
+		{"oppose", "oppos"},
+		{"contused", "contus"},
+		{"restrict", "restrict"},
+		{"tonsil", "tonsil"},
+		{"wearing", "wear"},
+		{"moods", "mood"},
+		{"concurrence", "concurr"},
+		{"weekly", "weekli"},
+		{"mustered", "muster"},
+		{"assistants", "assist"},
+		{"excellency", "excel"},
+		{"copy", "copi"},
+		{"scaly", "scali"},
+		{"season", "season"},
+		{"instinctively", "instinct"},
+		{"laborer", "labor"},
+		{"provide", "provid"},
+		{"negro", "negro"},
+		{"respond", "respond"},
+		{"fracture", "fractur"},
+		{"safer", "safer"},
+		{"territories", "territori"},
+		{"inverted", "invert"},
+		{"honorable", "honor"},
+		{"mustn", "mustn"},
+		{"recognising", "recognis"},
+		{"hen", "hen"},
+		{"cracking", "crack"},
+		{"greatly", "greatli"},
+		{"evenly", "evenli"},
+		{"hopeless", "hopeless"},
+		{"immobilisation", "immobilis"},
+		{"russia", "russia"},
+		{"hang", "hang"},
+		{"laxity", "laxiti"},
+		{"meningitis", "mening"},
+		{"beds", "bed"},
+		{"graduated", "graduat"},
+		{"acknowledging", "acknowledg"},
+		{"play", "plai"},
+		{"camden", "camden"},
+		{"wearisome", "wearisom"},
+		{"converge", "converg"},
+		{"obliged", "oblig"},
+		{"objectionable", "objection"},
+		{"fathom", "fathom"},
+		{"thing", "thing"},
+		{"peace", "peac"},
+		{"wrinkle", "wrinkl"},
+		{"dining", "dine"},
+		{"chartered", "charter"},
+		{"disclosed", "disclos"},
+		{"unsound", "unsound"},
+		{"minutely", "minut"},
+		{"nodded", "nod"},
+		{"wits", "wit"},
+		{"stolen", "stolen"},
+		{"elapse", "elaps"},
+		{"potent", "potent"},
+		{"matrix", "matrix"},
+		{"decent", "decent"},
+		{"hastening", "hasten"},
+		{"screw", "screw"},
+		{"onlookers", "onlook"},
+		{"carbonate", "carbon"},
+		{"commissionaire", "commissionair"},
+		{"frowning", "frown"},
+		{"seconds", "second"},
+		{"whistling", "whistl"},
+		{"endocarditis", "endocard"},
+		{"inflamed", "inflam"},
+		{"disgraced", "disgrac"},
+		{"once", "onc"},
+		{"engrossed", "engross"},
+		{"pot", "pot"},
+		{"months", "month"},
+		{"assumption", "assumpt"},
+		{"manuscripts", "manuscript"},
+		{"ironically", "iron"},
+		{"short", "short"},
+		{"makers", "maker"},
+		{"enjoyable", "enjoy"},
+		{"cordial", "cordial"},
+		{"forget", "forget"},
+		{"possessor", "possessor"},
+		{"brimmed", "brim"},
+		{"five", "five"},
+		{"arriving", "arriv"},
+		{"health", "health"},
+		{"lesson", "lesson"},
+		{"priceless", "priceless"},
+		{"nowhere", "nowher"},
+		{"foreseen", "foreseen"},
+		{"generously", "gener"},
+		{"paralyzed", "paralyz"},
+		{"dispatch", "dispatch"},
+		{"earnestly", "earnestli"},
+		{"frenchy", "frenchi"},
+		{"proclaimed", "proclaim"},
+		{"girls", "girl"},
+		{"poisons", "poison"},
+		{"curls", "curl"},
+		{"gowns", "gown"},
+		{"autumnal", "autumn"},
+		{"vertically", "vertic"},
+		{"suffrage", "suffrag"},
+		{"punctures", "punctur"},
+		{"vicksburg", "vicksburg"},
+		{"sites", "site"},
+		{"buff", "buff"},
+		{"last", "last"},
+		{"compliment", "compliment"},
+		{"moisture", "moistur"},
+		{"falls", "fall"},
+		{"potomac", "potomac"},
+		{"attending", "attend"},
+		{"yourself", "yourself"},
+		{"simultaneously", "simultan"},
+		{"agony", "agoni"},
+		{"plate", "plate"},
+		{"gypsy", "gypsi"},
+		{"columbia", "columbia"},
+		{"grew", "grew"},
+		{"spiteful", "spite"},
+		{"ringing", "ring"},
+		{"prime", "prime"},
+		{"proceeded", "proceed"},
+		{"mozhaysk", "mozhaysk"},
+		{"board", "board"},
+		{"longed", "long"},
+		{"sarcoma", "sarcoma"},
+		{"sand", "sand"},
+		{"primary", "primari"},
+		{"defended", "defend"},
+		{"bargain", "bargain"},
+		{"olmutz", "olmutz"},
+		{"pocket", "pocket"},
+		{"weariness", "weari"},
+		{"consisted", "consist"},
+		{"comprehend", "comprehend"},
+		{"windibank", "windibank"},
+		{"beasts", "beast"},
+		{"collapse", "collaps"},
+		{"tribunal", "tribun"},
+		{"expound", "expound"},
+		{"irresistible", "irresist"},
+		{"boss", "boss"},
+		{"sioux", "sioux"},
+		{"guiding", "guid"},
+		{"seems", "seem"},
+		{"greasy", "greasi"},
+		{"abundance", "abund"},
+		{"ashes", "ash"},
+		{"pulse", "puls"},
+		{"violation", "violat"},
+		{"voir", "voir"},
+		{"authorized", "author"},
+		{"noon", "noon"},
+		{"hurry", "hurri"},
+		{"bolkhovitinov", "bolkhovitinov"},
+		{"prisons", "prison"},
+		{"ether", "ether"},
+		{"exhaustion", "exhaust"},
+		{"denisov", "denisov"},
+		{"ruined", "ruin"},
+		{"walled", "wall"},
+		{"dingley", "dinglei"},
+		{"secrecy", "secreci"},
+		{"sided", "side"},
+		{"prank", "prank"},
+		{"occur", "occur"},
+		{"chords", "chord"},
+		{"third", "third"},
+		{"resume", "resum"},
+		{"cent", "cent"},
+		{"sacrificing", "sacrif"},
+		{"liberals", "liber"},
+		{"mankind", "mankind"},
+		{"coming", "come"},
+		{"flogging", "flog"},
+		{"uproar", "uproar"},
+		{"hiccough", "hiccough"},
+		{"explained", "explain"},
+		{"sequelae", "sequela"},
+		{"nodular", "nodular"},
+		{"asepsis", "asepsi"},
+		{"well", "well"},
+		{"mccarthy", "mccarthi"},
+		{"fort", "fort"},
+		{"hooker", "hooker"},
+		{"ships", "ship"},
+		{"acne", "acn"},
+		{"february", "februari"},
+		{"tincture", "tinctur"},
+		{"happening", "happen"},
+		{"hero", "hero"},
+		{"ossification", "ossif"},
+		{"varying", "vari"},
+		{"geometry", "geometri"},
+		{"baths", "bath"},
+		{"refer", "refer"},
+		{"tolstoy", "tolstoi"},
+		{"portugal", "portug"},
+		{"barely", "bare"},
+		{"broader", "broader"},
+		{"anger", "anger"},
+		{"bast", "bast"},
+		{"consequently", "consequ"},
+		{"assert", "assert"},
+		{"requirements", "requir"},
+		{"syringomyelia", "syringomyelia"},
+		{"sampson", "sampson"},
+		{"sordes", "sord"},
+		{"ranged", "rang"},
+		{"tower", "tower"},
+		{"madero", "madero"},
+		{"eagle", "eagl"},
+		{"exasperation", "exasper"},
+		{"bald", "bald"},
+		{"hullo", "hullo"},
+		{"desired", "desir"},
+		{"infects", "infect"},
+		{"helplessly", "helplessli"},
+		{"seriousness", "serious"},
+		{"figure", "figur"},
+		{"spontaneous", "spontan"},
+		{"flashed", "flash"},
+		{"rare", "rare"},
+		{"gland", "gland"},
+		{"justifications", "justif"},
+		{"elastic", "elast"},
+		{"published", "publish"},
+		{"thus", "thu"},
+		{"pay", "pai"},
+		{"urethritis", "urethr"},
+		{"lascar", "lascar"},
+		{"nominee", "nomine"},
+		{"censured", "censur"},
+		{"cabbage", "cabbag"},
+		{"allowing", "allow"},
+		{"feared", "fear"},
+		{"comprehensible", "comprehens"},
+		{"turks", "turk"},
+		{"terminates", "termin"},
+		{"guides", "guid"},
+		{"procure", "procur"},
+		{"customers", "custom"},
+		{"explains", "explain"},
+		{"elegantly", "elegantli"},
+		{"indulgent", "indulg"},
+		{"ame", "am"},
+		{"enabled", "enabl"},
+		{"removal", "remov"},
+		{"stamps", "stamp"},
+		{"underclothing", "undercloth"},
+		{"humiliation", "humili"},
+		{"horrified", "horrifi"},
+		{"irritated", "irrit"},
+		{"solicitude", "solicitud"},
+		{"marauding", "maraud"},
+		{"fortified", "fortifi"},
+		{"humane", "human"},
+		{"pinckney", "pincknei"},
+		{"blocks", "block"},
+		{"mack", "mack"},
+		{"partial", "partial"},
+		{"dispersed", "dispers"},
+		{"negotiate", "negoti"},
+		{"fruitful", "fruit"},
+		{"mitka", "mitka"},
+		{"cooking", "cook"},
+		{"countryside", "countrysid"},
+		{"untreated", "untreat"},
+		{"gervais", "gervai"},
+		{"articles", "articl"},
+		{"kindness", "kind"},
+		{"cold", "cold"},
+		{"comment", "comment"},
+		{"brunn", "brunn"},
+		{"sweep", "sweep"},
+		{"baize", "baiz"},
+		{"thousands", "thousand"},
+		{"squatter", "squatter"},
+		{"mitya", "mitya"},
+		{"woolen", "woolen"},
+		{"festive", "festiv"},
+		{"attempt", "attempt"},
+		{"infiltration", "infiltr"},
+		{"bweak", "bweak"},
+		{"processes", "process"},
+		{"nevertheless", "nevertheless"},
+		{"predilection", "predilect"},
+		{"incisive", "incis"},
+		{"flapping", "flap"},
+		{"gifts", "gift"},
+		{"populous", "popul"},
+		{"scenery", "sceneri"},
+		{"plunged", "plung"},
+		{"tidied", "tidi"},
+		{"unction", "unction"},
+		{"antitoxin", "antitoxin"},
+		{"denounce", "denounc"},
+		{"hooked", "hook"},
+		{"peculiarity", "peculiar"},
+		{"explicitly", "explicitli"},
+		{"widows", "widow"},
+		{"overflowing", "overflow"},
+		{"primarily", "primarili"},
+		{"renewed", "renew"},
+		{"unfortunate", "unfortun"},
+		{"photography", "photographi"},
+		{"fungate", "fungat"},
+		{"map", "map"},
+		{"complimented", "compliment"},
+		{"orphans", "orphan"},
+		{"wines", "wine"},
+		{"strand", "strand"},
+		{"sorely", "sore"},
+		{"won", "won"},
+		{"sensory", "sensori"},
+		{"eternally", "etern"},
+		{"whirl", "whirl"},
+		{"congestion", "congest"},
+		{"sinews", "sinew"},
+		{"gratitude", "gratitud"},
+		{"desperation", "desper"},
+		{"observes", "observ"},
+		{"klumpke", "klumpk"},
+		{"accrue", "accru"},
+		{"caps", "cap"},
+		{"kutuzov", "kutuzov"},
+		{"virulent", "virul"},
+		{"placing", "place"},
+		{"prostate", "prostat"},
+		{"hofkriegsrath", "hofkriegsrath"},
+		{"dimmler", "dimmler"},
+		{"mantilla", "mantilla"},
+		{"muscles", "muscl"},
+		{"septicaemia", "septicaemia"},
+		{"polonaise", "polonais"},
+		{"haematoma", "haematoma"},
+		{"strains", "strain"},
+		{"shako", "shako"},
+		{"competition", "competit"},
+		{"pear", "pear"},
+		{"thinned", "thin"},
+		{"adenoids", "adenoid"},
+		{"potato", "potato"},
+		{"sores", "sore"},
+		{"kansas", "kansa"},
+		{"valve", "valv"},
+		{"hayne", "hayn"},
+		{"mandible", "mandibl"},
+		{"bands", "band"},
+		{"raisins", "raisin"},
+		{"oregon", "oregon"},
+		{"painted", "paint"},
+		{"joy", "joi"},
+		{"scrutinizing", "scrutin"},
+		{"riots", "riot"},
+		{"pioneers", "pioneer"},
+		{"tug", "tug"},
+		{"talent", "talent"},
+		{"girl", "girl"},
+		{"mamma", "mamma"},
+		{"tangled", "tangl"},
+		{"polled", "poll"},
+		{"thrombi", "thrombi"},
+		{"idaho", "idaho"},
+		{"simple", "simpl"},
+		{"diminish", "diminish"},
+		{"defence", "defenc"},
+		{"chestnut", "chestnut"},
+		{"loses", "lose"},
+		{"millar", "millar"},
+		{"lexington", "lexington"},
+		{"collects", "collect"},
+		{"votes", "vote"},
+		{"personal", "person"},
+		{"scattered", "scatter"},
+		{"articular", "articular"},
+		{"lumbago", "lumbago"},
+		{"shout", "shout"},
+		{"discomfiture", "discomfitur"},
+		{"containing", "contain"},
+		{"accompanied", "accompani"},
+		{"aprons", "apron"},
+		{"books", "book"},
+		{"fair", "fair"},
+		{"bulbous", "bulbou"},
+		{"concurrently", "concurr"},
+		{"redeemed", "redeem"},
+		{"tout", "tout"},
+		{"used", "us"},
+		{"neighborhood", "neighborhood"},
+		{"prussians", "prussian"},
+		{"evidence", "evid"},
+		{"fuss", "fuss"},
+		{"dedicated", "dedic"},
+		{"clammy", "clammi"},
+		{"customs", "custom"},
+		{"segment", "segment"},
+		{"expected", "expect"},
+		{"whittier", "whittier"},
+		{"grangers", "granger"},
+		{"lotions", "lotion"},
+		{"exchanging", "exchang"},
+		{"resistant", "resist"},
+		{"performing", "perform"},
+		{"recreation", "recreat"},
+		{"sides", "side"},
+		{"infected", "infect"},
+		{"playing", "plai"},
+		{"persecution", "persecut"},
+		{"glance", "glanc"},
+		{"chord", "chord"},
+		{"presently", "present"},
+		{"history", "histori"},
+		{"aggrieved", "aggriev"},
+		{"round", "round"},
+		{"judge", "judg"},
+		{"security", "secur"},
+		{"regenerate", "regener"},
+		{"negotiated", "negoti"},
+		{"vaguely", "vagu"},
+		{"ligated", "ligat"},
+		{"spark", "spark"},
+		{"country", "countri"},
+		{"immunity", "immun"},
+		{"tuft", "tuft"},
+		{"notable", "notabl"},
+		{"measures", "measur"},
+		{"adjusted", "adjust"},
+		{"brigade", "brigad"},
+		{"phillips", "phillip"},
+		{"damages", "damag"},
+		{"adventitious", "adventiti"},
+		{"recounting", "recount"},
+		{"exact", "exact"},
+		{"pillow", "pillow"},
+		{"soldiers", "soldier"},
+		{"financing", "financ"},
+		{"outspread", "outspread"},
+		{"millard", "millard"},
+		{"deeper", "deeper"},
+		{"probe", "probe"},
+		{"our", "our"},
+		{"necessarily", "necessarili"},
+		{"just", "just"},
+		{"clerical", "cleric"},
+		{"diet", "diet"},
+		{"contemporaries", "contemporari"},
+		{"sheep", "sheep"},
+		{"thierry", "thierri"},
+		{"ulcers", "ulcer"},
+		{"degenerated", "degener"},
+		{"sinister", "sinist"},
+		{"succeed", "succe"},
+		{"displacement", "displac"},
+		{"these", "these"},
+		{"rousseau", "rousseau"},
+		{"fallen", "fallen"},
+		{"masonic", "mason"},
+		{"quantities", "quantiti"},
+		{"roses", "rose"},
+		{"incomplete", "incomplet"},
+		{"balaga", "balaga"},
+		{"lied", "li"},
+		{"lessons", "lesson"},
+		{"mortem", "mortem"},
+		{"zest", "zest"},
+		{"thousandth", "thousandth"},
+		{"constitutionally", "constitution"},
+		{"paralysis", "paralysi"},
+		{"personification", "personif"},
+		{"smile", "smile"},
+		{"obstacle", "obstacl"},
+		{"fortunate", "fortun"},
+		{"swim", "swim"},
+		{"ruining", "ruin"},
+		{"stasis", "stasi"},
+		{"wearily", "wearili"},
+		{"sanguinary", "sanguinari"},
+		{"jacobins", "jacobin"},
+		{"maison", "maison"},
+		{"have", "have"},
+		{"boldness", "bold"},
+		{"englishman", "englishman"},
+		{"savage", "savag"},
+		{"screams", "scream"},
+		{"owning", "own"},
+		{"levers", "lever"},
+		{"tottenham", "tottenham"},
+		{"income", "incom"},
+		{"prevailing", "prevail"},
+		{"saved", "save"},
+		{"ecstatically", "ecstat"},
+		{"exceed", "exce"},
+		{"blushing", "blush"},
+		{"swamp", "swamp"},
+		{"boiled", "boil"},
+		{"une", "un"},
+		{"imagined", "imagin"},
+		{"filipinos", "filipino"},
+		{"unequal", "unequ"},
+		{"peril", "peril"},
+		{"successor", "successor"},
+		{"happier", "happier"},
+		{"uncalled", "uncal"},
+		{"flung", "flung"},
+		{"farmer", "farmer"},
+		{"multiplied", "multipli"},
+		{"laws", "law"},
+		{"prophecy", "propheci"},
+		{"acquaintance", "acquaint"},
+		{"appointment", "appoint"},
+		{"local", "local"},
+		{"active", "activ"},
+		{"rarefied", "rarefi"},
+		{"coffin", "coffin"},
+		{"billon", "billon"},
+		{"ban", "ban"},
+		{"uprisings", "upris"},
+		{"covenant", "coven"},
+		{"candlestick", "candlestick"},
+		{"organ", "organ"},
+		{"whig", "whig"},
+		{"ordnance", "ordnanc"},
+		{"complete", "complet"},
+		{"blameworthy", "blameworthi"},
+		{"restoring", "restor"},
+		{"shy", "shy"},
+		{"subjected", "subject"},
+		{"raise", "rais"},
+		{"detect", "detect"},
+		{"shades", "shade"},
+		{"crushed", "crush"},
+		{"travelled", "travel"},
+		{"conversations", "convers"},
+		{"taxation", "taxat"},
+		{"hanging", "hang"},
+		{"reprehensible", "reprehens"},
+		{"inconvenience", "inconveni"},
+		{"inoculation", "inocul"},
+		{"also", "also"},
+		{"unabashed", "unabash"},
+		{"scandinavia", "scandinavia"},
+		{"dependencies", "depend"},
+		{"recognition", "recognit"},
+		{"andrusha", "andrusha"},
+		{"boulevard", "boulevard"},
+		{"venosum", "venosum"},
+		{"forthcoming", "forthcom"},
+		{"beggars", "beggar"},
+		{"pseudo", "pseudo"},
+		{"quartered", "quarter"},
+		{"foi", "foi"},
+		{"talks", "talk"},
+		{"gibbon", "gibbon"},
+		{"cortical", "cortic"},
+		{"halo", "halo"},
+		{"hearts", "heart"},
+		{"revolver", "revolv"},
+		{"speckled", "speckl"},
+		{"drag", "drag"},
+		{"paris", "pari"},
+		{"washing", "wash"},
+		{"suspicious", "suspici"},
+		{"twist", "twist"},
+		{"fleches", "flech"},
+		{"quivered", "quiver"},
+		{"countess", "countess"},
+		{"jokes", "joke"},
+		{"heretofore", "heretofor"},
+		{"confessed", "confess"},
+		{"noted", "note"},
+		{"starvation", "starvat"},
+		{"powerless", "powerless"},
+		{"trifles", "trifl"},
+		{"demand", "demand"},
+		{"kilburn", "kilburn"},
+		{"haze", "haze"},
+		{"zoology", "zoologi"},
+		{"concave", "concav"},
+		{"gluteus", "gluteu"},
+		{"militiamen", "militiamen"},
+		{"opportunity", "opportun"},
+		{"restraints", "restraint"},
+		{"sadness", "sad"},
+		{"grabern", "grabern"},
+		{"epilepsy", "epilepsi"},
+		{"accomplished", "accomplish"},
+		{"surveying", "survei"},
+		{"condescend", "condescend"},
+		{"unthinkable", "unthink"},
+		{"rise", "rise"},
+		{"exempt", "exempt"},
+		{"legislature", "legislatur"},
+		{"overshadowed", "overshadow"},
+		{"antipathy", "antipathi"},
+		{"clashed", "clash"},
+		{"formidable", "formid"},
+		{"couch", "couch"},
+		{"nostrils", "nostril"},
+		{"appeared", "appear"},
+		{"legged", "leg"},
+		{"helene", "helen"},
+		{"testing", "test"},
+		{"whigs", "whig"},
+		{"submucous", "submuc"},
+		{"null", "null"},
+		{"basin", "basin"},
+		{"chosen", "chosen"},
+		{"elapsed", "elaps"},
+		{"culminating", "culmin"},
+		{"wilmot", "wilmot"},
+		{"moscou", "moscou"},
+		{"toxins", "toxin"},
+		{"consider", "consid"},
+		{"ordinary", "ordinari"},
+		{"serums", "serum"},
+		{"threats", "threat"},
+		{"presents", "present"},
+		{"wooded", "wood"},
+		{"condition", "condit"},
+		{"rampart", "rampart"},
+		{"lungs", "lung"},
+		{"arbitration", "arbitr"},
+		{"ounces", "ounc"},
+		{"preposterous", "preposter"},
+		{"portrait", "portrait"},
+		{"designed", "design"},
+		{"respectfully", "respectfulli"},
+		{"tarsal", "tarsal"},
+		{"cession", "cession"},
+		{"mediation", "mediat"},
+		{"perforations", "perfor"},
+		{"ooze", "ooz"},
+		{"right", "right"},
+		{"dishes", "dish"},
+		{"priests", "priest"},
+		{"chest", "chest"},
+		{"concern", "concern"},
+		{"varicose", "varicos"},
+		{"nourishing", "nourish"},
+		{"converted", "convert"},
+		{"frosts", "frost"},
+		{"valuable", "valuabl"},
+		{"sleeves", "sleev"},
+		{"private", "privat"},
+		{"ischaemic", "ischaem"},
+		{"earned", "earn"},
+		{"dans", "dan"},
+		{"stingy", "stingi"},
+		{"blistering", "blister"},
+		{"trades", "trade"},
+		{"amazement", "amaz"},
+		{"practiced", "practic"},
+		{"moderate", "moder"},
+		{"paces", "pace"},
+		{"should", "should"},
+		{"salle", "sall"},
+		{"grenadier", "grenadi"},
+		{"testimony", "testimoni"},
+		{"obligation", "oblig"},
+		{"ministers", "minist"},
+		{"sunday", "sundai"},
+		{"noblest", "noblest"},
+		{"exclusion", "exclus"},
+		{"forgetfulness", "forget"},
+		{"valor", "valor"},
+		{"enclosure", "enclosur"},
+		{"gouverneur", "gouverneur"},
+		{"blushed", "blush"},
+		{"fangs", "fang"},
+		{"polygamy", "polygami"},
+		{"recognised", "recognis"},
+		{"chit", "chit"},
+		{"farmers", "farmer"},
+		{"secretory", "secretori"},
+		{"impregnated", "impregn"},
+		{"transformed", "transform"},
+		{"campstool", "campstool"},
+		{"nevada", "nevada"},
+		{"cavity", "caviti"},
+		{"lit", "lit"},
+		{"fainting", "faint"},
+		{"richmond", "richmond"},
+		{"notion", "notion"},
+		{"instead", "instead"},
+		{"offer", "offer"},
+		{"others", "other"},
+		{"sentenced", "sentenc"},
+		{"instructor", "instructor"},
+		{"orbital", "orbit"},
+		{"annoyed", "annoi"},
+		{"faut", "faut"},
+		{"outside", "outsid"},
+		{"captive", "captiv"},
+		{"correcting", "correct"},
+		{"whipcord", "whipcord"},
+		{"curtis", "curti"},
+		{"broadened", "broaden"},
+		{"diseased", "diseas"},
+		{"couples", "coupl"},
+		{"leash", "leash"},
+		{"stop", "stop"},
+		{"favoring", "favor"},
+		{"irksome", "irksom"},
+		{"gang", "gang"},
+		{"praised", "prais"},
+		{"tightness", "tight"},
+		{"mycetoma", "mycetoma"},
+		{"deliberate", "deliber"},
+		{"wetched", "wetch"},
+		{"steamboat", "steamboat"},
+		{"anus", "anu"},
+		{"unfair", "unfair"},
+		{"wardrop", "wardrop"},
+		{"piles", "pile"},
+		{"tacit", "tacit"},
+		{"strategy", "strategi"},
+		{"model", "model"},
+		{"diversified", "diversifi"},
+		{"scratched", "scratch"},
+		{"meanwhile", "meanwhil"},
+		{"trickled", "trickl"},
+		{"capture", "captur"},
+		{"dioxide", "dioxid"},
+		{"wobbewy", "wobbewi"},
+		{"nap", "nap"},
+		{"temperate", "temper"},
+		{"eventualities", "eventu"},
+		{"bruise", "bruis"},
+		{"excellence", "excel"},
+		{"snub", "snub"},
+		{"bitten", "bitten"},
+		{"spirit", "spirit"},
+		{"resignation", "resign"},
+		{"overpowered", "overpow"},
+		{"caressing", "caress"},
+		{"can", "can"},
+		{"circulatory", "circulatori"},
+		{"planting", "plant"},
+		{"folks", "folk"},
+		{"external", "extern"},
+		{"abolishing", "abolish"},
+		{"validity", "valid"},
+		{"anyway", "anywai"},
+		{"bee", "bee"},
+		{"disaster", "disast"},
+		{"hydatids", "hydatid"},
+		{"milliamperes", "milliamper"},
+		{"absurdly", "absurdli"},
+		{"settling", "settl"},
+		{"primaries", "primari"},
+		{"remain", "remain"},
+		{"rubbish", "rubbish"},
+		{"gazing", "gaze"},
+		{"diam", "diam"},
+		{"discovered", "discov"},
+		{"katie", "kati"},
+		{"dozed", "doze"},
+		{"anywhere", "anywher"},
+		{"peroxide", "peroxid"},
+		{"panting", "pant"},
+		{"reread", "reread"},
+		{"tip", "tip"},
+		{"associate", "associ"},
+		{"bassett", "bassett"},
+		{"survival", "surviv"},
+		{"convoy", "convoi"},
+		{"thursday", "thursdai"},
+		{"shutter", "shutter"},
+		{"crate", "crate"},
+		{"assailed", "assail"},
+		{"reservations", "reserv"},
+		{"closer", "closer"},
+		{"looked", "look"},
+		{"sequel", "sequel"},
+		{"fanned", "fan"},
+		{"drunkenness", "drunken"},
+		{"speck", "speck"},
+		{"amputated", "amput"},
+		{"lavra", "lavra"},
+		{"joins", "join"},
+		{"faradic", "farad"},
+		{"porous", "porou"},
+		{"lymphangiectasis", "lymphangiectasi"},
+		{"victories", "victori"},
+		{"derision", "deris"},
+		{"stiffened", "stiffen"},
+		{"periods", "period"},
+		{"furnish", "furnish"},
+		{"foresee", "forese"},
+		{"scraping", "scrape"},
+		{"charmed", "charm"},
+		{"rosy", "rosi"},
+		{"abducted", "abduct"},
+		{"extraordinary", "extraordinari"},
+		{"degree", "degre"},
+		{"opening", "open"},
+		{"chloroform", "chloroform"},
+		{"transverse", "transvers"},
+		{"reopening", "reopen"},
+		{"ape", "ap"},
+		{"passion", "passion"},
+		{"sokolniki", "sokolniki"},
+		{"occasioned", "occas"},
+		{"haworth", "haworth"},
+		{"four", "four"},
+		{"uncertain", "uncertain"},
+		{"adhesive", "adhes"},
+		{"enlargements", "enlarg"},
+		{"donning", "don"},
+		{"muddled", "muddl"},
+		{"involvement", "involv"},
+		{"decently", "decent"},
+		{"hazel", "hazel"},
+		{"partners", "partner"},
+		{"source", "sourc"},
+		{"incorporated", "incorpor"},
+		{"glittering", "glitter"},
+		{"lagging", "lag"},
+		{"williams", "william"},
+		{"shovel", "shovel"},
+		{"organization", "organ"},
+		{"eylau", "eylau"},
+		{"costal", "costal"},
+		{"conducting", "conduct"},
+		{"telegram", "telegram"},
+		{"clambered", "clamber"},
+		{"constitute", "constitut"},
+		{"bombs", "bomb"},
+		{"confident", "confid"},
+		{"inheritances", "inherit"},
+		{"conquered", "conquer"},
+		{"aureus", "aureu"},
+		{"pulsation", "pulsat"},
+		{"care", "care"},
+		{"satellites", "satellit"},
+		{"merciful", "merci"},
+		{"characterise", "characteris"},
+		{"fifths", "fifth"},
+		{"electrolysis", "electrolysi"},
+		{"prostrate", "prostrat"},
+		{"vogue", "vogu"},
+		{"conclusive", "conclus"},
+		{"agriculture", "agricultur"},
+		{"sustain", "sustain"},
+		{"neuron", "neuron"},
+		{"florida", "florida"},
+		{"statehood", "statehood"},
+		{"los", "lo"},
+		{"indebtedness", "indebted"},
+		{"twelfth", "twelfth"},
+		{"chekmar", "chekmar"},
+		{"merrimac", "merrimac"},
+		{"futile", "futil"},
+		{"enmity", "enmiti"},
+		{"speakers", "speaker"},
+		{"mistress", "mistress"},
+		{"compared", "compar"},
+		{"harp", "harp"},
+		{"mere", "mere"},
+		{"blows", "blow"},
+		{"insult", "insult"},
+		{"surrounding", "surround"},
+		{"culprit", "culprit"},
+		{"nudged", "nudg"},
+		{"extremities", "extrem"},
+		{"study", "studi"},
+		{"furlough", "furlough"},
+		{"cares", "care"},
+		{"reed", "reed"},
+		{"pulley", "pullei"},
+		{"apparent", "appar"},
+		{"rostov", "rostov"},
+		{"tents", "tent"},
+		{"unentrenched", "unentrench"},
+		{"insolent", "insol"},
+		{"disgraceful", "disgrac"},
+		{"scratch", "scratch"},
+		{"advocating", "advoc"},
+		{"mown", "mown"},
+		{"rum", "rum"},
+		{"arduous", "arduou"},
+		{"sea", "sea"},
+		{"adder", "adder"},
+		{"winthrop", "winthrop"},
+		{"boasted", "boast"},
+		{"demonstrating", "demonstr"},
+		{"unharnessed", "unhar"},
+		{"noble", "nobl"},
+		{"drove", "drove"},
+		{"recognizes", "recogn"},
+		{"companion", "companion"},
+		{"fedorovna", "fedorovna"},
+		{"twins", "twin"},
+		{"sanitary", "sanitari"},
+		{"race", "race"},
+		{"demanding", "demand"},
+		{"unpleasantness", "unpleas"},
+		{"invitation", "invit"},
+		{"suffers", "suffer"},
+		{"vast", "vast"},
+		{"melanin", "melanin"},
+		{"meatus", "meatu"},
+		{"leucocythaemia", "leucocythaemia"},
+		{"biscuit", "biscuit"},
+		{"whereby", "wherebi"},
+		{"fare", "fare"},
+		{"sterilised", "sterilis"},
+		{"bathe", "bath"},
+		{"hers", "her"},
+		{"unlike", "unlik"},
+		{"regulating", "regul"},
+		{"mingled", "mingl"},
+		{"statue", "statu"},
+		{"ironical", "iron"},
+		{"destroying", "destroi"},
+		{"select", "select"},
+		{"rings", "ring"},
+		{"warming", "warm"},
+		{"step", "step"},
+		{"judicial", "judici"},
+		{"perpetrated", "perpetr"},
+		{"reaching", "reach"},
+		{"small", "small"},
+		{"questioning", "question"},
+		{"saints", "saint"},
+		{"heaving", "heav"},
+		{"shot", "shot"},
+		{"exquisite", "exquisit"},
+		{"tremors", "tremor"},
+		{"keen", "keen"},
+		{"knyazkovo", "knyazkovo"},
+		{"humbug", "humbug"},
+		{"questions", "question"},
+		{"styled", "style"},
+		{"whitlow", "whitlow"},
+		{"transportation", "transport"},
+		{"imposed", "impos"},
+		{"carved", "carv"},
+		{"fell", "fell"},
+		{"protestant", "protest"},
+		{"doughy", "doughi"},
+		{"inability", "inabl"},
+		{"awaits", "await"},
+		{"tons", "ton"},
+		{"trusted", "trust"},
+		{"improved", "improv"},
+		{"labors", "labor"},
+		{"family", "famili"},
+		{"carting", "cart"},
+		{"slim", "slim"},
+		{"pillows", "pillow"},
+		{"uppermost", "uppermost"},
+		{"appellate", "appel"},
+		{"hugh", "hugh"},
+		{"iodine", "iodin"},
+		{"accession", "access"},
+		{"late", "late"},
+		{"irritants", "irrit"},
+		{"reproaches", "reproach"},
+		{"usurper", "usurp"},
+		{"alabama", "alabama"},
+		{"spaulding", "spauld"},
+		{"jesus", "jesu"},
+		{"corporations", "corpor"},
+		{"related", "relat"},
+		{"fainted", "faint"},
+		{"shame", "shame"},
+		{"gracefully", "gracefulli"},
+		{"lucy", "luci"},
+		{"adore", "ador"},
+		{"silently", "silent"},
+		{"indefinitely", "indefinit"},
+		{"disguised", "disguis"},
+		{"shrank", "shrank"},
+		{"sink", "sink"},
+		{"arrival", "arriv"},
+		{"bolkonski", "bolkonski"},
+		{"tutelage", "tutelag"},
+		{"groaned", "groan"},
+		{"detained", "detain"},
+		{"elicited", "elicit"},
+		{"snuffbox", "snuffbox"},
+		{"neutralising", "neutralis"},
+		{"clip", "clip"},
+		{"blossom", "blossom"},
+		{"ramify", "ramifi"},
+		{"duc", "duc"},
+		{"polish", "polish"},
+		{"yielded", "yield"},
+		{"reputation", "reput"},
+		{"mutual", "mutual"},
+		{"fall", "fall"},
+		{"packet", "packet"},
+		{"mathematics", "mathemat"},
+		{"interpreted", "interpret"},
+		{"penalized", "penal"},
+		{"self", "self"},
+		{"slay", "slai"},
+		{"dites", "dite"},
+		{"agree", "agre"},
+		{"boxer", "boxer"},
+		{"divorce", "divorc"},
+		{"years", "year"},
+		{"socialism", "social"},
+		{"bekleshev", "bekleshev"},
+		{"impatient", "impati"},
+		{"sipping", "sip"},
+		{"offspring", "offspr"},
+		{"marya", "marya"},
+		{"martineau", "martineau"},
+		{"landlady", "landladi"},
+		{"innate", "innat"},
+		{"massachusetts", "massachusett"},
+		{"fibrosis", "fibrosi"},
+		{"elders", "elder"},
+		{"mess", "mess"},
+		{"clash", "clash"},
+		{"forbidding", "forbid"},
+		{"condescending", "condescend"},
+		{"greetings", "greet"},
+		{"tray", "trai"},
+		{"evenings", "even"},
+		{"blunder", "blunder"},
+		{"tonnage", "tonnag"},
+		{"forgo", "forgo"},
+		{"favourable", "favour"},
+		{"lastly", "lastli"},
+		{"cheery", "cheeri"},
+		{"medullary", "medullari"},
+		{"steam", "steam"},
+		{"deputation", "deput"},
+		{"iodides", "iodid"},
+		{"mood", "mood"},
+		{"trunks", "trunk"},
+		{"knoll", "knoll"},
+		{"powerful", "power"},
+		{"employers", "employ"},
+		{"impossibility", "imposs"},
+		{"dissected", "dissect"},
+		{"districts", "district"},
+		{"slope", "slope"},
+		{"fwom", "fwom"},
+		{"exudes", "exud"},
+		{"mirrorlike", "mirrorlik"},
+		{"yauza", "yauza"},
+		{"anaesthesia", "anaesthesia"},
+		{"epiphysitis", "epiphys"},
+		{"expenses", "expens"},
+		{"hounds", "hound"},
+		{"softened", "soften"},
+		{"certainly", "certainli"},
+		{"shaped", "shape"},
+		{"find", "find"},
+		{"patriotic", "patriot"},
+		{"warring", "war"},
+		{"tickling", "tickl"},
+		{"boone", "boon"},
+		{"hunter", "hunter"},
+		{"lost", "lost"},
+		{"immigrant", "immigr"},
+		{"belonging", "belong"},
+		{"public", "public"},
+		{"guiana", "guiana"},
+		{"shut", "shut"},
+		{"professed", "profess"},
+		{"democrats", "democrat"},
+		{"wisdom", "wisdom"},
+		{"oklahoma", "oklahoma"},
+		{"slender", "slender"},
+		{"gentle", "gentl"},
+		{"undoubtedly", "undoubtedli"},
+		{"caries", "cari"},
+		{"voice", "voic"},
+		{"clapped", "clap"},
+		{"undressing", "undress"},
+		{"actual", "actual"},
+		{"fourteen", "fourteen"},
+		{"half", "half"},
+		{"becher", "becher"},
+		{"fan", "fan"},
+		{"weaknesses", "weak"},
+		{"sait", "sait"},
+		{"voluntarily", "voluntarili"},
+		{"crupper", "crupper"},
+		{"proclamations", "proclam"},
+		{"buttoning", "button"},
+		{"seeking", "seek"},
+		{"strove", "strove"},
+		{"import", "import"},
+		{"taxes", "tax"},
+		{"demonstration", "demonstr"},
+		{"straight", "straight"},
+		{"jostling", "jostl"},
+		{"residual", "residu"},
+		{"histological", "histolog"},
+		{"estimated", "estim"},
+		{"presence", "presenc"},
+		{"conferences", "confer"},
+		{"christmas", "christma"},
+		{"flourished", "flourish"},
+		{"squarely", "squar"},
+		{"submitted", "submit"},
+		{"neuro", "neuro"},
+		{"operations", "oper"},
+		{"startling", "startl"},
+		{"increased", "increas"},
+		{"intolerable", "intoler"},
+		{"detroit", "detroit"},
+		{"counties", "counti"},
+		{"corps", "corp"},
+		{"which", "which"},
+		{"sternly", "sternli"},
+		{"screen", "screen"},
+		{"affect", "affect"},
+		{"canopy", "canopi"},
+		{"whereas", "wherea"},
+		{"shevardino", "shevardino"},
+		{"bed", "bed"},
+		{"ferocious", "feroci"},
+		{"depth", "depth"},
+		{"tophi", "tophi"},
+		{"boarded", "board"},
+		{"provided", "provid"},
+		{"rockefeller", "rockefel"},
+		{"yelling", "yell"},
+		{"dentigerous", "dentiger"},
+		{"status", "statu"},
+		{"observer", "observ"},
+		{"boorishness", "boorish"},
+		{"clamored", "clamor"},
+		{"preserved", "preserv"},
+		{"fusiform", "fusiform"},
+		{"bargaining", "bargain"},
+		{"clipped", "clip"},
+		{"weights", "weight"},
+		{"dispatching", "dispatch"},
+		{"guilty", "guilti"},
+		{"opera", "opera"},
+		{"guidance", "guidanc"},
+		{"sinewy", "sinewi"},
+		{"heberden", "heberden"},
+		{"boat", "boat"},
+		{"hurled", "hurl"},
+		{"disappoint", "disappoint"},
+		{"presented", "present"},
+		{"siberia", "siberia"},
+		{"squeeze", "squeez"},
+		{"killed", "kill"},
+		{"marble", "marbl"},
+		{"excuses", "excus"},
+		{"curiosity", "curios"},
+		{"extremes", "extrem"},
+		{"obolenski", "obolenski"},
+		{"meals", "meal"},
+		{"kinds", "kind"},
+		{"dewy", "dewi"},
+		{"sub", "sub"},
+		{"separation", "separ"},
+		{"procession", "process"},
+		{"fools", "fool"},
+		{"expelled", "expel"},
+		{"age", "ag"},
+		{"ford", "ford"},
+		{"auditor", "auditor"},
+		{"grenadiers", "grenadi"},
+		{"prisoners", "prison"},
+		{"helpful", "help"},
+		{"slipping", "slip"},
+		{"digest", "digest"},
+		{"flowers", "flower"},
+		{"servants", "servant"},
+		{"buying", "bui"},
+		{"considerate", "consider"},
+		{"interrupted", "interrupt"},
+		{"motives", "motiv"},
+		{"earnest", "earnest"},
+		{"driving", "drive"},
+		{"illiteracy", "illiteraci"},
+		{"lunch", "lunch"},
+		{"broken", "broken"},
+		{"pedunculated", "peduncul"},
+		{"attempts", "attempt"},
+		{"entirety", "entireti"},
+		{"representatives", "repres"},
+		{"exceeded", "exceed"},
+		{"strife", "strife"},
+		{"needle", "needl"},
+		{"flesh", "flesh"},
+		{"voila", "voila"},
+		{"either", "either"},
+		{"wrath", "wrath"},
+		{"zeal", "zeal"},
+		{"skillful", "skill"},
+		{"combatants", "combat"},
+		{"possibilities", "possibl"},
+		{"scraped", "scrape"},
+		{"extravasated", "extravas"},
+		{"hellish", "hellish"},
+		{"wiser", "wiser"},
+		{"explanation", "explan"},
+		{"cady", "cadi"},
+		{"discoverable", "discover"},
+		{"creation", "creation"},
+		{"transplanted", "transplant"},
+		{"largest", "largest"},
+		{"constraint", "constraint"},
+		{"patella", "patella"},
+		{"recur", "recur"},
+		{"nineteenth", "nineteenth"},
+		{"charitable", "charit"},
+		{"hardened", "harden"},
+		{"lowering", "lower"},
+		{"anxiety", "anxieti"},
+		{"discriminations", "discrimin"},
+		{"spas", "spa"},
+		{"callender", "callend"},
+		{"continuance", "continu"},
+		{"chain", "chain"},
+		{"wronged", "wrong"},
+		{"commissioned", "commiss"},
+		{"elected", "elect"},
+		{"diaphragm", "diaphragm"},
+		{"costing", "cost"},
+		{"dozen", "dozen"},
+		{"rarity", "rariti"},
+		{"pauvre", "pauvr"},
+		{"despite", "despit"},
+		{"scrutiny", "scrutini"},
+		{"edges", "edg"},
+		{"thyreoid", "thyreoid"},
+		{"token", "token"},
+		{"record", "record"},
+		{"membrane", "membran"},
+		{"architect", "architect"},
+		{"beset", "beset"},
+		{"passages", "passag"},
+		{"musculo", "musculo"},
+		{"fabvier", "fabvier"},
+		{"arrive", "arriv"},
+		{"trophy", "trophi"},
+		{"bipp", "bipp"},
+		{"agility", "agil"},
+		{"xxi", "xxi"},
+		{"pole", "pole"},
+		{"freedmen", "freedmen"},
+		{"put", "put"},
+		{"actinomyces", "actinomyc"},
+		{"oath", "oath"},
+		{"socialistic", "socialist"},
+		{"flared", "flare"},
+		{"oris", "ori"},
+		{"promises", "promis"},
+		{"conveniently", "conveni"},
+		{"roman", "roman"},
+		{"unsatisfactory", "unsatisfactori"},
+		{"avoid", "avoid"},
+		{"efficient", "effici"},
+		{"impressions", "impress"},
+		{"amputations", "amput"},
+		{"abide", "abid"},
+		{"marrow", "marrow"},
+		{"ices", "ic"},
+		{"tide", "tide"},
+		{"worded", "word"},
+		{"medially", "medial"},
+		{"proportion", "proport"},
+		{"showed", "show"},
+		{"spinning", "spin"},
+		{"translucent", "transluc"},
+		{"banners", "banner"},
+		{"transferred", "transfer"},
+		{"thereof", "thereof"},
+		{"perspiration", "perspir"},
+		{"floundering", "flounder"},
+		{"borzoi", "borzoi"},
+		{"full", "full"},
+		{"writer", "writer"},
+		{"patrol", "patrol"},
+		{"erfurt", "erfurt"},
+		{"shoulders", "shoulder"},
+		{"prescribing", "prescrib"},
+		{"insignificant", "insignific"},
+		{"throwing", "throw"},
+		{"washed", "wash"},
+		{"vesenny", "vesenni"},
+		{"version", "version"},
+		{"prose", "prose"},
+		{"reestablished", "reestablish"},
+		{"gigantic", "gigant"},
+		{"circulated", "circul"},
+		{"edward", "edward"},
+		{"incumbent", "incumb"},
+		{"dismissed", "dismiss"},
+		{"compound", "compound"},
+		{"stragglers", "straggler"},
+		{"seating", "seat"},
+		{"progresses", "progress"},
+		{"noguchi", "noguchi"},
+		{"assault", "assault"},
+		{"convulsively", "convuls"},
+		{"plaintive", "plaintiv"},
+		{"dispose", "dispos"},
+		{"include", "includ"},
+		{"aggression", "aggress"},
+		{"spiritual", "spiritu"},
+		{"vision", "vision"},
+		{"shan", "shan"},
+		{"stanch", "stanch"},
+		{"atheromatous", "atheromat"},
+		{"emphasis", "emphasi"},
+		{"locked", "lock"},
+		{"unimportant", "unimport"},
+		{"orel", "orel"},
+		{"terminations", "termin"},
+		{"freckled", "freckl"},
+		{"inspection", "inspect"},
+		{"lodged", "lodg"},
+		{"exclamation", "exclam"},
+		{"assistant", "assist"},
+		{"boscombe", "boscomb"},
+		{"respite", "respit"},
+		{"vile", "vile"},
+		{"mayor", "mayor"},
+		{"informing", "inform"},
+		{"america", "america"},
+		{"metastases", "metastas"},
+		{"light", "light"},
+		{"approached", "approach"},
+		{"sniffing", "snif"},
+		{"supposition", "supposit"},
+		{"sands", "sand"},
+		{"gaspee", "gaspe"},
+		{"twelve", "twelv"},
+		{"eagerness", "eager"},
+		{"madura", "madura"},
+		{"lamps", "lamp"},
+		{"condyle", "condyl"},
+		{"sunshine", "sunshin"},
+		{"cancers", "cancer"},
+		{"indubitable", "indubit"},
+		{"realm", "realm"},
+		{"gonococcal", "gonococc"},
+		{"conclusions", "conclus"},
+		{"wells", "well"},
+		{"faculties", "faculti"},
+		{"recognized", "recogn"},
+		{"requests", "request"},
+		{"disinfected", "disinfect"},
+		{"slaughter", "slaughter"},
+		{"burn", "burn"},
+		{"with", "with"},
+		{"neat", "neat"},
+		{"ignorance", "ignor"},
+		{"army", "armi"},
+		{"freedom", "freedom"},
+		{"beamed", "beam"},
+		{"hunger", "hunger"},
+		{"tremble", "trembl"},
+		{"peter", "peter"},
+		{"profuse", "profus"},
+		{"formerly", "formerli"},
+		{"pipe", "pipe"},
+		{"wretched", "wretch"},
+		{"loudest", "loudest"},
+		{"tutor", "tutor"},
+		{"musket", "musket"},
+		{"intercolonial", "intercoloni"},
+		{"ratifying", "ratifi"},
+		{"belgium", "belgium"},
+		{"anniversary", "anniversari"},
+		{"advantageous", "advantag"},
+		{"appearance", "appear"},
+		{"misfortune", "misfortun"},
+		{"resistance", "resist"},
+		{"beausset", "beausset"},
+		{"coma", "coma"},
+		{"pleased", "pleas"},
+		{"bite", "bite"},
+		{"rangers", "ranger"},
+		{"polyvalent", "polyval"},
+		{"kindly", "kindli"},
+		{"revealing", "reveal"},
+		{"enriches", "enrich"},
+		{"abominations", "abomin"},
+		{"hoarseness", "hoars"},
+		{"add", "add"},
+		{"protopathic", "protopath"},
+		{"peculiar", "peculiar"},
+		{"witnessed", "wit"},
+		{"pelvic", "pelvic"},
+		{"aspect", "aspect"},
+		{"shadows", "shadow"},
+		{"neville", "nevil"},
+		{"pulaski", "pulaski"},
+		{"remarking", "remark"},
+		{"edited", "edit"},
+		{"popular", "popular"},
+		{"tufts", "tuft"},
+		{"mounted", "mount"},
+		{"hotels", "hotel"},
+		{"blessings", "bless"},
+		{"involved", "involv"},
+		{"satisfied", "satisfi"},
+		{"stump", "stump"},
+		{"pressing", "press"},
+		{"strands", "strand"},
+		{"unfriendly", "unfriendli"},
+		{"foliage", "foliag"},
+		{"substitute", "substitut"},
+		{"meeting", "meet"},
+		{"tit", "tit"},
+		{"accuracy", "accuraci"},
+		{"miner", "miner"},
+		{"substituting", "substitut"},
+		{"reasonable", "reason"},
+		{"limits", "limit"},
+		{"cited", "cite"},
+		{"dating", "date"},
+		{"province", "provinc"},
+		{"drummer", "drummer"},
+		{"livid", "livid"},
+		{"formally", "formal"},
+		{"movable", "movabl"},
+		{"scapular", "scapular"},
+		{"playful", "play"},
+		{"toast", "toast"},
+		{"justice", "justic"},
+		{"track", "track"},
+		{"slammed", "slam"},
+		{"attacking", "attack"},
+		{"physiology", "physiolog"},
+		{"trimmed", "trim"},
+		{"exclusive", "exclus"},
+		{"craniotabes", "craniotab"},
+		{"filaria", "filaria"},
+		{"conditions", "condit"},
+		{"poultice", "poultic"},
+		{"phenomenon", "phenomenon"},
+		{"atoms", "atom"},
+		{"ensures", "ensur"},
+		{"lisping", "lisp"},
+		{"chancre", "chancr"},
+		{"delicate", "delic"},
+		{"interests", "interest"},
+		{"tent", "tent"},
+		{"reactions", "reaction"},
+		{"orient", "orient"},
+		{"systems", "system"},
+		{"duchenne", "duchenn"},
+		{"angiomas", "angioma"},
+		{"resisting", "resist"},
+		{"does", "doe"},
+		{"respective", "respect"},
+		{"young", "young"},
+		{"immersion", "immers"},
+		{"extremely", "extrem"},
+		{"comparing", "compar"},
+		{"unarmed", "unarm"},
+		{"warning", "warn"},
+		{"tsars", "tsar"},
+		{"dumped", "dump"},
+		{"ocean", "ocean"},
+		{"wounds", "wound"},
+		{"laid", "laid"},
+		{"originated", "origin"},
+		{"wanted", "want"},
+		{"mary", "mari"},
+		{"frontal", "frontal"},
+		{"leader", "leader"},
+		{"dewey", "dewei"},
+		{"ambrine", "ambrin"},
+		{"caldrons", "caldron"},
+		{"appointments", "appoint"},
+		{"producing", "produc"},
+		{"unfavorable", "unfavor"},
+		{"mastoid", "mastoid"},
+		{"flush", "flush"},
+		{"expectations", "expect"},
+		{"japan", "japan"},
+		{"easiest", "easiest"},
+		{"solution", "solut"},
+		{"graciously", "gracious"},
+		{"three", "three"},
+		{"southeast", "southeast"},
+		{"calculation", "calcul"},
+		{"april", "april"},
+		{"hoofs", "hoof"},
+		{"zenger", "zenger"},
+		{"population", "popul"},
+		{"divided", "divid"},
+		{"dug", "dug"},
+		{"puff", "puff"},
+		{"observers", "observ"},
+		{"disliked", "dislik"},
+		{"manufacture", "manufactur"},
+		{"ancients", "ancient"},
+		{"expression", "express"},
+		{"skirmishers", "skirmish"},
+		{"assumes", "assum"},
+		{"ranging", "rang"},
+		{"bogucharovo", "bogucharovo"},
+		{"amiably", "amiabl"},
+		{"valves", "valv"},
+		{"synovial", "synovi"},
+		{"stately", "state"},
+		{"accessible", "access"},
+		{"repay", "repai"},
+		{"invasion", "invas"},
+		{"veranda", "veranda"},
+		{"advantageously", "advantag"},
+		{"rushes", "rush"},
+		{"variously", "various"},
+		{"resonance", "reson"},
+		{"hint", "hint"},
+		{"usefulness", "us"},
+		{"exaggerated", "exagger"},
+		{"astounded", "astound"},
+		{"cattle", "cattl"},
+		{"elevator", "elev"},
+		{"novelty", "novelti"},
+		{"prussian", "prussian"},
+		{"artisans", "artisan"},
+		{"pleura", "pleura"},
+		{"disinfection", "disinfect"},
+		{"feminine", "feminin"},
+		{"click", "click"},
+		{"eroded", "erod"},
+		{"looks", "look"},
+		{"disturbance", "disturb"},
+		{"contemptible", "contempt"},
+		{"devotedly", "devotedli"},
+		{"klyucharev", "klyucharev"},
+		{"reviendra", "reviendra"},
+		{"coaptation", "coaptat"},
+		{"decide", "decid"},
+		{"imbecile", "imbecil"},
+		{"icons", "icon"},
+		{"dokhturov", "dokhturov"},
+		{"hostility", "hostil"},
+		{"zat", "zat"},
+		{"differing", "differ"},
+		{"outlays", "outlai"},
+		{"fate", "fate"},
+		{"involve", "involv"},
+		{"chairs", "chair"},
+		{"augmented", "augment"},
+		{"readers", "reader"},
+		{"bang", "bang"},
+		{"lover", "lover"},
+		{"appreciation", "appreci"},
+		{"morbid", "morbid"},
+		{"situated", "situat"},
+		{"prevent", "prevent"},
+		{"proliferates", "prolifer"},
+		{"knows", "know"},
+		{"cheerfully", "cheerfulli"},
+		{"outlook", "outlook"},
+		{"phimosis", "phimosi"},
+		{"frola", "frola"},
+		{"table", "tabl"},
+		{"nocturnal", "nocturn"},
+		{"constructing", "construct"},
+		{"leadership", "leadership"},
+		{"complaining", "complain"},
+		{"incidentally", "incident"},
+		{"freemasons", "freemason"},
+		{"malaria", "malaria"},
+		{"commonwealth", "commonwealth"},
+		{"calm", "calm"},
+		{"dusted", "dust"},
+		{"barley", "barlei"},
+		{"jesting", "jest"},
+		{"sprinkled", "sprinkl"},
+		{"fixation", "fixat"},
+		{"wry", "wry"},
+		{"roused", "rous"},
+		{"surrounded", "surround"},
+		{"scoliosis", "scoliosi"},
+		{"reaping", "reap"},
+		{"peculiarities", "peculiar"},
+		{"disorders", "disord"},
+		{"yankovo", "yankovo"},
+		{"poorly", "poorli"},
+		{"cooks", "cook"},
+		{"suppressing", "suppress"},
+		{"worlds", "world"},
+		{"mastering", "master"},
+		{"peeped", "peep"},
+		{"festivities", "festiv"},
+		{"proper", "proper"},
+		{"covering", "cover"},
+		{"alley", "allei"},
+		{"days", "dai"},
+		{"pulsating", "pulsat"},
+		{"sloughs", "slough"},
+		{"oval", "oval"},
+		{"principles", "principl"},
+		{"mason", "mason"},
+		{"clattering", "clatter"},
+		{"times", "time"},
+		{"prompted", "prompt"},
+		{"perfection", "perfect"},
+		{"acrimonious", "acrimoni"},
+		{"contradiction", "contradict"},
+		{"smacked", "smack"},
+		{"decorated", "decor"},
+		{"hallux", "hallux"},
+		{"stimulation", "stimul"},
+		{"student", "student"},
+		{"eighteen", "eighteen"},
+		{"spend", "spend"},
+		{"bosom", "bosom"},
+		{"mixture", "mixtur"},
+		{"affording", "afford"},
+		{"nervous", "nervou"},
+		{"stocking", "stock"},
+		{"disturbing", "disturb"},
+		{"column", "column"},
+		{"concerned", "concern"},
+		{"uncomplicated", "uncompl"},
+		{"gunshot", "gunshot"},
+		{"fanning", "fan"},
+		{"reproaching", "reproach"},
+		{"contrast", "contrast"},
+		{"landlords", "landlord"},
+		{"midwife", "midwif"},
+		{"substantial", "substanti"},
+		{"fond", "fond"},
+		{"serious", "seriou"},
+		{"casement", "casement"},
+		{"maidservants", "maidserv"},
+		{"somewhat", "somewhat"},
+		{"language", "languag"},
+		{"envoy", "envoi"},
+		{"decades", "decad"},
+		{"intangible", "intang"},
+		{"labour", "labour"},
+		{"cunningly", "cunningli"},
+		{"arakcheev", "arakcheev"},
+		{"judged", "judg"},
+		{"malady", "maladi"},
+		{"conciliatory", "conciliatori"},
+		{"required", "requir"},
+		{"positively", "posit"},
+		{"who", "who"},
+		{"sort", "sort"},
+		{"sons", "son"},
+		{"prevention", "prevent"},
+		{"cards", "card"},
+		{"glove", "glove"},
+		{"cautiously", "cautious"},
+		{"papillae", "papilla"},
+		{"lawfully", "lawfulli"},
+		{"prospectors", "prospector"},
+		{"flowed", "flow"},
+		{"heroin", "heroin"},
+		{"classified", "classifi"},
+		{"alpha", "alpha"},
+		{"puberty", "puberti"},
+		{"differences", "differ"},
+		{"deliver", "deliv"},
+		{"vestibule", "vestibul"},
+		{"smilingly", "smilingli"},
+		{"securities", "secur"},
+		{"streaks", "streak"},
+		{"word", "word"},
+		{"relentless", "relentless"},
+		{"plan", "plan"},
+		{"russians", "russian"},
+		{"directory", "directori"},
+		{"trowel", "trowel"},
+		{"drop", "drop"},
+		{"introspective", "introspect"},
+		{"pure", "pure"},
+		{"safeguarded", "safeguard"},
+		{"acknowledge", "acknowledg"},
+		{"acres", "acr"},
+		{"parasite", "parasit"},
+		{"listen", "listen"},
+		{"enchanting", "enchant"},
+		{"devilish", "devilish"},
+		{"delhi", "delhi"},
+		{"resuming", "resum"},
+		{"conventions", "convent"},
+		{"swallowing", "swallow"},
+		{"fled", "fled"},
+		{"convictions", "convict"},
+		{"casual", "casual"},
+		{"michigan", "michigan"},
+		{"pained", "pain"},
+		{"managers", "manag"},
+		{"prospect", "prospect"},
+		{"demonstrations", "demonstr"},
+		{"touch", "touch"},
+		{"pastime", "pastim"},
+		{"better", "better"},
+		{"hot", "hot"},
+		{"neighboring", "neighbor"},
+		{"mark", "mark"},
+		{"rush", "rush"},
+		{"divisions", "divis"},
+		{"xxvi", "xxvi"},
+		{"moved", "move"},
+		{"mirrors", "mirror"},
+		{"silks", "silk"},
+		{"intracranial", "intracrani"},
+		{"knapsacks", "knapsack"},
+		{"leakage", "leakag"},
+		{"misgivings", "misgiv"},
+		{"united", "unit"},
+		{"oration", "orat"},
+		{"waterproof", "waterproof"},
+		{"successfully", "successfulli"},
+		{"hectic", "hectic"},
+		{"wings", "wing"},
+		{"masses", "mass"},
+		{"moaned", "moan"},
+		{"donned", "don"},
+		{"attendance", "attend"},
+		{"swore", "swore"},
+		{"pedicle", "pedicl"},
+		{"capable", "capabl"},
+		{"questioningly", "questioningli"},
+		{"darker", "darker"},
+		{"touched", "touch"},
+		{"pectoralis", "pectorali"},
+		{"somewhere", "somewher"},
+		{"stove", "stove"},
+		{"neutralise", "neutralis"},
+		{"eyelid", "eyelid"},
+		{"variety", "varieti"},
+		{"sin", "sin"},
+		{"serpentine", "serpentin"},
+		{"nizhegorod", "nizhegorod"},
+		{"liking", "like"},
+		{"horny", "horni"},
+		{"training", "train"},
+		{"providence", "provid"},
+		{"berezina", "berezina"},
+		{"confess", "confess"},
+		{"calamity", "calam"},
+		{"transparent", "transpar"},
+		{"operating", "oper"},
+		{"necks", "neck"},
+		{"yaroslavets", "yaroslavet"},
+		{"foot", "foot"},
+		{"headed", "head"},
+		{"rarely", "rare"},
+		{"arterioles", "arteriol"},
+		{"organized", "organ"},
+		{"font", "font"},
+		{"dominated", "domin"},
+		{"anaerobes", "anaerob"},
+		{"product", "product"},
+		{"forceful", "forc"},
+		{"coburg", "coburg"},
+		{"reckon", "reckon"},
+		{"punch", "punch"},
+		{"debt", "debt"},
+		{"bacteriology", "bacteriolog"},
+		{"ball", "ball"},
+		{"cup", "cup"},
+		{"brougham", "brougham"},
+		{"tuchkov", "tuchkov"},
+		{"precise", "precis"},
+		{"opsonins", "opsonin"},
+		{"negroes", "negro"},
+		{"forestalling", "forestal"},
+		{"paraffin", "paraffin"},
+		{"receiving", "receiv"},
+		{"ponds", "pond"},
+		{"malevolent", "malevol"},
+		{"irony", "ironi"},
+		{"edifice", "edific"},
+		{"wheeling", "wheel"},
+		{"strengthening", "strengthen"},
+		{"arthroplasty", "arthroplasti"},
+		{"len", "len"},
+		{"vehemence", "vehem"},
+		{"mainly", "mainli"},
+		{"tenure", "tenur"},
+		{"attracting", "attract"},
+		{"hardships", "hardship"},
+		{"platform", "platform"},
+		{"arsenical", "arsen"},
+		{"diplomatists", "diplomatist"},
+		{"toleration", "toler"},
+		{"extensively", "extens"},
+		{"exploratory", "exploratori"},
+		{"ruin", "ruin"},
+		{"wrist", "wrist"},
+		{"pitched", "pitch"},
+		{"timidity", "timid"},
+		{"dissolve", "dissolv"},
+		{"dissent", "dissent"},
+		{"intercurrent", "intercurr"},
+		{"serene", "seren"},
+		{"sincere", "sincer"},
+		{"averse", "avers"},
+		{"speculative", "specul"},
+		{"kuragin", "kuragin"},
+		{"enabling", "enabl"},
+		{"antagonists", "antagonist"},
+		{"totaled", "total"},
+		{"layer", "layer"},
+		{"pace", "pace"},
+		{"massacre", "massacr"},
+		{"masterly", "masterli"},
+		{"talleyrand", "talleyrand"},
+		{"bursting", "burst"},
+		{"dowry", "dowri"},
+		{"fortunately", "fortun"},
+		{"transported", "transport"},
+		{"orchestra", "orchestra"},
+		{"retire", "retir"},
+		{"manufactured", "manufactur"},
+		{"cheekbones", "cheekbon"},
+		{"loafer", "loafer"},
+		{"quantity", "quantiti"},
+		{"disentangled", "disentangl"},
+		{"doorpost", "doorpost"},
+		{"dreamed", "dream"},
+		{"eliminated", "elimin"},
+		{"extricate", "extric"},
+		{"toned", "tone"},
+		{"nails", "nail"},
+		{"spectacular", "spectacular"},
+		{"lasting", "last"},
+		{"way", "wai"},
+		{"inland", "inland"},
+		{"assign", "assign"},
+		{"appomattox", "appomattox"},
+		{"serbia", "serbia"},
+		{"wharf", "wharf"},
+		{"dura", "dura"},
+		{"pence", "penc"},
+		{"guns", "gun"},
+		{"supports", "support"},
+		{"common", "common"},
+		{"nunnery", "nunneri"},
+		{"believes", "believ"},
+		{"poultices", "poultic"},
+		{"cyril", "cyril"},
+		{"princess", "princess"},
+		{"shudder", "shudder"},
+		{"enhanced", "enhanc"},
+		{"shield", "shield"},
+		{"dined", "dine"},
+		{"damned", "damn"},
+		{"weighs", "weigh"},
+		{"obstruct", "obstruct"},
+		{"councilors", "councilor"},
+		{"relit", "relit"},
+		{"overtaking", "overtak"},
+		{"shape", "shape"},
+		{"proximity", "proxim"},
+		{"wandered", "wander"},
+		{"capturing", "captur"},
+		{"behavior", "behavior"},
+		{"friedlander", "friedland"},
+		{"unsteady", "unsteadi"},
+		{"boil", "boil"},
+		{"hoarse", "hoars"},
+		{"rickety", "ricketi"},
+		{"diphtheria", "diphtheria"},
+		{"utmost", "utmost"},
+		{"harvest", "harvest"},
+		{"operator", "oper"},
+		{"shoots", "shoot"},
+		{"meuse", "meus"},
+		{"foods", "food"},
+		{"catches", "catch"},
+		{"hid", "hid"},
+		{"staff", "staff"},
+		{"borrow", "borrow"},
+		{"improves", "improv"},
+		{"sepsis", "sepsi"},
+		{"comic", "comic"},
+		{"area", "area"},
+		{"rubber", "rubber"},
+		{"wrapped", "wrap"},
+		{"osteomyelitis", "osteomyel"},
+		{"bathing", "bath"},
+		{"coronet", "coronet"},
+		{"whither", "whither"},
+		{"outspoken", "outspoken"},
+		{"arousing", "arous"},
+		{"songs", "song"},
+		{"consecutive", "consecut"},
+		{"photograph", "photograph"},
+		{"swinging", "swing"},
+		{"expensive", "expens"},
+		{"pigmentation", "pigment"},
+		{"buzz", "buzz"},
+		{"circles", "circl"},
+		{"pawnbroker", "pawnbrok"},
+		{"hardship", "hardship"},
+		{"taylor", "taylor"},
+		{"warmth", "warmth"},
+		{"lyon", "lyon"},
+		{"brightest", "brightest"},
+		{"banking", "bank"},
+		{"amity", "amiti"},
+		{"handle", "handl"},
+		{"circumstances", "circumst"},
+		{"plowed", "plow"},
+		{"overseas", "oversea"},
+		{"purchase", "purchas"},
+		{"thereby", "therebi"},
+		{"chondro", "chondro"},
+		{"tethered", "tether"},
+		{"inspire", "inspir"},
+		{"moderately", "moder"},
+		{"stomatitis", "stomat"},
+		{"moment", "moment"},
+		{"stopping", "stop"},
+		{"allegiance", "allegi"},
+		{"cigarette", "cigarett"},
+		{"fix", "fix"},
+		{"experimentally", "experiment"},
+		{"warehouses", "warehous"},
+		{"drunkard", "drunkard"},
+		{"indian", "indian"},
+		{"disdainfully", "disdainfulli"},
+		{"unnaturally", "unnatur"},
+		{"extra", "extra"},
+		{"ages", "ag"},
+		{"tatarinova", "tatarinova"},
+		{"traumatic", "traumat"},
+		{"friendships", "friendship"},
+		{"ingrowing", "ingrow"},
+		{"failed", "fail"},
+		{"amounted", "amount"},
+		{"attraction", "attract"},
+		{"milka", "milka"},
+		{"shakos", "shako"},
+		{"bloodstained", "bloodstain"},
+		{"toller", "toller"},
+		{"impunity", "impun"},
+		{"universe", "univers"},
+		{"ermolov", "ermolov"},
+		{"ingratiating", "ingrati"},
+		{"sinuses", "sinus"},
+		{"indignantly", "indignantli"},
+		{"admired", "admir"},
+		{"refuse", "refus"},
+		{"grafted", "graft"},
+		{"espoused", "espous"},
+		{"missile", "missil"},
+		{"possesses", "possess"},
+		{"czartoryski", "czartoryski"},
+		{"madman", "madman"},
+		{"omen", "omen"},
+		{"attempted", "attempt"},
+		{"sake", "sake"},
+		{"abolitionists", "abolitionist"},
+		{"morio", "morio"},
+		{"lifted", "lift"},
+		{"annapolis", "annapoli"},
+		{"darkened", "darken"},
+		{"reddening", "redden"},
+		{"vesenya", "vesenya"},
+		{"lain", "lain"},
+		{"suspicion", "suspicion"},
+		{"phrases", "phrase"},
+		{"cuba", "cuba"},
+		{"recoil", "recoil"},
+		{"biting", "bite"},
+		{"era", "era"},
+		{"wall", "wall"},
+		{"guilford", "guilford"},
+		{"gut", "gut"},
+		{"contusion", "contus"},
+		{"hillock", "hillock"},
+		{"gerard", "gerard"},
+		{"sponge", "spong"},
+		{"bird", "bird"},
+		{"billion", "billion"},
+		{"creaking", "creak"},
+		{"flaw", "flaw"},
+		{"recalling", "recal"},
+		{"impulse", "impuls"},
+		{"occluded", "occlud"},
+		{"imparted", "impart"},
+		{"slack", "slack"},
+		{"blaming", "blame"},
+		{"river", "river"},
+		{"may", "mai"},
+		{"counting", "count"},
+		{"majestically", "majest"},
+		{"dmitrievna", "dmitrievna"},
+		{"unquestionable", "unquestion"},
+		{"differentiated", "differenti"},
+		{"privates", "privat"},
+		{"announcing", "announc"},
+		{"tarsus", "tarsu"},
+		{"illustrate", "illustr"},
+		{"pon", "pon"},
+		{"stock", "stock"},
+		{"oldenburg", "oldenburg"},
+		{"rebuke", "rebuk"},
+		{"stretchers", "stretcher"},
+		{"alliance", "allianc"},
+		{"unaware", "unawar"},
+		{"virtually", "virtual"},
+		{"splendid", "splendid"},
+		{"commonwealths", "commonwealth"},
+		{"chocolate", "chocol"},
+		{"cut", "cut"},
+		{"membranous", "membran"},
+		{"baffled", "baffl"},
+		{"eyes", "ey"},
+		{"nikita", "nikita"},
+		{"prosecuted", "prosecut"},
+		{"cluster", "cluster"},
+		{"analysis", "analysi"},
+		{"educated", "educ"},
+		{"means", "mean"},
+		{"crush", "crush"},
+		{"imitating", "imit"},
+		{"authorities", "author"},
+		{"back", "back"},
+		{"behaviour", "behaviour"},
+		{"lichen", "lichen"},
+		{"kiev", "kiev"},
+		{"navigable", "navig"},
+		{"auction", "auction"},
+		{"fibroma", "fibroma"},
+		{"rarer", "rarer"},
+		{"manners", "manner"},
+		{"accordingly", "accordingli"},
+		{"miserable", "miser"},
+		{"pwince", "pwinc"},
+		{"mirth", "mirth"},
+		{"pretending", "pretend"},
+		{"buildings", "build"},
+		{"didn", "didn"},
+		{"based", "base"},
+		{"lister", "lister"},
+		{"bring", "bring"},
+		{"cook", "cook"},
+		{"dismount", "dismount"},
+		{"attentive", "attent"},
+		{"pitiable", "pitiabl"},
+		{"fences", "fenc"},
+		{"monarchy", "monarchi"},
+		{"texture", "textur"},
+		{"stagger", "stagger"},
+		{"thiersch", "thiersch"},
+		{"fractures", "fractur"},
+		{"extends", "extend"},
+		{"oak", "oak"},
+		{"aghast", "aghast"},
+		{"strain", "strain"},
+		{"sowing", "sow"},
+		{"advocated", "advoc"},
+		{"colon", "colon"},
+		{"developing", "develop"},
+		{"clerk", "clerk"},
+		{"ascended", "ascend"},
+		{"mrs", "mr"},
+		{"feudal", "feudal"},
+		{"painlessly", "painlessli"},
+		{"trap", "trap"},
+		{"savory", "savori"},
+		{"immunities", "immun"},
+		{"hygroma", "hygroma"},
+		{"abscess", "abscess"},
+		{"diffusely", "diffus"},
+		{"beast", "beast"},
+		{"palace", "palac"},
+		{"sentiments", "sentiment"},
+		{"dust", "dust"},
+		{"russian", "russian"},
+		{"balked", "balk"},
+		{"frowned", "frown"},
+		{"service", "servic"},
+		{"arsenal", "arsen"},
+		{"grateful", "grate"},
+		{"introducing", "introduc"},
+		{"innominate", "innomin"},
+		{"louisa", "louisa"},
+		{"dissecting", "dissect"},
+		{"accounted", "account"},
+		{"aortic", "aortic"},
+		{"attractions", "attract"},
+		{"jem", "jem"},
+		{"inquiring", "inquir"},
+		{"severely", "sever"},
+		{"there", "there"},
+		{"lens", "len"},
+		{"mine", "mine"},
+		{"rations", "ration"},
+		{"methods", "method"},
+		{"peopled", "peopl"},
+		{"salient", "salient"},
+		{"armpits", "armpit"},
+		{"fairbank", "fairbank"},
+		{"being", "be"},
+		{"surrendering", "surrend"},
+		{"evoking", "evok"},
+		{"creak", "creak"},
+		{"presbyterians", "presbyterian"},
+		{"door", "door"},
+		{"considerations", "consider"},
+		{"gap", "gap"},
+		{"emergencies", "emerg"},
+		{"roofs", "roof"},
+		{"shoemakers", "shoemak"},
+		{"quitrent", "quitrent"},
+		{"unnamed", "unnam"},
+		{"hindering", "hinder"},
+		{"subsides", "subsid"},
+		{"emaciation", "emaci"},
+		{"wards", "ward"},
+		{"dragging", "drag"},
+		{"laboriously", "labori"},
+		{"giant", "giant"},
+		{"pretext", "pretext"},
+		{"arm", "arm"},
+		{"stories", "stori"},
+		{"pretensions", "pretens"},
+		{"fret", "fret"},
+		{"bodied", "bodi"},
+		{"faintly", "faintli"},
+		{"princely", "princ"},
+		{"delicacy", "delicaci"},
+		{"sown", "sown"},
+		{"counteracting", "counteract"},
+		{"shipping", "ship"},
+		{"wasn", "wasn"},
+		{"divert", "divert"},
+		{"wail", "wail"},
+		{"removed", "remov"},
+		{"indistinctly", "indistinctli"},
+		{"bravery", "braveri"},
+		{"echinococcus", "echinococcu"},
+		{"alcoholic", "alcohol"},
+		{"chale", "chale"},
+		{"additions", "addit"},
+		{"hundwed", "hundw"},
+		{"modified", "modifi"},
+		{"douglas", "dougla"},
+		{"episode", "episod"},
+		{"scurvy", "scurvi"},
+		{"convoluted", "convolut"},
+		{"recovered", "recov"},
+		{"hunters", "hunter"},
+		{"particulars", "particular"},
+		{"jolted", "jolt"},
+		{"met", "met"},
+		{"numerous", "numer"},
+		{"grass", "grass"},
+		{"lists", "list"},
+		{"merriest", "merriest"},
+		{"forever", "forev"},
+		{"slough", "slough"},
+		{"streatham", "streatham"},
+		{"digital", "digit"},
+		{"representing", "repres"},
+		{"petitioned", "petit"},
+		{"real", "real"},
+		{"given", "given"},
+		{"indirectly", "indirectli"},
+		{"extremity", "extrem"},
+		{"elegant", "eleg"},
+		{"goose", "goos"},
+		{"ship", "ship"},
+		{"theorists", "theorist"},
+		{"horrible", "horribl"},
+		{"difficulties", "difficulti"},
+		{"aides", "aid"},
+		{"whistle", "whistl"},
+		{"whereof", "whereof"},
+		{"low", "low"},
+		{"grows", "grow"},
+		{"joints", "joint"},
+		{"basic", "basic"},
+		{"tenacious", "tenaci"},
+		{"mournful", "mourn"},
+		{"devote", "devot"},
+		{"lancaster", "lancast"},
+		{"otis", "oti"},
+		{"slow", "slow"},
+		{"matches", "match"},
+		{"ruptured", "ruptur"},
+		{"objection", "object"},
+		{"hem", "hem"},
+		{"bolt", "bolt"},
+		{"marie", "mari"},
+		{"binding", "bind"},
+		{"railway", "railwai"},
+		{"postilion", "postilion"},
+		{"obedient", "obedi"},
+		{"chancres", "chancr"},
+		{"russell", "russel"},
+		{"stael", "stael"},
+		{"member", "member"},
+		{"bought", "bought"},
+		{"dregs", "dreg"},
+		{"dukes", "duke"},
+		{"intractable", "intract"},
+		{"decoyed", "decoi"},
+		{"onychia", "onychia"},
+		{"tube", "tube"},
+		{"pavement", "pavement"},
+		{"exploration", "explor"},
+		{"hanged", "hang"},
+		{"bacilli", "bacilli"},
+		{"affair", "affair"},
+		{"vladimirovich", "vladimirovich"},
+		{"mews", "mew"},
+		{"wanton", "wanton"},
+		{"adolescents", "adolesc"},
+		{"bourbons", "bourbon"},
+		{"flabby", "flabbi"},
+		{"merchant", "merchant"},
+		{"relaxed", "relax"},
+		{"waxy", "waxi"},
+		{"strenuous", "strenuou"},
+		{"novosiltsev", "novosiltsev"},
+		{"fulfils", "fulfil"},
+		{"style", "style"},
+		{"lumbar", "lumbar"},
+		{"aneurysms", "aneurysm"},
+		{"revoir", "revoir"},
+		{"depends", "depend"},
+		{"rhythmically", "rhythmic"},
+		{"officers", "offic"},
+		{"corresponds", "correspond"},
+		{"willarski", "willarski"},
+		{"offend", "offend"},
+		{"drowning", "drown"},
+		{"genial", "genial"},
+		{"shaded", "shade"},
+		{"intermittent", "intermitt"},
+		{"vie", "vie"},
+		{"antonio", "antonio"},
+		{"tonsils", "tonsil"},
+		{"tenement", "tenement"},
+		{"analogies", "analog"},
+		{"thrifty", "thrifti"},
+		{"fluttered", "flutter"},
+		{"predestined", "predestin"},
+		{"rounded", "round"},
+		{"camps", "camp"},
+		{"municipal", "municip"},
+		{"advised", "advis"},
+		{"soles", "sole"},
+		{"dozing", "doze"},
+		{"partner", "partner"},
+		{"dinners", "dinner"},
+		{"broadsheets", "broadsheet"},
+		{"societies", "societi"},
+		{"bonjour", "bonjour"},
+		{"weeks", "week"},
+		{"revenues", "revenu"},
+		{"ambassador", "ambassador"},
+		{"what", "what"},
+		{"governesses", "gover"},
+		{"naturally", "natur"},
+		{"espionage", "espionag"},
+		{"would", "would"},
+		{"giddiness", "giddi"},
+		{"charcoal", "charcoal"},
+		{"seclusion", "seclus"},
+		{"knocked", "knock"},
+		{"induration", "indur"},
+		{"entrance", "entranc"},
+		{"mowing", "mow"},
+		{"duodenal", "duoden"},
+		{"approaches", "approach"},
+		{"valuables", "valuabl"},
+		{"came", "came"},
+		{"advisable", "advis"},
+		{"brigand", "brigand"},
+		{"shag", "shag"},
+		{"monkey", "monkei"},
+		{"resolutions", "resolut"},
+		{"forwarded", "forward"},
+		{"fire", "fire"},
+		{"emperors", "emperor"},
+		{"blockading", "blockad"},
+		{"kosciusko", "kosciusko"},
+		{"countenance", "counten"},
+		{"cabs", "cab"},
+		{"whatever", "whatev"},
+		{"indolently", "indol"},
+		{"simplicity", "simplic"},
+		{"parasites", "parasit"},
+		{"pillaging", "pillag"},
+		{"child", "child"},
+		{"discrimination", "discrimin"},
+		{"eliminate", "elimin"},
+		{"endothelium", "endothelium"},
+		{"nothing", "noth"},
+		{"armed", "arm"},
+		{"karl", "karl"},
+		{"costly", "costli"},
+		{"appealing", "appeal"},
+		{"balance", "balanc"},
+		{"uterus", "uteru"},
+		{"presumption", "presumpt"},
+		{"tenaciously", "tenaci"},
+		{"parrot", "parrot"},
+		{"swollen", "swollen"},
+		{"rouse", "rous"},
+		{"arid", "arid"},
+		{"hanover", "hanov"},
+		{"bandaged", "bandag"},
+		{"fungus", "fungu"},
+		{"branching", "branch"},
+		{"entire", "entir"},
+		{"cheating", "cheat"},
+		{"mamonov", "mamonov"},
+		{"their", "their"},
+		{"strayed", "strai"},
+		{"endothelial", "endotheli"},
+		{"nausea", "nausea"},
+		{"snatching", "snatch"},
+		{"allows", "allow"},
+		{"intermediate", "intermedi"},
+		{"happens", "happen"},
+		{"handkerchiefs", "handkerchief"},
+		{"scheme", "scheme"},
+		{"apologize", "apolog"},
+		{"club", "club"},
+		{"combat", "combat"},
+		{"villages", "villag"},
+		{"luckless", "luckless"},
+		{"fail", "fail"},
+		{"trepidation", "trepid"},
+		{"accused", "accus"},
+		{"disappeared", "disappear"},
+		{"manure", "manur"},
+		{"stowe", "stow"},
+		{"munro", "munro"},
+		{"arranged", "arrang"},
+		{"hardware", "hardwar"},
+		{"scarcity", "scarciti"},
+		{"asphyxia", "asphyxia"},
+		{"shaken", "shaken"},
+		{"apportionment", "apportion"},
+		{"messenger", "messeng"},
+		{"sayings", "sai"},
+		{"next", "next"},
+		{"despairing", "despair"},
+		{"deeply", "deepli"},
+		{"brightening", "brighten"},
+		{"thanksgiving", "thanksgiv"},
+		{"victorious", "victori"},
+		{"bromides", "bromid"},
+		{"adjutants", "adjut"},
+		{"unterkunft", "unterkunft"},
+		{"xii", "xii"},
+		{"diminishing", "diminish"},
+		{"mouth", "mouth"},
+		{"subdue", "subdu"},
+		{"cake", "cake"},
+		{"labored", "labor"},
+		{"confirming", "confirm"},
+		{"unsettled", "unsettl"},
+		{"excise", "excis"},
+		{"annexed", "annex"},
+		{"earliest", "earliest"},
+		{"hypoglossal", "hypogloss"},
+		{"droplets", "droplet"},
+		{"assume", "assum"},
+		{"traded", "trade"},
+		{"embolus", "embolu"},
+		{"beams", "beam"},
+		{"flooding", "flood"},
+		{"dear", "dear"},
+		{"everyday", "everydai"},
+		{"legislative", "legisl"},
+		{"sore", "sore"},
+		{"beside", "besid"},
+		{"parting", "part"},
+		{"vol", "vol"},
+		{"colored", "color"},
+		{"genu", "genu"},
+		{"slowly", "slowli"},
+		{"juniper", "junip"},
+		{"compensated", "compens"},
+		{"endarteritis", "endarter"},
+		{"shamefully", "shamefulli"},
+		{"january", "januari"},
+		{"stripped", "strip"},
+		{"definitions", "definit"},
+		{"manufactures", "manufactur"},
+		{"bohemia", "bohemia"},
+		{"unceasingly", "unceasingli"},
+		{"degenerate", "degener"},
+		{"obviously", "obvious"},
+		{"let", "let"},
+		{"ligature", "ligatur"},
+		{"injunction", "injunct"},
+		{"fitting", "fit"},
+		{"wash", "wash"},
+		{"financier", "financi"},
+		{"adoption", "adopt"},
+		{"regret", "regret"},
+		{"anxiously", "anxious"},
+		{"arising", "aris"},
+		{"smelling", "smell"},
+		{"bankruptcy", "bankruptci"},
+		{"lymphocytes", "lymphocyt"},
+		{"rainy", "raini"},
+		{"describe", "describ"},
+		{"considered", "consid"},
+		{"clutching", "clutch"},
+		{"weighty", "weighti"},
+		{"freely", "freeli"},
+		{"travelling", "travel"},
+		{"wailed", "wail"},
+		{"sectional", "section"},
+		{"anton", "anton"},
+		{"leprosy", "leprosi"},
+		{"remarks", "remark"},
+		{"final", "final"},
+		{"microscopic", "microscop"},
+		{"official", "offici"},
+		{"network", "network"},
+		{"awakening", "awaken"},
+		{"slab", "slab"},
+		{"its", "it"},
+		{"pallor", "pallor"},
+		{"preventive", "prevent"},
+		{"meager", "meager"},
+		{"tells", "tell"},
+		{"tenths", "tenth"},
+		{"jests", "jest"},
+		{"termination", "termin"},
+		{"inherent", "inher"},
+		{"inexperienced", "inexperienc"},
+		{"conflict", "conflict"},
+		{"launched", "launch"},
+		{"speak", "speak"},
+		{"american", "american"},
+		{"civilians", "civilian"},
+		{"senses", "sens"},
+		{"decrease", "decreas"},
+		{"pits", "pit"},
+		{"bid", "bid"},
+		{"kindhearted", "kindheart"},
+		{"inhibit", "inhibit"},
+		{"pledged", "pledg"},
+		{"vermont", "vermont"},
+		{"hague", "hagu"},
+		{"expose", "expos"},
+		{"contents", "content"},
+		{"agreeably", "agreeabl"},
+		{"neighbourhood", "neighbourhood"},
+		{"cultivate", "cultiv"},
+		{"eve", "ev"},
+		{"disorganisation", "disorganis"},
+		{"strict", "strict"},
+		{"refusal", "refus"},
+		{"unilateral", "unilater"},
+		{"rumored", "rumor"},
+		{"marine", "marin"},
+		{"magnificent", "magnific"},
+		{"bareheaded", "barehead"},
+		{"massage", "massag"},
+		{"outbreak", "outbreak"},
+		{"signature", "signatur"},
+		{"murmur", "murmur"},
+		{"adventurers", "adventur"},
+		{"wrists", "wrist"},
+		{"greatcoats", "greatcoat"},
+		{"infantrymen", "infantrymen"},
+		{"fireplace", "fireplac"},
+		{"supporting", "support"},
+		{"unrest", "unrest"},
+		{"needles", "needl"},
+		{"nominating", "nomin"},
+		{"crust", "crust"},
+		{"ulcerating", "ulcer"},
+		{"noticing", "notic"},
+		{"andreevich", "andreevich"},
+		{"suppress", "suppress"},
+		{"vivid", "vivid"},
+		{"blast", "blast"},
+		{"telangiectasis", "telangiectasi"},
+		{"rigged", "rig"},
+		{"swedes", "swede"},
+		{"bank", "bank"},
+		{"gloomily", "gloomili"},
+		{"though", "though"},
+		{"puffed", "puf"},
+		{"bringing", "bring"},
+		{"extravasation", "extravas"},
+		{"shooting", "shoot"},
+		{"senseless", "senseless"},
+		{"graft", "graft"},
+		{"diamond", "diamond"},
+		{"putrefaction", "putrefact"},
+		{"baronial", "baroni"},
+		{"sputum", "sputum"},
+		{"sympathetic", "sympathet"},
+		{"sheepskin", "sheepskin"},
+		{"lantern", "lantern"},
+		{"commissioner", "commission"},
+		{"paxson", "paxson"},
+		{"kill", "kill"},
+		{"instinct", "instinct"},
+		{"pulled", "pull"},
+		{"noncommissioned", "noncommiss"},
+		{"bullet", "bullet"},
+		{"twitchings", "twitch"},
+		{"appendix", "appendix"},
+		{"petition", "petit"},
+		{"tis", "ti"},
+		{"elector", "elector"},
+		{"speedily", "speedili"},
+		{"salesman", "salesman"},
+		{"monosyllables", "monosyl"},
+		{"rusty", "rusti"},
+		{"communis", "communi"},
+		{"photographs", "photograph"},
+		{"infantryman", "infantryman"},
+		{"habit", "habit"},
+		{"potash", "potash"},
+		{"password", "password"},
+		{"huntsman", "huntsman"},
+		{"collodion", "collodion"},
+		{"auerstadt", "auerstadt"},
+		{"concentration", "concentr"},
+		{"bliss", "bliss"},
+		{"garrison", "garrison"},
+		{"communism", "commun"},
+		{"probability", "probabl"},
+		{"revising", "revis"},
+		{"september", "septemb"},
+		{"variance", "varianc"},
+		{"departed", "depart"},
+		{"riders", "rider"},
+		{"paquelin", "paquelin"},
+		{"satin", "satin"},
+		{"tilden", "tilden"},
+		{"native", "nativ"},
+		{"sterno", "sterno"},
+		{"russe", "russ"},
+		{"regretfully", "regretfulli"},
+		{"warriors", "warrior"},
+		{"misled", "misl"},
+		{"clamp", "clamp"},
+		{"qui", "qui"},
+		{"kuzminichna", "kuzminichna"},
+		{"summons", "summon"},
+		{"stretcher", "stretcher"},
+		{"galloped", "gallop"},
+		{"endless", "endless"},
+		{"dose", "dose"},
+		{"lanolin", "lanolin"},
+		{"prairies", "prairi"},
+		{"border", "border"},
+		{"reticule", "reticul"},
+		{"passive", "passiv"},
+		{"masculine", "masculin"},
+		{"perforates", "perfor"},
+		{"horizon", "horizon"},
+		{"protruding", "protrud"},
+		{"skillfully", "skillfulli"},
+		{"alternately", "altern"},
+		{"rests", "rest"},
+		{"beards", "beard"},
+		{"bills", "bill"},
+		{"bruises", "bruis"},
+		{"roast", "roast"},
+		{"particles", "particl"},
+		{"congregation", "congreg"},
+		{"principal", "princip"},
+		{"japanese", "japanes"},
+		{"etc", "etc"},
+		{"become", "becom"},
+		{"bonds", "bond"},
+		{"alexander", "alexand"},
+		{"rightful", "right"},
+		{"conditional", "condit"},
+		{"ozheg", "ozheg"},
+		{"pencil", "pencil"},
+		{"dangerously", "danger"},
+		{"carolina", "carolina"},
+		{"lowered", "lower"},
+		{"wheels", "wheel"},
+		{"balmoral", "balmor"},
+		{"whispering", "whisper"},
+		{"wealth", "wealth"},
+		{"forgery", "forgeri"},
+		{"stained", "stain"},
+		{"xxv", "xxv"},
+		{"revoked", "revok"},
+		{"gums", "gum"},
+		{"thirds", "third"},
+		{"surface", "surfac"},
+		{"attaining", "attain"},
+		{"bred", "bred"},
+		{"thomson", "thomson"},
+		{"purpose", "purpos"},
+		{"kamenski", "kamenski"},
+		{"chancellor", "chancellor"},
+		{"britain", "britain"},
+		{"fed", "fed"},
+		{"clergymen", "clergymen"},
+		{"extraordinarily", "extraordinarili"},
+		{"avoiding", "avoid"},
+		{"earning", "earn"},
+		{"couple", "coupl"},
+		{"peoples", "peopl"},
+		{"limited", "limit"},
+		{"que", "que"},
+		{"briskness", "brisk"},
+		{"racemosum", "racemosum"},
+		{"alien", "alien"},
+		{"valid", "valid"},
+		{"preparatory", "preparatori"},
+		{"upward", "upward"},
+		{"abusing", "abus"},
+		{"quite", "quit"},
+		{"cordiality", "cordial"},
+		{"presenting", "present"},
+		{"karay", "karai"},
+		{"knowing", "know"},
+		{"potassium", "potassium"},
+		{"dishonoured", "dishonour"},
+		{"manifestation", "manifest"},
+		{"macdonald", "macdonald"},
+		{"wonderful", "wonder"},
+		{"later", "later"},
+		{"confirmation", "confirm"},
+		{"efim", "efim"},
+		{"projected", "project"},
+		{"secure", "secur"},
+		{"very", "veri"},
+		{"plexus", "plexu"},
+		{"catacombs", "catacomb"},
+		{"dimly", "dimli"},
+		{"pea", "pea"},
+		{"criticizing", "critic"},
+		{"accompany", "accompani"},
+		{"forage", "forag"},
+		{"organic", "organ"},
+		{"summed", "sum"},
+		{"ostermann", "ostermann"},
+		{"packed", "pack"},
+		{"large", "larg"},
+		{"profunda", "profunda"},
+		{"autogenous", "autogen"},
+		{"superfluous", "superflu"},
+		{"robber", "robber"},
+		{"cosmopolitan", "cosmopolitan"},
+		{"humored", "humor"},
+		{"faster", "faster"},
+		{"incident", "incid"},
+		{"gerry", "gerri"},
+		{"prognosis", "prognosi"},
+		{"withdrawing", "withdraw"},
+		{"essential", "essenti"},
+		{"reads", "read"},
+		{"chesapeake", "chesapeak"},
+		{"reprimand", "reprimand"},
+		{"infants", "infant"},
+		{"expressly", "expressli"},
+		{"deformans", "deforman"},
+		{"hurting", "hurt"},
+		{"tossing", "toss"},
+		{"circassian", "circassian"},
+		{"connoisseurs", "connoisseur"},
+		{"treading", "tread"},
+		{"strides", "stride"},
+		{"descend", "descend"},
+		{"york", "york"},
+		{"image", "imag"},
+		{"prepatellar", "prepatellar"},
+		{"mystic", "mystic"},
+		{"bade", "bade"},
+		{"selecting", "select"},
+		{"leucocytes", "leucocyt"},
+		{"appetite", "appetit"},
+		{"pitiful", "piti"},
+		{"duct", "duct"},
+		{"test", "test"},
+		{"volition", "volit"},
+		{"household", "household"},
+		{"installed", "instal"},
+		{"storms", "storm"},
+		{"balls", "ball"},
+		{"metternich", "metternich"},
+		{"loathsome", "loathsom"},
+		{"creator", "creator"},
+		{"warehouse", "warehous"},
+		{"sergeant", "sergeant"},
+		{"deafened", "deafen"},
+		{"spirochaeta", "spirochaeta"},
+		{"blockade", "blockad"},
+		{"abandoning", "abandon"},
+		{"ingrowth", "ingrowth"},
+		{"peritoneum", "peritoneum"},
+		{"subscribed", "subscrib"},
+		{"framework", "framework"},
+		{"establishing", "establish"},
+		{"inserted", "insert"},
+		{"spell", "spell"},
+		{"candle", "candl"},
+		{"manual", "manual"},
+		{"monarchical", "monarch"},
+		{"reconsider", "reconsid"},
+		{"officials", "offici"},
+		{"burdensome", "burdensom"},
+		{"haemorrhages", "haemorrhag"},
+		{"adhering", "adher"},
+		{"upright", "upright"},
+		{"scold", "scold"},
+		{"overland", "overland"},
+		{"simplest", "simplest"},
+		{"picket", "picket"},
+		{"mindedly", "mindedli"},
+		{"sect", "sect"},
+		{"wedged", "wedg"},
+		{"brick", "brick"},
+		{"blank", "blank"},
+		{"josiah", "josiah"},
+		{"inspecting", "inspect"},
+		{"cart", "cart"},
+		{"righted", "right"},
+		{"pneumonia", "pneumonia"},
+		{"encouraging", "encourag"},
+		{"disabilities", "disabl"},
+		{"discomfort", "discomfort"},
+		{"ecclesiastical", "ecclesiast"},
+		{"expecting", "expect"},
+		{"outrages", "outrag"},
+		{"dilemma", "dilemma"},
+		{"granulating", "granul"},
+		{"trendelenburg", "trendelenburg"},
+		{"daylight", "daylight"},
+		{"energetic", "energet"},
+		{"chigoe", "chigo"},
+		{"illustrating", "illustr"},
+		{"concerts", "concert"},
+		{"superadded", "superad"},
+		{"obnoxious", "obnoxi"},
+		{"downy", "downi"},
+		{"roared", "roar"},
+		{"orderlies", "orderli"},
+		{"clinical", "clinic"},
+		{"condescendingly", "condescendingli"},
+		{"tormented", "torment"},
+		{"corridor", "corridor"},
+		{"dermoids", "dermoid"},
+		{"plunderers", "plunder"},
+		{"arbitrary", "arbitrari"},
+		{"entailed", "entail"},
+		{"dem", "dem"},
+		{"deer", "deer"},
+		{"passage", "passag"},
+		{"fortress", "fortress"},
+		{"regrets", "regret"},
+		{"birds", "bird"},
+		{"nutrient", "nutrient"},
+		{"gasped", "gasp"},
+		{"superficially", "superfici"},
+		{"renders", "render"},
+		{"administrative", "administr"},
+		{"pushing", "push"},
+		{"residence", "resid"},
+		{"cured", "cure"},
+		{"locality", "local"},
+		{"consists", "consist"},
+		{"ulm", "ulm"},
+		{"emperor", "emperor"},
+		{"dwell", "dwell"},
+		{"courted", "court"},
+		{"implying", "impli"},
+		{"cowboys", "cowboi"},
+		{"eighth", "eighth"},
+		{"handy", "handi"},
+		{"impose", "impos"},
+		{"implanted", "implant"},
+		{"recrudescence", "recrudesc"},
+		{"current", "current"},
+		{"rudely", "rude"},
+		{"winter", "winter"},
+		{"bad", "bad"},
+		{"alexis", "alexi"},
+		{"viscid", "viscid"},
+		{"powders", "powder"},
+		{"patient", "patient"},
+		{"adhered", "adher"},
+		{"favors", "favor"},
+		{"trouser", "trouser"},
+		{"mucous", "mucou"},
+		{"mighty", "mighti"},
+		{"splint", "splint"},
+		{"lines", "line"},
+		{"charter", "charter"},
+		{"raged", "rage"},
+		{"din", "din"},
+		{"code", "code"},
+		{"sarcomas", "sarcoma"},
+		{"destroy", "destroi"},
+		{"preparations", "prepar"},
+		{"basilic", "basil"},
+		{"nationalities", "nation"},
+		{"directing", "direct"},
+		{"povarskaya", "povarskaya"},
+		{"submaxillary", "submaxillari"},
+		{"specimens", "specimen"},
+		{"dignitary", "dignitari"},
+		{"expects", "expect"},
+		{"staggered", "stagger"},
+		{"middle", "middl"},
+		{"hospitality", "hospit"},
+		{"tramp", "tramp"},
+		{"bags", "bag"},
+		{"urate", "urat"},
+		{"purest", "purest"},
+		{"blended", "blend"},
+		{"perishes", "perish"},
+		{"respiratory", "respiratori"},
+		{"constitutes", "constitut"},
+		{"overhead", "overhead"},
+		{"spring", "spring"},
+		{"farther", "farther"},
+		{"floated", "float"},
+		{"savishna", "savishna"},
+		{"tales", "tale"},
+		{"directors", "director"},
+		{"packs", "pack"},
+		{"wider", "wider"},
+		{"bluish", "bluish"},
+		{"pattern", "pattern"},
+		{"periodically", "period"},
+		{"reverses", "revers"},
+		{"fulfill", "fulfil"},
+		{"fomentations", "foment"},
+		{"incendiary", "incendiari"},
+		{"arkansas", "arkansa"},
+		{"plenty", "plenti"},
+		{"intimidated", "intimid"},
+		{"annular", "annular"},
+		{"cooperative", "cooper"},
+		{"marbury", "marburi"},
+		{"persisted", "persist"},
+		{"portraits", "portrait"},
+		{"vow", "vow"},
+		{"relics", "relic"},
+		{"brings", "bring"},
+		{"gentlemen", "gentlemen"},
+		{"foes", "foe"},
+		{"compulsory", "compulsori"},
+		{"francis", "franci"},
+		{"outset", "outset"},
+		{"scotland", "scotland"},
+		{"violin", "violin"},
+		{"adroitly", "adroitli"},
+		{"fairy", "fairi"},
+		{"timely", "time"},
+		{"ribbon", "ribbon"},
+		{"bubo", "bubo"},
+		{"esaul", "esaul"},
+		{"advice", "advic"},
+		{"invading", "invad"},
+		{"stricture", "strictur"},
+		{"sweets", "sweet"},
+		{"greenbackers", "greenback"},
+		{"risked", "risk"},
+		{"live", "live"},
+		{"stolypin", "stolypin"},
+		{"spellbound", "spellbound"},
+		{"penetrating", "penetr"},
+		{"weaving", "weav"},
+		{"symbols", "symbol"},
+		{"saute", "saut"},
+		{"cleanly", "cleanli"},
+		{"rescript", "rescript"},
+		{"osteoma", "osteoma"},
+		{"secured", "secur"},
+		{"inconceivable", "inconceiv"},
+		{"ignatyevna", "ignatyevna"},
+		{"lads", "lad"},
+		{"regretting", "regret"},
+		{"urine", "urin"},
+		{"aseptic", "asept"},
+		{"arterial", "arteri"},
+		{"swarming", "swarm"},
+		{"instant", "instant"},
+		{"dislike", "dislik"},
+		{"heroism", "heroism"},
+		{"impress", "impress"},
+		{"congresses", "congress"},
+		{"vegetable", "veget"},
+		{"hendrikhovna", "hendrikhovna"},
+		{"haven", "haven"},
+		{"nail", "nail"},
+		{"healed", "heal"},
+		{"temporal", "tempor"},
+		{"match", "match"},
+		{"legal", "legal"},
+		{"jerking", "jerk"},
+		{"paroxysms", "paroxysm"},
+		{"groin", "groin"},
+		{"adams", "adam"},
+		{"sign", "sign"},
+		{"voronezh", "voronezh"},
+		{"progress", "progress"},
+		{"syringe", "syring"},
+		{"unless", "unless"},
+		{"halt", "halt"},
+		{"brotherly", "brotherli"},
+		{"bit", "bit"},
+		{"pitiless", "pitiless"},
+		{"ecstasy", "ecstasi"},
+		{"forestry", "forestri"},
+		{"preach", "preach"},
+		{"cushion", "cushion"},
+		{"disappear", "disappear"},
+		{"celebrated", "celebr"},
+		{"epithelioma", "epithelioma"},
+		{"bowed", "bow"},
+		{"atlanta", "atlanta"},
+		{"excised", "excis"},
+		{"win", "win"},
+		{"fever", "fever"},
+		{"rev", "rev"},
+		{"baseness", "base"},
+		{"overthrown", "overthrown"},
+		{"discharged", "discharg"},
+		{"corpus", "corpu"},
+		{"opinions", "opinion"},
+		{"enduring", "endur"},
+		{"bennigsen", "bennigsen"},
+		{"investigated", "investig"},
+		{"dressing", "dress"},
+		{"drink", "drink"},
+		{"forms", "form"},
+		{"mining", "mine"},
+		{"invariable", "invari"},
+		{"awkwardly", "awkwardli"},
+		{"toiling", "toil"},
+		{"your", "your"},
+		{"harness", "har"},
+		{"napkin", "napkin"},
+		{"tuberculosis", "tuberculosi"},
+		{"hippolyte", "hippolyt"},
+		{"instructed", "instruct"},
+		{"theology", "theologi"},
+		{"cloudless", "cloudless"},
+		{"emotion", "emot"},
+		{"gabriel", "gabriel"},
+		{"fibrositis", "fibros"},
+		{"tarutino", "tarutino"},
+		{"pursuit", "pursuit"},
+		{"acute", "acut"},
+		{"double", "doubl"},
+		{"distension", "distens"},
+		{"savary", "savari"},
+		{"warn", "warn"},
+		{"acutely", "acut"},
+		{"lime", "lime"},
+		{"heroes", "hero"},
+		{"offhand", "offhand"},
+		{"spared", "spare"},
+		{"intrinsic", "intrins"},
+		{"surrendered", "surrend"},
+		{"fibula", "fibula"},
+		{"terminated", "termin"},
+		{"capitulation", "capitul"},
+		{"fraud", "fraud"},
+		{"thorns", "thorn"},
+		{"backs", "back"},
+		{"reservation", "reserv"},
+		{"relatives", "rel"},
+		{"stood", "stood"},
+		{"pavlograds", "pavlograd"},
+		{"stoke", "stoke"},
+		{"instruction", "instruct"},
+		{"monopolies", "monopoli"},
+		{"intervene", "interven"},
+		{"oblige", "oblig"},
+		{"razor", "razor"},
+		{"bushy", "bushi"},
+		{"stations", "station"},
+		{"bars", "bar"},
+		{"leading", "lead"},
+		{"heiress", "heiress"},
+		{"moses", "mose"},
+		{"flag", "flag"},
+		{"groove", "groov"},
+		{"homesteads", "homestead"},
+		{"serried", "serri"},
+		{"channing", "chan"},
+		{"undue", "undu"},
+		{"lookout", "lookout"},
+		{"portmanteau", "portmanteau"},
+		{"shrewd", "shrewd"},
+		{"obtaining", "obtain"},
+		{"remove", "remov"},
+		{"comes", "come"},
+		{"intravenous", "intraven"},
+		{"diffusion", "diffus"},
+		{"warfare", "warfar"},
+		{"conscription", "conscript"},
+		{"smock", "smock"},
+		{"lapsed", "laps"},
+		{"doran", "doran"},
+		{"regain", "regain"},
+		{"fires", "fire"},
+		{"squirrel", "squirrel"},
+		{"individually", "individu"},
+		{"intervened", "interven"},
+		{"sport", "sport"},
+		{"alveoli", "alveoli"},
+		{"spots", "spot"},
+		{"intermuscular", "intermuscular"},
+		{"allusion", "allus"},
+		{"palpated", "palpat"},
+		{"acquisition", "acquisit"},
+		{"formed", "form"},
+		{"incontestable", "incontest"},
+		{"paralyzing", "paralyz"},
+		{"pasteur", "pasteur"},
+		{"blinds", "blind"},
+		{"screwed", "screw"},
+		{"outcome", "outcom"},
+		{"radium", "radium"},
+		{"imprisoned", "imprison"},
+		{"tomowwow", "tomowwow"},
+		{"fireworks", "firework"},
+		{"endured", "endur"},
+		{"compressing", "compress"},
+		{"exposed", "expos"},
+		{"contradictions", "contradict"},
+		{"performed", "perform"},
+		{"tradesmen", "tradesmen"},
+		{"spoken", "spoken"},
+		{"diary", "diari"},
+		{"prized", "prize"},
+		{"horribly", "horribl"},
+		{"parish", "parish"},
+		{"transaction", "transact"},
+		{"directs", "direct"},
+		{"compress", "compress"},
+		{"switzerland", "switzerland"},
+		{"leaves", "leav"},
+		{"improvised", "improvis"},
+		{"gravel", "gravel"},
+		{"greeting", "greet"},
+		{"wick", "wick"},
+		{"gambling", "gambl"},
+		{"putrefactive", "putrefact"},
+		{"bogdanich", "bogdanich"},
+		{"tserkov", "tserkov"},
+		{"inadequate", "inadequ"},
+		{"cirsoid", "cirsoid"},
+		{"watering", "water"},
+		{"standard", "standard"},
+		{"rotated", "rotat"},
+		{"insane", "insan"},
+		{"nitrate", "nitrat"},
+		{"nitric", "nitric"},
+		{"schonbrunn", "schonbrunn"},
+		{"condylomata", "condylomata"},
+		{"salivary", "salivari"},
+		{"thence", "thenc"},
+		{"mexicans", "mexican"},
+		{"debate", "debat"},
+		{"embankment", "embank"},
+		{"tibiae", "tibia"},
+		{"yards", "yard"},
+		{"dance", "danc"},
+		{"talkative", "talk"},
+		{"finland", "finland"},
+		{"holy", "holi"},
+		{"shelters", "shelter"},
+		{"medicinal", "medicin"},
+		{"indications", "indic"},
+		{"belaya", "belaya"},
+		{"messieurs", "messieur"},
+		{"constables", "constabl"},
+		{"detail", "detail"},
+		{"crile", "crile"},
+		{"lice", "lice"},
+		{"anaemia", "anaemia"},
+		{"tread", "tread"},
+		{"crockery", "crockeri"},
+		{"cocked", "cock"},
+		{"scepter", "scepter"},
+		{"period", "period"},
+		{"average", "averag"},
+		{"maintained", "maintain"},
+		{"transporting", "transport"},
+		{"attended", "attend"},
+		{"proposing", "propos"},
+		{"withdrew", "withdrew"},
+		{"involving", "involv"},
+		{"east", "east"},
+		{"shared", "share"},
+		{"contestants", "contest"},
+		{"lipping", "lip"},
+		{"muslin", "muslin"},
+		{"influenza", "influenza"},
+		{"fatherland", "fatherland"},
+		{"scriptures", "scriptur"},
+		{"americanization", "american"},
+		{"streptococcal", "streptococc"},
+		{"safeguard", "safeguard"},
+		{"redemption", "redempt"},
+		{"roger", "roger"},
+		{"near", "near"},
+		{"cords", "cord"},
+		{"grasped", "grasp"},
+		{"syphilitic", "syphilit"},
+		{"pfuel", "pfuel"},
+		{"monarch", "monarch"},
+		{"dosage", "dosag"},
+		{"passports", "passport"},
+		{"healthy", "healthi"},
+		{"realising", "realis"},
+		{"minister", "minist"},
+		{"tumbler", "tumbler"},
+		{"trembling", "trembl"},
+		{"clenching", "clench"},
+		{"intoxicating", "intox"},
+		{"mitrich", "mitrich"},
+		{"words", "word"},
+		{"schurz", "schurz"},
+		{"month", "month"},
+		{"recovery", "recoveri"},
+		{"helpless", "helpless"},
+		{"multiplication", "multipl"},
+		{"stoner", "stoner"},
+		{"establishment", "establish"},
+		{"splinters", "splinter"},
+		{"atrophic", "atroph"},
+		{"erza", "erza"},
+		{"ligating", "ligat"},
+		{"rural", "rural"},
+		{"imagination", "imagin"},
+		{"idiotic", "idiot"},
+		{"muttered", "mutter"},
+		{"frosty", "frosti"},
+		{"give", "give"},
+		{"implore", "implor"},
+		{"silk", "silk"},
+		{"phagedaenic", "phagedaen"},
+		{"hydrogen", "hydrogen"},
+		{"rattling", "rattl"},
+		{"venereal", "vener"},
+		{"nous", "nou"},
+		{"lumber", "lumber"},
+		{"indians", "indian"},
+		{"furs", "fur"},
+		{"heart", "heart"},
+		{"swagger", "swagger"},
+		{"swedish", "swedish"},
+		{"outlying", "outli"},
+		{"markov", "markov"},
+		{"equally", "equal"},
+		{"specie", "speci"},
+		{"universities", "univers"},
+		{"pads", "pad"},
+		{"compensation", "compens"},
+		{"newcomer", "newcom"},
+		{"musical", "music"},
+		{"ammonia", "ammonia"},
+		{"poniatowski", "poniatowski"},
+		{"davout", "davout"},
+		{"provinces", "provinc"},
+		{"trailing", "trail"},
+		{"maritime", "maritim"},
+		{"lawyer", "lawyer"},
+		{"today", "todai"},
+		{"her", "her"},
+		{"rival", "rival"},
+		{"disposal", "dispos"},
+		{"smelled", "smell"},
+		{"thursdays", "thursdai"},
+		{"dragoons", "dragoon"},
+		{"patience", "patienc"},
+		{"separated", "separ"},
+		{"respiration", "respir"},
+		{"mended", "mend"},
+		{"demands", "demand"},
+		{"snap", "snap"},
+		{"holder", "holder"},
+		{"upheaval", "upheav"},
+		{"stewards", "steward"},
+		{"turn", "turn"},
+		{"confession", "confess"},
+		{"squeezing", "squeez"},
+		{"august", "august"},
+		{"cuban", "cuban"},
+		{"earthly", "earthli"},
+		{"deepest", "deepest"},
+		{"apathy", "apathi"},
+		{"unexpected", "unexpect"},
+		{"destroyed", "destroi"},
+		{"wedding", "wed"},
+		{"diaphysis", "diaphysi"},
+		{"mahogany", "mahogani"},
+		{"projects", "project"},
+		{"rumours", "rumour"},
+		{"willful", "will"},
+		{"teres", "tere"},
+		{"catholic", "cathol"},
+		{"trot", "trot"},
+		{"school", "school"},
+		{"gilt", "gilt"},
+		{"den", "den"},
+		{"birmingham", "birmingham"},
+		{"circle", "circl"},
+		{"drawer", "drawer"},
+		{"appearances", "appear"},
+		{"adventures", "adventur"},
+		{"favouring", "favour"},
+		{"body", "bodi"},
+		{"fidelity", "fidel"},
+		{"solve", "solv"},
+		{"infant", "infant"},
+		{"mantle", "mantl"},
+		{"replacing", "replac"},
+		{"coronation", "coron"},
+		{"deserted", "desert"},
+		{"spot", "spot"},
+		{"gold", "gold"},
+		{"barre", "barr"},
+		{"outlined", "outlin"},
+		{"styptics", "styptic"},
+		{"crying", "cry"},
+		{"cleaning", "clean"},
+		{"memories", "memori"},
+		{"inconvenient", "inconveni"},
+		{"loot", "loot"},
+		{"told", "told"},
+		{"considering", "consid"},
+		{"fortunes", "fortun"},
+		{"serenity", "seren"},
+		{"bloody", "bloodi"},
+		{"reign", "reign"},
+		{"hushed", "hush"},
+		{"integument", "integu"},
+		{"haemangioma", "haemangioma"},
+		{"odour", "odour"},
+		{"mildest", "mildest"},
+		{"solving", "solv"},
+		{"intentionally", "intention"},
+		{"suffering", "suffer"},
+		{"ambush", "ambush"},
+		{"voiced", "voic"},
+		{"explosion", "explos"},
+		{"nigh", "nigh"},
+		{"swords", "sword"},
+		{"snout", "snout"},
+		{"clumsy", "clumsi"},
+		{"cat", "cat"},
+		{"league", "leagu"},
+		{"happiest", "happiest"},
+		{"gaped", "gape"},
+		{"molasses", "molass"},
+		{"cessation", "cessat"},
+		{"consumed", "consum"},
+		{"dorr", "dorr"},
+		{"curative", "cur"},
+		{"heavily", "heavili"},
+		{"coman", "coman"},
+		{"charles", "charl"},
+		{"proliferation", "prolifer"},
+		{"roi", "roi"},
+		{"dartmouth", "dartmouth"},
+		{"pedestal", "pedest"},
+		{"disabled", "disabl"},
+		{"antonovna", "antonovna"},
+		{"untold", "untold"},
+		{"singer", "singer"},
+		{"watchword", "watchword"},
+		{"freak", "freak"},
+		{"abolitionist", "abolitionist"},
+		{"veto", "veto"},
+		{"gloomier", "gloomier"},
+		{"vulgar", "vulgar"},
+		{"affectation", "affect"},
+		{"sends", "send"},
+		{"gullet", "gullet"},
+		{"surroundings", "surround"},
+		{"adipose", "adipos"},
+		{"chuckled", "chuckl"},
+		{"individuals", "individu"},
+		{"displaying", "displai"},
+		{"moments", "moment"},
+		{"franchise", "franchis"},
+		{"purple", "purpl"},
+		{"farms", "farm"},
+		{"samoa", "samoa"},
+		{"federations", "feder"},
+		{"europe", "europ"},
+		{"cautious", "cautiou"},
+		{"tutors", "tutor"},
+		{"gone", "gone"},
+		{"rose", "rose"},
+		{"retreats", "retreat"},
+		{"swellings", "swell"},
+		{"uncomfortable", "uncomfort"},
+		{"steep", "steep"},
+		{"advise", "advis"},
+		{"surmise", "surmis"},
+		{"querulous", "querul"},
+		{"represent", "repres"},
+		{"congratulated", "congratul"},
+		{"disappointments", "disappoint"},
+		{"worm", "worm"},
+		{"columbus", "columbu"},
+		{"slit", "slit"},
+		{"cow", "cow"},
+		{"determining", "determin"},
+		{"jump", "jump"},
+		{"longus", "longu"},
+		{"hydrops", "hydrop"},
+		{"predominates", "predomin"},
+		{"ceaseless", "ceaseless"},
+		{"parotid", "parotid"},
+		{"naso", "naso"},
+		{"exudation", "exud"},
+		{"mot", "mot"},
+		{"pacific", "pacif"},
+		{"serving", "serv"},
+		{"speculation", "specul"},
+		{"rapturously", "raptur"},
+		{"tennessee", "tennesse"},
+		{"taunted", "taunt"},
+		{"shriek", "shriek"},
+		{"confronted", "confront"},
+		{"limiting", "limit"},
+		{"system", "system"},
+		{"fresh", "fresh"},
+		{"extracted", "extract"},
+		{"epaulettes", "epaulett"},
+		{"pending", "pend"},
+		{"bore", "bore"},
+		{"dissatisfied", "dissatisfi"},
+		{"fatty", "fatti"},
+		{"irrevocable", "irrevoc"},
+		{"clanging", "clang"},
+		{"reveals", "reveal"},
+		{"telling", "tell"},
+		{"curled", "curl"},
+		{"crutch", "crutch"},
+		{"paget", "paget"},
+		{"glamour", "glamour"},
+		{"endeavors", "endeavor"},
+		{"courteous", "courteou"},
+		{"angioma", "angioma"},
+		{"uncontrollable", "uncontrol"},
+		{"chaps", "chap"},
+		{"roadside", "roadsid"},
+		{"morally", "moral"},
+		{"react", "react"},
+		{"happiness", "happi"},
+		{"merging", "merg"},
+		{"mutton", "mutton"},
+		{"competitors", "competitor"},
+		{"territorial", "territori"},
+		{"referendum", "referendum"},
+		{"stress", "stress"},
+		{"failures", "failur"},
+		{"dorsiflexion", "dorsiflexion"},
+		{"created", "creat"},
+		{"crowder", "crowder"},
+		{"eye", "ey"},
+		{"mayflower", "mayflow"},
+		{"lending", "lend"},
+		{"disgust", "disgust"},
+		{"fuel", "fuel"},
+		{"nerves", "nerv"},
+		{"hasn", "hasn"},
+		{"lend", "lend"},
+		{"case", "case"},
+		{"langeron", "langeron"},
+		{"ate", "at"},
+		{"spectacles", "spectacl"},
+		{"humanity", "human"},
+		{"commune", "commun"},
+		{"tries", "tri"},
+		{"aches", "ach"},
+		{"woods", "wood"},
+		{"shallow", "shallow"},
+		{"interpretation", "interpret"},
+		{"unattainable", "unattain"},
+		{"fixing", "fix"},
+		{"prosper", "prosper"},
+		{"congratulations", "congratul"},
+		{"sketch", "sketch"},
+		{"abdominal", "abdomin"},
+		{"attributed", "attribut"},
+		{"decision", "decis"},
+		{"scope", "scope"},
+		{"unsuccessful", "unsuccess"},
+		{"unmarried", "unmarri"},
+		{"morosely", "moros"},
+		{"beef", "beef"},
+		{"royalist", "royalist"},
+		{"causation", "causat"},
+		{"estates", "estat"},
+		{"payments", "payment"},
+		{"foam", "foam"},
+		{"freight", "freight"},
+		{"questioned", "question"},
+		{"abolished", "abolish"},
+		{"concessions", "concess"},
+		{"glowing", "glow"},
+		{"ammonium", "ammonium"},
+		{"swift", "swift"},
+		{"pleases", "pleas"},
+		{"appreciate", "appreci"},
+		{"sacrum", "sacrum"},
+		{"canceled", "cancel"},
+		{"cerebral", "cerebr"},
+		{"knew", "knew"},
+		{"veneration", "vener"},
+		{"tangle", "tangl"},
+		{"typhus", "typhu"},
+		{"merchandise", "merchandis"},
+		{"ossium", "ossium"},
+		{"themselves", "themselv"},
+		{"examines", "examin"},
+		{"continent", "contin"},
+		{"wielded", "wield"},
+		{"emigrant", "emigr"},
+		{"bonnet", "bonnet"},
+		{"pierre", "pierr"},
+		{"heed", "heed"},
+		{"planks", "plank"},
+		{"coincide", "coincid"},
+		{"ganglion", "ganglion"},
+		{"begging", "beg"},
+		{"winning", "win"},
+		{"syllabus", "syllabu"},
+		{"percentage", "percentag"},
+		{"belonged", "belong"},
+		{"stabs", "stab"},
+		{"masons", "mason"},
+		{"owners", "owner"},
+		{"heated", "heat"},
+		{"paralytic", "paralyt"},
+		{"exostosis", "exostosi"},
+		{"circulation", "circul"},
+		{"emphatically", "emphat"},
+		{"cupboard", "cupboard"},
+		{"morcar", "morcar"},
+		{"chattering", "chatter"},
+		{"wired", "wire"},
+		{"contraction", "contract"},
+		{"peacefully", "peacefulli"},
+		{"mashka", "mashka"},
+		{"confederated", "confeder"},
+		{"compression", "compress"},
+		{"unjust", "unjust"},
+		{"courteously", "courteous"},
+		{"changes", "chang"},
+		{"temperament", "tempera"},
+		{"monseigneur", "monseigneur"},
+		{"gipsies", "gipsi"},
+		{"leontiasis", "leontiasi"},
+		{"migratory", "migratori"},
+		{"housemaid", "housemaid"},
+		{"replacement", "replac"},
+		{"taenia", "taenia"},
+		{"outburst", "outburst"},
+		{"encampment", "encamp"},
+		{"madeira", "madeira"},
+		{"associated", "associ"},
+		{"custody", "custodi"},
+		{"suites", "suit"},
+		{"stupid", "stupid"},
+		{"monograph", "monograph"},
+		{"abruptly", "abruptli"},
+		{"spluttered", "splutter"},
+		{"apposition", "apposit"},
+		{"steal", "steal"},
+		{"regional", "region"},
+		{"collected", "collect"},
+		{"triumphantly", "triumphantli"},
+		{"thorough", "thorough"},
+		{"overlooked", "overlook"},
+		{"square", "squar"},
+		{"shift", "shift"},
+		{"tying", "ty"},
+		{"lead", "lead"},
+		{"thiers", "thier"},
+		{"whenever", "whenev"},
+		{"ideas", "idea"},
+		{"facing", "face"},
+		{"medicines", "medicin"},
+		{"necrosed", "necros"},
+		{"compelled", "compel"},
+		{"arthropathies", "arthropathi"},
+		{"quartermaster", "quartermast"},
+		{"constantine", "constantin"},
+		{"chiffonier", "chiffoni"},
+		{"trenches", "trench"},
+		{"languor", "languor"},
+		{"await", "await"},
+		{"dnieper", "dnieper"},
+		{"former", "former"},
+		{"dashed", "dash"},
+		{"coeur", "coeur"},
+		{"abnormal", "abnorm"},
+		{"voyage", "voyag"},
+		{"repose", "repos"},
+		{"settle", "settl"},
+		{"illegal", "illeg"},
+		{"extruded", "extrud"},
+		{"tuesday", "tuesdai"},
+		{"foreboding", "forebod"},
+		{"asserted", "assert"},
+		{"mesenteric", "mesenter"},
+		{"stamped", "stamp"},
+		{"stinging", "sting"},
+		{"reported", "report"},
+		{"ruddy", "ruddi"},
+		{"spongy", "spongi"},
+		{"odious", "odiou"},
+		{"eastern", "eastern"},
+		{"stiffness", "stiff"},
+		{"households", "household"},
+		{"girth", "girth"},
+		{"carranza", "carranza"},
+		{"amusing", "amus"},
+		{"cavalryman", "cavalryman"},
+		{"cell", "cell"},
+		{"subsidies", "subsidi"},
+		{"wolfe", "wolf"},
+		{"despise", "despis"},
+		{"sera", "sera"},
+		{"blissful", "bliss"},
+		{"hopeful", "hope"},
+		{"balanitis", "balan"},
+		{"feather", "feather"},
+		{"concentrically", "concentr"},
+		{"extended", "extend"},
+		{"hast", "hast"},
+		{"studied", "studi"},
+		{"striving", "strive"},
+		{"crushing", "crush"},
+		{"profoundly", "profoundli"},
+		{"natalie", "natali"},
+		{"rustling", "rustl"},
+		{"embark", "embark"},
+		{"amiability", "amiabl"},
+		{"detached", "detach"},
+		{"experienced", "experienc"},
+		{"deafness", "deaf"},
+		{"wherein", "wherein"},
+		{"prey", "prei"},
+		{"then", "then"},
+		{"rough", "rough"},
+		{"learn", "learn"},
+		{"dominating", "domin"},
+		{"comfort", "comfort"},
+		{"swarthy", "swarthi"},
+		{"issues", "issu"},
+		{"unpaid", "unpaid"},
+		{"encyclopaedia", "encyclopaedia"},
+		{"imperfect", "imperfect"},
+		{"proves", "prove"},
+		{"tenacity", "tenac"},
+		{"materially", "materi"},
+		{"languid", "languid"},
+		{"cornered", "corner"},
+		{"perturbed", "perturb"},
+		{"pleaded", "plead"},
+		{"device", "devic"},
+		{"cement", "cement"},
+		{"eternity", "etern"},
+		{"grace", "grace"},
+		{"directly", "directli"},
+		{"situations", "situat"},
+		{"persistent", "persist"},
+		{"heat", "heat"},
+		{"tour", "tour"},
+		{"detected", "detect"},
+		{"achieved", "achiev"},
+		{"stored", "store"},
+		{"profligate", "proflig"},
+		{"vex", "vex"},
+		{"sympathetically", "sympathet"},
+		{"warship", "warship"},
+		{"random", "random"},
+		{"old", "old"},
+		{"injuring", "injur"},
+		{"apportioned", "apport"},
+		{"managing", "manag"},
+		{"analyze", "analyz"},
+		{"klux", "klux"},
+		{"geneva", "geneva"},
+		{"dint", "dint"},
+		{"negligence", "neglig"},
+		{"scouts", "scout"},
+		{"dummy", "dummi"},
+		{"temptations", "temptat"},
+		{"incorporation", "incorpor"},
+		{"tracks", "track"},
+		{"bayonets", "bayonet"},
+		{"twinkled", "twinkl"},
+		{"surg", "surg"},
+		{"purposes", "purpos"},
+		{"serf", "serf"},
+		{"benefactors", "benefactor"},
+		{"mechanical", "mechan"},
+		{"grover", "grover"},
+		{"migrate", "migrat"},
+		{"cocci", "cocci"},
+		{"claret", "claret"},
+		{"uncontrolled", "uncontrol"},
+		{"founding", "found"},
+		{"deplored", "deplor"},
+		{"doubtful", "doubt"},
+		{"decrees", "decre"},
+		{"framing", "frame"},
+		{"claim", "claim"},
+		{"artistic", "artist"},
+		{"belongs", "belong"},
+		{"deity", "deiti"},
+		{"christian", "christian"},
+		{"contested", "contest"},
+		{"recess", "recess"},
+		{"restive", "restiv"},
+		{"reproved", "reprov"},
+		{"itching", "itch"},
+		{"excruciating", "excruci"},
+		{"compromises", "compromis"},
+		{"david", "david"},
+		{"everybody", "everybodi"},
+		{"cotton", "cotton"},
+		{"implicated", "implic"},
+		{"electoral", "elector"},
+		{"elementary", "elementari"},
+		{"exhibit", "exhibit"},
+		{"diverse", "divers"},
+		{"differently", "differ"},
+		{"depriving", "depriv"},
+		{"count", "count"},
+		{"younger", "younger"},
+		{"remarked", "remark"},
+		{"shifting", "shift"},
+		{"portion", "portion"},
+		{"gravitation", "gravit"},
+		{"bleeder", "bleeder"},
+		{"burden", "burden"},
+		{"convincing", "convinc"},
+		{"citizens", "citizen"},
+		{"hideous", "hideou"},
+		{"epiphysis", "epiphysi"},
+		{"consist", "consist"},
+		{"concise", "concis"},
+		{"doll", "doll"},
+		{"philip", "philip"},
+		{"pleasing", "pleas"},
+		{"simply", "simpli"},
+		{"european", "european"},
+		{"landowners", "landown"},
+		{"plundering", "plunder"},
+		{"aerogenes", "aerogen"},
+		{"guilt", "guilt"},
+		{"wrung", "wrung"},
+		{"squeaking", "squeak"},
+		{"committee", "committe"},
+		{"trains", "train"},
+		{"expedient", "expedi"},
+		{"inherit", "inherit"},
+		{"stretches", "stretch"},
+		{"meal", "meal"},
+		{"wouldn", "wouldn"},
+		{"chevalier", "chevali"},
+		{"soul", "soul"},
+		{"certainty", "certainti"},
+		{"dazzling", "dazzl"},
+		{"timidly", "timidli"},
+		{"thank", "thank"},
+		{"rescue", "rescu"},
+		{"ingenuity", "ingenu"},
+		{"avoided", "avoid"},
+		{"vaso", "vaso"},
+		{"trauma", "trauma"},
+		{"carbon", "carbon"},
+		{"sounded", "sound"},
+		{"refrained", "refrain"},
+		{"classic", "classic"},
+		{"prompt", "prompt"},
+		{"adopt", "adopt"},
+		{"spurned", "spurn"},
+		{"infusions", "infus"},
+		{"develops", "develop"},
+		{"hesitated", "hesit"},
+		{"composed", "compos"},
+		{"alleged", "alleg"},
+		{"spirilla", "spirilla"},
+		{"sweat", "sweat"},
+		{"unconcernedly", "unconcernedli"},
+		{"sensible", "sensibl"},
+		{"swear", "swear"},
+		{"divinity", "divin"},
+		{"sight", "sight"},
+		{"location", "locat"},
+		{"problem", "problem"},
+		{"pinkish", "pinkish"},
+		{"feed", "feed"},
+		{"admittedly", "admittedli"},
+		{"strategic", "strateg"},
+		{"dismantled", "dismantl"},
+		{"undergoing", "undergo"},
+		{"perforate", "perfor"},
+		{"sweet", "sweet"},
+		{"monday", "mondai"},
+		{"hungry", "hungri"},
+		{"grafts", "graft"},
+		{"unfamiliar", "unfamiliar"},
+		{"german", "german"},
+		{"seized", "seiz"},
+		{"peaked", "peak"},
+		{"workers", "worker"},
+		{"yields", "yield"},
+		{"wicket", "wicket"},
+		{"sleepless", "sleepless"},
+		{"parker", "parker"},
+		{"gazette", "gazett"},
+		{"respecting", "respect"},
+		{"leaned", "lean"},
+		{"pleasanter", "pleasant"},
+		{"priest", "priest"},
+		{"helped", "help"},
+		{"compressed", "compress"},
+		{"lately", "late"},
+		{"beneficial", "benefici"},
+		{"obliterating", "obliter"},
+		{"achilles", "achil"},
+		{"poor", "poor"},
+		{"enables", "enabl"},
+		{"pressed", "press"},
+		{"boxes", "box"},
+		{"cabot", "cabot"},
+		{"marquette", "marquett"},
+		{"dusting", "dust"},
+		{"presume", "presum"},
+		{"rewarded", "reward"},
+		{"bodice", "bodic"},
+		{"intentions", "intent"},
+		{"annexation", "annex"},
+		{"print", "print"},
+		{"miniature", "miniatur"},
+		{"maroon", "maroon"},
+		{"tactical", "tactic"},
+		{"flattering", "flatter"},
+		{"secular", "secular"},
+		{"unshakable", "unshak"},
+		{"growled", "growl"},
+		{"head", "head"},
+		{"useful", "us"},
+		{"lord", "lord"},
+		{"trade", "trade"},
+		{"thou", "thou"},
+		{"murder", "murder"},
+		{"halves", "halv"},
+		{"convalescence", "convalesc"},
+		{"greek", "greek"},
+		{"peronei", "peronei"},
+		{"overhauled", "overhaul"},
+		{"takes", "take"},
+		{"governess", "gover"},
+		{"reopened", "reopen"},
+		{"regions", "region"},
+		{"prosperous", "prosper"},
+		{"abigail", "abigail"},
+		{"sovereign", "sovereign"},
+		{"stanton", "stanton"},
+		{"solutions", "solut"},
+		{"baby", "babi"},
+		{"angrily", "angrili"},
+		{"appeased", "appeas"},
+		{"ooh", "ooh"},
+		{"manner", "manner"},
+		{"heals", "heal"},
+		{"facies", "faci"},
+		{"aleppo", "aleppo"},
+		{"radiating", "radiat"},
+		{"nephew", "nephew"},
+		{"weighed", "weigh"},
+		{"finer", "finer"},
+		{"weight", "weight"},
+		{"angel", "angel"},
+		{"shouldering", "shoulder"},
+		{"permissible", "permiss"},
+		{"perception", "percept"},
+		{"grip", "grip"},
+		{"mount", "mount"},
+		{"lewis", "lewi"},
+		{"disdain", "disdain"},
+		{"message", "messag"},
+		{"city", "citi"},
+		{"coagulation", "coagul"},
+		{"parallel", "parallel"},
+		{"paused", "paus"},
+		{"suppurations", "suppur"},
+		{"and", "and"},
+		{"appropriation", "appropri"},
+		{"conducted", "conduct"},
+		{"tighter", "tighter"},
+		{"webbing", "web"},
+		{"utterance", "utter"},
+		{"hum", "hum"},
+		{"neighbouring", "neighbour"},
+		{"wheeled", "wheel"},
+		{"sings", "sing"},
+		{"recesses", "recess"},
+		{"digestion", "digest"},
+		{"flexion", "flexion"},
+		{"misty", "misti"},
+		{"fauces", "fauc"},
+		{"marion", "marion"},
+		{"lobulated", "lobul"},
+		{"dimples", "dimpl"},
+		{"playfully", "playfulli"},
+		{"flail", "flail"},
+		{"saxe", "sax"},
+		{"seeming", "seem"},
+		{"personally", "person"},
+		{"surplus", "surplu"},
+		{"necrosis", "necrosi"},
+		{"stretch", "stretch"},
+		{"broad", "broad"},
+		{"needful", "need"},
+		{"permits", "permit"},
+		{"nipple", "nippl"},
+		{"infinity", "infin"},
+		{"discreet", "discreet"},
+		{"eosinophile", "eosinophil"},
+		{"mortal", "mortal"},
+		{"protestants", "protest"},
+		{"shifted", "shift"},
+		{"rung", "rung"},
+		{"vagina", "vagina"},
+		{"kettle", "kettl"},
+		{"larrey", "larrei"},
+		{"condyles", "condyl"},
+		{"spasmodically", "spasmod"},
+		{"reproach", "reproach"},
+		{"betrayal", "betray"},
+		{"nice", "nice"},
+		{"balancing", "balanc"},
+		{"naught", "naught"},
+		{"eldest", "eldest"},
+		{"deviation", "deviat"},
+		{"witted", "wit"},
+		{"disasters", "disast"},
+		{"trusts", "trust"},
+		{"australia", "australia"},
+		{"watt", "watt"},
+		{"challenged", "challeng"},
+		{"vein", "vein"},
+		{"politic", "polit"},
+		{"drawers", "drawer"},
+		{"supper", "supper"},
+		{"available", "avail"},
+		{"parkman", "parkman"},
+		{"flap", "flap"},
+		{"recklinghausen", "recklinghausen"},
+		{"poisoned", "poison"},
+		{"thwaites", "thwait"},
+		{"attaches", "attach"},
+		{"avulsion", "avuls"},
+		{"urgent", "urgent"},
+		{"crumbling", "crumbl"},
+		{"writers", "writer"},
+		{"legion", "legion"},
+		{"exercising", "exercis"},
+		{"leads", "lead"},
+		{"authors", "author"},
+		{"nod", "nod"},
+		{"mineral", "miner"},
+		{"destined", "destin"},
+		{"clotting", "clot"},
+		{"resist", "resist"},
+		{"console", "consol"},
+		{"conditionally", "condition"},
+		{"sow", "sow"},
+		{"daddy", "daddi"},
+		{"tennis", "tenni"},
+		{"motley", "motlei"},
+		{"hazards", "hazard"},
+		{"flapped", "flap"},
+		{"remembered", "rememb"},
+		{"hampshire", "hampshir"},
+		{"commencement", "commenc"},
+		{"chains", "chain"},
+		{"announced", "announc"},
+		{"anarchy", "anarchi"},
+		{"cauterisation", "cauteris"},
+		{"slips", "slip"},
+		{"austerlitz", "austerlitz"},
+		{"wassermann", "wassermann"},
+		{"offices", "offic"},
+		{"finished", "finish"},
+		{"renewal", "renew"},
+		{"abrasion", "abras"},
+		{"ambitious", "ambiti"},
+		{"maybe", "mayb"},
+		{"insidious", "insidi"},
+		{"mammary", "mammari"},
+		{"decade", "decad"},
+		{"monmouth", "monmouth"},
+		{"qualification", "qualif"},
+		{"jingling", "jingl"},
+		{"taciturn", "taciturn"},
+		{"arbitrarily", "arbitrarili"},
+		{"embassy", "embassi"},
+		{"stormy", "stormi"},
+		{"germans", "german"},
+		{"aims", "aim"},
+		{"beck", "beck"},
+		{"attract", "attract"},
+		{"faced", "face"},
+		{"minority", "minor"},
+		{"noma", "noma"},
+		{"suggestive", "suggest"},
+		{"faith", "faith"},
+		{"mystery", "mysteri"},
+		{"enliven", "enliven"},
+		{"jack", "jack"},
+		{"bence", "benc"},
+		{"lobnoe", "lobno"},
+		{"velvety", "velveti"},
+		{"makar", "makar"},
+		{"sonorous", "sonor"},
+		{"manor", "manor"},
+		{"incessant", "incess"},
+		{"stein", "stein"},
+		{"space", "space"},
+		{"outwards", "outward"},
+		{"forwards", "forward"},
+		{"burst", "burst"},
+		{"hundredth", "hundredth"},
+		{"quick", "quick"},
+		{"staying", "stai"},
+		{"lash", "lash"},
+		{"criminals", "crimin"},
+		{"plaits", "plait"},
+		{"mammae", "mamma"},
+		{"researches", "research"},
+		{"elbows", "elbow"},
+		{"interstitial", "interstiti"},
+		{"imitation", "imit"},
+		{"rostovs", "rostov"},
+		{"irrecoverable", "irrecover"},
+		{"feeding", "feed"},
+		{"mention", "mention"},
+		{"educational", "educ"},
+		{"left", "left"},
+		{"monopoly", "monopoli"},
+		{"populists", "populist"},
+		{"bureau", "bureau"},
+		{"belliard", "belliard"},
+		{"seizing", "seiz"},
+		{"threaten", "threaten"},
+		{"yaroslavl", "yaroslavl"},
+		{"stupidity", "stupid"},
+		{"collections", "collect"},
+		{"island", "island"},
+		{"kitchens", "kitchen"},
+		{"perplexed", "perplex"},
+		{"crowd", "crowd"},
+		{"wrought", "wrought"},
+		{"novo", "novo"},
+		{"bugler", "bugler"},
+		{"guarantee", "guarante"},
+		{"scarring", "scar"},
+		{"herds", "herd"},
+		{"trait", "trait"},
+		{"stopped", "stop"},
+		{"merry", "merri"},
+		{"hurts", "hurt"},
+		{"substance", "substanc"},
+		{"greedily", "greedili"},
+		{"surveyed", "survei"},
+		{"publicity", "public"},
+		{"alike", "alik"},
+		{"remotest", "remotest"},
+		{"predominance", "predomin"},
+		{"winced", "winc"},
+		{"prince", "princ"},
+		{"positive", "posit"},
+		{"undercurrents", "undercurr"},
+		{"leaders", "leader"},
+		{"investments", "invest"},
+		{"normal", "normal"},
+		{"troubling", "troubl"},
+		{"clutched", "clutch"},
+		{"grimace", "grimac"},
+		{"yellowish", "yellowish"},
+		{"jested", "jest"},
+		{"preservation", "preserv"},
+		{"potemkin", "potemkin"},
+		{"sworn", "sworn"},
+		{"sleeve", "sleev"},
+		{"softly", "softli"},
+		{"patiently", "patient"},
+		{"justly", "justli"},
+		{"instance", "instanc"},
+		{"neutral", "neutral"},
+		{"ruffian", "ruffian"},
+		{"prosperity", "prosper"},
+		{"millionaires", "millionair"},
+		{"lactation", "lactat"},
+		{"perplexity", "perplex"},
+		{"lucky", "lucki"},
+		{"austrians", "austrian"},
+		{"fourths", "fourth"},
+		{"wood", "wood"},
+		{"inoperable", "inoper"},
+		{"sodden", "sodden"},
+		{"incoherently", "incoher"},
+		{"tres", "tre"},
+		{"subordinates", "subordin"},
+		{"harriet", "harriet"},
+		{"uncovered", "uncov"},
+		{"withered", "wither"},
+		{"industrial", "industri"},
+		{"tendon", "tendon"},
+		{"notes", "note"},
+		{"innocently", "innoc"},
+		{"idle", "idl"},
+		{"replaced", "replac"},
+		{"greenbacks", "greenback"},
+		{"breed", "breed"},
+		{"sewn", "sewn"},
+		{"ulcerated", "ulcer"},
+		{"asiatic", "asiat"},
+		{"annals", "annal"},
+		{"puffing", "puf"},
+		{"painful", "pain"},
+		{"gratefully", "gratefulli"},
+		{"mastered", "master"},
+		{"defective", "defect"},
+		{"dangling", "dangl"},
+		{"glad", "glad"},
+		{"nearing", "near"},
+		{"invalid", "invalid"},
+		{"noisy", "noisi"},
+		{"boundaries", "boundari"},
+		{"anaerobic", "anaerob"},
+		{"declare", "declar"},
+		{"woven", "woven"},
+		{"glasses", "glass"},
+		{"formation", "format"},
+		{"deserve", "deserv"},
+		{"restriction", "restrict"},
+		{"khvostikov", "khvostikov"},
+		{"choosing", "choos"},
+		{"intensified", "intensifi"},
+		{"milk", "milk"},
+		{"exercise", "exercis"},
+		{"ostrich", "ostrich"},
+		{"puritan", "puritan"},
+		{"hook", "hook"},
+		{"dmitrich", "dmitrich"},
+		{"rapt", "rapt"},
+		{"pondicherry", "pondicherri"},
+		{"midday", "middai"},
+		{"sewing", "sew"},
+		{"decreases", "decreas"},
+		{"xxviii", "xxviii"},
+		{"complains", "complain"},
+		{"than", "than"},
+		{"extension", "extens"},
+		{"palsy", "palsi"},
+		{"depths", "depth"},
+		{"tea", "tea"},
+		{"begins", "begin"},
+		{"relate", "relat"},
+		{"cellars", "cellar"},
+		{"chair", "chair"},
+		{"lung", "lung"},
+		{"pneumococcal", "pneumococc"},
+		{"searched", "search"},
+		{"saltykov", "saltykov"},
+		{"migrations", "migrat"},
+		{"mallet", "mallet"},
+		{"chernyshev", "chernyshev"},
+		{"sly", "sly"},
+		{"answered", "answer"},
+		{"taverns", "tavern"},
+		{"stoper", "stoper"},
+		{"thick", "thick"},
+		{"link", "link"},
+		{"width", "width"},
+		{"worldly", "worldli"},
+		{"cancer", "cancer"},
+		{"intuition", "intuit"},
+		{"enlarged", "enlarg"},
+		{"canal", "canal"},
+		{"willingness", "willing"},
+		{"empty", "empti"},
+		{"each", "each"},
+		{"nerve", "nerv"},
+		{"turns", "turn"},
+		{"flinging", "fling"},
+		{"diminution", "diminut"},
+		{"pregnant", "pregnant"},
+		{"shells", "shell"},
+		{"arthur", "arthur"},
+		{"reasoner", "reason"},
+		{"republicans", "republican"},
+		{"swiftly", "swiftli"},
+		{"profusely", "profus"},
+		{"purely", "pure"},
+		{"affected", "affect"},
+		{"tossed", "toss"},
+		{"definite", "definit"},
+		{"inflammable", "inflamm"},
+		{"dusk", "dusk"},
+		{"forbid", "forbid"},
+		{"protected", "protect"},
+		{"region", "region"},
+		{"illustrated", "illustr"},
+		{"crawl", "crawl"},
+		{"shaved", "shave"},
+		{"venous", "venou"},
+		{"rapp", "rapp"},
+		{"declined", "declin"},
+		{"sit", "sit"},
+		{"behaved", "behav"},
+		{"species", "speci"},
+		{"requiring", "requir"},
+		{"backed", "back"},
+		{"torture", "tortur"},
+		{"gathered", "gather"},
+		{"assure", "assur"},
+		{"plant", "plant"},
+		{"laborious", "labori"},
+		{"recruit", "recruit"},
+		{"distressing", "distress"},
+		{"partisanship", "partisanship"},
+		{"monica", "monica"},
+		{"laboring", "labor"},
+		{"dessaix", "dessaix"},
+		{"kiss", "kiss"},
+		{"nizhni", "nizhni"},
+		{"greeted", "greet"},
+		{"disputed", "disput"},
+		{"inflict", "inflict"},
+		{"retreat", "retreat"},
+		{"hindered", "hinder"},
+		{"upsetting", "upset"},
+		{"granules", "granul"},
+		{"numb", "numb"},
+		{"germany", "germani"},
+		{"only", "onli"},
+		{"privations", "privat"},
+		{"annually", "annual"},
+		{"restored", "restor"},
+		{"cry", "cry"},
+		{"invented", "invent"},
+		{"gonorrhoea", "gonorrhoea"},
+		{"diffused", "diffus"},
+		{"indubitably", "indubit"},
+		{"shawl", "shawl"},
+		{"institution", "institut"},
+		{"mytishchi", "mytishchi"},
+		{"conviction", "convict"},
+		{"copious", "copiou"},
+		{"doctrines", "doctrin"},
+		{"affections", "affect"},
+		{"brilliantly", "brilliantli"},
+		{"restlessness", "restless"},
+		{"tertiary", "tertiari"},
+		{"staple", "stapl"},
+		{"difference", "differ"},
+		{"apparition", "apparit"},
+		{"copernicus", "copernicu"},
+		{"loudly", "loudli"},
+		{"pair", "pair"},
+		{"conveying", "convei"},
+		{"faculty", "faculti"},
+		{"fiancee", "fiance"},
+		{"editions", "edit"},
+		{"lofty", "lofti"},
+		{"constable", "constabl"},
+		{"commendation", "commend"},
+		{"questionable", "question"},
+		{"eminences", "emin"},
+		{"posterity", "poster"},
+		{"calhoun", "calhoun"},
+		{"form", "form"},
+		{"exiled", "exil"},
+		{"multiplicity", "multipl"},
+		{"kobelnitz", "kobelnitz"},
+		{"almighty", "almighti"},
+		{"nut", "nut"},
+		{"slippered", "slipper"},
+		{"suffice", "suffic"},
+		{"gist", "gist"},
+		{"fully", "fulli"},
+		{"neuritis", "neuriti"},
+		{"desires", "desir"},
+		{"relative", "rel"},
+		{"statements", "statement"},
+		{"pulses", "puls"},
+		{"curling", "curl"},
+		{"pile", "pile"},
+		{"thanked", "thank"},
+		{"again", "again"},
+		{"administered", "administ"},
+		{"alliances", "allianc"},
+		{"complacently", "complac"},
+		{"wearied", "weari"},
+		{"widened", "widen"},
+		{"purchased", "purchas"},
+		{"chronic", "chronic"},
+		{"imminent", "immin"},
+		{"disapproving", "disapprov"},
+		{"devitalised", "devitalis"},
+		{"sicca", "sicca"},
+		{"coloured", "colour"},
+		{"peered", "peer"},
+		{"paterson", "paterson"},
+		{"bony", "boni"},
+		{"barrel", "barrel"},
+		{"blotches", "blotch"},
+		{"burdens", "burden"},
+		{"ties", "ti"},
+		{"base", "base"},
+		{"services", "servic"},
+		{"outbursts", "outburst"},
+		{"contra", "contra"},
+		{"dead", "dead"},
+		{"hearing", "hear"},
+		{"ticket", "ticket"},
+		{"abyss", "abyss"},
+		{"amorous", "amor"},
+		{"pursued", "pursu"},
+		{"brotherhood", "brotherhood"},
+		{"shelf", "shelf"},
+		{"cautery", "cauteri"},
+		{"awaiting", "await"},
+		{"alight", "alight"},
+		{"belongings", "belong"},
+		{"owe", "ow"},
+		{"harshly", "harshli"},
+		{"jet", "jet"},
+		{"beatific", "beatif"},
+		{"troublesome", "troublesom"},
+		{"buttoned", "button"},
+		{"stroking", "stroke"},
+		{"eruptions", "erupt"},
+		{"disregarded", "disregard"},
+		{"intelligent", "intellig"},
+		{"supreme", "suprem"},
+		{"authentic", "authent"},
+		{"commander", "command"},
+		{"shuffling", "shuffl"},
+		{"frugal", "frugal"},
+		{"factories", "factori"},
+		{"favoritism", "favorit"},
+		{"flourish", "flourish"},
+		{"hors", "hor"},
+		{"punishes", "punish"},
+		{"london", "london"},
+		{"redoubt", "redoubt"},
+		{"hyperostosis", "hyperostosi"},
+		{"preaching", "preach"},
+		{"purify", "purifi"},
+		{"disturbed", "disturb"},
+		{"express", "express"},
+		{"heiresses", "heiress"},
+		{"resourceful", "resourc"},
+		{"sensational", "sensat"},
+		{"blister", "blister"},
+		{"arnold", "arnold"},
+		{"vacancies", "vacanc"},
+		{"cast", "cast"},
+		{"strange", "strang"},
+		{"component", "compon"},
+		{"intense", "intens"},
+		{"alarm", "alarm"},
+		{"doing", "do"},
+		{"republic", "republ"},
+		{"callous", "callou"},
+		{"staid", "staid"},
+		{"inward", "inward"},
+		{"flexor", "flexor"},
+		{"bread", "bread"},
+		{"snuffles", "snuffl"},
+		{"respectable", "respect"},
+		{"ceiling", "ceil"},
+		{"respectively", "respect"},
+		{"seeker", "seeker"},
+		{"trampled", "trampl"},
+		{"indeed", "inde"},
+		{"cylinder", "cylind"},
+		{"evacuated", "evacu"},
+		{"bounded", "bound"},
+		{"teacher", "teacher"},
+		{"silenced", "silenc"},
+		{"lounged", "loung"},
+		{"improving", "improv"},
+		{"tammany", "tammani"},
+		{"sufficient", "suffici"},
+		{"retracted", "retract"},
+		{"liquefy", "liquefi"},
+		{"contracted", "contract"},
+		{"interjected", "interject"},
+		{"perfume", "perfum"},
+		{"humiliating", "humili"},
+		{"warmest", "warmest"},
+		{"waterloo", "waterloo"},
+		{"rallied", "ralli"},
+		{"punishing", "punish"},
+		{"sheds", "shed"},
+		{"cripple", "crippl"},
+		{"fluctuating", "fluctuat"},
+		{"restless", "restless"},
+		{"straightened", "straighten"},
+		{"kate", "kate"},
+		{"nominally", "nomin"},
+		{"chill", "chill"},
+		{"emitting", "emit"},
+		{"burgoyne", "burgoyn"},
+		{"mercantile", "mercantil"},
+		{"aggrandizement", "aggrandiz"},
+		{"cross", "cross"},
+		{"disapprove", "disapprov"},
+		{"mechanically", "mechan"},
+		{"surpassing", "surpass"},
+		{"degenerative", "degen"},
+		{"millions", "million"},
+		{"weaver", "weaver"},
+		{"parade", "parad"},
+		{"caulaincourt", "caulaincourt"},
+		{"plundered", "plunder"},
+		{"gained", "gain"},
+		{"advanced", "advanc"},
+		{"sonata", "sonata"},
+		{"unseen", "unseen"},
+		{"calls", "call"},
+		{"pronated", "pronat"},
+		{"screamed", "scream"},
+		{"protectorate", "protector"},
+		{"sticks", "stick"},
+		{"opposing", "oppos"},
+		{"amusements", "amus"},
+		{"benefit", "benefit"},
+		{"reappeared", "reappear"},
+		{"powdered", "powder"},
+		{"corresponding", "correspond"},
+		{"preamble", "preambl"},
+		{"hamilton", "hamilton"},
+		{"rheumatism", "rheumat"},
+		{"troyka", "troyka"},
+		{"hyaline", "hyalin"},
+		{"college", "colleg"},
+		{"torturing", "tortur"},
+		{"aggravated", "aggrav"},
+		{"strips", "strip"},
+		{"headdress", "headdress"},
+		{"parades", "parad"},
+		{"plank", "plank"},
+		{"reflect", "reflect"},
+		{"mitenka", "mitenka"},
+		{"scream", "scream"},
+		{"regulated", "regul"},
+		{"constituted", "constitut"},
+		{"watered", "water"},
+		{"relieving", "reliev"},
+		{"fetching", "fetch"},
+		{"exasperated", "exasper"},
+		{"contains", "contain"},
+		{"north", "north"},
+		{"standing", "stand"},
+		{"whim", "whim"},
+		{"lawless", "lawless"},
+		{"weapons", "weapon"},
+		{"admire", "admir"},
+		{"comparative", "compar"},
+		{"already", "alreadi"},
+		{"pen", "pen"},
+		{"cabin", "cabin"},
+		{"primitive", "primit"},
+		{"approximated", "approxim"},
+		{"roots", "root"},
+		{"level", "level"},
+		{"aspirations", "aspir"},
+		{"confederates", "confeder"},
+		{"delighted", "delight"},
+		{"scrutinized", "scrutin"},
+		{"commerce", "commerc"},
+		{"lymph", "lymph"},
+		{"buttocks", "buttock"},
+		{"chatham", "chatham"},
+		{"supporters", "support"},
+		{"did", "did"},
+		{"characters", "charact"},
+		{"culminated", "culmin"},
+		{"outline", "outlin"},
+		{"harbors", "harbor"},
+		{"original", "origin"},
+		{"temporarily", "temporarili"},
+		{"chondral", "chondral"},
+		{"sclerosis", "sclerosi"},
+		{"torment", "torment"},
+		{"linking", "link"},
+		{"strokes", "stroke"},
+		{"crisp", "crisp"},
+		{"ranks", "rank"},
+		{"gallop", "gallop"},
+		{"ulceration", "ulcer"},
+		{"villa", "villa"},
+		{"clasped", "clasp"},
+		{"confused", "confus"},
+		{"dancing", "danc"},
+		{"wolves", "wolv"},
+		{"departing", "depart"},
+		{"while", "while"},
+		{"lectures", "lectur"},
+		{"drooped", "droop"},
+		{"veterans", "veteran"},
+		{"torments", "torment"},
+		{"stalls", "stall"},
+		{"permission", "permiss"},
+		{"posture", "postur"},
+		{"boracic", "borac"},
+		{"staffs", "staff"},
+		{"seem", "seem"},
+		{"raining", "rain"},
+		{"animation", "anim"},
+		{"kitten", "kitten"},
+		{"decorum", "decorum"},
+		{"imagining", "imagin"},
+		{"offensive", "offens"},
+		{"affection", "affect"},
+		{"telyanin", "telyanin"},
+		{"fasten", "fasten"},
+		{"undress", "undress"},
+		{"scanned", "scan"},
+		{"tory", "tori"},
+		{"advantage", "advantag"},
+		{"collectively", "collect"},
+		{"seats", "seat"},
+		{"factors", "factor"},
+		{"exhibited", "exhibit"},
+		{"follow", "follow"},
+		{"oil", "oil"},
+		{"ally", "alli"},
+		{"boyish", "boyish"},
+		{"dispositions", "disposit"},
+		{"dearest", "dearest"},
+		{"irresolutely", "irresolut"},
+		{"mature", "matur"},
+		{"assembly", "assembl"},
+		{"ugly", "ugli"},
+		{"knitting", "knit"},
+		{"zdrzhinski", "zdrzhinski"},
+		{"statesmen", "statesmen"},
+		{"centralization", "central"},
+		{"neurolemma", "neurolemma"},
+		{"succeeds", "succe"},
+		{"swing", "swing"},
+		{"granting", "grant"},
+		{"statement", "statement"},
+		{"duly", "duli"},
+		{"less", "less"},
+		{"bluff", "bluff"},
+		{"plows", "plow"},
+		{"ordering", "order"},
+		{"constructive", "construct"},
+		{"mastication", "mastic"},
+		{"indicated", "indic"},
+		{"remedy", "remedi"},
+		{"rulers", "ruler"},
+		{"arises", "aris"},
+		{"wink", "wink"},
+		{"conqueror", "conqueror"},
+		{"billions", "billion"},
+		{"dragged", "drag"},
+		{"journal", "journal"},
+		{"guardsmen", "guardsmen"},
+		{"potsdam", "potsdam"},
+		{"cherry", "cherri"},
+		{"disgruntled", "disgruntl"},
+		{"sturdy", "sturdi"},
+		{"applause", "applaus"},
+		{"muddles", "muddl"},
+		{"attacks", "attack"},
+		{"occurrences", "occurr"},
+		{"equipped", "equip"},
+		{"rogue", "rogu"},
+		{"fearing", "fear"},
+		{"boris", "bori"},
+		{"fedya", "fedya"},
+		{"superiority", "superior"},
+		{"trim", "trim"},
+		{"independently", "independ"},
+		{"tap", "tap"},
+		{"rent", "rent"},
+		{"india", "india"},
+		{"afresh", "afresh"},
+		{"disinfecting", "disinfect"},
+		{"lelorgne", "lelorgn"},
+		{"apron", "apron"},
+		{"replying", "repli"},
+		{"rents", "rent"},
+		{"mouthed", "mouth"},
+		{"exceedingly", "exceedingli"},
+		{"hailed", "hail"},
+		{"massaged", "massag"},
+		{"explain", "explain"},
+		{"prepare", "prepar"},
+		{"mediterranean", "mediterranean"},
+		{"downstairs", "downstair"},
+		{"undergoes", "undergo"},
+		{"onerous", "oner"},
+		{"behind", "behind"},
+		{"turned", "turn"},
+		{"mushrooms", "mushroom"},
+		{"implored", "implor"},
+		{"dram", "dram"},
+		{"discoloured", "discolour"},
+		{"samoan", "samoan"},
+		{"ulster", "ulster"},
+		{"fancy", "fanci"},
+		{"backwater", "backwat"},
+		{"consistently", "consist"},
+		{"reliable", "reliabl"},
+		{"irritating", "irrit"},
+		{"knife", "knife"},
+		{"lingered", "linger"},
+		{"sabers", "saber"},
+		{"palpitating", "palpit"},
+		{"mournfully", "mournfulli"},
+		{"deftly", "deftli"},
+		{"pensive", "pensiv"},
+		{"retraction", "retract"},
+		{"clump", "clump"},
+		{"fibroid", "fibroid"},
+		{"important", "import"},
+		{"hats", "hat"},
+		{"swayed", "swai"},
+		{"buren", "buren"},
+		{"doffed", "dof"},
+		{"shouldn", "shouldn"},
+		{"shamefaced", "shamefac"},
+		{"stagecoach", "stagecoach"},
+		{"scale", "scale"},
+		{"slanting", "slant"},
+		{"crash", "crash"},
+		{"shreds", "shred"},
+		{"bow", "bow"},
+		{"overgrown", "overgrown"},
+		{"variations", "variat"},
+		{"falsity", "falsiti"},
+		{"thud", "thud"},
+		{"summon", "summon"},
+		{"intra", "intra"},
+		{"vacancy", "vacanc"},
+		{"abundant", "abund"},
+		{"contemplated", "contempl"},
+		{"disconcerted", "disconcert"},
+		{"soothe", "sooth"},
+		{"dilate", "dilat"},
+		{"alice", "alic"},
+		{"crossly", "crossli"},
+		{"emerson", "emerson"},
+		{"illegitimate", "illegitim"},
+		{"lace", "lace"},
+		{"patent", "patent"},
+		{"drops", "drop"},
+		{"diluted", "dilut"},
+		{"effects", "effect"},
+		{"declaring", "declar"},
+		{"tuberculin", "tuberculin"},
+		{"electric", "electr"},
+		{"tourniquet", "tourniquet"},
+		{"secondary", "secondari"},
+		{"disposed", "dispos"},
+		{"lock", "lock"},
+		{"digit", "digit"},
+		{"saber", "saber"},
+		{"complained", "complain"},
+		{"disablement", "disabl"},
+		{"wens", "wen"},
+		{"debauchery", "debaucheri"},
+		{"nourish", "nourish"},
+		{"corpse", "corps"},
+		{"stooped", "stoop"},
+		{"dissatisfaction", "dissatisfact"},
+		{"macewen", "macewen"},
+		{"thigh", "thigh"},
+		{"uttered", "utter"},
+		{"tones", "tone"},
+		{"shots", "shot"},
+		{"contact", "contact"},
+		{"thirdly", "thirdli"},
+		{"cromwell", "cromwel"},
+		{"twitching", "twitch"},
+		{"suppurate", "suppur"},
+		{"promising", "promis"},
+		{"verge", "verg"},
+		{"suspended", "suspend"},
+		{"odontoma", "odontoma"},
+		{"rearranging", "rearrang"},
+		{"harder", "harder"},
+		{"coughing", "cough"},
+		{"isn", "isn"},
+		{"gonorrhoeal", "gonorrho"},
+		{"branch", "branch"},
+		{"after", "after"},
+		{"obstacles", "obstacl"},
+		{"violations", "violat"},
+		{"rejection", "reject"},
+		{"waved", "wave"},
+		{"tried", "tri"},
+		{"foster", "foster"},
+		{"aglow", "aglow"},
+		{"sighs", "sigh"},
+		{"creature", "creatur"},
+		{"addressed", "address"},
+		{"stockholders", "stockhold"},
+		{"imminence", "immin"},
+		{"infect", "infect"},
+		{"eat", "eat"},
+		{"allotted", "allot"},
+		{"tucked", "tuck"},
+		{"wishing", "wish"},
+		{"persist", "persist"},
+		{"fortification", "fortif"},
+		{"english", "english"},
+		{"muscularly", "muscularli"},
+		{"southampton", "southampton"},
+		{"kirsten", "kirsten"},
+		{"sloughy", "sloughi"},
+		{"notice", "notic"},
+		{"administrations", "administr"},
+		{"boiler", "boiler"},
+		{"postmaster", "postmast"},
+		{"saving", "save"},
+		{"pose", "pose"},
+		{"flexing", "flex"},
+		{"getting", "get"},
+		{"austria", "austria"},
+		{"diagnosis", "diagnosi"},
+		{"name", "name"},
+		{"fractured", "fractur"},
+		{"bullae", "bulla"},
+		{"faction", "faction"},
+		{"paul", "paul"},
+		{"gin", "gin"},
+		{"rhabdomyoma", "rhabdomyoma"},
+		{"unworthy", "unworthi"},
+		{"fly", "fly"},
+		{"accurately", "accur"},
+		{"too", "too"},
+		{"braunau", "braunau"},
+		{"roughly", "roughli"},
+		{"alert", "alert"},
+		{"principle", "principl"},
+		{"dividing", "divid"},
+		{"neck", "neck"},
+		{"cannon", "cannon"},
+		{"sloughing", "slough"},
+		{"gummata", "gummata"},
+		{"murat", "murat"},
+		{"majestic", "majest"},
+		{"plays", "plai"},
+		{"coalesce", "coalesc"},
+		{"caleche", "calech"},
+		{"development", "develop"},
+		{"bogdanovna", "bogdanovna"},
+		{"expulsion", "expuls"},
+		{"smoothing", "smooth"},
+		{"servitude", "servitud"},
+		{"tongues", "tongu"},
+		{"peripheral", "peripher"},
+		{"beyond", "beyond"},
+		{"temple", "templ"},
+		{"ingenious", "ingeni"},
+		{"beads", "bead"},
+		{"meshes", "mesh"},
+		{"terror", "terror"},
+		{"boundless", "boundless"},
+		{"attack", "attack"},
+		{"cutaneous", "cutan"},
+		{"out", "out"},
+		{"intact", "intact"},
+		{"nasty", "nasti"},
+		{"forlorn", "forlorn"},
+		{"grumbling", "grumbl"},
+		{"murphy", "murphi"},
+		{"beginnings", "begin"},
+		{"ceremony", "ceremoni"},
+		{"amid", "amid"},
+		{"scapula", "scapula"},
+		{"sufficiently", "suffici"},
+		{"resounding", "resound"},
+		{"distinctions", "distinct"},
+		{"politely", "polit"},
+		{"greeley", "greelei"},
+		{"register", "regist"},
+		{"wear", "wear"},
+		{"merge", "merg"},
+		{"trip", "trip"},
+		{"recurrence", "recurr"},
+		{"cone", "cone"},
+		{"wide", "wide"},
+		{"precautions", "precaut"},
+		{"ossifying", "ossifi"},
+		{"schoolmaster", "schoolmast"},
+		{"leaning", "lean"},
+		{"laurels", "laurel"},
+		{"design", "design"},
+		{"bivouacking", "bivouack"},
+		{"rude", "rude"},
+		{"hence", "henc"},
+		{"ferapontov", "ferapontov"},
+		{"entrusted", "entrust"},
+		{"replaces", "replac"},
+		{"beekeeper", "beekeep"},
+		{"infinitely", "infinit"},
+		{"never", "never"},
+		{"ganglionic", "ganglion"},
+		{"bedclothes", "bedcloth"},
+		{"unexpectedly", "unexpectedli"},
+		{"quakers", "quaker"},
+		{"slipper", "slipper"},
+		{"reassure", "reassur"},
+		{"submission", "submiss"},
+		{"issue", "issu"},
+		{"poupart", "poupart"},
+		{"contagiousness", "contagi"},
+		{"money", "monei"},
+		{"charming", "charm"},
+		{"purification", "purif"},
+		{"kocher", "kocher"},
+		{"therapeutic", "therapeut"},
+		{"destruction", "destruct"},
+		{"symptoms", "symptom"},
+		{"attic", "attic"},
+		{"hosts", "host"},
+		{"rapidity", "rapid"},
+		{"cousin", "cousin"},
+		{"macerated", "macer"},
+		{"involves", "involv"},
+		{"counsel", "counsel"},
+		{"bundle", "bundl"},
+		{"promoted", "promot"},
+		{"rep", "rep"},
+		{"kaysarov", "kaysarov"},
+		{"settled", "settl"},
+		{"fearful", "fear"},
+		{"groundless", "groundless"},
+		{"predisposition", "predisposit"},
+		{"protecting", "protect"},
+		{"inwards", "inward"},
+		{"composition", "composit"},
+		{"encountering", "encount"},
+		{"dyspnoea", "dyspnoea"},
+		{"unite", "unit"},
+		{"sponsor", "sponsor"},
+		{"deduced", "deduc"},
+		{"party", "parti"},
+		{"naive", "naiv"},
+		{"deformities", "deform"},
+		{"inquiry", "inquiri"},
+		{"restoration", "restor"},
+		{"tracts", "tract"},
+		{"zachary", "zachari"},
+		{"sweeping", "sweep"},
+		{"bury", "buri"},
+		{"foe", "foe"},
+		{"disappointment", "disappoint"},
+		{"disinterested", "disinterest"},
+		{"adolescent", "adolesc"},
+		{"disagreeable", "disagre"},
+		{"impeded", "imped"},
+		{"instinctive", "instinct"},
+		{"pound", "pound"},
+		{"idea", "idea"},
+		{"pretended", "pretend"},
+		{"otradnoe", "otradno"},
+		{"postal", "postal"},
+		{"fortifications", "fortif"},
+		{"cyanosis", "cyanosi"},
+		{"ourselves", "ourselv"},
+		{"maintains", "maintain"},
+		{"trying", "try"},
+		{"circumscribed", "circumscrib"},
+		{"keloid", "keloid"},
+		{"pennies", "penni"},
+		{"sterile", "steril"},
+		{"buckled", "buckl"},
+		{"arrests", "arrest"},
+		{"belligerent", "belliger"},
+		{"guided", "guid"},
+		{"vincent", "vincent"},
+		{"inquisitive", "inquisit"},
+		{"stoned", "stone"},
+		{"peaceably", "peaceabl"},
+		{"faire", "fair"},
+		{"der", "der"},
+		{"firing", "fire"},
+		{"remembering", "rememb"},
+		{"stitch", "stitch"},
+		{"symmetrical", "symmetr"},
+		{"benedict", "benedict"},
+		{"raid", "raid"},
+		{"snow", "snow"},
+		{"temper", "temper"},
+		{"fatigue", "fatigu"},
+		{"nodules", "nodul"},
+		{"closed", "close"},
+		{"prayer", "prayer"},
+		{"galvanic", "galvan"},
+		{"finding", "find"},
+		{"groomed", "groom"},
+		{"notably", "notabl"},
+		{"thicket", "thicket"},
+		{"lint", "lint"},
+		{"thirtieth", "thirtieth"},
+		{"pulmonary", "pulmonari"},
+		{"celebrate", "celebr"},
+		{"antiseptic", "antisept"},
+		{"uncommon", "uncommon"},
+		{"clearings", "clear"},
+		{"banquet", "banquet"},
+		{"implicates", "implic"},
+		{"folded", "fold"},
+		{"wish", "wish"},
+		{"ivanushka", "ivanushka"},
+		{"delightedly", "delightedli"},
+		{"engine", "engin"},
+		{"herself", "herself"},
+		{"dependence", "depend"},
+		{"possess", "possess"},
+		{"polk", "polk"},
+		{"indisposition", "indisposit"},
+		{"retaining", "retain"},
+		{"ramballe", "rambal"},
+		{"deposit", "deposit"},
+		{"atropin", "atropin"},
+		{"recognizing", "recogn"},
+		{"biography", "biographi"},
+		{"bowdoin", "bowdoin"},
+		{"relapse", "relaps"},
+		{"sex", "sex"},
+		{"repeal", "repeal"},
+		{"organs", "organ"},
+		{"exacerbations", "exacerb"},
+		{"baritone", "bariton"},
+		{"antitoxic", "antitox"},
+		{"attainment", "attain"},
+		{"personages", "personag"},
+		{"eleventh", "eleventh"},
+		{"mothers", "mother"},
+		{"payment", "payment"},
+		{"bishop", "bishop"},
+		{"lovers", "lover"},
+		{"tutolmin", "tutolmin"},
+		{"initial", "initi"},
+		{"located", "locat"},
+		{"dare", "dare"},
+		{"recovering", "recov"},
+		{"rook", "rook"},
+		{"fingering", "finger"},
+		{"guessed", "guess"},
+		{"vitality", "vital"},
+		{"cranial", "cranial"},
+		{"disarmed", "disarm"},
+		{"subjection", "subject"},
+		{"fact", "fact"},
+		{"connecticut", "connecticut"},
+		{"indulged", "indulg"},
+		{"tete", "tete"},
+		{"lanterns", "lantern"},
+		{"married", "marri"},
+		{"analogy", "analog"},
+		{"nurses", "nurs"},
+		{"funding", "fund"},
+		{"wisconsin", "wisconsin"},
+		{"mole", "mole"},
+		{"loyal", "loyal"},
+		{"reduce", "reduc"},
+		{"things", "thing"},
+		{"affects", "affect"},
+		{"background", "background"},
+		{"curse", "curs"},
+		{"tapers", "taper"},
+		{"eating", "eat"},
+		{"twas", "twa"},
+		{"munching", "munch"},
+		{"laughing", "laugh"},
+		{"scorbutic", "scorbut"},
+		{"responded", "respond"},
+		{"monarchs", "monarch"},
+		{"hound", "hound"},
+		{"purses", "purs"},
+		{"digitorum", "digitorum"},
+		{"team", "team"},
+		{"coward", "coward"},
+		{"granulations", "granul"},
+		{"elective", "elect"},
+		{"downhill", "downhil"},
+		{"forci", "forci"},
+		{"industrious", "industri"},
+		{"depended", "depend"},
+		{"scarce", "scarc"},
+		{"hit", "hit"},
+		{"intermediary", "intermediari"},
+		{"wool", "wool"},
+		{"dams", "dam"},
+		{"pamphlet", "pamphlet"},
+		{"fashion", "fashion"},
+		{"procured", "procur"},
+		{"few", "few"},
+		{"greyish", "greyish"},
+		{"butt", "butt"},
+		{"stables", "stabl"},
+		{"flies", "fli"},
+		{"celled", "cell"},
+		{"supervision", "supervis"},
+		{"fiske", "fisk"},
+		{"collapsing", "collaps"},
+		{"construed", "constru"},
+		{"preserves", "preserv"},
+		{"vergennes", "vergenn"},
+		{"caseation", "caseat"},
+		{"lasted", "last"},
+		{"deliberately", "deliber"},
+		{"uncle", "uncl"},
+		{"intimate", "intim"},
+		{"tin", "tin"},
+		{"carriers", "carrier"},
+		{"metivier", "metivi"},
+		{"autocratic", "autocrat"},
+		{"champagne", "champagn"},
+		{"liked", "like"},
+		{"blindfolded", "blindfold"},
+		{"lovely", "love"},
+		{"insignificance", "insignific"},
+		{"kolyazin", "kolyazin"},
+		{"digging", "dig"},
+		{"pick", "pick"},
+		{"withheld", "withheld"},
+		{"encamped", "encamp"},
+		{"hardly", "hardli"},
+		{"syllable", "syllabl"},
+		{"effecting", "effect"},
+		{"currents", "current"},
+		{"transmission", "transmiss"},
+		{"prohibited", "prohibit"},
+		{"besides", "besid"},
+		{"artificiality", "artifici"},
+		{"hopelessly", "hopelessli"},
+		{"resection", "resect"},
+		{"armistice", "armistic"},
+		{"parted", "part"},
+		{"singly", "singli"},
+		{"spurred", "spur"},
+		{"lesser", "lesser"},
+		{"senate", "senat"},
+		{"syncope", "syncop"},
+		{"radical", "radic"},
+		{"registered", "regist"},
+		{"prescribe", "prescrib"},
+		{"frankness", "frank"},
+		{"decrepit", "decrepit"},
+		{"instantly", "instantli"},
+		{"popularity", "popular"},
+		{"retains", "retain"},
+		{"fairly", "fairli"},
+		{"pollen", "pollen"},
+		{"seeing", "see"},
+		{"elevate", "elev"},
+		{"napoleon", "napoleon"},
+		{"inconsistent", "inconsist"},
+		{"cultivation", "cultiv"},
+		{"albany", "albani"},
+		{"meaning", "mean"},
+		{"empyema", "empyema"},
+		{"accounts", "account"},
+		{"enumerate", "enumer"},
+		{"contributing", "contribut"},
+		{"resists", "resist"},
+		{"clue", "clue"},
+		{"companions", "companion"},
+		{"footmarks", "footmark"},
+		{"resecting", "resect"},
+		{"passed", "pass"},
+		{"spence", "spenc"},
+		{"discernible", "discern"},
+		{"expressions", "express"},
+		{"troops", "troop"},
+		{"mumbling", "mumbl"},
+		{"account", "account"},
+		{"proprietary", "proprietari"},
+		{"cloacae", "cloaca"},
+		{"void", "void"},
+		{"remedied", "remedi"},
+		{"wednesday", "wednesdai"},
+		{"displayed", "displai"},
+		{"dangerous", "danger"},
+		{"prosecute", "prosecut"},
+		{"alexeevich", "alexeevich"},
+		{"calmer", "calmer"},
+		{"birch", "birch"},
+		{"clever", "clever"},
+		{"caustic", "caustic"},
+		{"sphinx", "sphinx"},
+		{"inhabitant", "inhabit"},
+		{"undressed", "undress"},
+		{"violently", "violent"},
+		{"rolling", "roll"},
+		{"erection", "erect"},
+		{"muscovites", "muscovit"},
+		{"biceps", "bicep"},
+		{"rocky", "rocki"},
+		{"smiled", "smile"},
+		{"received", "receiv"},
+		{"embrace", "embrac"},
+		{"awaken", "awaken"},
+		{"colonies", "coloni"},
+		{"insensitive", "insensit"},
+		{"inflammatory", "inflammatori"},
+		{"slower", "slower"},
+		{"undaunted", "undaunt"},
+		{"green", "green"},
+		{"saline", "salin"},
+		{"implicating", "implic"},
+		{"waist", "waist"},
+		{"born", "born"},
+		{"place", "place"},
+		{"carelessness", "careless"},
+		{"vessels", "vessel"},
+		{"auntie", "aunti"},
+		{"confidence", "confid"},
+		{"twofold", "twofold"},
+		{"unwell", "unwel"},
+		{"aptly", "aptli"},
+		{"naked", "nake"},
+		{"indispensable", "indispens"},
+		{"card", "card"},
+		{"despised", "despis"},
+		{"shillings", "shill"},
+		{"seneca", "seneca"},
+		{"fullness", "full"},
+		{"nathaniel", "nathaniel"},
+		{"vengeance", "vengeanc"},
+		{"uneven", "uneven"},
+		{"might", "might"},
+		{"group", "group"},
+		{"affirmation", "affirm"},
+		{"cheer", "cheer"},
+		{"spicules", "spicul"},
+		{"proliferated", "prolifer"},
+		{"considers", "consid"},
+		{"slapping", "slap"},
+		{"prolongations", "prolong"},
+		{"stare", "stare"},
+		{"bismuth", "bismuth"},
+		{"guardian", "guardian"},
+		{"avail", "avail"},
+		{"commanded", "command"},
+		{"exploits", "exploit"},
+		{"think", "think"},
+		{"membranosus", "membranosu"},
+		{"pigment", "pigment"},
+		{"previously", "previous"},
+		{"hung", "hung"},
+		{"loosed", "loos"},
+		{"socialists", "socialist"},
+		{"serpent", "serpent"},
+		{"louisville", "louisvil"},
+		{"emaciated", "emaci"},
+		{"carriage", "carriag"},
+		{"morrow", "morrow"},
+		{"rejoiced", "rejoic"},
+		{"embolic", "embol"},
+		{"rug", "rug"},
+		{"relates", "relat"},
+		{"answer", "answer"},
+		{"trunk", "trunk"},
+		{"assigned", "assign"},
+		{"disappearance", "disappear"},
+		{"asepticity", "asept"},
+		{"rabid", "rabid"},
+		{"slippery", "slipperi"},
+		{"crural", "crural"},
+		{"biniodide", "biniodid"},
+		{"repnin", "repnin"},
+		{"mimicked", "mimick"},
+		{"temperature", "temperatur"},
+		{"uvarov", "uvarov"},
+		{"entered", "enter"},
+		{"polished", "polish"},
+		{"precursor", "precursor"},
+		{"observation", "observ"},
+		{"piteous", "piteou"},
+		{"ivanych", "ivanych"},
+		{"halls", "hall"},
+		{"elevated", "elev"},
+		{"calling", "call"},
+		{"interposed", "interpos"},
+		{"urates", "urat"},
+		{"yawn", "yawn"},
+		{"frown", "frown"},
+		{"lodges", "lodg"},
+		{"subcutaneous", "subcutan"},
+		{"stationed", "station"},
+		{"pardon", "pardon"},
+		{"barefooted", "barefoot"},
+		{"explanations", "explan"},
+		{"figures", "figur"},
+		{"colombia", "colombia"},
+		{"crops", "crop"},
+		{"superiors", "superior"},
+		{"diabetes", "diabet"},
+		{"tubulo", "tubulo"},
+		{"vividness", "vivid"},
+		{"director", "director"},
+		{"seller", "seller"},
+		{"greet", "greet"},
+		{"drastic", "drastic"},
+		{"affirm", "affirm"},
+		{"contingent", "conting"},
+		{"lasts", "last"},
+		{"overgrowth", "overgrowth"},
+		{"formations", "format"},
+		{"saying", "sai"},
+		{"penalties", "penalti"},
+		{"tilled", "till"},
+		{"survive", "surviv"},
+		{"between", "between"},
+		{"practice", "practic"},
+		{"permitted", "permit"},
+		{"taking", "take"},
+		{"emerging", "emerg"},
+		{"vagus", "vagu"},
+		{"devil", "devil"},
+		{"seniority", "senior"},
+		{"frightening", "frighten"},
+		{"plastic", "plastic"},
+		{"convention", "convent"},
+		{"sobbing", "sob"},
+		{"happily", "happili"},
+		{"philadelphia", "philadelphia"},
+		{"shipbuilding", "shipbuild"},
+		{"suppressed", "suppress"},
+		{"congressional", "congression"},
+		{"deem", "deem"},
+		{"imbued", "imbu"},
+		{"motionless", "motionless"},
+		{"caseated", "caseat"},
+		{"disfiguring", "disfigur"},
+		{"alexandre", "alexandr"},
+		{"horizontal", "horizont"},
+		{"portfolio", "portfolio"},
+		{"refined", "refin"},
+		{"found", "found"},
+		{"news", "new"},
+		{"amazed", "amaz"},
+		{"agrarian", "agrarian"},
+		{"moss", "moss"},
+		{"temperatures", "temperatur"},
+		{"sad", "sad"},
+		{"favoured", "favour"},
+		{"proposal", "propos"},
+		{"strangely", "strang"},
+		{"discerned", "discern"},
+		{"favored", "favor"},
+		{"forestall", "forestal"},
+		{"loading", "load"},
+		{"preceding", "preced"},
+		{"trail", "trail"},
+		{"participant", "particip"},
+		{"stuff", "stuff"},
+		{"lived", "live"},
+		{"semenov", "semenov"},
+		{"catiche", "catich"},
+		{"suggestion", "suggest"},
+		{"fearlessly", "fearlessli"},
+		{"gifted", "gift"},
+		{"capitally", "capit"},
+		{"beeches", "beech"},
+		{"produces", "produc"},
+		{"street", "street"},
+		{"jeopardy", "jeopardi"},
+		{"irrigation", "irrig"},
+		{"narrowed", "narrow"},
+		{"absolved", "absolv"},
+		{"fated", "fate"},
+		{"dared", "dare"},
+		{"council", "council"},
+		{"coachman", "coachman"},
+		{"prearranged", "prearrang"},
+		{"submissively", "submiss"},
+		{"sessile", "sessil"},
+		{"passionately", "passion"},
+		{"socialist", "socialist"},
+		{"entrenched", "entrench"},
+		{"nations", "nation"},
+		{"dispatches", "dispatch"},
+		{"depending", "depend"},
+		{"inform", "inform"},
+		{"beecher", "beecher"},
+		{"premises", "premis"},
+		{"shrieked", "shriek"},
+		{"walling", "wall"},
+		{"beardless", "beardless"},
+		{"pitt", "pitt"},
+		{"courtesy", "courtesi"},
+		{"delusion", "delus"},
+		{"envious", "enviou"},
+		{"consult", "consult"},
+		{"rhode", "rhode"},
+		{"like", "like"},
+		{"efficiency", "effici"},
+		{"mutter", "mutter"},
+		{"field", "field"},
+		{"elope", "elop"},
+		{"restricted", "restrict"},
+		{"literary", "literari"},
+		{"andros", "andro"},
+		{"gluteal", "gluteal"},
+		{"bacillary", "bacillari"},
+		{"savings", "save"},
+		{"franz", "franz"},
+		{"excuse", "excus"},
+		{"conjunctiva", "conjunctiva"},
+		{"reversed", "revers"},
+		{"striven", "striven"},
+		{"piled", "pile"},
+		{"vexatious", "vexati"},
+		{"requested", "request"},
+		{"susceptible", "suscept"},
+		{"sprang", "sprang"},
+		{"isa", "isa"},
+		{"approximate", "approxim"},
+		{"glanced", "glanc"},
+		{"ants", "ant"},
+		{"hodgkin", "hodgkin"},
+		{"mob", "mob"},
+		{"foresight", "foresight"},
+		{"smart", "smart"},
+		{"topic", "topic"},
+		{"slavery", "slaveri"},
+		{"tuberculous", "tubercul"},
+		{"junctions", "junction"},
+		{"curved", "curv"},
+		{"upper", "upper"},
+		{"embryo", "embryo"},
+		{"magnitski", "magnitski"},
+		{"disclaimed", "disclaim"},
+		{"realizing", "realiz"},
+		{"murderer", "murder"},
+		{"foresees", "forese"},
+		{"appalachians", "appalachian"},
+		{"inviting", "invit"},
+		{"revelation", "revel"},
+		{"mcmaster", "mcmaster"},
+		{"banged", "bang"},
+		{"costume", "costum"},
+		{"mikhaylovna", "mikhaylovna"},
+		{"hotel", "hotel"},
+		{"innocent", "innoc"},
+		{"renew", "renew"},
+		{"ophthalmia", "ophthalmia"},
+		{"attire", "attir"},
+		{"leather", "leather"},
+		{"deposited", "deposit"},
+		{"papillary", "papillari"},
+		{"supra", "supra"},
+		{"sun", "sun"},
+		{"scuffle", "scuffl"},
+		{"continue", "continu"},
+		{"towels", "towel"},
+		{"improvement", "improv"},
+		{"plymouth", "plymouth"},
+		{"striking", "strike"},
+		{"transference", "transfer"},
+		{"publican", "publican"},
+		{"palate", "palat"},
+		{"magnitude", "magnitud"},
+		{"disposition", "disposit"},
+		{"deceive", "deceiv"},
+		{"abraham", "abraham"},
+		{"vibrating", "vibrat"},
+		{"denounced", "denounc"},
+		{"tubs", "tub"},
+		{"railroads", "railroad"},
+		{"shaving", "shave"},
+		{"solemn", "solemn"},
+		{"charge", "charg"},
+		{"moans", "moan"},
+		{"wind", "wind"},
+		{"sideways", "sidewai"},
+		{"prize", "prize"},
+		{"critics", "critic"},
+		{"pin", "pin"},
+		{"stuffy", "stuffi"},
+		{"museums", "museum"},
+		{"animals", "anim"},
+		{"schoolboy", "schoolboi"},
+		{"regards", "regard"},
+		{"treasury", "treasuri"},
+		{"folding", "fold"},
+		{"winchester", "winchest"},
+		{"obdurate", "obdur"},
+		{"estimating", "estim"},
+		{"apologies", "apolog"},
+		{"spaniards", "spaniard"},
+		{"cellulitis", "cellul"},
+		{"tenderness", "tender"},
+		{"reddened", "redden"},
+		{"nutrition", "nutrit"},
+		{"roans", "roan"},
+		{"balloon", "balloon"},
+		{"confluent", "confluent"},
+		{"everything", "everyth"},
+		{"zealously", "zealous"},
+		{"initials", "initi"},
+		{"electing", "elect"},
+		{"cavalry", "cavalri"},
+		{"applying", "appli"},
+		{"confederate", "confeder"},
+		{"singsong", "singsong"},
+		{"emancipation", "emancip"},
+		{"wants", "want"},
+		{"brodie", "brodi"},
+		{"corpuscles", "corpuscl"},
+		{"naevus", "naevu"},
+		{"glycosuria", "glycosuria"},
+		{"crusts", "crust"},
+		{"molluscum", "molluscum"},
+		{"actions", "action"},
+		{"staples", "stapl"},
+		{"potatoes", "potato"},
+		{"burns", "burn"},
+		{"fascination", "fascin"},
+		{"sleeping", "sleep"},
+		{"pultusk", "pultusk"},
+		{"category", "categori"},
+		{"condensation", "condens"},
+		{"resemblance", "resembl"},
+		{"asylums", "asylum"},
+		{"honour", "honour"},
+		{"elevation", "elev"},
+		{"roasting", "roast"},
+		{"compel", "compel"},
+		{"resentment", "resent"},
+		{"busily", "busili"},
+		{"channels", "channel"},
+		{"guest", "guest"},
+		{"career", "career"},
+		{"futility", "futil"},
+		{"anel", "anel"},
+		{"abominable", "abomin"},
+		{"gaol", "gaol"},
+		{"sweden", "sweden"},
+		{"intelligible", "intellig"},
+		{"infiltrated", "infiltr"},
+		{"sorrows", "sorrow"},
+		{"sober", "sober"},
+		{"atrocities", "atroc"},
+		{"outstripped", "outstrip"},
+		{"pneumococcus", "pneumococcu"},
+		{"splinter", "splinter"},
+		{"elated", "elat"},
+		{"bloodless", "bloodless"},
+		{"comments", "comment"},
+		{"schemes", "scheme"},
+		{"moreover", "moreov"},
+		{"plait", "plait"},
+		{"dwelling", "dwell"},
+		{"urban", "urban"},
+		{"rickets", "ricket"},
+		{"twitched", "twitch"},
+		{"coli", "coli"},
+		{"enthusiasm", "enthusiasm"},
+		{"porch", "porch"},
+		{"mastitis", "mastiti"},
+		{"relationship", "relationship"},
+		{"chagrined", "chagrin"},
+		{"chatter", "chatter"},
+		{"unchanging", "unchang"},
+		{"patriot", "patriot"},
+		{"heroic", "heroic"},
+		{"mercy", "merci"},
+		{"recompense", "recompens"},
+		{"scrotum", "scrotum"},
+		{"prediction", "predict"},
+		{"swabbing", "swab"},
+		{"crept", "crept"},
+		{"examples", "exampl"},
+		{"foraging", "forag"},
+		{"historic", "histor"},
+		{"stooping", "stoop"},
+		{"braddock", "braddock"},
+		{"sponsors", "sponsor"},
+		{"weakened", "weaken"},
+		{"controversies", "controversi"},
+		{"hinder", "hinder"},
+		{"finely", "fine"},
+		{"balcony", "balconi"},
+		{"pacing", "pace"},
+		{"mayo", "mayo"},
+		{"carotid", "carotid"},
+		{"coinage", "coinag"},
+		{"evaporating", "evapor"},
+		{"rich", "rich"},
+		{"crimes", "crime"},
+		{"physiological", "physiolog"},
+		{"paring", "pare"},
+		{"facilitate", "facilit"},
+		{"surely", "sure"},
+		{"logic", "logic"},
+		{"amputate", "amput"},
+		{"irrational", "irrat"},
+		{"breaks", "break"},
+		{"breathlessly", "breathlessli"},
+		{"odontomas", "odontoma"},
+		{"contented", "content"},
+		{"spiral", "spiral"},
+		{"glitter", "glitter"},
+		{"warty", "warti"},
+		{"sergeevich", "sergeevich"},
+		{"victory", "victori"},
+		{"thankful", "thank"},
+		{"shrewdly", "shrewdli"},
+		{"enghien", "enghien"},
+		{"frivolous", "frivol"},
+		{"wove", "wove"},
+		{"trained", "train"},
+		{"trophic", "trophic"},
+		{"inexplicable", "inexplic"},
+		{"remembrance", "remembr"},
+		{"whippers", "whipper"},
+		{"virtue", "virtu"},
+		{"specialist", "specialist"},
+		{"haul", "haul"},
+		{"excess", "excess"},
+		{"mortemart", "mortemart"},
+		{"immersed", "immers"},
+		{"unconcern", "unconcern"},
+		{"cyst", "cyst"},
+		{"accident", "accid"},
+		{"valet", "valet"},
+		{"des", "de"},
+		{"projection", "project"},
+		{"music", "music"},
+		{"deal", "deal"},
+		{"permanently", "perman"},
+		{"rontgen", "rontgen"},
+		{"heaps", "heap"},
+		{"naming", "name"},
+		{"spotted", "spot"},
+		{"leisurely", "leisur"},
+		{"mustaches", "mustach"},
+		{"exchanged", "exchang"},
+		{"farmhouse", "farmhous"},
+		{"closely", "close"},
+		{"inexhaustible", "inexhaust"},
+		{"mask", "mask"},
+		{"whisky", "whiski"},
+		{"wages", "wage"},
+		{"handling", "handl"},
+		{"sterilisation", "sterilis"},
+		{"warlike", "warlik"},
+		{"dreaded", "dread"},
+		{"awe", "aw"},
+		{"deposed", "depos"},
+		{"contemplate", "contempl"},
+		{"rewards", "reward"},
+		{"intervening", "interven"},
+		{"diamonds", "diamond"},
+		{"clad", "clad"},
+		{"untouched", "untouch"},
+		{"encephaloid", "encephaloid"},
+		{"clicked", "click"},
+		{"keeps", "keep"},
+		{"whew", "whew"},
+		{"histories", "histori"},
+		{"film", "film"},
+		{"flattered", "flatter"},
+		{"goods", "good"},
+		{"exclamations", "exclam"},
+		{"ended", "end"},
+		{"originating", "origin"},
+		{"glairy", "glairi"},
+		{"ranch", "ranch"},
+		{"snatched", "snatch"},
+		{"entails", "entail"},
+		{"seldom", "seldom"},
+		{"forges", "forg"},
+		{"must", "must"},
+		{"barn", "barn"},
+		{"paragraphs", "paragraph"},
+		{"hundred", "hundr"},
+		{"scarpa", "scarpa"},
+		{"award", "award"},
+		{"greater", "greater"},
+		{"confluence", "confluenc"},
+		{"honor", "honor"},
+		{"fourteenth", "fourteenth"},
+		{"assented", "assent"},
+		{"leeches", "leech"},
+		{"escaped", "escap"},
+		{"mysticism", "mystic"},
+		{"successive", "success"},
+		{"ratio", "ratio"},
+		{"contracture", "contractur"},
+		{"added", "ad"},
+		{"blundering", "blunder"},
+		{"endowed", "endow"},
+		{"alluded", "allud"},
+		{"darkness", "dark"},
+		{"impact", "impact"},
+		{"theoretical", "theoret"},
+		{"theodore", "theodor"},
+		{"foundations", "foundat"},
+		{"grasp", "grasp"},
+		{"dressings", "dress"},
+		{"bearing", "bear"},
+		{"plates", "plate"},
+		{"fancies", "fanci"},
+		{"leashes", "leash"},
+		{"hoped", "hope"},
+		{"epidermis", "epidermi"},
+		{"condemn", "condemn"},
+		{"classical", "classic"},
+		{"hawaiian", "hawaiian"},
+		{"somehow", "somehow"},
+		{"inherited", "inherit"},
+		{"forum", "forum"},
+		{"vigorous", "vigor"},
+		{"crouched", "crouch"},
+		{"ridicule", "ridicul"},
+		{"hospitals", "hospit"},
+		{"above", "abov"},
+		{"xxiv", "xxiv"},
+		{"shade", "shade"},
+		{"taney", "tanei"},
+		{"barbara", "barbara"},
+		{"curtains", "curtain"},
+		{"erected", "erect"},
+		{"raft", "raft"},
+		{"metropolitan", "metropolitan"},
+		{"comet", "comet"},
+		{"pledge", "pledg"},
+		{"dim", "dim"},
+		{"reared", "rear"},
+		{"etre", "etr"},
+		{"braces", "brace"},
+		{"majority", "major"},
+		{"overwhelmed", "overwhelm"},
+		{"congregations", "congreg"},
+		{"lid", "lid"},
+		{"loyally", "loyal"},
+		{"insistence", "insist"},
+		{"calculations", "calcul"},
+		{"inflation", "inflat"},
+		{"rider", "rider"},
+		{"pain", "pain"},
+		{"deaf", "deaf"},
+		{"loneliness", "loneli"},
+		{"simulating", "simul"},
+		{"favorable", "favor"},
+		{"onwards", "onward"},
+		{"champion", "champion"},
+		{"dances", "danc"},
+		{"adroitness", "adroit"},
+		{"embedded", "embed"},
+		{"regenerated", "regener"},
+		{"kirilych", "kirilych"},
+		{"poisonous", "poison"},
+		{"prosecutions", "prosecut"},
+		{"incidence", "incid"},
+		{"super", "super"},
+		{"ensuing", "ensu"},
+		{"she", "she"},
+		{"look", "look"},
+		{"boots", "boot"},
+		{"undefined", "undefin"},
+		{"risk", "risk"},
+		{"distribution", "distribut"},
+		{"dying", "dy"},
+		{"transfers", "transfer"},
+		{"undergo", "undergo"},
+		{"disfranchised", "disfranchis"},
+		{"bloodshed", "bloodsh"},
+		{"tedious", "tediou"},
+		{"consistent", "consist"},
+		{"passers", "passer"},
+		{"hinders", "hinder"},
+		{"adductor", "adductor"},
+		{"blooded", "blood"},
+		{"poker", "poker"},
+		{"soirees", "soire"},
+		{"naturedly", "naturedli"},
+		{"employing", "emploi"},
+		{"wet", "wet"},
+		{"said", "said"},
+		{"eggs", "egg"},
+		{"cornet", "cornet"},
+		{"borrowed", "borrow"},
+		{"unlimited", "unlimit"},
+		{"grief", "grief"},
+		{"wormwood", "wormwood"},
+		{"eaters", "eater"},
+		{"inter", "inter"},
+		{"drifted", "drift"},
+		{"aspen", "aspen"},
+		{"newton", "newton"},
+		{"cottage", "cottag"},
+		{"summary", "summari"},
+		{"consternation", "constern"},
+		{"amicable", "amic"},
+		{"worker", "worker"},
+		{"blinking", "blink"},
+		{"tightly", "tightli"},
+		{"restaurant", "restaur"},
+		{"treachery", "treacheri"},
+		{"sacrifice", "sacrific"},
+		{"die", "die"},
+		{"spending", "spend"},
+		{"haggard", "haggard"},
+		{"sympathy", "sympathi"},
+		{"martial", "martial"},
+		{"gates", "gate"},
+		{"symbol", "symbol"},
+		{"lies", "li"},
+		{"predecessor", "predecessor"},
+		{"thwee", "thwee"},
+		{"cock", "cock"},
+		{"seemingly", "seemingli"},
+		{"crepitation", "crepit"},
+		{"traces", "trace"},
+		{"traversed", "travers"},
+		{"evidences", "evid"},
+		{"substances", "substanc"},
+		{"managed", "manag"},
+		{"oriental", "orient"},
+		{"antechamber", "antechamb"},
+		{"diminishes", "diminish"},
+		{"stepped", "step"},
+		{"analytical", "analyt"},
+		{"dissection", "dissect"},
+		{"indicates", "indic"},
+		{"commodities", "commod"},
+		{"panel", "panel"},
+		{"feature", "featur"},
+		{"worry", "worri"},
+		{"ferdinand", "ferdinand"},
+		{"fragments", "fragment"},
+		{"stockings", "stock"},
+		{"trapezius", "trapeziu"},
+		{"deprives", "depriv"},
+		{"camelia", "camelia"},
+		{"beatson", "beatson"},
+		{"advertising", "advertis"},
+		{"mid", "mid"},
+		{"method", "method"},
+		{"injurious", "injuri"},
+		{"paddington", "paddington"},
+		{"oily", "oili"},
+		{"admirable", "admir"},
+		{"vindicated", "vindic"},
+		{"appease", "appeas"},
+		{"wineglass", "wineglass"},
+		{"gloves", "glove"},
+		{"capital", "capit"},
+		{"clink", "clink"},
+		{"bitterly", "bitterli"},
+		{"salvation", "salvat"},
+		{"teratoma", "teratoma"},
+		{"outdone", "outdon"},
+		{"several", "sever"},
+		{"wag", "wag"},
+		{"visible", "visibl"},
+		{"bass", "bass"},
+		{"heartily", "heartili"},
+		{"umbrella", "umbrella"},
+		{"commonest", "commonest"},
+		{"steamboats", "steamboat"},
+		{"scarcely", "scarc"},
+		{"sunset", "sunset"},
+		{"autonomy", "autonomi"},
+		{"suffragists", "suffragist"},
+		{"cherished", "cherish"},
+		{"dip", "dip"},
+		{"unkind", "unkind"},
+		{"pneumo", "pneumo"},
+		{"paid", "paid"},
+		{"holland", "holland"},
+		{"prohibiting", "prohibit"},
+		{"diversion", "divers"},
+		{"injuriously", "injuri"},
+		{"imperturbable", "imperturb"},
+		{"forminsk", "forminsk"},
+		{"irritate", "irrit"},
+		{"keeping", "keep"},
+		{"nasal", "nasal"},
+		{"seas", "sea"},
+		{"fluid", "fluid"},
+		{"unionists", "unionist"},
+		{"indorsed", "indors"},
+		{"berthier", "berthier"},
+		{"shuddered", "shudder"},
+		{"hosmer", "hosmer"},
+		{"sneer", "sneer"},
+		{"brusque", "brusqu"},
+		{"campaigning", "campaign"},
+		{"disorganised", "disorganis"},
+		{"pamphlets", "pamphlet"},
+		{"hypodermic", "hypoderm"},
+		{"kisses", "kiss"},
+		{"supported", "support"},
+		{"offending", "offend"},
+		{"tyler", "tyler"},
+		{"cushing", "cush"},
+		{"circulating", "circul"},
+		{"treaties", "treati"},
+		{"gaiters", "gaiter"},
+		{"ruse", "ruse"},
+		{"reducing", "reduc"},
+		{"please", "pleas"},
+		{"frederick", "frederick"},
+		{"illness", "ill"},
+		{"economical", "econom"},
+		{"scars", "scar"},
+		{"buy", "bui"},
+		{"depressing", "depress"},
+		{"coincident", "coincid"},
+		{"curving", "curv"},
+		{"ripley", "riplei"},
+		{"transport", "transport"},
+		{"joyful", "joy"},
+		{"proteins", "protein"},
+		{"ruthless", "ruthless"},
+		{"funke", "funk"},
+		{"trouble", "troubl"},
+		{"disposing", "dispos"},
+		{"cleansed", "cleans"},
+		{"gospels", "gospel"},
+		{"gratify", "gratifi"},
+		{"smoothly", "smoothli"},
+		{"charred", "char"},
+		{"connected", "connect"},
+		{"calomel", "calomel"},
+		{"impressive", "impress"},
+		{"nationalized", "nation"},
+		{"hither", "hither"},
+		{"swamps", "swamp"},
+		{"dressers", "dresser"},
+		{"dispersion", "dispers"},
+		{"trenton", "trenton"},
+		{"subjacent", "subjac"},
+		{"reigns", "reign"},
+		{"proposition", "proposit"},
+		{"guess", "guess"},
+		{"deaths", "death"},
+		{"game", "game"},
+		{"devout", "devout"},
+		{"wartime", "wartim"},
+		{"voting", "vote"},
+		{"refreshed", "refresh"},
+		{"perseverance", "persever"},
+		{"protege", "proteg"},
+		{"tetanic", "tetan"},
+		{"change", "chang"},
+		{"crusades", "crusad"},
+		{"yawning", "yawn"},
+		{"phagocytes", "phagocyt"},
+		{"znaim", "znaim"},
+		{"humiliated", "humili"},
+		{"caused", "caus"},
+		{"inhaled", "inhal"},
+		{"thundered", "thunder"},
+		{"sighted", "sight"},
+		{"doctor", "doctor"},
+		{"refraining", "refrain"},
+		{"released", "releas"},
+		{"platt", "platt"},
+		{"shouldered", "shoulder"},
+		{"myxomatous", "myxomat"},
+		{"pelvis", "pelvi"},
+		{"differed", "differ"},
+		{"object", "object"},
+		{"railroad", "railroad"},
+		{"honors", "honor"},
+		{"listening", "listen"},
+		{"cavernous", "cavern"},
+		{"assurance", "assur"},
+		{"most", "most"},
+		{"sanctuary", "sanctuari"},
+		{"billet", "billet"},
+		{"churches", "church"},
+		{"accountant", "account"},
+		{"muco", "muco"},
+		{"needed", "need"},
+		{"asked", "ask"},
+		{"england", "england"},
+		{"durrenstein", "durrenstein"},
+		{"ears", "ear"},
+		{"thumping", "thump"},
+		{"writings", "write"},
+		{"room", "room"},
+		{"reproached", "reproach"},
+		{"caseating", "caseat"},
+		{"policies", "polici"},
+		{"gentry", "gentri"},
+		{"flitted", "flit"},
+		{"sold", "sold"},
+		{"sans", "san"},
+		{"opinion", "opinion"},
+		{"dropping", "drop"},
+		{"convent", "convent"},
+		{"spores", "spore"},
+		{"plumped", "plump"},
+		{"myelitis", "myeliti"},
+		{"tact", "tact"},
+		{"combined", "combin"},
+		{"twitch", "twitch"},
+		{"cab", "cab"},
+		{"arrives", "arriv"},
+		{"hadn", "hadn"},
+		{"febrile", "febril"},
+		{"desultory", "desultori"},
+		{"boot", "boot"},
+		{"guests", "guest"},
+		{"densely", "dens"},
+		{"massive", "massiv"},
+		{"bowel", "bowel"},
+		{"prejudice", "prejudic"},
+		{"vainly", "vainli"},
+		{"worse", "wors"},
+		{"tint", "tint"},
+		{"directed", "direct"},
+		{"latane", "latan"},
+		{"repent", "repent"},
+		{"oxford", "oxford"},
+		{"mon", "mon"},
+		{"commons", "common"},
+		{"donets", "donet"},
+		{"sporotrichosis", "sporotrichosi"},
+		{"quilt", "quilt"},
+		{"efficiently", "effici"},
+		{"unsolved", "unsolv"},
+		{"skins", "skin"},
+		{"glorious", "gloriou"},
+		{"repulsive", "repuls"},
+		{"epistle", "epistl"},
+		{"commands", "command"},
+		{"dejected", "deject"},
+		{"metal", "metal"},
+		{"tropical", "tropic"},
+		{"knee", "knee"},
+		{"gossips", "gossip"},
+		{"reunion", "reunion"},
+		{"mindedness", "minded"},
+		{"miners", "miner"},
+		{"engineer", "engin"},
+		{"resumption", "resumpt"},
+		{"prominence", "promin"},
+		{"fellow", "fellow"},
+		{"paths", "path"},
+		{"deacon", "deacon"},
+		{"failing", "fail"},
+		{"sticky", "sticki"},
+		{"receptions", "recept"},
+		{"lupus", "lupu"},
+		{"tocqueville", "tocquevil"},
+		{"mild", "mild"},
+		{"been", "been"},
+		{"enactment", "enact"},
+		{"greatcoat", "greatcoat"},
+		{"periostitis", "periost"},
+		{"battles", "battl"},
+		{"est", "est"},
+		{"control", "control"},
+		{"stocks", "stock"},
+		{"parquet", "parquet"},
+		{"hitting", "hit"},
+		{"gaily", "gaili"},
+		{"godfreys", "godfrei"},
+		{"howard", "howard"},
+		{"parent", "parent"},
+		{"callosities", "callos"},
+		{"redoubled", "redoubl"},
+		{"arranging", "arrang"},
+		{"land", "land"},
+		{"turning", "turn"},
+		{"accidents", "accid"},
+		{"sable", "sabl"},
+		{"cheapness", "cheap"},
+		{"acknowledged", "acknowledg"},
+		{"reflex", "reflex"},
+		{"metastasis", "metastasi"},
+		{"barriers", "barrier"},
+		{"wept", "wept"},
+		{"grow", "grow"},
+		{"autumn", "autumn"},
+		{"bazdeev", "bazdeev"},
+		{"scorching", "scorch"},
+		{"occurring", "occur"},
+		{"wagons", "wagon"},
+		{"universally", "univers"},
+		{"thrill", "thrill"},
+		{"raised", "rais"},
+		{"thief", "thief"},
+		{"milan", "milan"},
+		{"insanity", "insan"},
+		{"grievances", "grievanc"},
+		{"sealing", "seal"},
+		{"allay", "allai"},
+		{"sets", "set"},
+		{"genlis", "genli"},
+		{"adler", "adler"},
+		{"under", "under"},
+		{"campfires", "campfir"},
+		{"paralyses", "paralys"},
+		{"tier", "tier"},
+		{"rheumatoid", "rheumatoid"},
+		{"crossing", "cross"},
+		{"historians", "historian"},
+		{"plight", "plight"},
+		{"schlappanitz", "schlappanitz"},
+		{"checks", "check"},
+		{"dactylitis", "dactyl"},
+		{"drenched", "drench"},
+		{"restricting", "restrict"},
+		{"pigmented", "pigment"},
+		{"glued", "glu"},
+		{"popularly", "popularli"},
+		{"competent", "compet"},
+		{"defend", "defend"},
+		{"deferential", "deferenti"},
+		{"opposed", "oppos"},
+		{"discontent", "discont"},
+		{"character", "charact"},
+		{"ottoman", "ottoman"},
+		{"aiming", "aim"},
+		{"monster", "monster"},
+		{"contended", "contend"},
+		{"budget", "budget"},
+		{"absence", "absenc"},
+		{"dock", "dock"},
+		{"reduction", "reduct"},
+		{"summarily", "summarili"},
+		{"believed", "believ"},
+		{"craftsmen", "craftsmen"},
+		{"smelt", "smelt"},
+		{"stupidest", "stupidest"},
+		{"curtsied", "curtsi"},
+		{"interaction", "interact"},
+		{"coercion", "coercion"},
+		{"affiliated", "affili"},
+		{"reveal", "reveal"},
+		{"surgeon", "surgeon"},
+		{"contain", "contain"},
+		{"excluding", "exclud"},
+		{"advising", "advis"},
+		{"breast", "breast"},
+		{"ataxia", "ataxia"},
+		{"epithelial", "epitheli"},
+		{"lured", "lure"},
+		{"imploringly", "imploringli"},
+		{"lipomas", "lipoma"},
+		{"discouraged", "discourag"},
+		{"loops", "loop"},
+		{"battered", "batter"},
+		{"inch", "inch"},
+		{"pride", "pride"},
+		{"boils", "boil"},
+		{"nodes", "node"},
+		{"antibodies", "antibodi"},
+		{"friendly", "friendli"},
+		{"divide", "divid"},
+		{"poison", "poison"},
+		{"gory", "gori"},
+		{"obtained", "obtain"},
+		{"shyly", "shyli"},
+		{"progressives", "progress"},
+		{"typewritten", "typewritten"},
+		{"huddled", "huddl"},
+		{"disobey", "disobei"},
+		{"return", "return"},
+		{"languidly", "languidli"},
+		{"stumbling", "stumbl"},
+		{"lacking", "lack"},
+		{"imprisonment", "imprison"},
+		{"meditating", "medit"},
+		{"comforts", "comfort"},
+		{"lot", "lot"},
+		{"prevailed", "prevail"},
+		{"material", "materi"},
+		{"synovitis", "synov"},
+		{"physical", "physic"},
+		{"trochanter", "trochant"},
+		{"goot", "goot"},
+		{"finishing", "finish"},
+		{"having", "have"},
+		{"openings", "open"},
+		{"viewed", "view"},
+		{"liberty", "liberti"},
+		{"tactics", "tactic"},
+		{"adroit", "adroit"},
+		{"elimination", "elimin"},
+		{"repeated", "repeat"},
+		{"burned", "burn"},
+		{"rupture", "ruptur"},
+		{"shock", "shock"},
+		{"judiciary", "judiciari"},
+		{"sectionalism", "section"},
+		{"princes", "princ"},
+		{"conciliation", "concili"},
+		{"refinements", "refin"},
+		{"cogwheels", "cogwheel"},
+		{"quinine", "quinin"},
+		{"punched", "punch"},
+		{"crumpled", "crumpl"},
+		{"planters", "planter"},
+		{"ivanovich", "ivanovich"},
+		{"rudeness", "rude"},
+		{"compliance", "complianc"},
+		{"chamber", "chamber"},
+		{"silver", "silver"},
+		{"salmon", "salmon"},
+		{"cachexia", "cachexia"},
+		{"vladimir", "vladimir"},
+		{"chancroid", "chancroid"},
+		{"arthritis", "arthriti"},
+		{"provincials", "provinci"},
+		{"exceptionally", "exception"},
+		{"john", "john"},
+		{"analgesia", "analgesia"},
+		{"cargo", "cargo"},
+		{"flying", "fly"},
+		{"cannonade", "cannonad"},
+		{"red", "red"},
+		{"reduced", "reduc"},
+		{"seceded", "seced"},
+		{"delight", "delight"},
+		{"lunatics", "lunat"},
+		{"nuts", "nut"},
+		{"arms", "arm"},
+		{"bobtailed", "bobtail"},
+		{"ruinous", "ruinou"},
+		{"persuaded", "persuad"},
+		{"advertised", "advertis"},
+		{"comprehended", "comprehend"},
+		{"suppurates", "suppur"},
+		{"contemptuous", "contemptu"},
+		{"yells", "yell"},
+		{"anglican", "anglican"},
+		{"provincialism", "provinci"},
+		{"bind", "bind"},
+		{"controversy", "controversi"},
+		{"pustule", "pustul"},
+		{"tear", "tear"},
+		{"tax", "tax"},
+		{"governed", "govern"},
+		{"stall", "stall"},
+		{"park", "park"},
+		{"postponed", "postpon"},
+		{"compassionately", "compassion"},
+		{"establish", "establish"},
+		{"bone", "bone"},
+		{"toilets", "toilet"},
+		{"entertainment", "entertain"},
+		{"stung", "stung"},
+		{"inside", "insid"},
+		{"endorsed", "endors"},
+		{"stone", "stone"},
+		{"colony", "coloni"},
+		{"swallowed", "swallow"},
+		{"fox", "fox"},
+		{"excesses", "excess"},
+		{"abridged", "abridg"},
+		{"decided", "decid"},
+		{"pulsate", "pulsat"},
+		{"candidates", "candid"},
+		{"discerning", "discern"},
+		{"rake", "rake"},
+		{"ribbons", "ribbon"},
+		{"gazed", "gaze"},
+		{"brigham", "brigham"},
+		{"sliding", "slide"},
+		{"known", "known"},
+		{"stumbled", "stumbl"},
+		{"mississippi", "mississippi"},
+		{"complaint", "complaint"},
+		{"understood", "understood"},
+		{"navy", "navi"},
+		{"parcel", "parcel"},
+		{"centers", "center"},
+		{"stage", "stage"},
+		{"everywhere", "everywher"},
+		{"shinshin", "shinshin"},
+		{"thirsty", "thirsti"},
+		{"feebleness", "feebl"},
+		{"beware", "bewar"},
+		{"forecast", "forecast"},
+		{"certificates", "certif"},
+		{"shedding", "shed"},
+		{"generations", "gener"},
+		{"pere", "pere"},
+		{"data", "data"},
+		{"management", "manag"},
+		{"precious", "preciou"},
+		{"predetermined", "predetermin"},
+		{"paying", "pai"},
+		{"warmer", "warmer"},
+		{"rogers", "roger"},
+		{"ventured", "ventur"},
+		{"commissioners", "commission"},
+		{"noisily", "noisili"},
+		{"pirates", "pirat"},
+		{"export", "export"},
+		{"lounge", "loung"},
+		{"objections", "object"},
+		{"enlightenment", "enlighten"},
+		{"commits", "commit"},
+		{"immediately", "immedi"},
+		{"gesticulating", "gesticul"},
+		{"cells", "cell"},
+		{"palmar", "palmar"},
+		{"races", "race"},
+		{"revolution", "revolut"},
+		{"deviations", "deviat"},
+		{"wolf", "wolf"},
+		{"ghost", "ghost"},
+		{"interfering", "interf"},
+		{"dispatched", "dispatch"},
+		{"environment", "environ"},
+		{"clanking", "clank"},
+		{"expert", "expert"},
+		{"overcame", "overcam"},
+		{"taurida", "taurida"},
+		{"documents", "document"},
+		{"aloud", "aloud"},
+		{"strongest", "strongest"},
+		{"unique", "uniqu"},
+		{"tone", "tone"},
+		{"splints", "splint"},
+		{"branches", "branch"},
+		{"arkharovs", "arkharov"},
+		{"stripping", "strip"},
+		{"world", "world"},
+		{"units", "unit"},
+		{"bead", "bead"},
+		{"enemy", "enemi"},
+		{"aloysius", "aloysiu"},
+		{"recognise", "recognis"},
+		{"quiet", "quiet"},
+		{"phagedaena", "phagedaena"},
+		{"inactivity", "inact"},
+		{"debs", "deb"},
+		{"handley", "handlei"},
+		{"sentinel", "sentinel"},
+		{"italians", "italian"},
+		{"originally", "origin"},
+		{"scornfully", "scornfulli"},
+		{"block", "block"},
+		{"staggering", "stagger"},
+		{"uniformity", "uniform"},
+		{"because", "becaus"},
+		{"predictions", "predict"},
+		{"fish", "fish"},
+		{"bridge", "bridg"},
+		{"inspected", "inspect"},
+		{"consequence", "consequ"},
+		{"high", "high"},
+		{"appeal", "appeal"},
+		{"domestic", "domest"},
+		{"otherwise", "otherwis"},
+		{"unsuited", "unsuit"},
+		{"populism", "popul"},
+		{"anglo", "anglo"},
+		{"learned", "learn"},
+		{"rams", "ram"},
+		{"changing", "chang"},
+		{"disc", "disc"},
+		{"distinctive", "distinct"},
+		{"caesars", "caesar"},
+		{"boyars", "boyar"},
+		{"defining", "defin"},
+		{"particularly", "particularli"},
+		{"nobody", "nobodi"},
+		{"heave", "heav"},
+		{"immeasurable", "immeasur"},
+		{"septic", "septic"},
+		{"laboratory", "laboratori"},
+		{"rendering", "render"},
+		{"censorship", "censorship"},
+		{"distraction", "distract"},
+		{"promise", "promis"},
+		{"deglutition", "deglutit"},
+		{"granted", "grant"},
+		{"ministry", "ministri"},
+		{"marriage", "marriag"},
+		{"participated", "particip"},
+		{"leave", "leav"},
+		{"coquetry", "coquetri"},
+		{"guinea", "guinea"},
+		{"crowded", "crowd"},
+		{"exploit", "exploit"},
+		{"detain", "detain"},
+		{"brilliant", "brilliant"},
+		{"coordinate", "coordin"},
+		{"embroidered", "embroid"},
+		{"anything", "anyth"},
+		{"thumb", "thumb"},
+		{"senator", "senat"},
+		{"infectious", "infecti"},
+		{"joking", "joke"},
+		{"standardized", "standard"},
+		{"upwards", "upward"},
+		{"acromion", "acromion"},
+		{"impassable", "impass"},
+		{"come", "come"},
+		{"diagnosed", "diagnos"},
+		{"type", "type"},
+		{"stitches", "stitch"},
+		{"implication", "implic"},
+		{"tension", "tension"},
+		{"urge", "urg"},
+		{"employment", "employ"},
+		{"including", "includ"},
+		{"yale", "yale"},
+		{"watching", "watch"},
+		{"glow", "glow"},
+		{"traditions", "tradit"},
+		{"unfathomable", "unfathom"},
+		{"rakes", "rake"},
+		{"tsar", "tsar"},
+		{"till", "till"},
+		{"planning", "plan"},
+		{"thousand", "thousand"},
+		{"dandies", "dandi"},
+		{"popped", "pop"},
+		{"factor", "factor"},
+		{"hernial", "hernial"},
+		{"obvious", "obviou"},
+		{"action", "action"},
+		{"conference", "confer"},
+		{"reading", "read"},
+		{"banker", "banker"},
+		{"tastes", "tast"},
+		{"flask", "flask"},
+		{"weep", "weep"},
+		{"hobby", "hobbi"},
+		{"sallow", "sallow"},
+		{"uplands", "upland"},
+		{"cellar", "cellar"},
+		{"denomination", "denomin"},
+		{"drives", "drive"},
+		{"carbuncle", "carbuncl"},
+		{"ankle", "ankl"},
+		{"suvorov", "suvorov"},
+		{"accorded", "accord"},
+		{"layers", "layer"},
+		{"call", "call"},
+		{"surfaces", "surfac"},
+		{"pungent", "pungent"},
+		{"riveted", "rivet"},
+		{"drainage", "drainag"},
+		{"gibraltar", "gibraltar"},
+		{"supremacy", "supremaci"},
+		{"proofs", "proof"},
+		{"joyously", "joyous"},
+		{"sent", "sent"},
+		{"spoil", "spoil"},
+		{"momentary", "momentari"},
+		{"divan", "divan"},
+		{"anecdotes", "anecdot"},
+		{"arguing", "argu"},
+		{"astronomy", "astronomi"},
+		{"detachments", "detach"},
+		{"sufficed", "suffic"},
+		{"rounds", "round"},
+		{"marines", "marin"},
+		{"manned", "man"},
+		{"cannot", "cannot"},
+		{"ordre", "ordr"},
+		{"wrestle", "wrestl"},
+		{"defect", "defect"},
+		{"clothed", "cloth"},
+		{"pints", "pint"},
+		{"bristol", "bristol"},
+		{"defiance", "defianc"},
+		{"wiped", "wipe"},
+		{"preparing", "prepar"},
+		{"scott", "scott"},
+		{"mistake", "mistak"},
+		{"carpal", "carpal"},
+		{"zanthoma", "zanthoma"},
+		{"americans", "american"},
+		{"earn", "earn"},
+		{"brooks", "brook"},
+		{"dislocations", "disloc"},
+		{"dipping", "dip"},
+		{"crafts", "craft"},
+		{"flooded", "flood"},
+		{"enact", "enact"},
+		{"polite", "polit"},
+		{"freshly", "freshli"},
+		{"chondroma", "chondroma"},
+		{"impoverished", "impoverish"},
+		{"threefold", "threefold"},
+		{"resembles", "resembl"},
+		{"sombre", "sombr"},
+		{"minute", "minut"},
+		{"encroaches", "encroach"},
+		{"pensions", "pension"},
+		{"petitions", "petit"},
+		{"couldn", "couldn"},
+		{"freehold", "freehold"},
+		{"unbroken", "unbroken"},
+		{"dripping", "drip"},
+		{"passengers", "passeng"},
+		{"weed", "weed"},
+		{"adherent", "adher"},
+		{"boiling", "boil"},
+		{"calcification", "calcif"},
+		{"meadow", "meadow"},
+		{"petrovich", "petrovich"},
+		{"contradicted", "contradict"},
+		{"blew", "blew"},
+		{"complacency", "complac"},
+		{"homoplastic", "homoplast"},
+		{"excluded", "exclud"},
+		{"lengths", "length"},
+		{"spasm", "spasm"},
+		{"vive", "vive"},
+		{"furnished", "furnish"},
+		{"abate", "abat"},
+		{"resolved", "resolv"},
+		{"loans", "loan"},
+		{"industries", "industri"},
+		{"cheers", "cheer"},
+		{"tune", "tune"},
+		{"nesvitski", "nesvitski"},
+		{"mistaking", "mistak"},
+		{"direction", "direct"},
+		{"throws", "throw"},
+		{"strike", "strike"},
+		{"princeton", "princeton"},
+		{"communion", "communion"},
+		{"stain", "stain"},
+		{"engagement", "engag"},
+		{"achievements", "achiev"},
+		{"recommends", "recommend"},
+		{"urethra", "urethra"},
+		{"curvature", "curvatur"},
+		{"foch", "foch"},
+		{"luxury", "luxuri"},
+		{"asylum", "asylum"},
+		{"vespers", "vesper"},
+		{"protest", "protest"},
+		{"san", "san"},
+		{"impetuous", "impetu"},
+		{"iodoform", "iodoform"},
+		{"november", "novemb"},
+		{"jackson", "jackson"},
+		{"saddled", "saddl"},
+		{"jacksonian", "jacksonian"},
+		{"tendency", "tendenc"},
+		{"lurid", "lurid"},
+		{"grown", "grown"},
+		{"localised", "localis"},
+		{"anticipated", "anticip"},
+		{"rucastle", "rucastl"},
+		{"sword", "sword"},
+		{"bent", "bent"},
+		{"audibly", "audibl"},
+		{"entertaining", "entertain"},
+		{"veins", "vein"},
+		{"fiercely", "fierc"},
+		{"deserts", "desert"},
+		{"distant", "distant"},
+		{"dealing", "deal"},
+		{"triceps", "tricep"},
+		{"ascertained", "ascertain"},
+		{"critical", "critic"},
+		{"puckering", "pucker"},
+		{"plantation", "plantat"},
+		{"heaven", "heaven"},
+		{"harper", "harper"},
+		{"mop", "mop"},
+		{"point", "point"},
+		{"haemorrhage", "haemorrhag"},
+		{"future", "futur"},
+		{"talking", "talk"},
+		{"disintegrated", "disintegr"},
+		{"virtues", "virtu"},
+		{"hut", "hut"},
+		{"gets", "get"},
+		{"sky", "sky"},
+		{"eternal", "etern"},
+		{"transplanting", "transplant"},
+		{"modifications", "modif"},
+		{"barred", "bar"},
+		{"addresses", "address"},
+		{"experiencing", "experienc"},
+		{"got", "got"},
+		{"glided", "glide"},
+		{"davis", "davi"},
+		{"wild", "wild"},
+		{"coil", "coil"},
+		{"footmen", "footmen"},
+		{"excellent", "excel"},
+		{"rumors", "rumor"},
+		{"accessory", "accessori"},
+		{"warnings", "warn"},
+		{"webster", "webster"},
+		{"scented", "scent"},
+		{"engraved", "engrav"},
+		{"decay", "decai"},
+		{"clung", "clung"},
+		{"hopelessness", "hopeless"},
+		{"clang", "clang"},
+		{"crop", "crop"},
+		{"affectionately", "affection"},
+		{"main", "main"},
+		{"unsightly", "unsightli"},
+		{"numbered", "number"},
+		{"sonya", "sonya"},
+		{"toulon", "toulon"},
+		{"bachelor", "bachelor"},
+		{"marry", "marri"},
+		{"pulling", "pull"},
+		{"termed", "term"},
+		{"wincing", "winc"},
+		{"familiar", "familiar"},
+		{"savannah", "savannah"},
+		{"enjoy", "enjoi"},
+		{"cheaper", "cheaper"},
+		{"ascribed", "ascrib"},
+		{"urethral", "urethr"},
+		{"corrosive", "corros"},
+		{"enlist", "enlist"},
+		{"readjusted", "readjust"},
+		{"footing", "foot"},
+		{"suspecting", "suspect"},
+		{"criticisms", "critic"},
+		{"blackened", "blacken"},
+		{"naples", "napl"},
+		{"food", "food"},
+		{"markets", "market"},
+		{"elizabeth", "elizabeth"},
+		{"complication", "complic"},
+		{"refers", "refer"},
+		{"papule", "papul"},
+		{"curtain", "curtain"},
+		{"solely", "sole"},
+		{"guam", "guam"},
+		{"drum", "drum"},
+		{"coal", "coal"},
+		{"vigorously", "vigor"},
+		{"determined", "determin"},
+		{"nobles", "nobl"},
+		{"contrasted", "contrast"},
+		{"engorgement", "engorg"},
+		{"charged", "charg"},
+		{"flagrant", "flagrant"},
+		{"feels", "feel"},
+		{"communicated", "commun"},
+		{"friends", "friend"},
+		{"arterio", "arterio"},
+		{"temples", "templ"},
+		{"classes", "class"},
+		{"scent", "scent"},
+		{"medial", "medial"},
+		{"temperance", "temper"},
+	},
+}
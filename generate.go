@@ -0,0 +1,15 @@
+package porter
+
+// snowball_porter.go is the Porter stemmer compiled from Snowball source.
+// It is checked against stemmer.go in the tests and serves as the
+// reference output of the Snowball compiler.
+//go:generate go run ./internal/cmd/snowballc -name porterSnowball -o snowball_porter.go internal/snowball/algorithms/porter.sbl
+//go:generate go run ./internal/cmd/snowballc -name finnishSnowball -o snowball_finnish.go internal/snowball/algorithms/finnish.sbl
+
+// suffix_tries.go has the tries that find the suffixes of steps 2 to 4 of
+// stemmer.go, generated from the suffixes and replacements in porter.rules.
+//go:generate go run ./internal/cmd/suffixgen -steps 2,3,4 -o suffix_tries.go porter.rules
+
+// frequent_stems.go has the stems of the most frequent English words, looked
+// up before stemming, generated from frequent_words.txt.
+//go:generate go run ./internal/cmd/stemtablegen -o frequent_stems.go frequent_words.txt
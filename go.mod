@@ -1,3 +1,5 @@
 module github.com/a2800276/porter
 
 go 1.25.6
+
+require golang.org/x/text v0.40.0
@@ -0,0 +1,110 @@
+package porter
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TextStemmer stems the words of running text in place: each word is
+// replaced by its stem and everything between words (punctuation, white
+// space, line breaks and bytes that are not valid UTF-8) is copied
+// unchanged, so that the stemmed text lines up with the original. A word is
+// a run of letters and digits.
+//
+// The zero value stems with Stem.
+type TextStemmer struct {
+	// Stem stems a word; nil means Stem.
+	Stem func(word string) (string, error)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// Copy copies src to dst, replacing each word by its stem. It returns the
+// first error reading, writing or stemming.
+func (t TextStemmer) Copy(dst io.Writer, src io.Reader) error {
+	stem := t.Stem
+	if stem == nil {
+		stem = Stem
+	}
+	in := bufio.NewReader(src)
+	out := bufio.NewWriter(dst)
+	var word []byte
+	flush := func() error {
+		if len(word) == 0 {
+			return nil
+		}
+		stemmed, err := stem(string(word))
+		if err != nil {
+			return fmt.Errorf("stemming %q: %w", word, err)
+		}
+		out.WriteString(stemmed)
+		word = word[:0]
+		return nil
+	}
+	for {
+		r, size, err := in.ReadRune()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if isWordRune(r) {
+			word = utf8.AppendRune(word, r)
+			continue
+		}
+		if err := flush(); err != nil {
+			return err
+		}
+		if r == utf8.RuneError && size == 1 {
+			// copy the invalid byte rather than U+FFFD
+			in.UnreadRune()
+			b, _ := in.ReadByte()
+			out.WriteByte(b)
+			continue
+		}
+		out.WriteRune(r)
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	return out.Flush()
+}
+
+// StemText returns text with each word replaced by its stem.
+//
+// Example:
+//
+//	var t porter.TextStemmer
+//	stemmed, err := t.StemText("Connected, connecting.\n")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	// stemmed is "connect, connect.\n"
+func (t TextStemmer) StemText(text string) (string, error) {
+	var b strings.Builder
+	if err := t.Copy(&b, strings.NewReader(text)); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// StemText returns text with each word replaced by its Porter stem and
+// everything between words unchanged; see TextStemmer.
+//
+// Example:
+//
+//	stemmed, err := porter.StemText("The runners (running) ran.")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	// stemmed is "the runner (run) ran."
+func StemText(text string) (string, error) {
+	return TextStemmer{}.StemText(text)
+}
@@ -0,0 +1,68 @@
+package porter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestStemText(t *testing.T) {
+	var test = []stemmerTest{
+		{"", ""},
+		{"running", "run"},
+		{"The runners (running) ran.", "the runner (run) ran."},
+		{"  Connected,\tconnecting.\r\n\nconnections!", "  connect,\tconnect.\r\n\nconnect!"},
+		{"e-mails re-used 2nd 1990s", "e-mail re-us 2nd 1990"},
+		{"café \xff\xfe ponies", "café \xff\xfe poni"},
+		{"généralement, ponies", "généralement, poni"},
+	}
+
+	for _, tc := range test {
+		have, err := StemText(tc.in)
+		if err != nil {
+			t.Errorf("'%s': %v", tc.in, err)
+		}
+		if have != tc.out {
+			t.Errorf("'%s' want '%s' have '%s'\n", tc.in, tc.out, have)
+		}
+	}
+}
+
+func TestTextStemmer(t *testing.T) {
+	s := TextStemmer{Stem: StemS}
+	have, err := s.StemText("Ponies, horses and glasses.")
+	if want := "pony, horse and glasse."; err != nil || have != want {
+		t.Errorf("want '%s' have '%s' (%v)\n", want, have, err)
+	}
+
+	s = TextStemmer{Stem: func(w string) (string, error) {
+		if w == "bad" {
+			return "", ErrInvalidInput
+		}
+		return w, nil
+	}}
+	if _, err := s.StemText("good bad"); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("want ErrInvalidInput have %v", err)
+	}
+}
+
+func TestTextStemmerCopy(t *testing.T) {
+	// a long input crosses the reader's buffer boundaries mid-word
+	in := strings.Repeat("Running, connected ponies;\n", 2000)
+	want := strings.Repeat("run, connect poni;\n", 2000)
+	var b strings.Builder
+	if err := (TextStemmer{}).Copy(&b, strings.NewReader(in)); err != nil {
+		t.Fatal(err)
+	}
+	if b.String() != want {
+		t.Errorf("stemmed text differs, have %d bytes want %d", b.Len(), len(want))
+	}
+}
+
+func ExampleStemText() {
+	stemmed, _ := StemText("Generalizations: the runners were running!")
+	fmt.Println(stemmed)
+	// Output:
+	// gener: the runner were run!
+}
@@ -0,0 +1,62 @@
+// Command snowballc compiles a Snowball stemming algorithm into Go code
+// that runs on the internal/snowball runtime. It is meant to be run by
+// go generate, e.g.
+//
+//	//go:generate go run ./internal/cmd/snowballc -name porterSnowball -o snowball_porter.go internal/snowball/algorithms/porter.sbl
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/a2800276/porter/internal/snowball/compiler"
+)
+
+func main() {
+	var (
+		out  = flag.String("o", "", "output file (default stdout)")
+		pkg  = flag.String("pkg", "", "package of the generated file (default $GOPACKAGE)")
+		name = flag.String("name", "", "name of the generated type")
+	)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: snowballc [flags] file.sbl\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 || *name == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *pkg == "" {
+		*pkg = os.Getenv("GOPACKAGE")
+	}
+	if *pkg == "" {
+		fmt.Fprintln(os.Stderr, "snowballc: -pkg not given and $GOPACKAGE not set")
+		os.Exit(2)
+	}
+
+	src, err := os.ReadFile(flag.Arg(0))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "snowballc: %v\n", err)
+		os.Exit(1)
+	}
+	code, err := compiler.Compile(string(src), compiler.Options{
+		Package: *pkg,
+		Name:    *name,
+		Source:  flag.Arg(0),
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "snowballc: %s: %v\n", flag.Arg(0), err)
+		os.Exit(1)
+	}
+	if *out == "" {
+		_, err = os.Stdout.Write(code)
+	} else {
+		err = os.WriteFile(*out, code, 0o644)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "snowballc: %v\n", err)
+		os.Exit(1)
+	}
+}
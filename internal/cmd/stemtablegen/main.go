@@ -0,0 +1,212 @@
+// Command stemtablegen generates the table of precomputed stems that Stem
+// and StemBytes look frequent words up in before they run the algorithm,
+// from a list of words, most frequent first. The table is a minimal perfect
+// hash table: each word hashes to an entry of its own, with the hash of
+// package stemhash. The stems are those of porter.StemTrace, which runs the
+// algorithm without looking in the table; the porter package builds without
+// the generated file, so the table can be generated from scratch. It is
+// meant to be run by go generate, e.g.
+//
+//	//go:generate go run ./internal/cmd/stemtablegen -o frequent_stems.go frequent_words.txt
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/a2800276/porter"
+	"github.com/a2800276/porter/internal/stemhash"
+)
+
+func main() {
+	var (
+		out = flag.String("o", "", "output file (default stdout)")
+		pkg = flag.String("pkg", "", "package of the generated file (default $GOPACKAGE)")
+		n   = flag.Int("n", 0, "number of words to take from the list (default all)")
+	)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: stemtablegen [flags] words.txt\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *pkg == "" {
+		*pkg = os.Getenv("GOPACKAGE")
+	}
+	if *pkg == "" {
+		fmt.Fprintln(os.Stderr, "stemtablegen: -pkg not given and $GOPACKAGE not set")
+		os.Exit(2)
+	}
+
+	f, err := os.Open(flag.Arg(0))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "stemtablegen: %v\n", err)
+		os.Exit(1)
+	}
+	words, err := readWords(f, *n)
+	f.Close()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "stemtablegen: %s: %v\n", flag.Arg(0), err)
+		os.Exit(1)
+	}
+	code, err := generate(words, *pkg, flag.Arg(0))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "stemtablegen: %s: %v\n", flag.Arg(0), err)
+		os.Exit(1)
+	}
+	if *out == "" {
+		_, err = os.Stdout.Write(code)
+	} else {
+		err = os.WriteFile(*out, code, 0o644)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "stemtablegen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// readWords reads the first n words (all if n is 0) of a word list with a
+// word per line, optionally followed by a count, and comments starting with
+// '#'. Words of one or two letters, which the stemmer leaves alone without
+// looking at them, words longer than stemhash.MaxLen, which the table does
+// not hash whole, and words given twice are skipped.
+func readWords(r io.Reader, n int) ([]string, error) {
+	var words []string
+	seen := map[string]bool{}
+	s := bufio.NewScanner(r)
+	for line := 1; s.Scan() && (n == 0 || len(words) < n); line++ {
+		fields := bytes.Fields(s.Bytes())
+		if len(fields) == 0 || fields[0][0] == '#' {
+			continue
+		}
+		word := string(fields[0])
+		for i := 0; i < len(word); i++ {
+			if word[i] < 'a' || word[i] > 'z' {
+				return nil, fmt.Errorf("line %d: %q is not a lowercase ASCII word", line, word)
+			}
+		}
+		if len(word) < 3 || len(word) > stemhash.MaxLen || seen[word] {
+			continue
+		}
+		seen[word] = true
+		words = append(words, word)
+	}
+	return words, s.Err()
+}
+
+// place finds a seed per bucket of words, mixed with seed 0, that sends
+// the words of the bucket to free entries of n, trying the biggest buckets
+// first, and returns the seeds and the word of each entry.
+func place(words []string, buckets int) ([]uint16, []string, bool) {
+	n := len(words)
+	byBucket := make([][]string, buckets)
+	for _, w := range words {
+		b := stemhash.Slot(stemhash.Hash([]byte(w)), 0, buckets)
+		byBucket[b] = append(byBucket[b], w)
+	}
+	order := make([]int, buckets)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return len(byBucket[order[i]]) > len(byBucket[order[j]]) })
+
+	seeds := make([]uint16, buckets)
+	entries := make([]string, n)
+	for _, b := range order {
+		if len(byBucket[b]) == 0 {
+			break
+		}
+		placed := false
+		for seed := 1; seed <= 0xffff && !placed; seed++ {
+			slots := make([]int, 0, len(byBucket[b]))
+			placed = true
+			for _, w := range byBucket[b] {
+				slot := stemhash.Slot(stemhash.Hash([]byte(w)), uint16(seed), n)
+				taken := entries[slot] != ""
+				for _, s := range slots {
+					taken = taken || s == slot
+				}
+				if taken {
+					placed = false
+					break
+				}
+				slots = append(slots, slot)
+			}
+			if placed {
+				seeds[b] = uint16(seed)
+				for i, w := range byBucket[b] {
+					entries[slots[i]] = w
+				}
+			}
+		}
+		if !placed {
+			return nil, nil, false
+		}
+	}
+	return seeds, entries, true
+}
+
+// generate returns the Go source of the table of the stems of words.
+func generate(words []string, pkg, source string) ([]byte, error) {
+	if len(words) == 0 {
+		return nil, fmt.Errorf("no words")
+	}
+	var (
+		seeds   []uint16
+		entries []string
+	)
+	// about three words per bucket, or more buckets if that is too few
+	for buckets := len(words)/3 + 1; entries == nil; buckets += buckets / 4 {
+		if buckets > len(words) {
+			return nil, fmt.Errorf("no perfect hash found for %d words", len(words))
+		}
+		seeds, entries, _ = place(words, buckets)
+	}
+
+	maxLen := 0
+	for _, w := range words {
+		maxLen = max(maxLen, len(w))
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by stemtablegen from %s. DO NOT EDIT.\n\n", source)
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	b.WriteString("func init() { frequentStems = &frequentStemTable }\n\n")
+	fmt.Fprintf(&b, "// frequentStemTable has the stems of the %d most frequent words of\n// %s of three letters or more.\n", len(words), source)
+	b.WriteString("var frequentStemTable = stemTable{\n")
+	fmt.Fprintf(&b, "maxLen: %d,\n", maxLen)
+	b.WriteString("seeds: []uint16{")
+	for i, s := range seeds {
+		if i%16 == 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "%d, ", s)
+	}
+	b.WriteString("\n},\n")
+	b.WriteString("tags: []uint16{")
+	for i, w := range entries {
+		if i%12 == 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "%#04x, ", uint16(stemhash.Hash([]byte(w))))
+	}
+	b.WriteString("\n},\n")
+	b.WriteString("entries: []stemEntry{\n")
+	for _, w := range entries {
+		stem, err := porter.StemTrace(w, nil)
+		if err != nil {
+			return nil, fmt.Errorf("%q: %v", w, err)
+		}
+		fmt.Fprintf(&b, "{%q, %q},\n", w, stem)
+	}
+	b.WriteString("},\n}\n")
+	return format.Source(b.Bytes())
+}
@@ -0,0 +1,171 @@
+// Command suffixgen generates the suffix tries that the hand-coded Porter
+// stemmer uses to find the longest suffix of a step, from the steps of a
+// rule file. Only the suffixes and replacements are taken from the rules;
+// the conditions are tested by the stemmer. It is meant to be run by go
+// generate, e.g.
+//
+//	//go:generate go run ./internal/cmd/suffixgen -steps 2,3,4 -o suffix_tries.go porter.rules
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/a2800276/porter"
+)
+
+func main() {
+	var (
+		out   = flag.String("o", "", "output file (default stdout)")
+		pkg   = flag.String("pkg", "", "package of the generated file (default $GOPACKAGE)")
+		steps = flag.String("steps", "", "comma-separated names of the steps to generate tries for")
+	)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: suffixgen [flags] file.rules\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 || *steps == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *pkg == "" {
+		*pkg = os.Getenv("GOPACKAGE")
+	}
+	if *pkg == "" {
+		fmt.Fprintln(os.Stderr, "suffixgen: -pkg not given and $GOPACKAGE not set")
+		os.Exit(2)
+	}
+
+	f, err := os.Open(flag.Arg(0))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "suffixgen: %v\n", err)
+		os.Exit(1)
+	}
+	rules, err := porter.ParseRules(f)
+	f.Close()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "suffixgen: %s: %v\n", flag.Arg(0), err)
+		os.Exit(1)
+	}
+	code, err := generate(rules, strings.Split(*steps, ","), *pkg, flag.Arg(0))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "suffixgen: %s: %v\n", flag.Arg(0), err)
+		os.Exit(1)
+	}
+	if *out == "" {
+		_, err = os.Stdout.Write(code)
+	} else {
+		err = os.WriteFile(*out, code, 0o644)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "suffixgen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// trie is a trie of reversed suffixes under construction.
+type trie struct {
+	next   [][26]int
+	accept []int // 1 + the index of the rule of the suffix ending here, or 0
+}
+
+// add adds the suffix of rule i. A suffix of an earlier rule is accepted
+// with that rule, as the stemmer tries the rules with the same suffix in
+// order.
+func (t *trie) add(suffix string, i int) {
+	state := 0
+	for j := len(suffix) - 1; j >= 0; j-- {
+		c := suffix[j] - 'a'
+		if t.next[state][c] == 0 {
+			t.next = append(t.next, [26]int{})
+			t.accept = append(t.accept, 0)
+			t.next[state][c] = len(t.next) - 1
+		}
+		state = t.next[state][c]
+	}
+	if t.accept[state] == 0 {
+		t.accept[state] = i + 1
+	}
+}
+
+// generate returns the Go source of the tries for the named steps of rules.
+func generate(rules *porter.RuleSet, steps []string, pkg, source string) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by suffixgen from %s. DO NOT EDIT.\n\n", source)
+	fmt.Fprintf(&b, "package %s\n", pkg)
+	for _, name := range steps {
+		var step *porter.RuleStep
+		for i := range rules.Steps {
+			if rules.Steps[i].Name == name {
+				step = &rules.Steps[i]
+			}
+		}
+		if step == nil {
+			return nil, fmt.Errorf("no step %q", name)
+		}
+
+		t := &trie{next: make([][26]int, 1), accept: make([]int, 1)}
+		var suffixes []string
+		for i, r := range step.Rules {
+			if r.Suffix == "" || r.Undouble {
+				return nil, fmt.Errorf("step %s: rule %q has no suffix to replace", name, r.String())
+			}
+			t.add(r.Suffix, i)
+			if !slices.Contains(suffixes, r.Suffix) {
+				suffixes = append(suffixes, r.Suffix)
+			}
+		}
+		if len(t.next) > 256 {
+			return nil, fmt.Errorf("step %s: %d states, more than 256", name, len(t.next))
+		}
+
+		fmt.Fprintf(&b, "\n// step%sSuffixes finds the longest of the suffixes of step %s:\n", name, name)
+		line := "//"
+		for i, s := range suffixes {
+			s = " " + s
+			if i < len(suffixes)-1 {
+				s += ","
+			} else {
+				s += "."
+			}
+			if len(line)+len(s) > 76 {
+				fmt.Fprintln(&b, line)
+				line = "//"
+			}
+			line += s
+		}
+		fmt.Fprintln(&b, line)
+		fmt.Fprintf(&b, "var step%sSuffixes = suffixTrie{\n", name)
+		b.WriteString("next: [][26]uint8{\n")
+		for s, next := range t.next {
+			var edges []string
+			for c, n := range next {
+				if n != 0 {
+					edges = append(edges, fmt.Sprintf("'%c' - 'a': %d", 'a'+c, n))
+				}
+			}
+			fmt.Fprintf(&b, "%d: {%s},\n", s, strings.Join(edges, ", "))
+		}
+		b.WriteString("},\n")
+		b.WriteString("accept: []uint8{")
+		for s, a := range t.accept {
+			if s > 0 {
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(&b, "%d", a)
+		}
+		b.WriteString("},\n")
+		b.WriteString("rules: []suffixRule{\n")
+		for _, r := range step.Rules {
+			fmt.Fprintf(&b, "{%d, []byte(%q)}, // %s\n", len(r.Suffix), r.Replacement, r.String())
+		}
+		b.WriteString("},\n}\n")
+	}
+	return format.Source(b.Bytes())
+}
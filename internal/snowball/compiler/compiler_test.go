@@ -0,0 +1,108 @@
+package compiler
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCompileAlgorithms(t *testing.T) {
+	files, err := filepath.Glob("../algorithms/*.sbl")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no algorithms found: %v", err)
+	}
+	for _, file := range files {
+		src, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		code, err := Compile(string(src), Options{Package: "p", Name: "x", Source: file})
+		if err != nil {
+			t.Errorf("%s: %v", file, err)
+			continue
+		}
+		if !strings.Contains(string(code), "func (z *x) stem(env *snowball.Env) bool") {
+			t.Errorf("%s: no stem method in generated code", file)
+		}
+	}
+}
+
+func TestLexerEscapes(t *testing.T) {
+	l := newLexer(`stringescapes {} stringdef a" hex 'E4' stringdef ss decimal '223' 'k{a"}{a"}n' '{U+00F6}{ss}' '{'}'`)
+	var have []string
+	for {
+		tok, err := l.next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tok.kind == tEOF {
+			break
+		}
+		have = append(have, tok.text)
+	}
+	want := []string{"kään", "öß", "'"}
+	if strings.Join(have, " ") != strings.Join(want, " ") {
+		t.Errorf("want: %q have: %q", want, have)
+	}
+}
+
+func TestAmongResults(t *testing.T) {
+	prog, err := parse(`
+		routines ( R C )
+		define C as true
+		define R as among( 'a' 'b' (delete) 'c' C 'd' ('e') 'f' )
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := prog.amongs[0]
+	want := map[string]int{"a": 1, "b": 1, "c": 2, "d": 3, "f": 4}
+	for _, e := range a.entries {
+		if e.result != want[e.s] {
+			t.Errorf("'%s' want result %d have %d", e.s, want[e.s], e.result)
+		}
+	}
+	if len(a.cmds) != 4 {
+		t.Errorf("want 4 commands, have %d", len(a.cmds))
+	}
+}
+
+func TestGrouping(t *testing.T) {
+	prog, err := parse(`
+		groupings ( v w x )
+		define v 'aeiou'
+		define w v + 'y' - 'a'
+		define x 'ba' + 'ab'
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"v": "aeiou", "w": "eiouy", "x": "ab"}
+	for _, g := range prog.groupings {
+		if g.chars != want[g.name] {
+			t.Errorf("grouping %s want: %q have: %q", g.name, want[g.name], g.chars)
+		}
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	var test = map[string]string{
+		`routines ( R )`:                                    "declared but not defined",
+		`routines ( R ) define R as frobnicate`:             "unknown command",
+		`routines ( R ) define R as ( 'a'`:                  "end of file",
+		`routines ( R ) define R as repeat true`:            "never terminates",
+		`routines ( R ) define R as ([substring])`:          "substring without among",
+		`routines ( R ) define R as among('a' 'a')`:         "twice",
+		`integers ( p ) routines ( p )`:                     "already declared",
+		`routines ( R ) define R as 'unterminated`:          "unterminated string",
+		`stringescapes {} routines ( R ) define R as '{x}'`: "undefined escape",
+	}
+
+	for src, want := range test {
+		_, err := Compile(src, Options{Package: "p", Name: "x"})
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%s: want error containing %q, have %v", src, want, err)
+		}
+	}
+}
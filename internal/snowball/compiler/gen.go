@@ -0,0 +1,537 @@
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Options controls the generated code.
+type Options struct {
+	Package string // package clause of the generated file
+	Name    string // name of the generated type, also prefixes its tables
+	Source  string // file name mentioned in the header comment
+}
+
+// Compile compiles the Snowball program src into Go source.
+//
+// The generated file declares the type opts.Name holding the program's
+// variables. Every routine becomes a method on it taking a *snowball.Env;
+// externals keep their Snowball name, other routines are prefixed with
+// "r_".
+func Compile(src string, opts Options) ([]byte, error) {
+	prog, err := parse(src)
+	if err != nil {
+		return nil, err
+	}
+	g := &gen{prog: prog, opts: opts, out: &bytes.Buffer{}}
+	g.file()
+	if g.err != nil {
+		return nil, g.err
+	}
+	out, err := format.Source(g.out.Bytes())
+	if err != nil {
+		return g.out.Bytes(), fmt.Errorf("formatting generated code: %w", err)
+	}
+	return out, nil
+}
+
+type gen struct {
+	prog *program
+	opts Options
+	out  *bytes.Buffer
+	err  error
+
+	// per routine state
+	backward bool
+	n        int  // counter for labels and variables
+	amongVar bool // the routine uses amongVar
+}
+
+func (g *gen) printf(format string, args ...interface{}) {
+	fmt.Fprintf(g.out, format, args...)
+}
+
+func (g *gen) file() {
+	g.printf("// Code generated by snowballc from %s. DO NOT EDIT.\n\n", filepath.Base(g.opts.Source))
+	g.printf("package %s\n\n", g.opts.Package)
+	g.printf("import \"github.com/a2800276/porter/internal/snowball\"\n\n")
+
+	for _, gr := range g.prog.groupings {
+		g.printf("var %sG_%s = snowball.NewGrouping(%s)\n", g.opts.Name, gr.name, strconv.Quote(gr.chars))
+	}
+	for _, a := range g.prog.amongs {
+		g.amongTable(a)
+	}
+
+	g.printf("\n// %s holds the variables of %s.\n", g.opts.Name, filepath.Base(g.opts.Source))
+	g.printf("type %s struct {\n", g.opts.Name)
+	for _, name := range g.prog.decls[kInteger] {
+		g.printf("i_%s int\n", name)
+	}
+	for _, name := range g.prog.decls[kBoolean] {
+		g.printf("b_%s bool\n", name)
+	}
+	for _, name := range g.prog.decls[kString] {
+		g.printf("s_%s string\n", name)
+	}
+	g.printf("}\n")
+
+	for _, r := range g.prog.routines {
+		g.routine(r)
+	}
+}
+
+// amongTable writes the entries of a, longest first as FindAmong needs.
+func (g *gen) amongTable(a *among) {
+	entries := append([]amongEntry(nil), a.entries...)
+	sort.SliceStable(entries, func(i, j int) bool { return len(entries[i].s) > len(entries[j].s) })
+	conds := g.conds(a)
+	g.printf("\nvar %sA%d = []snowball.Among{\n", g.opts.Name, a.id)
+	for _, e := range entries {
+		g.printf("{S: %s, Result: %d", strconv.Quote(e.s), e.result)
+		if e.cond != "" {
+			g.printf(", Cond: %d", indexOf(conds, e.cond)+1)
+		}
+		g.printf("},\n")
+	}
+	g.printf("}\n")
+}
+
+// conds lists the routines used as conditions in a.
+func (g *gen) conds(a *among) []string {
+	var conds []string
+	for _, e := range a.entries {
+		if e.cond != "" && indexOf(conds, e.cond) < 0 {
+			conds = append(conds, e.cond)
+		}
+	}
+	return conds
+}
+
+func indexOf(list []string, s string) int {
+	for i, x := range list {
+		if x == s {
+			return i
+		}
+	}
+	return -1
+}
+
+func (g *gen) method(name string) string {
+	if g.prog.kinds[name] == kExternal {
+		return name
+	}
+	return "r_" + name
+}
+
+func (g *gen) routine(r *routine) {
+	g.backward = r.backward
+	g.n = 0
+	g.amongVar = false
+
+	body := g.sub(func() { g.command(r.body, "return false") })
+	g.printf("\nfunc (z *%s) %s(env *snowball.Env) bool {\n", g.opts.Name, g.method(r.name))
+	if g.amongVar {
+		g.printf("var amongVar int\n")
+	}
+	g.out.WriteString(body)
+	g.printf("return true\n}\n")
+}
+
+// sub returns what f writes instead of writing it.
+func (g *gen) sub(f func()) string {
+	saved := g.out
+	g.out = &bytes.Buffer{}
+	f()
+	s := g.out.String()
+	g.out = saved
+	return s
+}
+
+func (g *gen) label() string {
+	g.n++
+	return fmt.Sprintf("lab%d", g.n)
+}
+
+func (g *gen) variable() string {
+	g.n++
+	return fmt.Sprintf("v%d", g.n)
+}
+
+// save declares v holding the cursor in a form that survives changes to
+// the string behind it, i.e. after the cursor in backward mode.
+func (g *gen) save(v string) {
+	if g.backward {
+		g.printf("%s := env.Limit - env.Cursor\n", v)
+	} else {
+		g.printf("%s := env.Cursor\n", v)
+	}
+}
+
+func (g *gen) restore(v string) string {
+	if g.backward {
+		return fmt.Sprintf("env.Cursor = env.Limit - %s", v)
+	}
+	return fmt.Sprintf("env.Cursor = %s", v)
+}
+
+// canFail is false for commands that always succeed.
+func canFail(n *node) bool {
+	switch n.op {
+	case "true", "set", "unset", "setmark", "[", "]", "<+", "->", "try", "do", "repeat":
+		return false
+	case "$":
+		switch n.kids[0].op {
+		case "=", "+=", "-=", "*=", "/=":
+			return false
+		}
+	case "seq", "backwards":
+		for _, k := range n.kids {
+			if canFail(k) {
+				return true
+			}
+		}
+		return false
+	case "or":
+		return canFail(n.kids[0]) && canFail(n.kids[1])
+	case "and":
+		return canFail(n.kids[0]) || canFail(n.kids[1])
+	case "test", "goto", "gopast":
+		return canFail(n.kids[0])
+	}
+	return true
+}
+
+// command writes the code for n, running the statement fail if n fails.
+func (g *gen) command(n *node, fail string) {
+	switch n.op {
+	case "seq":
+		for _, k := range n.kids {
+			g.command(k, fail)
+		}
+	case "or":
+		if !canFail(n.kids[0]) {
+			g.command(n.kids[0], fail)
+			return
+		}
+		outer, inner, v := g.label(), g.label(), g.variable()
+		g.printf("%s:\nfor {\n", outer)
+		g.save(v)
+		g.printf("%s:\nfor {\n", inner)
+		g.command(n.kids[0], "break "+inner)
+		g.printf("break %s\n}\n", outer)
+		g.printf("%s\n", g.restore(v))
+		g.command(n.kids[1], fail)
+		g.printf("break %s\n}\n", outer)
+	case "and":
+		v := g.variable()
+		g.save(v)
+		g.command(n.kids[0], fail)
+		g.printf("%s\n", g.restore(v))
+		g.command(n.kids[1], fail)
+	case "not":
+		lab, v := g.label(), g.variable()
+		g.save(v)
+		g.printf("%s:\nfor {\n", lab)
+		g.command(n.kids[0], "break "+lab)
+		g.printf("%s\n}\n", fail)
+		g.printf("%s\n", g.restore(v))
+	case "test":
+		v := g.variable()
+		g.save(v)
+		g.command(n.kids[0], fail)
+		g.printf("%s\n", g.restore(v))
+	case "try":
+		if !canFail(n.kids[0]) {
+			g.command(n.kids[0], fail)
+			return
+		}
+		lab, v := g.label(), g.variable()
+		g.save(v)
+		g.printf("%s:\nfor {\n", lab)
+		g.command(n.kids[0], g.restore(v)+"\nbreak "+lab)
+		g.printf("break %s\n}\n", lab)
+	case "do":
+		v := g.variable()
+		g.save(v)
+		if canFail(n.kids[0]) {
+			lab := g.label()
+			g.printf("%s:\nfor {\n", lab)
+			g.command(n.kids[0], "break "+lab)
+			g.printf("break %s\n}\n", lab)
+		} else {
+			g.command(n.kids[0], fail)
+		}
+		g.printf("%s\n", g.restore(v))
+	case "fail":
+		g.command(n.kids[0], fail)
+		g.printf("%s\n", fail)
+	case "goto", "gopast":
+		g.gopast(n, fail)
+	case "repeat", "atleast":
+		g.repeat(n, fail)
+	case "loop":
+		v := g.variable()
+		g.printf("for %s := %s; %s > 0; %s-- {\n", v, g.expr(n.ae), v, v)
+		g.command(n.kids[0], fail)
+		g.printf("}\n")
+	case "backwards":
+		saved := g.backward
+		g.printf("env.LimitBackward = env.Cursor\nenv.Cursor = env.Limit\n")
+		g.backward = true
+		g.command(n.kids[0], fail)
+		g.backward = saved
+		g.printf("env.Cursor = env.LimitBackward\n")
+	case "setlimit":
+		g.setlimit(n, fail)
+	default:
+		g.primitive(n, fail)
+	}
+}
+
+// gopast writes goto and gopast: try the command at each position in
+// turn until it succeeds. goto leaves the cursor before the match.
+func (g *gen) gopast(n *node, fail string) {
+	c := n.kids[0]
+	if !canFail(c) {
+		if n.op == "goto" {
+			n = &node{op: "test", kids: n.kids}
+		} else {
+			n = c
+		}
+		g.command(n, fail)
+		return
+	}
+	outer, inner, v := g.label(), g.label(), g.variable()
+	g.printf("%s:\nfor {\n", outer)
+	g.save(v)
+	g.printf("%s:\nfor {\n", inner)
+	g.command(c, "break "+inner)
+	if n.op == "goto" {
+		g.printf("%s\n", g.restore(v))
+	}
+	g.printf("break %s\n}\n", outer)
+	g.printf("%s\n", g.restore(v))
+	g.step(fail)
+	g.printf("}\n")
+}
+
+// step moves the cursor one character in the current direction, running
+// fail at the limit.
+func (g *gen) step(fail string) {
+	if g.backward {
+		g.printf("if env.Cursor <= env.LimitBackward {\n%s\n}\nenv.PrevChar()\n", fail)
+	} else {
+		g.printf("if env.Cursor >= env.Limit {\n%s\n}\nenv.NextChar()\n", fail)
+	}
+}
+
+// repeat writes repeat and atleast: run the command as often as it
+// succeeds.
+func (g *gen) repeat(n *node, fail string) {
+	c := n.kids[0]
+	if !canFail(c) {
+		g.err = fmt.Errorf("line %d: %s of a command that cannot fail never terminates", n.line, n.op)
+		return
+	}
+	count := ""
+	if n.op == "atleast" {
+		count = g.variable()
+		g.printf("%s := %s\n", count, g.expr(n.ae))
+	}
+	outer, inner, v := g.label(), g.label(), g.variable()
+	g.printf("%s:\nfor {\n", outer)
+	g.save(v)
+	g.printf("%s:\nfor {\n", inner)
+	g.command(c, "break "+inner)
+	if count != "" {
+		g.printf("%s--\n", count)
+	}
+	g.printf("continue %s\n}\n", outer)
+	g.printf("%s\n", g.restore(v))
+	g.printf("break %s\n}\n", outer)
+	if count != "" {
+		g.printf("if %s > 0 {\n%s\n}\n", count, fail)
+	}
+}
+
+// setlimit writes "setlimit C1 for C2": C1 moves the cursor to the new
+// limit, and C2 runs from the original cursor within it.
+func (g *gen) setlimit(n *node, fail string) {
+	v, lim := g.variable(), g.variable()
+	g.save(v)
+	g.command(n.kids[0], fail)
+	if g.backward {
+		g.printf("%s := env.LimitBackward\nenv.LimitBackward = env.Cursor\n", lim)
+		g.printf("%s\n", g.restore(v))
+		g.command(n.kids[1], fmt.Sprintf("env.LimitBackward = %s\n%s", lim, fail))
+		g.printf("env.LimitBackward = %s\n", lim)
+	} else {
+		g.printf("%s := env.Limit\nenv.Limit = env.Cursor\n", lim)
+		g.printf("%s\n", g.restore(v))
+		g.command(n.kids[1], fmt.Sprintf("env.Limit = %s\n%s", lim, fail))
+		g.printf("env.Limit = %s\n", lim)
+	}
+}
+
+// check writes code running fail unless cond holds.
+func (g *gen) check(cond, fail string) {
+	g.printf("if !%s {\n%s\n}\n", cond, fail)
+}
+
+func (g *gen) primitive(n *node, fail string) {
+	b := ""
+	if g.backward {
+		b = "B"
+	}
+	switch n.op {
+	case "true":
+	case "false":
+		g.printf("%s\n", fail)
+	case "str":
+		g.check(fmt.Sprintf("env.EqS%s(%s)", b, strconv.Quote(n.name)), fail)
+	case "strvar":
+		g.check(fmt.Sprintf("env.EqS%s(z.s_%s)", b, n.name), fail)
+	case "grouping":
+		g.check(fmt.Sprintf("env.InGrouping%s(%sG_%s)", b, g.opts.Name, n.name), fail)
+	case "non":
+		g.check(fmt.Sprintf("env.OutGrouping%s(%sG_%s)", b, g.opts.Name, n.name), fail)
+	case "call":
+		g.check(fmt.Sprintf("z.%s(env)", g.method(n.name)), fail)
+	case "bool":
+		g.check("z.b_"+n.name, fail)
+	case "set", "unset":
+		g.printf("z.b_%s = %v\n", n.name, n.op == "set")
+	case "[":
+		if g.backward {
+			g.printf("env.Ket = env.Cursor\n")
+		} else {
+			g.printf("env.Bra = env.Cursor\n")
+		}
+	case "]":
+		if g.backward {
+			g.printf("env.Bra = env.Cursor\n")
+		} else {
+			g.printf("env.Ket = env.Cursor\n")
+		}
+	case "next":
+		g.step(fail)
+	case "hop":
+		hop := "Hop"
+		if g.backward {
+			hop = "HopBack"
+		}
+		g.check(fmt.Sprintf("env.%s(%s)", hop, g.expr(n.ae)), fail)
+	case "delete":
+		g.check("env.SliceDel()", fail)
+	case "<-":
+		g.check(fmt.Sprintf("env.SliceFrom(%s)", g.strArg(n.kids[0])), fail)
+	case "<+":
+		g.printf("env.Insert(%s)\n", g.strArg(n.kids[0]))
+	case "->":
+		g.printf("z.s_%s = env.SliceTo()\n", n.name)
+	case "setmark":
+		g.printf("z.i_%s = env.Cursor\n", n.name)
+	case "tomark":
+		v := g.variable()
+		g.printf("%s := %s\n", v, g.expr(n.ae))
+		if g.backward {
+			g.printf("if env.Cursor < %s {\n%s\n}\n", v, fail)
+		} else {
+			g.printf("if env.Cursor > %s {\n%s\n}\n", v, fail)
+		}
+		g.printf("env.Cursor = %s\n", v)
+	case "atmark":
+		g.printf("if env.Cursor != %s {\n%s\n}\n", g.expr(n.ae), fail)
+	case "$":
+		switch op := n.kids[0].op; op {
+		case "=", "+=", "-=", "*=", "/=":
+			g.printf("z.i_%s %s %s\n", n.name, op, g.expr(n.ae))
+		default:
+			g.printf("if !(z.i_%s %s %s) {\n%s\n}\n", n.name, op, g.expr(n.ae), fail)
+		}
+	case "substring":
+		g.findAmong(n.among, fail)
+	case "among":
+		if !n.among.linked {
+			g.findAmong(n.among, fail)
+		}
+		g.dispatch(n.among, fail)
+	default:
+		g.err = fmt.Errorf("line %d: unhandled command %s", n.line, n.op)
+	}
+}
+
+func (g *gen) strArg(n *node) string {
+	if n.op == "strvar" {
+		return "z.s_" + n.name
+	}
+	return strconv.Quote(n.name)
+}
+
+// findAmong writes the search of an among table.
+func (g *gen) findAmong(a *among, fail string) {
+	b := ""
+	if g.backward {
+		b = "B"
+	}
+	cond := "nil"
+	if conds := g.conds(a); len(conds) > 0 {
+		var s strings.Builder
+		s.WriteString("func(c int) bool {\nswitch c {\n")
+		for i, name := range conds {
+			fmt.Fprintf(&s, "case %d:\nreturn z.%s(env)\n", i+1, g.method(name))
+		}
+		s.WriteString("}\nreturn false\n}")
+		cond = s.String()
+	}
+	table := fmt.Sprintf("%sA%d", g.opts.Name, a.id)
+	if !a.commands() {
+		g.printf("if env.FindAmong%s(%s, %s) == 0 {\n%s\n}\n", b, table, cond, fail)
+		return
+	}
+	g.amongVar = true
+	g.printf("amongVar = env.FindAmong%s(%s, %s)\n", b, table, cond)
+	g.printf("if amongVar == 0 {\n%s\n}\n", fail)
+}
+
+// dispatch writes the commands run for the result of an among search.
+func (g *gen) dispatch(a *among, fail string) {
+	first := true
+	for i, c := range a.cmds {
+		if c.op == "seq" && len(c.kids) == 0 {
+			continue
+		}
+		if first {
+			g.printf("if amongVar == %d {\n", i+1)
+			first = false
+		} else {
+			g.printf("} else if amongVar == %d {\n", i+1)
+		}
+		g.command(c, fail)
+	}
+	if !first {
+		g.printf("}\n")
+	}
+}
+
+func (g *gen) expr(e *expr) string {
+	switch e.op {
+	case "num":
+		return e.val
+	case "int":
+		return "z.i_" + e.val
+	case "limit":
+		return "env.Limit"
+	case "cursor":
+		return "env.Cursor"
+	case "neg":
+		return "-" + g.expr(e.l)
+	}
+	return "(" + g.expr(e.l) + " " + e.op + " " + g.expr(e.r) + ")"
+}
@@ -0,0 +1,246 @@
+// Package compiler translates Snowball programs into Go source that runs
+// on the snowball runtime package.
+//
+// It understands the part of the language used by the stemmers in this
+// repository: declarations, groupings, routines, backwardmode, among and
+// substring, the string and cursor commands, integer and boolean
+// variables, and stringdef/stringescapes for non-ASCII letters.
+package compiler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tEOF tokenKind = iota
+	tName
+	tString
+	tNumber
+	tSymbol
+)
+
+type token struct {
+	kind tokenKind
+	text string // the name, symbol or (unescaped) string contents
+	line int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tEOF:
+		return "end of file"
+	case tString:
+		return strconv.Quote(t.text)
+	}
+	return "'" + t.text + "'"
+}
+
+// symbols, longest first so that e.g. "<=" is preferred over "<".
+var symbols = []string{
+	"<-", "<+", "->", "+=", "-=", "*=", "/=", "==", "!=", "<=", ">=",
+	"(", ")", "[", "]", "$", "=", "<", ">", "+", "-", "*", "/",
+}
+
+type lexer struct {
+	src  string
+	pos  int
+	line int
+
+	// stringescapes and stringdef state
+	escOpen, escClose byte
+	defs              map[string]string
+}
+
+func newLexer(src string) *lexer {
+	return &lexer{src: src, line: 1, defs: map[string]string{}}
+}
+
+func (l *lexer) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("line %d: %s", l.line, fmt.Sprintf(format, args...))
+}
+
+// skip skips whitespace and comments.
+func (l *lexer) skip() error {
+	for l.pos < len(l.src) {
+		switch c := l.src[l.pos]; {
+		case c == '\n':
+			l.line++
+			l.pos++
+		case c == ' ' || c == '\t' || c == '\r':
+			l.pos++
+		case strings.HasPrefix(l.src[l.pos:], "//"):
+			for l.pos < len(l.src) && l.src[l.pos] != '\n' {
+				l.pos++
+			}
+		case strings.HasPrefix(l.src[l.pos:], "/*"):
+			end := strings.Index(l.src[l.pos+2:], "*/")
+			if end < 0 {
+				return l.errorf("unterminated comment")
+			}
+			l.line += strings.Count(l.src[l.pos:l.pos+2+end], "\n")
+			l.pos += end + 4
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
+func isNameByte(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// next returns the next token.
+func (l *lexer) next() (token, error) {
+	if err := l.skip(); err != nil {
+		return token{}, err
+	}
+	if l.pos >= len(l.src) {
+		return token{kind: tEOF, line: l.line}, nil
+	}
+	start := l.pos
+	c := l.src[l.pos]
+	switch {
+	case c == '\'':
+		s, err := l.str()
+		return token{kind: tString, text: s, line: l.line}, err
+	case c >= '0' && c <= '9':
+		for l.pos < len(l.src) && l.src[l.pos] >= '0' && l.src[l.pos] <= '9' {
+			l.pos++
+		}
+		return token{kind: tNumber, text: l.src[start:l.pos], line: l.line}, nil
+	case isNameByte(c):
+		for l.pos < len(l.src) && isNameByte(l.src[l.pos]) {
+			l.pos++
+		}
+		name := l.src[start:l.pos]
+		switch name {
+		case "stringescapes":
+			return l.stringescapes()
+		case "stringdef":
+			return l.stringdef()
+		}
+		return token{kind: tName, text: name, line: l.line}, nil
+	}
+	for _, s := range symbols {
+		if strings.HasPrefix(l.src[l.pos:], s) {
+			l.pos += len(s)
+			return token{kind: tSymbol, text: s, line: l.line}, nil
+		}
+	}
+	return token{}, l.errorf("unexpected character %q", c)
+}
+
+// str reads a string literal, expanding escapes.
+func (l *lexer) str() (string, error) {
+	l.pos++ // opening quote
+	var b strings.Builder
+	for {
+		if l.pos >= len(l.src) || l.src[l.pos] == '\n' {
+			return "", l.errorf("unterminated string")
+		}
+		c := l.src[l.pos]
+		switch {
+		case c == '\'':
+			l.pos++
+			return b.String(), nil
+		case l.escOpen != 0 && c == l.escOpen:
+			end := strings.IndexByte(l.src[l.pos:], l.escClose)
+			if end < 0 {
+				return "", l.errorf("unterminated escape")
+			}
+			s, err := l.escape(l.src[l.pos+1 : l.pos+end])
+			if err != nil {
+				return "", err
+			}
+			b.WriteString(s)
+			l.pos += end + 1
+		default:
+			b.WriteByte(c)
+			l.pos++
+		}
+	}
+}
+
+// escape expands the contents of an escape: a stringdef name, U+XXXX, or
+// the escape characters themselves.
+func (l *lexer) escape(name string) (string, error) {
+	if s, ok := l.defs[name]; ok {
+		return s, nil
+	}
+	if strings.HasPrefix(name, "U+") {
+		r, err := strconv.ParseUint(name[2:], 16, 32)
+		if err != nil || !utf8.ValidRune(rune(r)) {
+			return "", l.errorf("bad escape {%s}", name)
+		}
+		return string(rune(r)), nil
+	}
+	if name == "'" || name == string(l.escOpen) {
+		return name, nil
+	}
+	return "", l.errorf("undefined escape {%s}", name)
+}
+
+func (l *lexer) word() string {
+	_ = l.skip()
+	start := l.pos
+	for l.pos < len(l.src) && l.src[l.pos] > ' ' {
+		l.pos++
+	}
+	return l.src[start:l.pos]
+}
+
+// stringescapes reads the two escape characters, e.g. "stringescapes {}".
+func (l *lexer) stringescapes() (token, error) {
+	esc := l.word()
+	if len(esc) != 2 {
+		return token{}, l.errorf("stringescapes needs two characters, have %q", esc)
+	}
+	l.escOpen, l.escClose = esc[0], esc[1]
+	return l.next()
+}
+
+// stringdef reads a definition such as "stringdef a" hex 'E4'" or
+// "stringdef o" '{U+00F6}'".
+func (l *lexer) stringdef() (token, error) {
+	name := l.word()
+	if name == "" {
+		return token{}, l.errorf("stringdef without a name")
+	}
+	t, err := l.next()
+	if err != nil {
+		return t, err
+	}
+	radix := 0
+	if t.kind == tName && (t.text == "hex" || t.text == "decimal") {
+		radix = 16
+		if t.text == "decimal" {
+			radix = 10
+		}
+		if t, err = l.next(); err != nil {
+			return t, err
+		}
+	}
+	if t.kind != tString {
+		return token{}, l.errorf("stringdef %s: want a string, have %v", name, t)
+	}
+	s := t.text
+	if radix != 0 {
+		var b strings.Builder
+		for _, f := range strings.Fields(s) {
+			r, err := strconv.ParseUint(f, radix, 32)
+			if err != nil || !utf8.ValidRune(rune(r)) {
+				return token{}, l.errorf("stringdef %s: bad character %q", name, f)
+			}
+			b.WriteRune(rune(r))
+		}
+		s = b.String()
+	}
+	l.defs[name] = s
+	return l.next()
+}
@@ -0,0 +1,617 @@
+package compiler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// kinds of declared names
+const (
+	kInteger  = "integers"
+	kBoolean  = "booleans"
+	kString   = "strings"
+	kRoutine  = "routines"
+	kExternal = "externals"
+	kGrouping = "groupings"
+)
+
+// node is a command in a routine body. op names the command, mostly with
+// its Snowball spelling.
+type node struct {
+	op    string
+	kids  []*node
+	name  string // literal string, or the variable/routine/grouping name
+	ae    *expr  // the argument of hop, tomark, atmark, loop, atleast, $
+	among *among // for among and substring
+	line  int
+}
+
+// expr is an arithmetic expression.
+type expr struct {
+	op   string // "num", "int", "limit", "cursor", "neg", "+", "-", "*", "/"
+	val  string
+	l, r *expr
+}
+
+type amongEntry struct {
+	s      string
+	result int
+	cond   string // routine that must hold, or ""
+}
+
+type among struct {
+	id      int
+	entries []amongEntry
+	cmds    []*node // cmds[result-1] is run for a match with result
+	linked  bool    // the search was done by a preceding substring
+}
+
+type routine struct {
+	name     string
+	backward bool
+	body     *node
+}
+
+type grouping struct {
+	name  string
+	chars string
+}
+
+// program is a parsed Snowball program.
+type program struct {
+	kinds     map[string]string
+	decls     map[string][]string // kind -> names, in declaration order
+	groupings []*grouping
+	routines  []*routine
+	amongs    []*among
+}
+
+type parser struct {
+	lex      *lexer
+	tok      token
+	peeked   bool
+	prog     *program
+	backward bool
+	pending  *node // substring waiting for its among
+}
+
+func parse(src string) (*program, error) {
+	p := &parser{
+		lex: newLexer(src),
+		prog: &program{
+			kinds: map[string]string{},
+			decls: map[string][]string{},
+		},
+	}
+	if err := p.program(kEOF); err != nil {
+		return nil, err
+	}
+	for _, kind := range []string{kRoutine, kExternal} {
+		for _, name := range p.prog.decls[kind] {
+			if p.prog.routine(name) == nil {
+				return nil, fmt.Errorf("routine %s declared but not defined", name)
+			}
+		}
+	}
+	return p.prog, nil
+}
+
+const kEOF = ""
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("line %d: %s", p.tok.line, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) peek() (token, error) {
+	if !p.peeked {
+		t, err := p.lex.next()
+		if err != nil {
+			return t, err
+		}
+		p.tok = t
+		p.peeked = true
+	}
+	return p.tok, nil
+}
+
+func (p *parser) next() (token, error) {
+	t, err := p.peek()
+	p.peeked = false
+	return t, err
+}
+
+// is reports whether the next token is the name or symbol s.
+func (p *parser) is(s string) bool {
+	t, err := p.peek()
+	return err == nil && (t.kind == tName || t.kind == tSymbol) && t.text == s
+}
+
+func (p *parser) expect(s string) error {
+	t, err := p.next()
+	if err != nil {
+		return err
+	}
+	if (t.kind != tName && t.kind != tSymbol) || t.text != s {
+		return p.errorf("want '%s', have %v", s, t)
+	}
+	return nil
+}
+
+func (p *parser) name() (string, error) {
+	t, err := p.next()
+	if err != nil {
+		return "", err
+	}
+	if t.kind != tName {
+		return "", p.errorf("want a name, have %v", t)
+	}
+	return t.text, nil
+}
+
+func (p *parser) nameOf(kinds ...string) (string, error) {
+	name, err := p.name()
+	if err != nil {
+		return "", err
+	}
+	for _, k := range kinds {
+		if p.prog.kinds[name] == k {
+			return name, nil
+		}
+	}
+	return "", p.errorf("%s is not one of %s", name, strings.Join(kinds, ", "))
+}
+
+// program parses declarations and definitions until the token end, which
+// is ")" inside backwardmode.
+func (p *parser) program(end string) error {
+	for {
+		t, err := p.peek()
+		if err != nil {
+			return err
+		}
+		if t.kind == tEOF {
+			if end != kEOF {
+				return p.errorf("want '%s', have end of file", end)
+			}
+			return nil
+		}
+		if end != kEOF && p.is(end) {
+			return nil
+		}
+		if t.kind != tName {
+			return p.errorf("unexpected %v", t)
+		}
+		switch t.text {
+		case kInteger, kBoolean, kString, kRoutine, kExternal, kGrouping:
+			err = p.declaration()
+		case "define":
+			err = p.define()
+		case "backwardmode":
+			err = p.backwardmode()
+		default:
+			err = p.errorf("unexpected %v", t)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (p *parser) declaration() error {
+	t, _ := p.next()
+	if err := p.expect("("); err != nil {
+		return err
+	}
+	for !p.is(")") {
+		name, err := p.name()
+		if err != nil {
+			return err
+		}
+		if k, ok := p.prog.kinds[name]; ok {
+			return p.errorf("%s already declared in %s", name, k)
+		}
+		p.prog.kinds[name] = t.text
+		p.prog.decls[t.text] = append(p.prog.decls[t.text], name)
+	}
+	return p.expect(")")
+}
+
+func (p *parser) backwardmode() error {
+	_, _ = p.next()
+	if err := p.expect("("); err != nil {
+		return err
+	}
+	p.backward = true
+	if err := p.program(")"); err != nil {
+		return err
+	}
+	p.backward = false
+	return p.expect(")")
+}
+
+func (p *parser) define() error {
+	_, _ = p.next()
+	name, err := p.nameOf(kRoutine, kExternal, kGrouping)
+	if err != nil {
+		return err
+	}
+	if p.prog.kinds[name] == kGrouping {
+		return p.defineGrouping(name)
+	}
+	if p.prog.routine(name) != nil {
+		return p.errorf("routine %s defined twice", name)
+	}
+	if err := p.expect("as"); err != nil {
+		return err
+	}
+	p.pending = nil
+	body, err := p.command()
+	if err != nil {
+		return err
+	}
+	if p.pending != nil {
+		return p.errorf("substring without among in %s", name)
+	}
+	p.prog.routines = append(p.prog.routines, &routine{name: name, backward: p.backward, body: body})
+	return nil
+}
+
+// defineGrouping parses 'chars' or grouping names joined with + and -.
+func (p *parser) defineGrouping(name string) error {
+	set := map[rune]bool{}
+	add := true
+	for {
+		t, err := p.next()
+		if err != nil {
+			return err
+		}
+		var chars string
+		switch {
+		case t.kind == tString:
+			chars = t.text
+		case t.kind == tName && p.prog.kinds[t.text] == kGrouping:
+			g := p.prog.grouping(t.text)
+			if g == nil {
+				return p.errorf("grouping %s used before its definition", t.text)
+			}
+			chars = g.chars
+		default:
+			return p.errorf("want a string or grouping, have %v", t)
+		}
+		for _, r := range chars {
+			set[r] = add
+		}
+		switch {
+		case p.is("+"):
+			add = true
+		case p.is("-"):
+			add = false
+		default:
+			var rs []rune
+			for r, in := range set {
+				if in {
+					rs = append(rs, r)
+				}
+			}
+			sort.Slice(rs, func(i, j int) bool { return rs[i] < rs[j] })
+			p.prog.groupings = append(p.prog.groupings, &grouping{name: name, chars: string(rs)})
+			return nil
+		}
+		_, _ = p.next()
+	}
+}
+
+func (prog *program) routine(name string) *routine {
+	for _, r := range prog.routines {
+		if r.name == name {
+			return r
+		}
+	}
+	return nil
+}
+
+func (prog *program) grouping(name string) *grouping {
+	for _, g := range prog.groupings {
+		if g.name == name {
+			return g
+		}
+	}
+	return nil
+}
+
+// command parses C1 or C2, C1 and C2, which bind more tightly than
+// juxtaposition in a list of commands.
+func (p *parser) command() (*node, error) {
+	c, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for p.is("or") || p.is("and") {
+		t, _ := p.next()
+		c2, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		c = &node{op: t.text, kids: []*node{c, c2}, line: t.line}
+	}
+	return c, nil
+}
+
+func (p *parser) unary() (*node, error) {
+	t, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	n := &node{op: t.text, line: t.line}
+	switch t.kind {
+	case tString:
+		n.op, n.name = "str", t.text
+		return n, nil
+	case tSymbol:
+		return p.symbolCommand(n)
+	case tName:
+	default:
+		return nil, p.errorf("unexpected %v", t)
+	}
+
+	switch t.text {
+	case "not", "test", "try", "do", "fail", "goto", "gopast", "repeat", "backwards":
+		c, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		n.kids = []*node{c}
+	case "loop", "atleast":
+		if n.ae, err = p.expr(); err != nil {
+			return nil, err
+		}
+		c, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		n.kids = []*node{c}
+	case "setlimit":
+		c1, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("for"); err != nil {
+			return nil, err
+		}
+		c2, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		n.kids = []*node{c1, c2}
+	case "hop", "tomark", "atmark":
+		if n.ae, err = p.expr(); err != nil {
+			return nil, err
+		}
+	case "setmark":
+		if n.name, err = p.nameOf(kInteger); err != nil {
+			return nil, err
+		}
+	case "set", "unset":
+		if n.name, err = p.nameOf(kBoolean); err != nil {
+			return nil, err
+		}
+	case "non":
+		if p.is("-") {
+			_, _ = p.next()
+		}
+		if n.name, err = p.nameOf(kGrouping); err != nil {
+			return nil, err
+		}
+	case "delete", "next", "true", "false":
+	case "substring":
+		if p.pending != nil {
+			return nil, p.errorf("substring without among")
+		}
+		p.pending = n
+	case "among":
+		if err := p.among(n); err != nil {
+			return nil, err
+		}
+	default:
+		switch p.prog.kinds[t.text] {
+		case kRoutine, kExternal:
+			n.op = "call"
+		case kGrouping:
+			n.op = "grouping"
+		case kBoolean:
+			n.op = "bool"
+		case kString:
+			n.op = "strvar"
+		default:
+			return nil, p.errorf("unknown command or name %s", t.text)
+		}
+		n.name = t.text
+	}
+	return n, nil
+}
+
+func (p *parser) symbolCommand(n *node) (*node, error) {
+	var err error
+	switch n.op {
+	case "(":
+		n.op = "seq"
+		for !p.is(")") {
+			c, err := p.command()
+			if err != nil {
+				return nil, err
+			}
+			n.kids = append(n.kids, c)
+		}
+		_, _ = p.next()
+	case "[", "]":
+	case "<-", "<+":
+		t, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case t.kind == tString:
+			n.kids = []*node{{op: "str", name: t.text}}
+		case t.kind == tName && p.prog.kinds[t.text] == kString:
+			n.kids = []*node{{op: "strvar", name: t.text}}
+		default:
+			return nil, p.errorf("%s wants a string, have %v", n.op, t)
+		}
+	case "->":
+		if n.name, err = p.nameOf(kString); err != nil {
+			return nil, err
+		}
+	case "$":
+		if n.name, err = p.nameOf(kInteger); err != nil {
+			return nil, err
+		}
+		t, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		switch t.text {
+		case "=", "+=", "-=", "*=", "/=", "==", "!=", "<", "<=", ">", ">=":
+			n.kids = []*node{{op: t.text}}
+		default:
+			return nil, p.errorf("want an assignment or comparison, have %v", t)
+		}
+		if n.ae, err = p.expr(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, p.errorf("unexpected '%s'", n.op)
+	}
+	return n, nil
+}
+
+// among parses the strings and commands of among(...).
+func (p *parser) among(n *node) error {
+	a := &among{id: len(p.prog.amongs)}
+	p.prog.amongs = append(p.prog.amongs, a)
+	n.among = a
+	if p.pending != nil {
+		p.pending.among = a
+		a.linked = true
+		p.pending = nil
+	}
+	if err := p.expect("("); err != nil {
+		return err
+	}
+	seen := map[string]bool{}
+	var open []int // entries waiting for a command
+	for !p.is(")") {
+		t, err := p.next()
+		if err != nil {
+			return err
+		}
+		switch {
+		case t.kind == tString:
+			if seen[t.text] {
+				return p.errorf("'%s' appears twice in among", t.text)
+			}
+			seen[t.text] = true
+			a.entries = append(a.entries, amongEntry{s: t.text})
+			i := len(a.entries) - 1
+			if t2, err := p.peek(); err == nil && t2.kind == tName {
+				if k := p.prog.kinds[t2.text]; k == kRoutine || k == kExternal {
+					// a string with a condition has no command
+					_, _ = p.next()
+					a.entries[i].cond = t2.text
+					a.cmds = append(a.cmds, &node{op: "seq"})
+					a.entries[i].result = len(a.cmds)
+					continue
+				}
+			}
+			open = append(open, i)
+		case t.kind == tSymbol && t.text == "(":
+			p.peeked = true
+			p.tok = t
+			c, err := p.unary()
+			if err != nil {
+				return err
+			}
+			a.cmds = append(a.cmds, c)
+			for _, i := range open {
+				a.entries[i].result = len(a.cmds)
+			}
+			open = open[:0]
+		default:
+			return p.errorf("unexpected %v in among", t)
+		}
+	}
+	if len(open) > 0 {
+		a.cmds = append(a.cmds, &node{op: "seq"})
+		for _, i := range open {
+			a.entries[i].result = len(a.cmds)
+		}
+	}
+	return p.expect(")")
+}
+
+// commands is true if a runs a command for any of its results.
+func (a *among) commands() bool {
+	for _, c := range a.cmds {
+		if c.op != "seq" || len(c.kids) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *parser) expr() (*expr, error) {
+	e, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for p.is("+") || p.is("-") {
+		t, _ := p.next()
+		r, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		e = &expr{op: t.text, l: e, r: r}
+	}
+	return e, nil
+}
+
+func (p *parser) term() (*expr, error) {
+	e, err := p.factor()
+	if err != nil {
+		return nil, err
+	}
+	for p.is("*") || p.is("/") {
+		t, _ := p.next()
+		r, err := p.factor()
+		if err != nil {
+			return nil, err
+		}
+		e = &expr{op: t.text, l: e, r: r}
+	}
+	return e, nil
+}
+
+func (p *parser) factor() (*expr, error) {
+	t, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case t.kind == tNumber:
+		return &expr{op: "num", val: t.text}, nil
+	case t.kind == tSymbol && t.text == "-":
+		e, err := p.factor()
+		return &expr{op: "neg", l: e}, err
+	case t.kind == tSymbol && t.text == "(":
+		e, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		return e, p.expect(")")
+	case t.kind == tName && (t.text == "limit" || t.text == "cursor"):
+		return &expr{op: t.text}, nil
+	case t.kind == tName && p.prog.kinds[t.text] == kInteger:
+		return &expr{op: "int", val: t.text}, nil
+	}
+	return nil, p.errorf("want an integer expression, have %v", t)
+}
@@ -0,0 +1,328 @@
+// Package snowball is the runtime for stemmers generated from Snowball
+// sources by the snowballc tool.
+//
+// Snowball programs work on a single string with a cursor and two limits,
+// and mark the slice to be replaced with bra and ket. All positions are
+// byte offsets into the UTF-8 encoded string; the character-wise commands
+// (next, hop, groupings) step over whole runes.
+//
+// For the Snowball language itself, see:
+//
+//	http://snowballstem.org/compiler/snowman.html
+package snowball
+
+import (
+	"unicode/utf8"
+)
+
+// Env is the state a generated stemmer operates on.
+type Env struct {
+	b             []byte
+	Cursor        int // current position
+	Limit         int // forward limit, initially the end of the string
+	LimitBackward int // backward limit, initially 0
+	Bra           int // start of the current slice
+	Ket           int // end of the current slice
+}
+
+// NewEnv returns an Env for the string s, with the cursor at its start.
+func NewEnv(s string) *Env {
+	env := &Env{}
+	env.SetCurrent(s)
+	return env
+}
+
+// SetCurrent replaces the string being worked on and resets all positions.
+func (env *Env) SetCurrent(s string) {
+	env.b = append(env.b[:0], s...)
+	env.Cursor = 0
+	env.Limit = len(env.b)
+	env.LimitBackward = 0
+	env.Bra = 0
+	env.Ket = len(env.b)
+}
+
+// Current returns the string being worked on.
+func (env *Env) Current() string {
+	return string(env.b)
+}
+
+// NextChar moves the cursor forward by one character.
+func (env *Env) NextChar() {
+	_, n := utf8.DecodeRune(env.b[env.Cursor:env.Limit])
+	env.Cursor += n
+}
+
+// PrevChar moves the cursor backward by one character.
+func (env *Env) PrevChar() {
+	_, n := utf8.DecodeLastRune(env.b[env.LimitBackward:env.Cursor])
+	env.Cursor -= n
+}
+
+// Hop moves the cursor forward by n characters. It fails, leaving the
+// cursor alone, if that would move it past the limit.
+func (env *Env) Hop(n int) bool {
+	c := env.Cursor
+	for ; n > 0; n-- {
+		if c >= env.Limit {
+			return false
+		}
+		_, size := utf8.DecodeRune(env.b[c:env.Limit])
+		c += size
+	}
+	if n < 0 {
+		return false
+	}
+	env.Cursor = c
+	return true
+}
+
+// HopBack moves the cursor backward by n characters. It fails, leaving the
+// cursor alone, if that would move it past the backward limit.
+func (env *Env) HopBack(n int) bool {
+	c := env.Cursor
+	for ; n > 0; n-- {
+		if c <= env.LimitBackward {
+			return false
+		}
+		_, size := utf8.DecodeLastRune(env.b[env.LimitBackward:c])
+		c -= size
+	}
+	if n < 0 {
+		return false
+	}
+	env.Cursor = c
+	return true
+}
+
+// EqS is true if s follows the cursor, which is then moved past it.
+func (env *Env) EqS(s string) bool {
+	if env.Limit-env.Cursor < len(s) || string(env.b[env.Cursor:env.Cursor+len(s)]) != s {
+		return false
+	}
+	env.Cursor += len(s)
+	return true
+}
+
+// EqSB is true if s precedes the cursor, which is then moved before it.
+func (env *Env) EqSB(s string) bool {
+	if env.Cursor-env.LimitBackward < len(s) || string(env.b[env.Cursor-len(s):env.Cursor]) != s {
+		return false
+	}
+	env.Cursor -= len(s)
+	return true
+}
+
+// InGrouping is true if the character after the cursor is in g, and then
+// moves the cursor past it.
+func (env *Env) InGrouping(g *Grouping) bool {
+	if env.Cursor >= env.Limit {
+		return false
+	}
+	r, n := utf8.DecodeRune(env.b[env.Cursor:env.Limit])
+	if !g.Contains(r) {
+		return false
+	}
+	env.Cursor += n
+	return true
+}
+
+// InGroupingB is true if the character before the cursor is in g, and then
+// moves the cursor before it.
+func (env *Env) InGroupingB(g *Grouping) bool {
+	if env.Cursor <= env.LimitBackward {
+		return false
+	}
+	r, n := utf8.DecodeLastRune(env.b[env.LimitBackward:env.Cursor])
+	if !g.Contains(r) {
+		return false
+	}
+	env.Cursor -= n
+	return true
+}
+
+// OutGrouping is true if there is a character after the cursor that is not
+// in g, and then moves the cursor past it.
+func (env *Env) OutGrouping(g *Grouping) bool {
+	if env.Cursor >= env.Limit {
+		return false
+	}
+	r, n := utf8.DecodeRune(env.b[env.Cursor:env.Limit])
+	if g.Contains(r) {
+		return false
+	}
+	env.Cursor += n
+	return true
+}
+
+// OutGroupingB is true if there is a character before the cursor that is
+// not in g, and then moves the cursor before it.
+func (env *Env) OutGroupingB(g *Grouping) bool {
+	if env.Cursor <= env.LimitBackward {
+		return false
+	}
+	r, n := utf8.DecodeLastRune(env.b[env.LimitBackward:env.Cursor])
+	if g.Contains(r) {
+		return false
+	}
+	env.Cursor -= n
+	return true
+}
+
+// replace replaces b[bra:ket] with s, adjusting the limit and cursor, and
+// returns the change in length.
+func (env *Env) replace(bra, ket int, s string) int {
+	adjustment := len(s) - (ket - bra)
+	tail := len(env.b) - ket
+	if adjustment > 0 {
+		env.b = append(env.b, s[:adjustment]...)
+	}
+	copy(env.b[bra+len(s):], env.b[ket:ket+tail])
+	copy(env.b[bra:], s)
+	env.b = env.b[:bra+len(s)+tail]
+
+	env.Limit += adjustment
+	if env.Cursor >= ket {
+		env.Cursor += adjustment
+	} else if env.Cursor > bra {
+		env.Cursor = bra
+	}
+	return adjustment
+}
+
+// sliceOK is true if bra and ket mark a valid slice.
+func (env *Env) sliceOK() bool {
+	return 0 <= env.Bra && env.Bra <= env.Ket && env.Ket <= env.Limit && env.Limit <= len(env.b)
+}
+
+// SliceFrom replaces the slice with s. It fails if the slice is invalid.
+func (env *Env) SliceFrom(s string) bool {
+	if !env.sliceOK() {
+		return false
+	}
+	env.replace(env.Bra, env.Ket, s)
+	env.Ket = env.Bra + len(s)
+	return true
+}
+
+// SliceDel deletes the slice. It fails if the slice is invalid.
+func (env *Env) SliceDel() bool {
+	return env.SliceFrom("")
+}
+
+// SliceTo returns the contents of the slice, or "" if it is invalid.
+func (env *Env) SliceTo() string {
+	if !env.sliceOK() {
+		return ""
+	}
+	return string(env.b[env.Bra:env.Ket])
+}
+
+// Insert inserts s at the cursor, leaving the cursor where it is and
+// moving bra and ket along if they are at or after it.
+func (env *Env) Insert(s string) {
+	c := env.Cursor
+	adjustment := env.replace(c, c, s)
+	if c <= env.Bra {
+		env.Bra += adjustment
+	}
+	if c <= env.Ket {
+		env.Ket += adjustment
+	}
+	env.Cursor = c
+}
+
+// Among is one entry of an among table.
+type Among struct {
+	S      string // the string to match
+	Result int    // the value returned by FindAmong, at least 1
+	Cond   int    // the routine that must hold after matching, 0 for none
+}
+
+// FindAmong finds the longest entry of amongs that follows the cursor and,
+// if it has a condition, for which cond returns true. It moves the cursor
+// past the match and returns its result, or returns 0 if nothing matched.
+//
+// amongs must be sorted by decreasing length, which the generator does.
+func (env *Env) FindAmong(amongs []Among, cond func(int) bool) int {
+	c := env.Cursor
+	for i := range amongs {
+		a := &amongs[i]
+		if env.Limit-c < len(a.S) || string(env.b[c:c+len(a.S)]) != a.S {
+			continue
+		}
+		env.Cursor = c + len(a.S)
+		if a.Cond == 0 {
+			return a.Result
+		}
+		ok := cond(a.Cond)
+		env.Cursor = c + len(a.S)
+		if ok {
+			return a.Result
+		}
+	}
+	env.Cursor = c
+	return 0
+}
+
+// FindAmongB is the backward version of FindAmong: it matches the entries
+// against the text before the cursor, and moves the cursor before the
+// match.
+func (env *Env) FindAmongB(amongs []Among, cond func(int) bool) int {
+	c := env.Cursor
+	for i := range amongs {
+		a := &amongs[i]
+		if c-env.LimitBackward < len(a.S) || string(env.b[c-len(a.S):c]) != a.S {
+			continue
+		}
+		env.Cursor = c - len(a.S)
+		if a.Cond == 0 {
+			return a.Result
+		}
+		ok := cond(a.Cond)
+		env.Cursor = c - len(a.S)
+		if ok {
+			return a.Result
+		}
+	}
+	env.Cursor = c
+	return 0
+}
+
+// Grouping is a set of characters.
+type Grouping struct {
+	min  rune
+	bits []uint64
+}
+
+// NewGrouping returns the grouping containing the characters in chars.
+func NewGrouping(chars string) *Grouping {
+	g := &Grouping{min: utf8.MaxRune}
+	hi := rune(-1)
+	for _, r := range chars {
+		if r < g.min {
+			g.min = r
+		}
+		if r > hi {
+			hi = r
+		}
+	}
+	if hi < 0 {
+		return g
+	}
+	g.bits = make([]uint64, (hi-g.min)/64+1)
+	for _, r := range chars {
+		i := r - g.min
+		g.bits[i/64] |= 1 << (i % 64)
+	}
+	return g
+}
+
+// Contains is true if r is in g.
+func (g *Grouping) Contains(r rune) bool {
+	i := r - g.min
+	if i < 0 || int(i/64) >= len(g.bits) {
+		return false
+	}
+	return g.bits[i/64]&(1<<(i%64)) != 0
+}
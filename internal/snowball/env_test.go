@@ -0,0 +1,89 @@
+package snowball
+
+import "testing"
+
+func TestSliceFrom(t *testing.T) {
+	env := NewEnv("hopping")
+	env.Cursor, env.Bra, env.Ket = 7, 3, 7
+	if !env.SliceFrom("e") {
+		t.Fatal("SliceFrom failed")
+	}
+	if have := env.Current(); have != "hope" {
+		t.Errorf("want 'hope' have '%s'", have)
+	}
+	if env.Cursor != 4 || env.Limit != 4 || env.Ket != 4 {
+		t.Errorf("cursor %d limit %d ket %d, want 4 4 4", env.Cursor, env.Limit, env.Ket)
+	}
+}
+
+func TestInsert(t *testing.T) {
+	env := NewEnv("hopng")
+	env.Cursor, env.Bra, env.Ket = 3, 3, 5
+	env.Insert("pi")
+	if have := env.Current(); have != "hopping" {
+		t.Errorf("want 'hopping' have '%s'", have)
+	}
+	if env.Cursor != 3 || env.Bra != 5 || env.Ket != 7 {
+		t.Errorf("cursor %d bra %d ket %d, want 3 5 7", env.Cursor, env.Bra, env.Ket)
+	}
+}
+
+func TestHop(t *testing.T) {
+	env := NewEnv("käse")
+	if !env.Hop(2) || env.Cursor != 3 {
+		t.Errorf("Hop(2): cursor %d, want 3", env.Cursor)
+	}
+	if env.Hop(3) || env.Cursor != 3 {
+		t.Errorf("Hop(3) past the limit moved the cursor to %d", env.Cursor)
+	}
+	if !env.HopBack(1) || env.Cursor != 1 {
+		t.Errorf("HopBack(1): cursor %d, want 1", env.Cursor)
+	}
+}
+
+func TestFindAmongB(t *testing.T) {
+	amongs := []Among{
+		{S: "sses", Result: 1},
+		{S: "ies", Result: 2, Cond: 1},
+		{S: "es", Result: 3},
+		{S: "s", Result: 4},
+	}
+	var test = []struct {
+		word   string
+		cond   bool
+		result int
+		cursor int
+	}{
+		{"caresses", true, 1, 4},
+		{"ponies", true, 2, 3},
+		{"ponies", false, 3, 4},
+		{"cats", true, 4, 3},
+		{"cat", true, 0, 3},
+	}
+
+	for _, tc := range test {
+		env := NewEnv(tc.word)
+		env.Cursor = env.Limit
+		result := env.FindAmongB(amongs, func(int) bool { return tc.cond })
+		if result != tc.result || env.Cursor != tc.cursor {
+			t.Errorf("'%s' want %d at %d have %d at %d", tc.word, tc.result, tc.cursor, result, env.Cursor)
+		}
+	}
+}
+
+func TestGrouping(t *testing.T) {
+	g := NewGrouping("aeiouyäö")
+	for _, r := range "aeiouyäö" {
+		if !g.Contains(r) {
+			t.Errorf("%q not in grouping", r)
+		}
+	}
+	for _, r := range "bzåÿ`" {
+		if g.Contains(r) {
+			t.Errorf("%q in grouping", r)
+		}
+	}
+	if NewGrouping("").Contains('a') {
+		t.Error("empty grouping contains 'a'")
+	}
+}
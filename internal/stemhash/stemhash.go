@@ -0,0 +1,51 @@
+// Package stemhash is the hash of the table of frequent words and their
+// stems that the porter package looks words up in before stemming them. It
+// is shared by the package and by stemtablegen, which generates the table,
+// so that both send every word to the same entry.
+//
+// The table is a minimal perfect hash table: the words are put in buckets
+// by their hash mixed with seed 0, and the seed of each bucket sends each of
+// its words to an entry of its own when mixed with their hash.
+package stemhash
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// MaxLen is the length of the longest word Hash tells apart from all
+// others of its length.
+const MaxLen = 16
+
+// Hash hashes a word of up to MaxLen bytes, which it reads as two
+// overlapping uint64s (or uint32s for a word of four to seven bytes, and
+// its first, middle and last byte for a shorter one) that, with the length,
+// determine the word. Longer words are hashed by their first and last eight
+// bytes.
+func Hash(b []byte) uint64 {
+	n := len(b)
+	var x, y uint64
+	switch {
+	case n >= 8:
+		x, y = binary.LittleEndian.Uint64(b), binary.LittleEndian.Uint64(b[n-8:])
+	case n >= 4:
+		x, y = uint64(binary.LittleEndian.Uint32(b)), uint64(binary.LittleEndian.Uint32(b[n-4:]))
+	case n > 0:
+		x = uint64(b[0])<<16 | uint64(b[n/2])<<8 | uint64(b[n-1])
+	}
+	return x*0x9e3779b97f4a7c15 ^ bits.RotateLeft64(y*0xc2b2ae3d27d4eb4f, 31) ^ uint64(n)
+}
+
+// Slot mixes the hash h of a word with seed, with the finalizer of
+// MurmurHash3 so that the results of different seeds are unrelated, and
+// maps it to one of n slots. Seed 0 gives the bucket of a word, the seed of
+// its bucket its entry.
+func Slot(h uint64, seed uint16, n int) int {
+	h ^= uint64(seed) * 0x9e3779b97f4a7c15
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return int(h >> 32 * uint64(n) >> 32)
+}
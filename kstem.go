@@ -0,0 +1,284 @@
+package porter
+
+import (
+	_ "embed"
+	"strings"
+	"sync"
+)
+
+// This file implements a dictionary-validated stemmer in the style of the
+// one described in:
+//
+//	Krovetz, 1993, Viewing Morphology as an Inference Process, Proceedings
+//	of ACM SIGIR '93, pp 191-202
+//
+// Where Porter removes a suffix whenever the remaining stem is long enough,
+// KStem removes it only if what remains is a word. The result is always a
+// word, so it can be shown to users, at the cost of conflating fewer forms:
+// words that cannot be reduced to a word in the lexicon are left alone.
+//
+// The lexicon holds the headwords of the en_US Hunspell dictionary built
+// from SCOWL, without the inflected and derived forms Hunspell generates
+// from them with affix flags; kstem_lexicon.txt says where it comes from.
+// As in Krovetz's stemmer, a word that is in the lexicon is not stemmed, so
+// that words that only look inflected or derived, like "news", "morning" or
+// "business", are left alone. The dictionary lists some inflected and
+// derived forms as words of their own, like "running" and "easily", which
+// are left alone too.
+
+//go:embed kstem_lexicon.txt
+var kstemLexiconData string
+
+var (
+	kstemLexiconOnce sync.Once
+	kstemLexicon     map[string]struct{}
+)
+
+// loadKStemLexicon reads the lexicon, a word per line after comment lines
+// starting with '#'.
+func loadKStemLexicon() {
+	lines := strings.Split(kstemLexiconData, "\n")
+	kstemLexicon = make(map[string]struct{}, len(lines))
+	for _, w := range lines {
+		if w = strings.TrimSpace(w); w != "" && w[0] != '#' {
+			kstemLexicon[w] = struct{}{}
+		}
+	}
+}
+
+// kstemIsWord is true if w is in the lexicon.
+func kstemIsWord(w string) bool {
+	kstemLexiconOnce.Do(loadKStemLexicon)
+	_, ok := kstemLexicon[w]
+	return ok
+}
+
+// kstemRule replaces suffix with each of repl in turn; the first result
+// that is a word is the stem.
+type kstemRule struct {
+	suffix string
+	repl   []string
+	min    int      // minimum length of the word without the suffix
+	except []string // endings of words the rule does not apply to
+	e      bool     // also try the stem as is and with 'e' added
+	double bool     // first try the stem with a final double consonant undoubled
+}
+
+// The rules are tried in order: inflectional endings first, then
+// comparatives and derivational endings. Within each group, longer
+// suffixes come before the shorter ones they end in so that e.g. "ies" can
+// restore a 'y'.
+var (
+	// plurals and third person singular
+	kstemPlurals = []kstemRule{
+		{suffix: "ies", repl: []string{"y", "ie"}, min: 2},
+		{suffix: "es", repl: []string{"e", ""}, min: 2},
+		{suffix: "s", repl: []string{""}, min: 3, except: []string{"ss", "us", "is"}},
+	}
+
+	// past tense and participles
+	kstemVerbEndings = []kstemRule{
+		{suffix: "ied", repl: []string{"y", "ie"}, min: 2},
+		{suffix: "eed", repl: []string{"ee"}, min: 2},
+		{suffix: "ed", min: 2, e: true, double: true, except: []string{"eed"}},
+		{suffix: "ying", repl: []string{"ie"}, min: 1},
+		{suffix: "ing", min: 3, e: true, double: true},
+	}
+
+	kstemComparatives = []kstemRule{
+		{suffix: "iest", repl: []string{"y"}, min: 2},
+		{suffix: "ier", repl: []string{"y"}, min: 2},
+		{suffix: "est", min: 4, e: true, double: true},
+		{suffix: "er", repl: []string{"e"}, min: 4},
+	}
+
+	kstemDerivations = []kstemRule{
+		{suffix: "iness", repl: []string{"y"}, min: 2},
+		{suffix: "ness", repl: []string{""}, min: 3},
+		{suffix: "ily", repl: []string{"y"}, min: 2},
+		{suffix: "ally", repl: []string{"al"}, min: 3},
+		{suffix: "bly", repl: []string{"ble"}, min: 2},
+		{suffix: "ly", repl: []string{""}, min: 4, except: []string{"lly"}},
+		{suffix: "ility", repl: []string{"le"}, min: 2},
+		{suffix: "ality", repl: []string{"al"}, min: 2},
+		{suffix: "ivity", repl: []string{"ive"}, min: 2},
+		{suffix: "ity", min: 3, e: true},
+		{suffix: "ment", repl: []string{""}, min: 3},
+		{suffix: "ication", repl: []string{"y"}, min: 2},
+		{suffix: "ization", repl: []string{"ize"}, min: 2},
+		{suffix: "ation", repl: []string{"ate"}, min: 3, e: true},
+		{suffix: "sion", repl: []string{"se", "s"}, min: 2},
+		{suffix: "tion", repl: []string{"te", "t"}, min: 4},
+		{suffix: "iable", repl: []string{"y"}, min: 2},
+		{suffix: "able", min: 3, e: true, double: true},
+		{suffix: "ible", min: 3, e: true},
+		{suffix: "ical", repl: []string{"ic", "y"}, min: 2},
+		{suffix: "ial", repl: []string{"y"}, min: 2},
+		{suffix: "al", min: 6, e: true},
+		{suffix: "ive", repl: []string{"e"}, min: 3, e: true},
+		{suffix: "ize", min: 3, e: true},
+		{suffix: "ism", min: 3, e: true},
+		{suffix: "ic", repl: []string{"y"}, min: 4, e: true},
+		{suffix: "iful", repl: []string{"y"}, min: 2},
+		{suffix: "ful", repl: []string{""}, min: 3},
+		{suffix: "ancy", repl: []string{"ant"}, min: 2},
+		{suffix: "ency", repl: []string{"ent"}, min: 2},
+		{suffix: "ance", min: 4, e: true},
+		{suffix: "ence", min: 4, e: true},
+	}
+
+	kstemRules = concatRules(kstemPlurals, kstemVerbEndings, kstemComparatives, kstemDerivations)
+)
+
+func concatRules(groups ...[]kstemRule) []kstemRule {
+	var rules []kstemRule
+	for _, g := range groups {
+		rules = append(rules, g...)
+	}
+	return rules
+}
+
+func kstemVowel(c byte) bool {
+	return c == 'a' || c == 'e' || c == 'i' || c == 'o' || c == 'u'
+}
+
+// kstemUndouble is true if a doubled c at the end of a stem may have been
+// doubled for the suffix, as in "stopped"; words do end in "ll", "ss" and
+// "zz".
+func kstemUndouble(c byte) bool {
+	return !kstemVowel(c) && c != 'l' && c != 's' && c != 'z'
+}
+
+// kstemPreferE is true if a stem looks like it lost a final 'e', so that
+// "hop" from "hoping" is tried as "hope" before "hop": it is a vowel and a
+// consonant ("us" from "using"), or ends consonant-vowel-consonant with the
+// last consonant not w, x or y.
+func kstemPreferE(s string) bool {
+	n := len(s)
+	switch {
+	case n == 2:
+		return kstemVowel(s[0]) && !kstemVowel(s[1])
+	case n > 2:
+		c := s[n-1]
+		return !kstemVowel(s[n-3]) && kstemVowel(s[n-2]) && !kstemVowel(c) &&
+			c != 'w' && c != 'x' && c != 'y'
+	}
+	return false
+}
+
+// candidates returns the possible stems of w under rule r, most likely
+// first, or nil if the rule does not apply.
+func (r *kstemRule) candidates(w string) []string {
+	if !strings.HasSuffix(w, r.suffix) || len(w)-len(r.suffix) < r.min {
+		return nil
+	}
+	for _, e := range r.except {
+		if strings.HasSuffix(w, e) {
+			return nil
+		}
+	}
+	stem := w[:len(w)-len(r.suffix)]
+	var c []string
+	// "running" is "run", not "runn"; but "added" is "add"
+	if n := len(stem); r.double && n > 3 && stem[n-1] == stem[n-2] && kstemUndouble(stem[n-1]) {
+		c = append(c, stem[:n-1])
+	}
+	for _, s := range r.repl {
+		c = append(c, stem+s)
+	}
+	if r.e {
+		if kstemPreferE(stem) {
+			c = append(c, stem+"e", stem)
+		} else {
+			c = append(c, stem, stem+"e")
+		}
+	}
+	return c
+}
+
+// kstem returns the stem of the lowercase word w, and whether it found one.
+func kstem(w string) (string, bool) {
+	if kstemIsWord(w) {
+		return w, false
+	}
+	return kstemApply(w, kstemRules)
+}
+
+// kstemApply returns the first word that one of rules reduces the
+// lowercase word w to, and whether there was one.
+func kstemApply(w string, rules []kstemRule) (string, bool) {
+	if len(w) <= 2 {
+		return w, false
+	}
+	for i := range rules {
+		for _, c := range rules[i].candidates(w) {
+			if c != w && kstemIsWord(c) {
+				return c, true
+			}
+		}
+	}
+	return w, false
+}
+
+// KStemmer is a dictionary-validated stemmer: it removes an inflectional or
+// derivational suffix only if what remains is a word in its English
+// lexicon, so that "cities" becomes "city" rather than Porter's "citi".
+// Words that are in the lexicon are left alone.
+//
+// Words that cannot be reduced to a lexicon word are returned lowercased
+// but otherwise unchanged. If PorterFallback is set, words that are not in
+// the lexicon at all are stemmed with the Porter algorithm instead.
+//
+// The zero value is ready to use.
+type KStemmer struct {
+	// PorterFallback makes Stem use the Porter algorithm for unknown
+	// words: those that are not in the lexicon and cannot be reduced to a
+	// word in it.
+	PorterFallback bool
+}
+
+// Stem stems the given English word and returns the stemmed form as a
+// string.
+//
+// The input word is converted to lowercase. Words with characters other
+// than ASCII letters are returned lowercased but otherwise unchanged.
+//
+// Empty input is valid and returns an empty string with no error.
+//
+// Example:
+//
+//	var k porter.KStemmer
+//	stemmed, err := k.Stem("generously")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	// stemmed is "generous"
+func (k KStemmer) Stem(word string) (string, error) {
+	if word == "" {
+		return "", nil
+	}
+	w := strings.ToLower(word)
+	for i := 0; i < len(w); i++ {
+		if w[i] < 'a' || w[i] > 'z' {
+			return w, nil
+		}
+	}
+	if stem, ok := kstem(w); ok || !k.PorterFallback || kstemIsWord(w) {
+		return stem, nil
+	}
+	return Stem(w)
+}
+
+// StemKrovetz stems the given English word with a KStemmer without Porter
+// fallback, so that the result is always a word or the word itself.
+//
+// Example:
+//
+//	stemmed, err := porter.StemKrovetz("cities")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	// stemmed is "city"
+func StemKrovetz(word string) (string, error) {
+	return KStemmer{}.Stem(word)
+}
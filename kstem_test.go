@@ -0,0 +1,173 @@
+package porter
+
+import (
+	"fmt"
+	"testing"
+)
+
+var kstemTests = []stemmerTest{
+	// plurals
+	{"cities", "city"},
+	{"dies", "die"},
+	{"horses", "horse"},
+	{"boxes", "box"},
+	{"books", "book"},
+	{"things", "thing"},
+	{"mornings", "morning"},
+	{"glass", "glass"},
+	{"news", "news"},
+	// past tense and participles
+	{"hoping", "hope"},
+	{"added", "add"},
+	{"walked", "walk"},
+	{"agreed", "agree"},
+	{"seed", "seed"},
+	{"carried", "carry"},
+	{"jumping", "jump"},
+	{"kissing", "kiss"},
+	{"bringing", "bring"},
+	{"morning", "morning"},
+	// comparatives
+	{"happier", "happy"},
+	{"larger", "large"},
+	{"greatest", "great"},
+	{"kindest", "kind"},
+	{"corner", "corner"},
+	// derivational endings
+	{"generally", "general"},
+	{"generously", "generous"},
+	{"quickly", "quick"},
+	{"nicely", "nice"},
+	{"musically", "musical"},
+	{"confusion", "confuse"},
+	{"reliable", "rely"},
+	{"general", "general"},
+	{"station", "station"},
+	{"information", "information"},
+	// short and unknown words
+	{"as", "as"},
+	{"is", "is"},
+	{"xyzzy", "xyzzy"},
+	{"grokkers", "grokkers"},
+}
+
+// Words of the lexicon are left alone, however inflected or derived they
+// look, and the minimum stem lengths keep "thing" from becoming "the".
+var kstemLexiconTests = []string{
+	"thing", "bring", "string", "apply", "reply", "early", "business",
+	"capable", "butter", "does", "question", "sentence", "position", "news",
+	"morning", "several", "medical", "evening", "information", "running",
+}
+
+func TestStemKrovetz(t *testing.T) {
+	for _, test := range kstemTests {
+		stemmed, err := StemKrovetz(test.in)
+		if err != nil {
+			t.Errorf("'%s' unexpected error: %v\n", test.in, err)
+			continue
+		}
+		if stemmed != test.out {
+			t.Errorf("'%s' want '%s' have '%s'\n", test.in, test.out, stemmed)
+		}
+	}
+}
+
+func TestStemKrovetzLexiconWords(t *testing.T) {
+	for _, w := range kstemLexiconTests {
+		if stemmed, _ := StemKrovetz(w); stemmed != w {
+			t.Errorf("'%s' want '%s' have '%s'\n", w, w, stemmed)
+		}
+	}
+}
+
+func TestKStemmerPorterFallback(t *testing.T) {
+	k := KStemmer{PorterFallback: true}
+	var test = []stemmerTest{
+		{"generally", "general"}, // reduced to a word
+		{"general", "general"},   // a word, left alone
+		{"grokkers", "grokker"},  // unknown, stemmed by Porter
+		{"xyzzy", "xyzzi"},
+		{"", ""},
+	}
+
+	for _, tc := range test {
+		if stemmed, err := k.Stem(tc.in); err != nil || stemmed != tc.out {
+			t.Errorf("'%s' want '%s' have '%s' (%v)\n", tc.in, tc.out, stemmed, err)
+		}
+	}
+}
+
+// Inflected forms that the lexicon does not list, as it has only the
+// headwords, are reduced to the headword.
+func TestStemKrovetzUnlisted(t *testing.T) {
+	var test = []stemmerTest{
+		{"emails", "email"},
+		{"browsers", "browser"},
+		{"websites", "website"},
+		{"selfies", "selfie"},
+		{"downloaded", "download"},
+		{"googled", "google"},
+		{"crowdfunding", "crowdfund"},
+	}
+
+	for _, tc := range test {
+		if kstemIsWord(tc.in) {
+			t.Errorf("'%s' in lexicon", tc.in)
+		}
+		if stemmed, _ := StemKrovetz(tc.in); stemmed != tc.out {
+			t.Errorf("'%s' want '%s' have '%s'\n", tc.in, tc.out, stemmed)
+		}
+	}
+}
+
+func TestStemKrovetzCase(t *testing.T) {
+	var test = []stemmerTest{
+		{"Cities", "city"},
+		{"GENERALLY", "general"},
+		{"Café", "café"},
+		{"o'er", "o'er"},
+		{"", ""},
+	}
+
+	for _, tc := range test {
+		if stemmed, _ := StemKrovetz(tc.in); stemmed != tc.out {
+			t.Errorf("'%s' want '%s' have '%s'\n", tc.in, tc.out, stemmed)
+		}
+	}
+}
+
+func TestKStemLexicon(t *testing.T) {
+	for _, w := range []string{"run", "easy", "general", "use", "born"} {
+		if !kstemIsWord(w) {
+			t.Errorf("'%s' not in lexicon", w)
+		}
+	}
+	// Porter stems are not words
+	for _, w := range []string{"valu", "argu", "stopp", "easili"} {
+		if kstemIsWord(w) {
+			t.Errorf("'%s' in lexicon", w)
+		}
+	}
+}
+
+// Every result of the stemmer is a word or the word itself.
+func TestStemKrovetzWords(t *testing.T) {
+	for _, test := range tests {
+		stemmed, _ := StemKrovetz(test.in)
+		if stemmed != test.in && !kstemIsWord(stemmed) {
+			t.Errorf("'%s' stemmed to '%s', which is not in the lexicon", test.in, stemmed)
+		}
+	}
+}
+
+func ExampleStemKrovetz() {
+	for _, word := range []string{"cities", "generally", "hoping"} {
+		kstemmed, _ := StemKrovetz(word)
+		stemmed, _ := Stem(word)
+		fmt.Println(word, kstemmed, stemmed)
+	}
+	// Output:
+	// cities city citi
+	// generally general gener
+	// hoping hope hope
+}
@@ -0,0 +1,240 @@
+package porter
+
+import (
+	"sort"
+	"sync"
+	"unicode"
+)
+
+// This file implements a small language identifier for Latin-script text
+// using the rank-order n-gram profiles described in:
+//
+//	Cavnar, Trenkle, 1994, N-Gram-Based Text Categorization, Proceedings
+//	of SDAIR-94, pp 161-175
+//
+// Each language is represented by the most frequent trigrams of a short
+// sample text. A document is assigned to the language whose profile is
+// closest by the "out of place" measure: the sum, over the document's top
+// trigrams, of how far each one's rank is from its rank in the language's
+// profile.
+
+// Language is an ISO 639-1 language code such as "en".
+type Language string
+
+const (
+	LangUnknown Language = "und" // undetermined, as in BCP 47
+	LangArabic  Language = "ar"
+	LangEnglish Language = "en"
+	LangFinnish Language = "fi"
+	LangFrench  Language = "fr"
+	LangGerman  Language = "de"
+	LangSpanish Language = "es"
+)
+
+const (
+	langProfileSize = 300 // trigrams kept per profile
+	langMinTrigrams = 20  // shorter documents are not identified
+)
+
+// langSamples are the texts the profiles are built from. They only need to
+// be long enough to capture each language's common letter sequences.
+var langSamples = map[Language]string{
+	LangEnglish: `The quick growth of the city during the last century changed the way
+		people lived and worked. Most of the families who moved there came from
+		small villages in the north, and they brought with them their own habits
+		and traditions. It was not always easy for them to find work, but the
+		factories that were being built along the river needed many hands. Over
+		the years the old houses near the harbour were replaced by offices and
+		shops, and the streets became wider and busier. Today the city is known
+		for its universities, its museums and the parks which surround the
+		centre. Visitors who come for a weekend often say that there is too much
+		to see, and that they would like to return in the summer when the
+		weather is warmer and the evenings are longer. What they remember most
+		is the friendliness of the people and the feeling that something
+		interesting is always happening around the next corner.`,
+	LangFinnish: `Kaupungin nopea kasvu viime vuosisadan aikana muutti tapaa, jolla
+		ihmiset elivät ja tekivät työtä. Useimmat perheet, jotka muuttivat
+		sinne, tulivat pieniltä kyliltä pohjoisesta, ja he toivat mukanaan omat
+		tapansa ja perinteensä. Heidän ei ollut aina helppoa löytää työtä,
+		mutta joen varrelle rakennetut tehtaat tarvitsivat paljon työntekijöitä.
+		Vuosien kuluessa sataman lähellä olleet vanhat talot korvattiin
+		toimistoilla ja kaupoilla, ja kaduista tuli leveämpiä ja vilkkaampia.
+		Nykyään kaupunki tunnetaan yliopistoistaan, museoistaan ja puistoista,
+		jotka ympäröivät keskustaa. Viikonlopuksi tulevat matkailijat sanovat
+		usein, että nähtävää on liikaa, ja että he haluaisivat palata kesällä,
+		kun sää on lämpimämpi ja illat ovat pidempiä. Parhaiten he muistavat
+		ihmisten ystävällisyyden ja tunteen siitä, että jotakin kiinnostavaa
+		tapahtuu aina seuraavan kulman takana.`,
+	LangGerman: `Das schnelle Wachstum der Stadt im letzten Jahrhundert hat die Art
+		und Weise verändert, wie die Menschen lebten und arbeiteten. Die meisten
+		Familien, die dorthin zogen, kamen aus kleinen Dörfern im Norden und
+		brachten ihre eigenen Gewohnheiten und Traditionen mit. Es war für sie
+		nicht immer leicht, Arbeit zu finden, aber die Fabriken, die entlang des
+		Flusses gebaut wurden, brauchten viele Arbeiter. Im Laufe der Jahre
+		wurden die alten Häuser in der Nähe des Hafens durch Büros und Geschäfte
+		ersetzt, und die Straßen wurden breiter und belebter. Heute ist die
+		Stadt bekannt für ihre Universitäten, ihre Museen und die Parks, die das
+		Zentrum umgeben. Besucher, die für ein Wochenende kommen, sagen oft, dass
+		es zu viel zu sehen gibt und dass sie gerne im Sommer zurückkehren
+		würden, wenn das Wetter wärmer ist und die Abende länger sind. Am meisten
+		erinnern sie sich an die Freundlichkeit der Menschen und an das Gefühl,
+		dass hinter der nächsten Ecke immer etwas Interessantes geschieht.`,
+	LangFrench: `La croissance rapide de la ville au cours du siècle dernier a changé
+		la façon dont les gens vivaient et travaillaient. La plupart des
+		familles qui s'y sont installées venaient de petits villages du nord, et
+		elles ont apporté avec elles leurs propres habitudes et traditions. Il
+		n'était pas toujours facile pour elles de trouver du travail, mais les
+		usines que l'on construisait le long de la rivière avaient besoin de
+		beaucoup de bras. Au fil des années, les vieilles maisons près du port
+		ont été remplacées par des bureaux et des magasins, et les rues sont
+		devenues plus larges et plus animées. Aujourd'hui, la ville est connue
+		pour ses universités, ses musées et les parcs qui entourent le centre.
+		Les visiteurs qui viennent pour un week-end disent souvent qu'il y a
+		trop de choses à voir, et qu'ils aimeraient revenir en été, quand il
+		fait plus chaud et que les soirées sont plus longues. Ce dont ils se
+		souviennent le plus, c'est la gentillesse des habitants et le sentiment
+		qu'il se passe toujours quelque chose d'intéressant au coin de la rue.`,
+	LangSpanish: `El rápido crecimiento de la ciudad durante el siglo pasado cambió la
+		forma en que la gente vivía y trabajaba. La mayoría de las familias que
+		se mudaron allí venían de pequeños pueblos del norte, y trajeron consigo
+		sus propias costumbres y tradiciones. No siempre fue fácil para ellos
+		encontrar trabajo, pero las fábricas que se construían a lo largo del
+		río necesitaban muchas manos. Con los años, las casas viejas cerca del
+		puerto fueron sustituidas por oficinas y tiendas, y las calles se
+		volvieron más anchas y más concurridas. Hoy la ciudad es conocida por
+		sus universidades, sus museos y los parques que rodean el centro. Los
+		visitantes que vienen para un fin de semana dicen a menudo que hay
+		demasiado que ver, y que les gustaría volver en verano, cuando hace más
+		calor y las tardes son más largas. Lo que más recuerdan es la amabilidad
+		de la gente y la sensación de que siempre está pasando algo interesante
+		a la vuelta de la esquina.`,
+}
+
+var (
+	langProfilesOnce sync.Once
+	langProfiles     map[Language]map[string]int // trigram -> rank
+)
+
+// trigramCounts counts the trigrams of the words in text, with each word
+// lowercased and padded with a space on either side so that word starts
+// and ends are captured. Only letters are kept.
+func trigramCounts(text string) map[string]int {
+	counts := map[string]int{}
+	word := []rune{' '}
+	flush := func() {
+		if len(word) > 1 {
+			word = append(word, ' ')
+			for i := 0; i+3 <= len(word); i++ {
+				counts[string(word[i:i+3])]++
+			}
+		}
+		word = word[:1]
+	}
+	for _, r := range text {
+		if unicode.IsLetter(r) {
+			word = append(word, unicode.ToLower(r))
+		} else {
+			flush()
+		}
+	}
+	flush()
+	return counts
+}
+
+// rankTrigrams returns the n most frequent trigrams in counts, most
+// frequent first. Ties are broken alphabetically to keep ranks stable.
+func rankTrigrams(counts map[string]int, n int) []string {
+	grams := make([]string, 0, len(counts))
+	for g := range counts {
+		grams = append(grams, g)
+	}
+	sort.Slice(grams, func(i, j int) bool {
+		if counts[grams[i]] != counts[grams[j]] {
+			return counts[grams[i]] > counts[grams[j]]
+		}
+		return grams[i] < grams[j]
+	})
+	if len(grams) > n {
+		grams = grams[:n]
+	}
+	return grams
+}
+
+func buildLangProfiles() {
+	langProfiles = make(map[Language]map[string]int, len(langSamples))
+	for lang, sample := range langSamples {
+		profile := map[string]int{}
+		for rank, g := range rankTrigrams(trigramCounts(sample), langProfileSize) {
+			profile[g] = rank
+		}
+		langProfiles[lang] = profile
+	}
+}
+
+// IdentifyLanguage guesses the language of a Latin-script text. It knows
+// English, Finnish, French, German and Spanish.
+//
+// The text should contain at least a sentence or so; for shorter texts,
+// and for texts that are not written in the Latin script, IdentifyLanguage
+// returns LangUnknown and false.
+//
+// Example:
+//
+//	lang, ok := porter.IdentifyLanguage("Talvella järvet jäätyvät")
+//	// lang is porter.LangFinnish, ok is true
+func IdentifyLanguage(text string) (Language, bool) {
+	langProfilesOnce.Do(buildLangProfiles)
+
+	if !isLatinText(text) {
+		return LangUnknown, false
+	}
+	counts := trigramCounts(text)
+	total := 0
+	for _, n := range counts {
+		total += n
+	}
+	if total < langMinTrigrams {
+		return LangUnknown, false
+	}
+	grams := rankTrigrams(counts, langProfileSize)
+
+	best, bestDistance := LangUnknown, -1
+	for lang, profile := range langProfiles {
+		distance := 0
+		for rank, g := range grams {
+			if r, ok := profile[g]; ok {
+				if r > rank {
+					distance += r - rank
+				} else {
+					distance += rank - r
+				}
+			} else {
+				distance += langProfileSize
+			}
+		}
+		if bestDistance < 0 || distance < bestDistance || distance == bestDistance && lang < best {
+			best, bestDistance = lang, distance
+		}
+	}
+	// if hardly any trigram is known to the winner, this is not a
+	// language we know
+	if bestDistance >= len(grams)*langProfileSize*9/10 {
+		return LangUnknown, false
+	}
+	return best, true
+}
+
+// isLatinText reports whether most letters of text are in the Latin
+// script.
+func isLatinText(text string) bool {
+	latin, other := 0, 0
+	for _, r := range text {
+		switch {
+		case unicode.Is(unicode.Latin, r):
+			latin++
+		case unicode.IsLetter(r):
+			other++
+		}
+	}
+	return latin > other
+}
@@ -0,0 +1,379 @@
+package porter
+
+import (
+	"strings"
+	"sync"
+)
+
+// This file implements a lemmatizer for English: it maps inflected forms to
+// their dictionary form ("ran" to "run", "mice" to "mouse", "better" to
+// "good") using tables of irregular forms, and handles regular inflection
+// with the plural, verb and comparative rules of the KStem stemmer, so
+// that a regular lemma is always a word in the KStem lexicon.
+
+// PartOfSpeech restricts the forms a Lemmatizer considers.
+type PartOfSpeech int
+
+const (
+	AnyPOS    PartOfSpeech = iota // try verbs, then nouns, then adjectives
+	Noun                          // plurals
+	Verb                          // third person, past tense and participles
+	Adjective                     // comparatives and superlatives
+)
+
+// irregularVerbs lists each verb's lemma followed by its irregular forms.
+var irregularVerbs = []string{
+	"arise arose arisen",
+	"awake awoke awoken",
+	"be am is are was were been",
+	"bear bore borne born",
+	"beat beaten",
+	"become became",
+	"begin began begun",
+	"bend bent",
+	"bet",
+	"bind bound",
+	"bite bit bitten",
+	"bleed bled",
+	"blow blew blown",
+	"break broke broken",
+	"breed bred",
+	"bring brought",
+	"build built",
+	"burn burnt",
+	"burst",
+	"buy bought",
+	"catch caught",
+	"choose chose chosen",
+	"cling clung",
+	"come came",
+	"cost",
+	"creep crept",
+	"cut",
+	"deal dealt",
+	"dig dug",
+	"do does did done",
+	"draw drew drawn",
+	"dream dreamt",
+	"drink drank drunk",
+	"drive drove driven",
+	"dwell dwelt",
+	"eat ate eaten",
+	"fall fell fallen",
+	"feed fed",
+	"feel felt",
+	"fight fought",
+	"find found",
+	"flee fled",
+	"fling flung",
+	"fly flew flown",
+	"forbid forbade forbidden",
+	"forget forgot forgotten",
+	"forgive forgave forgiven",
+	"freeze froze frozen",
+	"get got gotten",
+	"give gave given",
+	"go goes went gone",
+	"grow grew grown",
+	"hang hung",
+	"have has had",
+	"hear heard",
+	"hide hid hidden",
+	"hit",
+	"hold held",
+	"hurt",
+	"keep kept",
+	"kneel knelt",
+	"know knew known",
+	"lay laid",
+	"lead led",
+	"lean leant",
+	"leap leapt",
+	"learn learnt",
+	"leave left",
+	"lend lent",
+	"let",
+	"lie lay lain",
+	"light lit",
+	"lose lost",
+	"make made",
+	"mean meant",
+	"meet met",
+	"pay paid",
+	"put",
+	"quit",
+	"read",
+	"ride rode ridden",
+	"ring rang rung",
+	"rise rose risen",
+	"run ran",
+	"say said",
+	"see saw seen",
+	"seek sought",
+	"sell sold",
+	"send sent",
+	"set",
+	"sew sewn",
+	"shake shook shaken",
+	"shed",
+	"shine shone",
+	"shoot shot",
+	"show shown",
+	"shrink shrank shrunk",
+	"shut",
+	"sing sang sung",
+	"sink sank sunk",
+	"sit sat",
+	"slay slew slain",
+	"sleep slept",
+	"slide slid",
+	"sling slung",
+	"speak spoke spoken",
+	"speed sped",
+	"spell spelt",
+	"spend spent",
+	"spill spilt",
+	"spin spun",
+	"spit spat",
+	"split",
+	"spread",
+	"spring sprang sprung",
+	"stand stood",
+	"steal stole stolen",
+	"stick stuck",
+	"sting stung",
+	"stink stank stunk",
+	"stride strode stridden",
+	"strike struck stricken",
+	"strive strove striven",
+	"swear swore sworn",
+	"sweep swept",
+	"swim swam swum",
+	"swing swung",
+	"take took taken",
+	"teach taught",
+	"tear tore torn",
+	"tell told",
+	"think thought",
+	"throw threw thrown",
+	"thrust",
+	"tread trod trodden",
+	"understand understood",
+	"wake woke woken",
+	"wear wore worn",
+	"weave wove woven",
+	"weep wept",
+	"win won",
+	"wring wrung",
+	"write wrote written",
+}
+
+// irregularNouns lists each noun's singular followed by its irregular
+// plurals, and nouns that end like a plural but are not one.
+var irregularNouns = []string{
+	"alumnus alumni",
+	"analysis analyses",
+	"appendix appendices",
+	"axis axes",
+	"bacterium bacteria",
+	"basis bases",
+	"cactus cacti",
+	"calf calves",
+	"child children",
+	"crisis crises",
+	"criterion criteria",
+	"curriculum curricula",
+	"diagnosis diagnoses",
+	"die dice",
+	"elf elves",
+	"foot feet",
+	"fungus fungi",
+	"goose geese",
+	"half halves",
+	"hoof hooves",
+	"hypothesis hypotheses",
+	"index indices",
+	"knife knives",
+	"leaf leaves",
+	"life lives",
+	"loaf loaves",
+	"louse lice",
+	"man men",
+	"matrix matrices",
+	"mouse mice",
+	"news",
+	"nucleus nuclei",
+	"ox oxen",
+	"parenthesis parentheses",
+	"penny pence",
+	"person people",
+	"phenomenon phenomena",
+	"radius radii",
+	"scarf scarves",
+	"self selves",
+	"series",
+	"sheaf sheaves",
+	"shelf shelves",
+	"species",
+	"stimulus stimuli",
+	"stratum strata",
+	"syllabus syllabi",
+	"thesis theses",
+	"thief thieves",
+	"tooth teeth",
+	"vertex vertices",
+	"wife wives",
+	"wolf wolves",
+	"woman women",
+}
+
+// irregularAdjectives lists each adjective's positive form followed by its
+// irregular comparatives and superlatives.
+var irregularAdjectives = []string{
+	"bad worse worst",
+	"far farther farthest further furthest",
+	"good better best",
+	"little less least",
+	"many more most",
+	"old elder eldest",
+}
+
+// uninflectedWords are adverbs and pronouns that end like an inflected form
+// but are not one, and that the lexicon does not list.
+var uninflectedWords = map[string]bool{
+	"alas": true, "backwards": true, "besides": true, "forwards": true,
+	"ours": true, "overseas": true, "sideways": true, "sometimes": true,
+	"theirs": true, "yours": true,
+}
+
+var (
+	lemmaTablesOnce sync.Once
+	lemmaTables     map[PartOfSpeech]map[string]string // form -> lemma
+)
+
+func buildLemmaTables() {
+	lemmaTables = map[PartOfSpeech]map[string]string{
+		Verb:      lemmaTable(irregularVerbs),
+		Noun:      lemmaTable(irregularNouns),
+		Adjective: lemmaTable(irregularAdjectives),
+	}
+}
+
+// lemmaTable maps every form in lines to its lemma, the first word of its
+// line. Lemmas map to themselves, even where they are also a form of
+// another lemma ("lay" is the past of "lie").
+func lemmaTable(lines []string) map[string]string {
+	table := map[string]string{}
+	for _, line := range lines {
+		l := strings.Fields(line)[0]
+		table[l] = l
+	}
+	for _, line := range lines {
+		forms := strings.Fields(line)
+		for _, f := range forms[1:] {
+			if _, ok := table[f]; !ok {
+				table[f] = forms[0]
+			}
+		}
+	}
+	return table
+}
+
+// lemmaRules are the KStem rules for the regular inflections of each part
+// of speech.
+var lemmaRules = map[PartOfSpeech][]kstemRule{
+	AnyPOS:    concatRules(kstemPlurals, kstemVerbEndings, kstemComparatives),
+	Noun:      kstemPlurals,
+	Verb:      concatRules(kstemPlurals, kstemVerbEndings),
+	Adjective: kstemComparatives,
+}
+
+// lemma returns the lemma of the lowercase word w as pos.
+func lemma(w string, pos PartOfSpeech) string {
+	lemmaTablesOnce.Do(buildLemmaTables)
+	tables := []PartOfSpeech{pos}
+	if pos == AnyPOS {
+		tables = []PartOfSpeech{Verb, Noun, Adjective}
+	}
+	for _, t := range tables {
+		if l, ok := lemmaTables[t][w]; ok {
+			return l
+		}
+	}
+	// a word of the lexicon is its own lemma, however inflected it looks:
+	// "morning" is not a form of "morn"
+	if kstemIsWord(w) || uninflectedWords[w] {
+		return w
+	}
+	l, _ := kstemApply(w, lemmaRules[pos])
+	return l
+}
+
+// Lemmatizer maps English words to their lemmas, the forms found in a
+// dictionary: "ran" and "runs" become "run", "mice" becomes "mouse" and
+// "better" becomes "good". Unlike a stemmer it only undoes inflection, and
+// its results are always words; a word of its lexicon, such as "morning" or
+// "running", or that it does not recognize as an inflected form, is
+// returned lowercased but otherwise unchanged.
+//
+// The zero value is ready to use.
+type Lemmatizer struct {
+	// ThenStem makes the lemmatizer stem each lemma with Stem, so that
+	// "ran" and "runs" both become "run" and "generously" becomes
+	// "gener".
+	ThenStem bool
+}
+
+// Lemmatize returns the lemma of the given word. Without a part of speech,
+// irregular verb forms take precedence: "saw" becomes "see", not "saw".
+//
+// The input word is converted to lowercase. Words with characters other
+// than ASCII letters are returned lowercased but otherwise unchanged.
+//
+// Empty input is valid and returns an empty string with no error.
+//
+// Example:
+//
+//	var l porter.Lemmatizer
+//	lemma, err := l.Lemmatize("went")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	// lemma is "go"
+func (l Lemmatizer) Lemmatize(word string) (string, error) {
+	return l.LemmatizeAs(word, AnyPOS)
+}
+
+// LemmatizeAs returns the lemma of the given word used as pos, so that for
+// example the noun "saws" becomes "saw" while the verb "saw" becomes
+// "see". It handles its input like Lemmatize.
+func (l Lemmatizer) LemmatizeAs(word string, pos PartOfSpeech) (string, error) {
+	if word == "" {
+		return "", nil
+	}
+	w := strings.ToLower(word)
+	for i := 0; i < len(w); i++ {
+		if w[i] < 'a' || w[i] > 'z' {
+			return w, nil
+		}
+	}
+	w = lemma(w, pos)
+	if l.ThenStem {
+		return Stem(w)
+	}
+	return w, nil
+}
+
+// Lemmatize returns the lemma of the given word using a Lemmatizer
+// without stemming.
+//
+// Example:
+//
+//	lemma, err := porter.Lemmatize("mice")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	// lemma is "mouse"
+func Lemmatize(word string) (string, error) {
+	return Lemmatizer{}.Lemmatize(word)
+}
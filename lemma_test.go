@@ -0,0 +1,159 @@
+package porter
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+var lemmaTests = []stemmerTest{
+	// irregular verbs
+	{"ran", "run"},
+	{"went", "go"},
+	{"gone", "go"},
+	{"was", "be"},
+	{"is", "be"},
+	{"children", "child"},
+	{"mice", "mouse"},
+	{"feet", "foot"},
+	{"women", "woman"},
+	{"better", "good"},
+	{"worst", "bad"},
+	{"thought", "think"},
+	{"lay", "lay"},
+	{"lain", "lie"},
+	// regular inflection
+	{"jumping", "jump"},
+	{"walked", "walk"},
+	{"hoping", "hope"},
+	{"carries", "carry"},
+	{"cities", "city"},
+	{"horses", "horse"},
+	{"happier", "happy"},
+	{"kindest", "kind"},
+	// lemmas and words that are not inflected
+	{"run", "run"},
+	{"mouse", "mouse"},
+	{"news", "news"},
+	{"running", "running"},
+	{"thing", "thing"},
+	{"string", "string"},
+	{"ceiling", "ceiling"},
+	{"butter", "butter"},
+	{"morning", "morning"},
+	{"evening", "evening"},
+	{"physics", "physics"},
+	{"mathematics", "mathematics"},
+	{"sometimes", "sometimes"},
+	{"easily", "easily"},
+	{"generous", "generous"},
+	{"xyzzy", "xyzzy"},
+}
+
+func TestLemmatize(t *testing.T) {
+	for _, test := range lemmaTests {
+		lemma, err := Lemmatize(test.in)
+		if err != nil {
+			t.Errorf("'%s' unexpected error: %v\n", test.in, err)
+			continue
+		}
+		if lemma != test.out {
+			t.Errorf("'%s' want '%s' have '%s'\n", test.in, test.out, lemma)
+		}
+	}
+}
+
+func TestLemmatizeAs(t *testing.T) {
+	var test = []struct {
+		in  string
+		pos PartOfSpeech
+		out string
+	}{
+		{"saw", AnyPOS, "see"},
+		{"saw", Verb, "see"},
+		{"saw", Noun, "saw"},
+		{"saws", Noun, "saw"},
+		{"leaves", Noun, "leaf"},
+		{"leaves", Verb, "leave"},
+		{"left", Verb, "leave"},
+		{"better", Adjective, "good"},
+		{"better", Verb, "better"},
+		{"walked", Noun, "walked"},
+		{"walks", Verb, "walk"},
+		{"larger", Adjective, "large"},
+		{"larger", Noun, "larger"},
+	}
+
+	var l Lemmatizer
+	for _, tc := range test {
+		if lemma, _ := l.LemmatizeAs(tc.in, tc.pos); lemma != tc.out {
+			t.Errorf("'%s' as %d want '%s' have '%s'\n", tc.in, tc.pos, tc.out, lemma)
+		}
+	}
+}
+
+func TestLemmatizeThenStem(t *testing.T) {
+	l := Lemmatizer{ThenStem: true}
+	var test = []stemmerTest{
+		{"ran", "run"},
+		{"mice", "mous"},
+		{"better", "good"},
+		{"generously", "gener"},
+		{"Children", "child"},
+		{"", ""},
+	}
+
+	for _, tc := range test {
+		if stemmed, err := l.Lemmatize(tc.in); err != nil || stemmed != tc.out {
+			t.Errorf("'%s' want '%s' have '%s' (%v)\n", tc.in, tc.out, stemmed, err)
+		}
+	}
+}
+
+func TestLemmatizeCase(t *testing.T) {
+	var test = []stemmerTest{
+		{"Went", "go"},
+		{"MICE", "mouse"},
+		{"o'er", "o'er"},
+		{"Café", "café"},
+		{"", ""},
+	}
+
+	for _, tc := range test {
+		if lemma, _ := Lemmatize(tc.in); lemma != tc.out {
+			t.Errorf("'%s' want '%s' have '%s'\n", tc.in, tc.out, lemma)
+		}
+	}
+}
+
+// Every irregular form is listed under only one lemma in its table.
+func TestIrregularTables(t *testing.T) {
+	for name, lines := range map[string][]string{
+		"verbs":      irregularVerbs,
+		"nouns":      irregularNouns,
+		"adjectives": irregularAdjectives,
+	} {
+		seen := map[string]string{}
+		for _, line := range lines {
+			forms := strings.Fields(line)
+			for _, f := range forms[1:] {
+				if other, ok := seen[f]; ok {
+					t.Errorf("%s: '%s' is a form of both '%s' and '%s'", name, f, other, forms[0])
+				}
+				seen[f] = forms[0]
+			}
+		}
+	}
+}
+
+func ExampleLemmatize() {
+	for _, word := range []string{"ran", "mice", "better"} {
+		lemma, _ := Lemmatize(word)
+		stemmed, _ := Stem(word)
+		fmt.Println(word, lemma, stemmed)
+	}
+	// Output:
+	// ran run ran
+	// mice mouse mice
+	// better good better
+}
@@ -0,0 +1,134 @@
+package porter
+
+import (
+	"bytes"
+	"strings"
+)
+
+// This file implements two light stemmers for English, for applications
+// that want plurals folded but not derivational endings removed, e.g. so
+// that "generalization" and "general" stay apart:
+//
+// "light Porter" runs only steps 1a, 1b and 1c of the Porter algorithm,
+// removing plurals, -ed and -ing and turning a final 'y' into 'i'.
+//
+// The S-stemmer only removes plurals, using the three rules given in:
+//
+//	Harman, 1991, How Effective is Suffixing?, Journal of the American
+//	Society for Information Science, Vol. 42, no. 1, pp 7-15
+
+// z.stemLight(b) is z.stem(b) with only the first steps, which deal with
+// inflectional endings.
+func (z *stemmer) stemLight(b []byte) int {
+	z.b = b
+	z.j = 0
+	z.k = len(b) - 1
+
+	if z.k > 1 {
+		z.step1ab()
+		z.step1c()
+	}
+	return z.k
+}
+
+// StemLight stems the given word with the first steps of the Porter
+// algorithm only: plurals and the endings -ed and -ing are removed, and a
+// final 'y' after a vowel in the stem becomes 'i', but derivational endings
+// such as -ation or -ful are left alone.
+//
+// The input word is converted to lowercase. Empty input is valid and
+// returns an empty string with no error.
+//
+// Example:
+//
+//	stemmed, err := porter.StemLight("generalizations")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	// stemmed is "generalization"
+func StemLight(word string) (string, error) {
+	if word == "" {
+		return "", nil
+	}
+	var z stemmer
+	b := []byte(strings.ToLower(word))
+	bn := z.stemLight(b)
+	if bn >= 0 && bn < len(z.b) {
+		return string(z.b[:bn+1]), nil
+	}
+	return "", ErrInvalidInput
+}
+
+// StemLightBytes is the byte slice version of StemLight. Like StemBytes, it
+// lowercases and stems b in place and returns a sub-slice of it.
+func StemLightBytes(b []byte) ([]byte, error) {
+	if len(b) == 0 {
+		return b[:0], nil
+	}
+	lowerLetters(b)
+	var z stemmer
+	bn := z.stemLight(b)
+	if bn >= 0 && bn < len(b) {
+		return b[:bn+1], nil
+	}
+	return b[:0], ErrInvalidInput
+}
+
+var (
+	_AES  = []byte("aes")
+	_AIES = []byte("aies")
+	_EES  = []byte("ees")
+	_EIES = []byte("eies")
+	_ES   = []byte("es")
+	_OES  = []byte("oes")
+	_SS   = []byte("ss")
+	_US   = []byte("us")
+)
+
+// sstem applies the first of Harman's rules that matches b and returns the
+// stemmed word, a prefix of b except that "ies" becomes "y" in place:
+//
+//	ies -> y   unless the word ends in "aies" or "eies"
+//	es  -> e   unless the word ends in "aes", "ees" or "oes"
+//	s   ->     unless the word ends in "us" or "ss"
+//
+// Words of one or two letters, like "is" and "us", are not stemmed.
+func sstem(b []byte) []byte {
+	n := len(b)
+	switch {
+	case n <= 2:
+	case bytes.HasSuffix(b, _IES) && !bytes.HasSuffix(b, _AIES) && !bytes.HasSuffix(b, _EIES):
+		b[n-3] = 'y'
+		return b[:n-2]
+	case bytes.HasSuffix(b, _ES) && !bytes.HasSuffix(b, _AES) && !bytes.HasSuffix(b, _EES) && !bytes.HasSuffix(b, _OES):
+		return b[:n-1]
+	case b[n-1] == 's' && !bytes.HasSuffix(b, _US) && !bytes.HasSuffix(b, _SS):
+		return b[:n-1]
+	}
+	return b
+}
+
+// StemS stems the given word with Harman's S-stemmer, which only folds
+// plurals: "ponies" becomes "pony", "horses" becomes "horse" and "cats"
+// becomes "cat", while "bus", "glass" and "generalization" are left alone.
+//
+// The input word is converted to lowercase. Empty input is valid and
+// returns an empty string with no error.
+//
+// Example:
+//
+//	stemmed, err := porter.StemS("ponies")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	// stemmed is "pony"
+func StemS(word string) (string, error) {
+	return string(sstem([]byte(strings.ToLower(word)))), nil
+}
+
+// StemSBytes is the byte slice version of StemS. Like StemBytes, it
+// lowercases and stems b in place and returns a sub-slice of it.
+func StemSBytes(b []byte) ([]byte, error) {
+	lowerLetters(b)
+	return sstem(b), nil
+}
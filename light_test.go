@@ -0,0 +1,183 @@
+package porter
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+var lightTests = []stemmerTest{
+	// step 1a
+	{"caresses", "caress"},
+	{"ponies", "poni"},
+	{"ties", "ti"},
+	{"caress", "caress"},
+	{"cats", "cat"},
+	// step 1b
+	{"feed", "feed"},
+	{"agreed", "agree"},
+	{"disabled", "disable"},
+	{"matting", "mat"},
+	{"mating", "mate"},
+	{"meeting", "meet"},
+	{"milling", "mill"},
+	{"messing", "mess"},
+	{"meetings", "meet"},
+	{"conflated", "conflate"},
+	{"troubled", "trouble"},
+	{"sized", "size"},
+	{"hopping", "hop"},
+	{"falling", "fall"},
+	{"filing", "file"},
+	{"sing", "sing"},
+	// step 1c
+	{"happy", "happi"},
+	{"sky", "sky"},
+	// derivational endings are kept
+	{"generalizations", "generalization"},
+	{"relational", "relational"},
+	{"hopeful", "hopeful"},
+	{"goodness", "goodness"},
+	{"electrical", "electrical"},
+	// short words
+	{"is", "is"},
+	{"as", "as"},
+}
+
+var sstemTests = []stemmerTest{
+	// ies -> y
+	{"ponies", "pony"},
+	{"queries", "query"},
+	{"cookies", "cooky"},
+	{"aies", "aie"},
+	{"eies", "eie"},
+	// es -> e
+	{"horses", "horse"},
+	{"boxes", "boxe"},
+	{"heroes", "heroe"},
+	{"toes", "toe"},
+	{"trees", "tree"},
+	{"aloes", "aloe"},
+	{"amoebaes", "amoebae"},
+	// s ->
+	{"cats", "cat"},
+	{"products", "product"},
+	{"bus", "bus"},
+	{"glass", "glass"},
+	{"corpus", "corpus"},
+	// not plurals
+	{"running", "running"},
+	{"generalization", "generalization"},
+	{"is", "is"},
+	{"as", "as"},
+	{"s", "s"},
+}
+
+func TestStemLight(t *testing.T) {
+	for _, test := range lightTests {
+		stemmed, err := StemLight(test.in)
+		if err != nil {
+			t.Errorf("'%s' unexpected error: %v\n", test.in, err)
+			continue
+		}
+		if stemmed != test.out {
+			t.Errorf("'%s' want '%s' have '%s'\n", test.in, test.out, stemmed)
+		}
+	}
+}
+
+func TestStemLightBytes(t *testing.T) {
+	for _, test := range lightTests {
+		stemmed, err := StemLightBytes([]byte(test.in))
+		if err != nil {
+			t.Errorf("'%s' unexpected error: %v\n", test.in, err)
+			continue
+		}
+		if string(stemmed) != test.out {
+			t.Errorf("'%s' want '%s' have '%s'\n", test.in, test.out, stemmed)
+		}
+	}
+}
+
+// Light Porter is the first part of full Porter: continuing with the
+// remaining steps gives the same result as Stem.
+func TestStemLightPorter(t *testing.T) {
+	for _, test := range tests {
+		var z stemmer
+		b := []byte(strings.ToLower(test.in))
+		z.stemLight(b)
+		if len(b) > 2 {
+			z.step2()
+			z.step3()
+			z.step4()
+			z.step5()
+		}
+		if stemmed := string(b[:z.k+1]); stemmed != test.out {
+			t.Errorf("'%s' want '%s' have '%s'\n", test.in, test.out, stemmed)
+		}
+	}
+}
+
+func TestStemS(t *testing.T) {
+	for _, test := range sstemTests {
+		stemmed, err := StemS(test.in)
+		if err != nil {
+			t.Errorf("'%s' unexpected error: %v\n", test.in, err)
+			continue
+		}
+		if stemmed != test.out {
+			t.Errorf("'%s' want '%s' have '%s'\n", test.in, test.out, stemmed)
+		}
+	}
+}
+
+func TestStemSBytes(t *testing.T) {
+	for _, test := range sstemTests {
+		stemmed, err := StemSBytes([]byte(test.in))
+		if err != nil {
+			t.Errorf("'%s' unexpected error: %v\n", test.in, err)
+			continue
+		}
+		if string(stemmed) != test.out {
+			t.Errorf("'%s' want '%s' have '%s'\n", test.in, test.out, stemmed)
+		}
+	}
+}
+
+func TestLightCase(t *testing.T) {
+	var test = map[string][2]string{
+		"PONIES":   {"poni", "pony"},
+		"Meetings": {"meet", "meeting"},
+		"":         {"", ""},
+	}
+
+	for in, want := range test {
+		if light, _ := StemLight(in); light != want[0] {
+			t.Errorf("StemLight('%s') want '%s' have '%s'\n", in, want[0], light)
+		}
+		if s, _ := StemS(in); s != want[1] {
+			t.Errorf("StemS('%s') want '%s' have '%s'\n", in, want[1], s)
+		}
+	}
+}
+
+func BenchmarkStemSBytes(b *testing.B) {
+	buf := make([]byte, 0, 16)
+	for i := 0; i < b.N; i++ {
+		buf = append(buf[:0], "ponies"...)
+		_, _ = StemSBytes(buf)
+	}
+}
+
+func ExampleStemS() {
+	for _, word := range []string{"ponies", "products", "generalizations"} {
+		s, _ := StemS(word)
+		light, _ := StemLight(word)
+		stemmed, _ := Stem(word)
+		fmt.Println(word, s, light, stemmed)
+	}
+	// Output:
+	// ponies pony poni poni
+	// products product product product
+	// generalizations generalization generalization gener
+}
@@ -0,0 +1,478 @@
+package porter
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// This file implements a generic suffix-stripping engine that interprets
+// rules written in the notation of Porter's paper, such as
+//
+//	(m>0) ational -> ate
+//	(*v*) ing ->
+//	(*d and not (*L or *S or *Z)) -> single letter
+//
+// The Porter stemmer itself is shipped in that format in porter.rules and
+// checked against the hand-coded stemmer in the tests.
+
+//go:embed porter.rules
+var porterRulesData string
+
+var (
+	porterRulesOnce sync.Once
+	porterRules     *RuleSet
+)
+
+// PorterRules returns the rules of the Porter stemmer. The RuleSet is
+// shared and must not be modified.
+func PorterRules() *RuleSet {
+	porterRulesOnce.Do(func() {
+		rs, err := ParseRules(strings.NewReader(porterRulesData))
+		if err != nil {
+			panic("porter.rules: " + err.Error())
+		}
+		porterRules = rs
+	})
+	return porterRules
+}
+
+// RuleSet is a suffix-stripping stemmer made of steps that are applied to a
+// word in order.
+type RuleSet struct {
+	Steps []RuleStep
+}
+
+// RuleStep is a list of rules of which at most one is applied: the rule
+// with the longest suffix that the word ends in, leaving a stem of at least
+// one letter. If several rules have that suffix, the first one whose
+// condition holds is applied. If none does, the step leaves the word alone,
+// even when a rule with a shorter suffix would apply.
+type RuleStep struct {
+	Name string
+	// After is the name of an earlier step; if set, this step only runs if
+	// a rule of that step was applied.
+	After string
+	Rules []Rule
+
+	after int // index of After in the RuleSet, or -1
+}
+
+// Rule replaces Suffix by Replacement if Condition holds for the stem, the
+// word without Suffix.
+type Rule struct {
+	Condition   string // as written, without parentheses; "" always holds
+	Suffix      string
+	Replacement string
+	// Undouble is set for rules written "-> single letter", which remove
+	// the last letter of a word ending in a double consonant. Replacement
+	// is empty.
+	Undouble bool
+
+	cond func(z *stemmer) bool
+}
+
+// String returns the rule as written in the rule format.
+func (r *Rule) String() string {
+	var b strings.Builder
+	if r.Condition != "" {
+		b.WriteString("(" + r.Condition + ") ")
+	}
+	if r.Suffix != "" {
+		b.WriteString(r.Suffix + " ")
+	}
+	b.WriteString("->")
+	if r.Undouble {
+		b.WriteString(" single letter")
+	} else if r.Replacement != "" {
+		b.WriteString(" " + r.Replacement)
+	}
+	return b.String()
+}
+
+// ParseRules reads a RuleSet in the rule format of porter.rules.
+//
+// Blank lines and lines starting with '#' are ignored. A line
+//
+//	step NAME [after STEP]
+//
+// starts a step, and the lines after it up to the next step are its rules:
+//
+//	[(CONDITION)] [SUFFIX] -> [REPLACEMENT]
+//
+// SUFFIX and REPLACEMENT are lowercase letters and may be left out when
+// empty. The REPLACEMENT "single letter" removes the last letter of the
+// word and needs an empty SUFFIX. A CONDITION is made of the following,
+// combined with and, or, not and parentheses, and is tested on the stem:
+//
+//	m>N, m=N, m<N  the measure of the stem, its number of vowel-consonant sequences
+//	*v*            the stem contains a vowel
+//	*d             the stem ends in a double consonant
+//	*o             the stem ends consonant-vowel-consonant, the last not w, x or y
+//	*X             the stem ends in the letter x, for any uppercase letter X
+//
+// Example:
+//
+//	rules, err := porter.ParseRules(strings.NewReader(`
+//	step 1
+//	    (*v*) ing ->
+//	    (m>0) ly ->
+//	`))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	stemmed, err := rules.Stem("walking")
+//	// stemmed is "walk"
+func ParseRules(r io.Reader) (*RuleSet, error) {
+	rs := &RuleSet{}
+	steps := map[string]int{}
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		if fields := strings.Fields(line); fields[0] == "step" {
+			step := RuleStep{after: -1}
+			switch {
+			case len(fields) == 2:
+			case len(fields) == 4 && fields[2] == "after":
+				i, ok := steps[fields[3]]
+				if !ok {
+					return nil, fmt.Errorf("line %d: no step %q before this one", n, fields[3])
+				}
+				step.After, step.after = fields[3], i
+			default:
+				return nil, fmt.Errorf("line %d: want \"step NAME [after STEP]\"", n)
+			}
+			step.Name = fields[1]
+			if _, ok := steps[step.Name]; ok {
+				return nil, fmt.Errorf("line %d: step %q defined twice", n, step.Name)
+			}
+			if len(rs.Steps) == 64 {
+				return nil, fmt.Errorf("line %d: more than 64 steps", n)
+			}
+			steps[step.Name] = len(rs.Steps)
+			rs.Steps = append(rs.Steps, step)
+			continue
+		}
+		if len(rs.Steps) == 0 {
+			return nil, fmt.Errorf("line %d: rule outside a step", n)
+		}
+		rule, err := parseRule(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", n, err)
+		}
+		step := &rs.Steps[len(rs.Steps)-1]
+		step.Rules = append(step.Rules, rule)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// parseRule parses a line holding a rule.
+func parseRule(line string) (Rule, error) {
+	var r Rule
+	if line[0] == '(' {
+		depth, end := 0, -1
+		for i := 0; i < len(line) && end < 0; i++ {
+			switch line[i] {
+			case '(':
+				depth++
+			case ')':
+				if depth--; depth == 0 {
+					end = i
+				}
+			}
+		}
+		if end < 0 {
+			return r, fmt.Errorf("unbalanced parentheses")
+		}
+		r.Condition = strings.Join(strings.Fields(line[1:end]), " ")
+		cond, err := parseCondition(r.Condition)
+		if err != nil {
+			return r, fmt.Errorf("condition %q: %v", r.Condition, err)
+		}
+		r.cond = cond
+		line = line[end+1:]
+	}
+
+	suffix, repl, ok := strings.Cut(line, "->")
+	if !ok {
+		return r, fmt.Errorf("want \"[(CONDITION)] [SUFFIX] -> [REPLACEMENT]\"")
+	}
+	s, rp := strings.Fields(suffix), strings.Fields(repl)
+	if len(s) > 1 {
+		return r, fmt.Errorf("suffix %q is not one word", strings.TrimSpace(suffix))
+	}
+	if len(s) == 1 {
+		r.Suffix = s[0]
+	}
+	switch {
+	case len(rp) == 2 && rp[0] == "single" && rp[1] == "letter":
+		if r.Suffix != "" {
+			return r, fmt.Errorf("\"single letter\" needs an empty suffix")
+		}
+		r.Undouble = true
+	case len(rp) > 1:
+		return r, fmt.Errorf("replacement %q is not one word", strings.TrimSpace(repl))
+	case len(rp) == 1:
+		r.Replacement = rp[0]
+	}
+	for _, w := range []string{r.Suffix, r.Replacement} {
+		for _, c := range []byte(w) {
+			if c < 'a' || c > 'z' {
+				return r, fmt.Errorf("%q is not lowercase letters", w)
+			}
+		}
+	}
+	return r, nil
+}
+
+// condParser is a recursive descent parser for rule conditions. The
+// conditions it returns test the stem z.b[:z.j+1].
+type condParser struct {
+	toks []string
+	pos  int
+}
+
+// parseCondition parses the condition s.
+func parseCondition(s string) (func(z *stemmer) bool, error) {
+	p := &condParser{toks: lexCondition(s)}
+	cond, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.toks) {
+		return nil, fmt.Errorf("unexpected %q", p.toks[p.pos])
+	}
+	return cond, nil
+}
+
+// lexCondition splits a condition into parentheses, operators, numbers,
+// words and the *v*, *d, *o and *X tests.
+func lexCondition(s string) []string {
+	var toks []string
+	for i := 0; i < len(s); {
+		c := s[i]
+		j := i + 1
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+			continue
+		case c == '*':
+			if strings.HasPrefix(s[i:], "*v*") {
+				j = i + 3
+			} else if j < len(s) {
+				j++
+			}
+		case isLetter(c):
+			for j < len(s) && isLetter(s[j]) {
+				j++
+			}
+		case c >= '0' && c <= '9':
+			for j < len(s) && s[j] >= '0' && s[j] <= '9' {
+				j++
+			}
+		}
+		toks = append(toks, s[i:j])
+		i = j
+	}
+	return toks
+}
+
+func isLetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func (p *condParser) next() string {
+	if p.pos == len(p.toks) {
+		return ""
+	}
+	p.pos++
+	return p.toks[p.pos-1]
+}
+
+func (p *condParser) peek() string {
+	if p.pos == len(p.toks) {
+		return ""
+	}
+	return p.toks[p.pos]
+}
+
+func (p *condParser) or() (func(z *stemmer) bool, error) {
+	a, err := p.and()
+	for err == nil && p.peek() == "or" {
+		p.next()
+		var b func(z *stemmer) bool
+		if b, err = p.and(); err == nil {
+			a0 := a
+			a = func(z *stemmer) bool { return a0(z) || b(z) }
+		}
+	}
+	return a, err
+}
+
+func (p *condParser) and() (func(z *stemmer) bool, error) {
+	a, err := p.not()
+	for err == nil && p.peek() == "and" {
+		p.next()
+		var b func(z *stemmer) bool
+		if b, err = p.not(); err == nil {
+			a0 := a
+			a = func(z *stemmer) bool { return a0(z) && b(z) }
+		}
+	}
+	return a, err
+}
+
+func (p *condParser) not() (func(z *stemmer) bool, error) {
+	switch t := p.next(); {
+	case t == "not":
+		a, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+		return func(z *stemmer) bool { return !a(z) }, nil
+	case t == "(":
+		a, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if p.next() != ")" {
+			return nil, fmt.Errorf("missing )")
+		}
+		return a, nil
+	case t == "m":
+		op := p.next()
+		n, err := strconv.Atoi(p.next())
+		if err != nil {
+			return nil, fmt.Errorf("want m>N, m=N or m<N")
+		}
+		switch op {
+		case ">":
+			return func(z *stemmer) bool { return z.m() > n }, nil
+		case "=":
+			return func(z *stemmer) bool { return z.m() == n }, nil
+		case "<":
+			return func(z *stemmer) bool { return z.m() < n }, nil
+		}
+		return nil, fmt.Errorf("want m>N, m=N or m<N")
+	case t == "*v*":
+		return (*stemmer).vowelinstem, nil
+	case t == "*d":
+		return func(z *stemmer) bool { return z.doublec(z.j) }, nil
+	case t == "*o":
+		return func(z *stemmer) bool { return z.cvc(z.j) }, nil
+	case len(t) == 2 && t[0] == '*' && 'A' <= t[1] && t[1] <= 'Z':
+		c := t[1] - 'A' + 'a'
+		return func(z *stemmer) bool { return z.j >= 0 && z.b[z.j] == c }, nil
+	case t == "":
+		return nil, fmt.Errorf("unexpected end")
+	}
+	return nil, fmt.Errorf("unexpected %q", p.toks[p.pos-1])
+}
+
+// hasSuffix is bytes.HasSuffix for a string suffix, without converting it.
+func hasSuffix(b []byte, s string) bool {
+	return len(b) >= len(s) && string(b[len(b)-len(s):]) == s
+}
+
+// apply applies the rule of s that matches the word z.b[:z.k+1], if any,
+// and reports whether there was one. The word may grow beyond z.b. The
+// rule is reported to z.trace like those of the hand-coded steps. If tried
+// is not nil, it is called with the index of each rule whose condition is
+// tested and the result.
+func (s *RuleStep) apply(z *stemmer, tried func(rule int, passed bool)) bool {
+	word := z.b[:z.k+1]
+	longest := -1
+	for i := range s.Rules {
+		suffix := s.Rules[i].Suffix
+		if len(suffix) > longest && len(suffix) < len(word) && hasSuffix(word, suffix) {
+			longest = len(suffix)
+		}
+	}
+	for i := range s.Rules {
+		r := &s.Rules[i]
+		if len(r.Suffix) != longest || !hasSuffix(word, r.Suffix) {
+			continue
+		}
+		z.j = z.k - len(r.Suffix)
+		passed := r.cond == nil || r.cond(z)
+		if tried != nil {
+			tried(i, passed)
+		}
+		if !passed {
+			continue
+		}
+		if r.Undouble {
+			z.fire(z.b[z.k-1:z.k+1], z.b[z.k:z.k+1])
+			z.k--
+			return true
+		}
+		if z.trace != nil && r.Suffix != r.Replacement {
+			z.fire(word[z.j+1:], []byte(r.Replacement))
+		}
+		z.b = append(z.b[:z.j+1], r.Replacement...)
+		z.k = len(z.b) - 1
+		return true
+	}
+	return false
+}
+
+// stem applies the steps of rs to z.b and returns the index of the last
+// letter of the result, like stemmer.stem. If fired is not nil, it is
+// called for each rule applied, as in RuleSet.StemTrace, and if tried is
+// not nil, for each rule whose condition is tested, as in RuleStep.apply.
+func (rs *RuleSet) stem(z *stemmer, fired func(step, rule string), tried func(step, rule int, passed bool)) int {
+	z.k = len(z.b) - 1
+	if z.k <= 1 {
+		return z.k
+	}
+	var applied uint64 // bit i is set if a rule of step i was applied
+	for i := range rs.Steps {
+		s := &rs.Steps[i]
+		if s.after >= 0 && applied&(1<<s.after) == 0 {
+			continue
+		}
+		if fired != nil {
+			z.trace = func(rule string) { fired(s.Name, rule) }
+		}
+		var triedRule func(rule int, passed bool)
+		if tried != nil {
+			triedRule = func(rule int, passed bool) { tried(i, rule, passed) }
+		}
+		if s.apply(z, triedRule) {
+			applied |= 1 << i
+		}
+	}
+	return z.k
+}
+
+// Stem stems the given word with the rules of rs, after converting it to
+// lowercase. With PorterRules, it returns the same stems as Stem.
+func (rs *RuleSet) Stem(word string) (string, error) {
+	return rs.StemTrace(word, nil)
+}
+
+// StemTrace stems the given word like RuleSet.Stem and calls fired, if not
+// nil, for each rule that is applied, with the name of its step and the
+// suffix replaced and its replacement, as in the package StemTrace. Rules
+// that leave the word unchanged, like "ss -> ss", are not reported.
+func (rs *RuleSet) StemTrace(word string, fired func(step, rule string)) (string, error) {
+	if word == "" {
+		return "", nil
+	}
+	z := stemmer{b: []byte(strings.ToLower(word))}
+	bn := rs.stem(&z, fired, nil)
+	if bn >= 0 && bn < len(z.b) {
+		return string(z.b[:bn+1]), nil
+	}
+	return "", ErrInvalidInput
+}
@@ -0,0 +1,157 @@
+package porter
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestPorterRules(t *testing.T) {
+	rules := PorterRules()
+	for _, test := range tests {
+		stemmed, err := rules.Stem(test.in)
+		if err != nil {
+			t.Errorf("'%s' unexpected error: %v\n", test.in, err)
+			continue
+		}
+		if stemmed != test.out {
+			t.Errorf("'%s' want '%s' have '%s'\n", test.in, test.out, stemmed)
+		}
+	}
+}
+
+func TestRuleString(t *testing.T) {
+	for _, step := range PorterRules().Steps {
+		for _, r := range step.Rules {
+			parsed, err := parseRule(r.String())
+			if err != nil {
+				t.Errorf("'%s' unexpected error: %v\n", r.String(), err)
+				continue
+			}
+			if have := parsed.String(); have != r.String() {
+				t.Errorf("'%s' want '%s' have '%s'\n", r.Suffix, r.String(), have)
+			}
+		}
+	}
+}
+
+func TestParseRules(t *testing.T) {
+	rules, err := ParseRules(strings.NewReader(`
+# a toy stemmer
+step 1
+	(*v*) ing ->
+	(m>0 and not *S) ly ->
+step 2 after 1
+	(*d) -> single letter
+	(m<2 and *o and (*T or *K)) ->   e
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ruleTests = []stemmerTest{
+		{"walking", "walk"},
+		{"wing", "wing"},
+		{"quickly", "quick"},
+		{"lly", "lly"},
+		{"sly", "sly"},
+		{"running", "run"},
+		{"quickness", "quickness"},
+		{"waking", "wake"},
+		{"Writing", "write"},
+	}
+	for _, test := range ruleTests {
+		if stemmed, _ := rules.Stem(test.in); stemmed != test.out {
+			t.Errorf("'%s' want '%s' have '%s'\n", test.in, test.out, stemmed)
+		}
+	}
+}
+
+func TestParseRulesErrors(t *testing.T) {
+	var errorTests = []stemmerTest{
+		{"ing ->", "line 1: rule outside a step"},
+		{"step 1\n(m>0 ing ->", "line 2: unbalanced parentheses"},
+		{"step 1\n(m) ing ->", `line 2: condition "m": want m>N, m=N or m<N`},
+		{"step 1\n(*v* or) ing ->", `line 2: condition "*v* or": unexpected end`},
+		{"step 1\n(*v* *d) ing ->", `line 2: condition "*v* *d": unexpected "*d"`},
+		{"step 1\n(*x) ing ->", `line 2: condition "*x": unexpected "*x"`},
+		{"step 1\ning", `line 2: want "[(CONDITION)] [SUFFIX] -> [REPLACEMENT]"`},
+		{"step 1\nING ->", `line 2: "ING" is not lowercase letters`},
+		{"step 1\ning -> single letter", `line 2: "single letter" needs an empty suffix`},
+		{"step 1 after 2", `line 1: no step "2" before this one`},
+		{"step 1\nstep 1", `line 2: step "1" defined twice`},
+	}
+	for _, test := range errorTests {
+		_, err := ParseRules(strings.NewReader(test.in))
+		if err == nil || err.Error() != test.out {
+			t.Errorf("'%s' want '%s' have '%v'\n", test.in, test.out, err)
+		}
+	}
+}
+
+func BenchmarkPorterRules(b *testing.B) {
+	rules := PorterRules()
+	for i := 0; i < b.N; i++ {
+		rules.Stem("running")
+	}
+}
+
+func ExampleParseRules() {
+	rules, err := ParseRules(strings.NewReader(`
+step 1
+	(*v*) ing ->
+	(m>0) ly ->
+`))
+	if err != nil {
+		panic(err)
+	}
+	stemmed, _ := rules.Stem("walking")
+	fmt.Println(stemmed)
+	// Output: walk
+}
+
+// handStep is the step of the hand-coded stemmer that does the work of
+// each step of porter.rules.
+var handStep = map[string]string{
+	"1a": "step1ab", "1b": "step1ab", "1b2": "step1ab", "1c": "step1c",
+	"2": "step2", "3": "step3", "4": "step4", "5a": "step5", "5b": "step5",
+}
+
+func TestRulesInSync(t *testing.T) {
+	rules := &RuleSet{Steps: Rules()}
+	for _, step := range rules.Steps {
+		if _, ok := handStep[step.Name]; !ok {
+			t.Fatalf("'%s' no hand-coded step\n", step.Name)
+		}
+	}
+	words := suffixWords()
+	for _, test := range tests {
+		words = append(words, test.in)
+	}
+	for _, word := range words {
+		var want, have []string
+		StemTrace(word, func(step, rule string) {
+			want = append(want, step+" "+rule)
+		})
+		rules.StemTrace(word, func(step, rule string) {
+			have = append(have, handStep[step]+" "+rule)
+		})
+		if strings.Join(want, ", ") != strings.Join(have, ", ") {
+			t.Errorf("'%s' want '%s' have '%s'\n", word, strings.Join(want, ", "), strings.Join(have, ", "))
+		}
+	}
+}
+
+func TestRulesMarkdown(t *testing.T) {
+	want, err := os.ReadFile("RULES.md")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var have strings.Builder
+	if err := PorterRules().WriteMarkdown(&have, "Porter stemmer rules"); err != nil {
+		t.Fatal(err)
+	}
+	if have.String() != string(want) {
+		t.Errorf("RULES.md is out of date, run \"make rules\"")
+	}
+}
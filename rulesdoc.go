@@ -0,0 +1,99 @@
+package porter
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Rules returns the steps of the Porter stemmer and their rules, in the
+// order Stem applies them. They are the rules of PorterRules, and the tests
+// check that on every word of the test vocabulary they fire exactly where
+// those of the hand-coded stemmer do. The result is a copy and may be
+// modified.
+//
+// Example:
+//
+//	for _, step := range porter.Rules() {
+//	    for _, r := range step.Rules {
+//	        fmt.Println(step.Name, r.Condition, r.Suffix, r.Replacement)
+//	    }
+//	}
+func Rules() []RuleStep {
+	steps := append([]RuleStep(nil), PorterRules().Steps...)
+	for i := range steps {
+		steps[i].Rules = append([]Rule(nil), steps[i].Rules...)
+	}
+	return steps
+}
+
+// WriteMarkdown writes the rules of rs to w as Markdown, with a table of
+// rules per step under the heading title.
+func (rs *RuleSet) WriteMarkdown(w io.Writer, title string) error {
+	b := bufio.NewWriter(w)
+	fmt.Fprintf(b, "# %s\n\n", title)
+	b.WriteString("Each step applies at most one rule: the rule with the longest suffix\n" +
+		"the word ends in, leaving a stem of at least one letter, whose condition\n" +
+		"holds for the stem. `m` is the measure of the stem, `*v*` means it has a\n" +
+		"vowel, `*d` that it ends in a double consonant, `*o` that it ends\n" +
+		"consonant-vowel-consonant (not w, x or y) and `*S` that it ends in s.\n")
+	for _, s := range rs.Steps {
+		fmt.Fprintf(b, "\n## Step %s\n\n", s.Name)
+		if s.After != "" {
+			fmt.Fprintf(b, "Only if a rule of step %s was applied.\n\n", s.After)
+		}
+		b.WriteString("| Condition | Suffix | Replacement |\n")
+		b.WriteString("| --- | --- | --- |\n")
+		for _, r := range s.Rules {
+			repl := code(r.Replacement)
+			if r.Undouble {
+				repl = "single letter"
+			}
+			fmt.Fprintf(b, "| %s | %s | %s |\n", code(r.Condition), code(r.Suffix), repl)
+		}
+	}
+	return b.Flush()
+}
+
+// code returns s as a Markdown code span, or "" if s is empty.
+func code(s string) string {
+	if s == "" {
+		return ""
+	}
+	return "`" + s + "`"
+}
+
+// WriteDOT writes the rules of rs to w as a Graphviz graph named name, with
+// a node per step listing its rules and edges in the order the steps run.
+func (rs *RuleSet) WriteDOT(w io.Writer, name string) error {
+	b := bufio.NewWriter(w)
+	fmt.Fprintf(b, "digraph %q {\n", name)
+	b.WriteString("\tnode [shape=box, fontname=\"monospace\"];\n")
+	for _, s := range rs.Steps {
+		var label strings.Builder
+		label.WriteString("step " + s.Name + "\\n\\n")
+		for i := range s.Rules {
+			label.WriteString(s.Rules[i].String() + "\\l")
+		}
+		fmt.Fprintf(b, "\t%q [label=\"%s\"];\n", s.Name, label.String())
+	}
+	// a step that only runs after another can also be skipped
+	for i, s := range rs.Steps {
+		for j := i + 1; j < len(rs.Steps); j++ {
+			next := rs.Steps[j]
+			switch {
+			case next.After != "":
+				fmt.Fprintf(b, "\t%q -> %q [label=\"if %s applied\"];\n", s.Name, next.Name, next.After)
+				continue
+			case j > i+1:
+				fmt.Fprintf(b, "\t%q -> %q [label=\"otherwise\"];\n", s.Name, next.Name)
+			default:
+				fmt.Fprintf(b, "\t%q -> %q;\n", s.Name, next.Name)
+			}
+			break
+		}
+	}
+	b.WriteString("}\n")
+	return b.Flush()
+}
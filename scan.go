@@ -0,0 +1,98 @@
+package porter
+
+import (
+	"bufio"
+	"bytes"
+	"unicode"
+)
+
+// isPunct is true for the characters removed from around words by the
+// split functions.
+func isPunct(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// scanWord is bufio.ScanWords with the punctuation around words removed.
+// Tokens that are all punctuation are skipped here rather than returned as
+// empty tokens, which would make a bufio.Scanner stop at the end of its
+// input.
+func scanWord(data []byte, atEOF bool) (advance int, word []byte, err error) {
+	for {
+		adv, tok, err := bufio.ScanWords(data[advance:], atEOF)
+		if tok == nil || err != nil {
+			return advance + adv, nil, err
+		}
+		advance += adv
+		if word = bytes.TrimFunc(tok, isPunct); len(word) > 0 {
+			return advance, word, nil
+		}
+	}
+}
+
+// ScanStemmedWords is a split function for a bufio.Scanner that returns
+// the stem of each space-separated word, with the punctuation around it
+// removed: "(Running)." becomes "run". Words are stemmed with StemBytes in
+// the scanner's buffer, so scanning does not allocate. The stem is only
+// valid until the next call to Scan.
+//
+// Example:
+//
+//	scanner := bufio.NewScanner(os.Stdin)
+//	scanner.Split(porter.ScanStemmedWords)
+//	for scanner.Scan() {
+//	    fmt.Println(scanner.Text())
+//	}
+//	if err := scanner.Err(); err != nil {
+//	    log.Fatal(err)
+//	}
+func ScanStemmedWords(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	advance, word, err := scanWord(data, atEOF)
+	if word == nil || err != nil {
+		return advance, nil, err
+	}
+	// the scanner does not look at consumed bytes again
+	stemmed, err := StemBytes(word)
+	if err != nil {
+		return 0, nil, err
+	}
+	return advance, stemmed, nil
+}
+
+// WordStemSplitter is a split function for a bufio.Scanner that returns
+// each space-separated word as it appears in the text, without the
+// punctuation around it, and makes its stem available through Stem. The
+// stem is computed with StemBytes in a buffer that is reused, so scanning
+// does not allocate once the buffer has grown to the longest word.
+//
+// The zero value is ready to use.
+//
+// Example:
+//
+//	var words porter.WordStemSplitter
+//	scanner := bufio.NewScanner(os.Stdin)
+//	scanner.Split(words.Split)
+//	for scanner.Scan() {
+//	    fmt.Printf("%s\t%s\n", scanner.Bytes(), words.Stem())
+//	}
+type WordStemSplitter struct {
+	stem []byte
+}
+
+// Split is the bufio.SplitFunc.
+func (s *WordStemSplitter) Split(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	advance, word, err := scanWord(data, atEOF)
+	if word == nil || err != nil {
+		return advance, nil, err
+	}
+	s.stem = append(s.stem[:0], word...)
+	if s.stem, err = StemBytes(s.stem); err != nil {
+		return 0, nil, err
+	}
+	return advance, word, nil
+}
+
+// Stem returns the stem of the last word returned by Split. It is only
+// valid until the next call to Split.
+func (s *WordStemSplitter) Stem() []byte {
+	return s.stem
+}
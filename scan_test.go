@@ -0,0 +1,105 @@
+package porter
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+const scanText = "The runners, (running) ran.\n  Connected -- connections!\tGENERALIZATIONS {\n} ponies"
+
+func TestScanStemmedWords(t *testing.T) {
+	want := []string{"the", "runner", "run", "ran", "connect", "connect", "gener", "poni"}
+
+	// a small buffer and one byte reads make words span buffer refills
+	for _, small := range []bool{false, true} {
+		r := strings.NewReader(scanText)
+		scanner := bufio.NewScanner(r)
+		if small {
+			scanner = bufio.NewScanner(iotest.OneByteReader(r))
+			scanner.Buffer(make([]byte, 2), 64)
+		}
+		scanner.Split(ScanStemmedWords)
+		var have []string
+		for scanner.Scan() {
+			have = append(have, scanner.Text())
+		}
+		if err := scanner.Err(); err != nil {
+			t.Fatal(err)
+		}
+		if fmt.Sprint(have) != fmt.Sprint(want) {
+			t.Errorf("want: %v have: %v", want, have)
+		}
+	}
+}
+
+func TestWordStemSplitter(t *testing.T) {
+	want := []string{"The/the", "runners/runner", "running/run", "ran/ran", "Connected/connect",
+		"connections/connect", "GENERALIZATIONS/gener", "ponies/poni"}
+
+	var words WordStemSplitter
+	scanner := bufio.NewScanner(iotest.HalfReader(strings.NewReader(scanText)))
+	scanner.Buffer(make([]byte, 4), 64)
+	scanner.Split(words.Split)
+	var have []string
+	for scanner.Scan() {
+		have = append(have, scanner.Text()+"/"+string(words.Stem()))
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(have) != fmt.Sprint(want) {
+		t.Errorf("want: %v have: %v", want, have)
+	}
+}
+
+func TestScanStemmedWordsAllocs(t *testing.T) {
+	text := strings.Repeat(scanText+" ", 100)
+	r := strings.NewReader(text)
+	buf := make([]byte, 4096)
+	var words WordStemSplitter
+	words.Split([]byte("generalizations "), false) // grow the stem buffer
+	for _, split := range []bufio.SplitFunc{ScanStemmedWords, words.Split} {
+		allocs := testing.AllocsPerRun(10, func() {
+			r.Reset(text)
+			scanner := bufio.NewScanner(r)
+			scanner.Buffer(buf, len(buf))
+			scanner.Split(split)
+			for scanner.Scan() {
+			}
+		})
+		// the scanner itself
+		if allocs > 1 {
+			t.Errorf("want at most 1 allocation have %v", allocs)
+		}
+	}
+}
+
+func BenchmarkScanStemmedWords(b *testing.B) {
+	text := strings.Repeat(scanText+" ", 100)
+	r := strings.NewReader(text)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(text)))
+	for i := 0; i < b.N; i++ {
+		r.Reset(text)
+		scanner := bufio.NewScanner(r)
+		scanner.Split(ScanStemmedWords)
+		for scanner.Scan() {
+		}
+	}
+}
+
+func ExampleScanStemmedWords() {
+	scanner := bufio.NewScanner(strings.NewReader("The runners (running) ran."))
+	scanner.Split(ScanStemmedWords)
+	for scanner.Scan() {
+		fmt.Println(scanner.Text())
+	}
+	// Output:
+	// the
+	// runner
+	// run
+	// ran
+}
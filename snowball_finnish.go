@@ -0,0 +1,784 @@
+// Code generated by snowballc from finnish.sbl. DO NOT EDIT.
+
+package porter
+
+import "github.com/a2800276/porter/internal/snowball"
+
+var finnishSnowballG_AEI = snowball.NewGrouping("aeiä")
+var finnishSnowballG_C = snowball.NewGrouping("bcdfghjklmnpqrstvwxz")
+var finnishSnowballG_V1 = snowball.NewGrouping("aeiouyäö")
+var finnishSnowballG_V2 = snowball.NewGrouping("aeiouäö")
+var finnishSnowballG_particle_end = snowball.NewGrouping("aeinotuyäö")
+
+var finnishSnowballA0 = []snowball.Among{
+	{S: "kään", Result: 1},
+	{S: "kaan", Result: 1},
+	{S: "hän", Result: 1},
+	{S: "kin", Result: 1},
+	{S: "kö", Result: 1},
+	{S: "han", Result: 1},
+	{S: "pä", Result: 1},
+	{S: "sti", Result: 2},
+	{S: "ko", Result: 1},
+	{S: "pa", Result: 1},
+}
+
+var finnishSnowballA1 = []snowball.Among{
+	{S: "nsä", Result: 3},
+	{S: "nsa", Result: 3},
+	{S: "mme", Result: 3},
+	{S: "nne", Result: 3},
+	{S: "än", Result: 5},
+	{S: "si", Result: 1},
+	{S: "ni", Result: 2},
+	{S: "an", Result: 4},
+	{S: "en", Result: 6},
+}
+
+var finnishSnowballA2 = []snowball.Among{
+	{S: "ssa", Result: 1},
+	{S: "sta", Result: 1},
+	{S: "lla", Result: 1},
+	{S: "lta", Result: 1},
+	{S: "ta", Result: 1},
+	{S: "na", Result: 1},
+}
+
+var finnishSnowballA3 = []snowball.Among{
+	{S: "ssä", Result: 1},
+	{S: "stä", Result: 1},
+	{S: "llä", Result: 1},
+	{S: "ltä", Result: 1},
+	{S: "tä", Result: 1},
+	{S: "nä", Result: 1},
+}
+
+var finnishSnowballA4 = []snowball.Among{
+	{S: "lle", Result: 1},
+	{S: "ine", Result: 1},
+}
+
+var finnishSnowballA5 = []snowball.Among{
+	{S: "ää", Result: 1},
+	{S: "öö", Result: 1},
+	{S: "aa", Result: 1},
+	{S: "ee", Result: 1},
+	{S: "ii", Result: 1},
+	{S: "oo", Result: 1},
+	{S: "uu", Result: 1},
+}
+
+var finnishSnowballA6 = []snowball.Among{
+	{S: "hän", Result: 5},
+	{S: "hön", Result: 6},
+	{S: "siin", Result: 7, Cond: 1},
+	{S: "seen", Result: 8, Cond: 2},
+	{S: "tten", Result: 10, Cond: 1},
+	{S: "ttä", Result: 13},
+	{S: "ssä", Result: 14},
+	{S: "stä", Result: 14},
+	{S: "llä", Result: 14},
+	{S: "ltä", Result: 14},
+	{S: "han", Result: 1},
+	{S: "hen", Result: 2},
+	{S: "hin", Result: 3},
+	{S: "hon", Result: 4},
+	{S: "den", Result: 9, Cond: 1},
+	{S: "tta", Result: 13},
+	{S: "tä", Result: 14},
+	{S: "ssa", Result: 14},
+	{S: "sta", Result: 14},
+	{S: "lla", Result: 14},
+	{S: "lta", Result: 14},
+	{S: "lle", Result: 14},
+	{S: "nä", Result: 14},
+	{S: "ksi", Result: 14},
+	{S: "ine", Result: 14},
+	{S: "ä", Result: 12},
+	{S: "ta", Result: 14},
+	{S: "na", Result: 14},
+	{S: "n", Result: 11},
+	{S: "a", Result: 12},
+}
+
+var finnishSnowballA7 = []snowball.Among{
+	{S: "impä", Result: 2},
+	{S: "immä", Result: 2},
+	{S: "mpä", Result: 1},
+	{S: "mmä", Result: 1},
+	{S: "impi", Result: 2},
+	{S: "impa", Result: 2},
+	{S: "immi", Result: 2},
+	{S: "imma", Result: 2},
+	{S: "ejä", Result: 2},
+	{S: "mpi", Result: 1},
+	{S: "mpa", Result: 1},
+	{S: "mmi", Result: 1},
+	{S: "mma", Result: 1},
+	{S: "eja", Result: 2},
+}
+
+var finnishSnowballA8 = []snowball.Among{
+	{S: "i", Result: 1},
+	{S: "j", Result: 1},
+}
+
+var finnishSnowballA9 = []snowball.Among{
+	{S: "imma", Result: 2},
+	{S: "mma", Result: 1},
+}
+
+// finnishSnowball holds the variables of finnish.sbl.
+type finnishSnowball struct {
+	i_p1             int
+	i_p2             int
+	b_ending_removed bool
+	s_x              string
+}
+
+func (z *finnishSnowball) r_mark_regions(env *snowball.Env) bool {
+	z.i_p1 = env.Limit
+	z.i_p2 = env.Limit
+lab1:
+	for {
+		v3 := env.Cursor
+	lab2:
+		for {
+			if !env.InGrouping(finnishSnowballG_V1) {
+				break lab2
+			}
+			env.Cursor = v3
+			break lab1
+		}
+		env.Cursor = v3
+		if env.Cursor >= env.Limit {
+			return false
+		}
+		env.NextChar()
+	}
+lab4:
+	for {
+		v6 := env.Cursor
+	lab5:
+		for {
+			if !env.OutGrouping(finnishSnowballG_V1) {
+				break lab5
+			}
+			break lab4
+		}
+		env.Cursor = v6
+		if env.Cursor >= env.Limit {
+			return false
+		}
+		env.NextChar()
+	}
+	z.i_p1 = env.Cursor
+lab7:
+	for {
+		v9 := env.Cursor
+	lab8:
+		for {
+			if !env.InGrouping(finnishSnowballG_V1) {
+				break lab8
+			}
+			env.Cursor = v9
+			break lab7
+		}
+		env.Cursor = v9
+		if env.Cursor >= env.Limit {
+			return false
+		}
+		env.NextChar()
+	}
+lab10:
+	for {
+		v12 := env.Cursor
+	lab11:
+		for {
+			if !env.OutGrouping(finnishSnowballG_V1) {
+				break lab11
+			}
+			break lab10
+		}
+		env.Cursor = v12
+		if env.Cursor >= env.Limit {
+			return false
+		}
+		env.NextChar()
+	}
+	z.i_p2 = env.Cursor
+	return true
+}
+
+func (z *finnishSnowball) r_R2(env *snowball.Env) bool {
+	if !(z.i_p2 <= env.Cursor) {
+		return false
+	}
+	return true
+}
+
+func (z *finnishSnowball) r_particle_etc(env *snowball.Env) bool {
+	var amongVar int
+	v1 := env.Limit - env.Cursor
+	v3 := z.i_p1
+	if env.Cursor < v3 {
+		return false
+	}
+	env.Cursor = v3
+	v2 := env.LimitBackward
+	env.LimitBackward = env.Cursor
+	env.Cursor = env.Limit - v1
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(finnishSnowballA0, nil)
+	if amongVar == 0 {
+		env.LimitBackward = v2
+		return false
+	}
+	env.Bra = env.Cursor
+	env.LimitBackward = v2
+	if amongVar == 1 {
+		if !env.InGroupingB(finnishSnowballG_particle_end) {
+			return false
+		}
+	} else if amongVar == 2 {
+		if !z.r_R2(env) {
+			return false
+		}
+	}
+	if !env.SliceDel() {
+		return false
+	}
+	return true
+}
+
+func (z *finnishSnowball) r_possessive(env *snowball.Env) bool {
+	var amongVar int
+	v1 := env.Limit - env.Cursor
+	v3 := z.i_p1
+	if env.Cursor < v3 {
+		return false
+	}
+	env.Cursor = v3
+	v2 := env.LimitBackward
+	env.LimitBackward = env.Cursor
+	env.Cursor = env.Limit - v1
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(finnishSnowballA1, nil)
+	if amongVar == 0 {
+		env.LimitBackward = v2
+		return false
+	}
+	env.Bra = env.Cursor
+	env.LimitBackward = v2
+	if amongVar == 1 {
+		v5 := env.Limit - env.Cursor
+	lab4:
+		for {
+			if !env.EqSB("k") {
+				break lab4
+			}
+			return false
+		}
+		env.Cursor = env.Limit - v5
+		if !env.SliceDel() {
+			return false
+		}
+	} else if amongVar == 2 {
+		if !env.SliceDel() {
+			return false
+		}
+		env.Ket = env.Cursor
+		if !env.EqSB("kse") {
+			return false
+		}
+		env.Bra = env.Cursor
+		if !env.SliceFrom("ksi") {
+			return false
+		}
+	} else if amongVar == 3 {
+		if !env.SliceDel() {
+			return false
+		}
+	} else if amongVar == 4 {
+		if env.FindAmongB(finnishSnowballA2, nil) == 0 {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	} else if amongVar == 5 {
+		if env.FindAmongB(finnishSnowballA3, nil) == 0 {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	} else if amongVar == 6 {
+		if env.FindAmongB(finnishSnowballA4, nil) == 0 {
+			return false
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	return true
+}
+
+func (z *finnishSnowball) r_LONG(env *snowball.Env) bool {
+	if env.FindAmongB(finnishSnowballA5, nil) == 0 {
+		return false
+	}
+	return true
+}
+
+func (z *finnishSnowball) r_VI(env *snowball.Env) bool {
+	if !env.EqSB("i") {
+		return false
+	}
+	if !env.InGroupingB(finnishSnowballG_V2) {
+		return false
+	}
+	return true
+}
+
+func (z *finnishSnowball) r_case_ending(env *snowball.Env) bool {
+	var amongVar int
+	v1 := env.Limit - env.Cursor
+	v3 := z.i_p1
+	if env.Cursor < v3 {
+		return false
+	}
+	env.Cursor = v3
+	v2 := env.LimitBackward
+	env.LimitBackward = env.Cursor
+	env.Cursor = env.Limit - v1
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(finnishSnowballA6, func(c int) bool {
+		switch c {
+		case 1:
+			return z.r_VI(env)
+		case 2:
+			return z.r_LONG(env)
+		}
+		return false
+	})
+	if amongVar == 0 {
+		env.LimitBackward = v2
+		return false
+	}
+	env.Bra = env.Cursor
+	env.LimitBackward = v2
+	if amongVar == 1 {
+		if !env.EqSB("a") {
+			return false
+		}
+	} else if amongVar == 2 {
+		if !env.EqSB("e") {
+			return false
+		}
+	} else if amongVar == 3 {
+		if !env.EqSB("i") {
+			return false
+		}
+	} else if amongVar == 4 {
+		if !env.EqSB("o") {
+			return false
+		}
+	} else if amongVar == 5 {
+		if !env.EqSB("ä") {
+			return false
+		}
+	} else if amongVar == 6 {
+		if !env.EqSB("ö") {
+			return false
+		}
+	} else if amongVar == 11 {
+		v5 := env.Limit - env.Cursor
+	lab4:
+		for {
+			v6 := env.Limit - env.Cursor
+		lab7:
+			for {
+				v9 := env.Limit - env.Cursor
+			lab8:
+				for {
+					if !z.r_LONG(env) {
+						break lab8
+					}
+					break lab7
+				}
+				env.Cursor = env.Limit - v9
+				if !env.EqSB("ie") {
+					env.Cursor = env.Limit - v5
+					break lab4
+				}
+				break lab7
+			}
+			env.Cursor = env.Limit - v6
+			if env.Cursor <= env.LimitBackward {
+				env.Cursor = env.Limit - v5
+				break lab4
+			}
+			env.PrevChar()
+			env.Bra = env.Cursor
+			break lab4
+		}
+	} else if amongVar == 12 {
+		if !env.InGroupingB(finnishSnowballG_V1) {
+			return false
+		}
+		if !env.OutGroupingB(finnishSnowballG_V1) {
+			return false
+		}
+	} else if amongVar == 13 {
+		if !env.EqSB("e") {
+			return false
+		}
+	}
+	if !env.SliceDel() {
+		return false
+	}
+	z.b_ending_removed = true
+	return true
+}
+
+func (z *finnishSnowball) r_other_endings(env *snowball.Env) bool {
+	var amongVar int
+	v1 := env.Limit - env.Cursor
+	v3 := z.i_p2
+	if env.Cursor < v3 {
+		return false
+	}
+	env.Cursor = v3
+	v2 := env.LimitBackward
+	env.LimitBackward = env.Cursor
+	env.Cursor = env.Limit - v1
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(finnishSnowballA7, nil)
+	if amongVar == 0 {
+		env.LimitBackward = v2
+		return false
+	}
+	env.Bra = env.Cursor
+	env.LimitBackward = v2
+	if amongVar == 1 {
+		v5 := env.Limit - env.Cursor
+	lab4:
+		for {
+			if !env.EqSB("po") {
+				break lab4
+			}
+			return false
+		}
+		env.Cursor = env.Limit - v5
+	}
+	if !env.SliceDel() {
+		return false
+	}
+	return true
+}
+
+func (z *finnishSnowball) r_i_plural(env *snowball.Env) bool {
+	v1 := env.Limit - env.Cursor
+	v3 := z.i_p1
+	if env.Cursor < v3 {
+		return false
+	}
+	env.Cursor = v3
+	v2 := env.LimitBackward
+	env.LimitBackward = env.Cursor
+	env.Cursor = env.Limit - v1
+	env.Ket = env.Cursor
+	if env.FindAmongB(finnishSnowballA8, nil) == 0 {
+		env.LimitBackward = v2
+		return false
+	}
+	env.Bra = env.Cursor
+	env.LimitBackward = v2
+	if !env.SliceDel() {
+		return false
+	}
+	return true
+}
+
+func (z *finnishSnowball) r_t_plural(env *snowball.Env) bool {
+	var amongVar int
+	v1 := env.Limit - env.Cursor
+	v3 := z.i_p1
+	if env.Cursor < v3 {
+		return false
+	}
+	env.Cursor = v3
+	v2 := env.LimitBackward
+	env.LimitBackward = env.Cursor
+	env.Cursor = env.Limit - v1
+	env.Ket = env.Cursor
+	if !env.EqSB("t") {
+		env.LimitBackward = v2
+		return false
+	}
+	env.Bra = env.Cursor
+	v4 := env.Limit - env.Cursor
+	if !env.InGroupingB(finnishSnowballG_V1) {
+		env.LimitBackward = v2
+		return false
+	}
+	env.Cursor = env.Limit - v4
+	if !env.SliceDel() {
+		env.LimitBackward = v2
+		return false
+	}
+	env.LimitBackward = v2
+	v5 := env.Limit - env.Cursor
+	v7 := z.i_p2
+	if env.Cursor < v7 {
+		return false
+	}
+	env.Cursor = v7
+	v6 := env.LimitBackward
+	env.LimitBackward = env.Cursor
+	env.Cursor = env.Limit - v5
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(finnishSnowballA9, nil)
+	if amongVar == 0 {
+		env.LimitBackward = v6
+		return false
+	}
+	env.Bra = env.Cursor
+	env.LimitBackward = v6
+	if amongVar == 1 {
+		v9 := env.Limit - env.Cursor
+	lab8:
+		for {
+			if !env.EqSB("po") {
+				break lab8
+			}
+			return false
+		}
+		env.Cursor = env.Limit - v9
+	}
+	if !env.SliceDel() {
+		return false
+	}
+	return true
+}
+
+func (z *finnishSnowball) r_tidy(env *snowball.Env) bool {
+	v1 := env.Limit - env.Cursor
+	v3 := z.i_p1
+	if env.Cursor < v3 {
+		return false
+	}
+	env.Cursor = v3
+	v2 := env.LimitBackward
+	env.LimitBackward = env.Cursor
+	env.Cursor = env.Limit - v1
+	v4 := env.Limit - env.Cursor
+lab5:
+	for {
+		v6 := env.Limit - env.Cursor
+		if !z.r_LONG(env) {
+			break lab5
+		}
+		env.Cursor = env.Limit - v6
+		env.Ket = env.Cursor
+		if env.Cursor <= env.LimitBackward {
+			break lab5
+		}
+		env.PrevChar()
+		env.Bra = env.Cursor
+		if !env.SliceDel() {
+			break lab5
+		}
+		break lab5
+	}
+	env.Cursor = env.Limit - v4
+	v7 := env.Limit - env.Cursor
+lab8:
+	for {
+		env.Ket = env.Cursor
+		if !env.InGroupingB(finnishSnowballG_AEI) {
+			break lab8
+		}
+		env.Bra = env.Cursor
+		if !env.InGroupingB(finnishSnowballG_C) {
+			break lab8
+		}
+		if !env.SliceDel() {
+			break lab8
+		}
+		break lab8
+	}
+	env.Cursor = env.Limit - v7
+	v9 := env.Limit - env.Cursor
+lab10:
+	for {
+		env.Ket = env.Cursor
+		if !env.EqSB("j") {
+			break lab10
+		}
+		env.Bra = env.Cursor
+	lab11:
+		for {
+			v13 := env.Limit - env.Cursor
+		lab12:
+			for {
+				if !env.EqSB("o") {
+					break lab12
+				}
+				break lab11
+			}
+			env.Cursor = env.Limit - v13
+			if !env.EqSB("u") {
+				break lab10
+			}
+			break lab11
+		}
+		if !env.SliceDel() {
+			break lab10
+		}
+		break lab10
+	}
+	env.Cursor = env.Limit - v9
+	v14 := env.Limit - env.Cursor
+lab15:
+	for {
+		env.Ket = env.Cursor
+		if !env.EqSB("o") {
+			break lab15
+		}
+		env.Bra = env.Cursor
+		if !env.EqSB("j") {
+			break lab15
+		}
+		if !env.SliceDel() {
+			break lab15
+		}
+		break lab15
+	}
+	env.Cursor = env.Limit - v14
+	env.LimitBackward = v2
+lab16:
+	for {
+		v18 := env.Limit - env.Cursor
+	lab17:
+		for {
+			if !env.OutGroupingB(finnishSnowballG_V1) {
+				break lab17
+			}
+			env.Cursor = env.Limit - v18
+			break lab16
+		}
+		env.Cursor = env.Limit - v18
+		if env.Cursor <= env.LimitBackward {
+			return false
+		}
+		env.PrevChar()
+	}
+	env.Ket = env.Cursor
+	if !env.InGroupingB(finnishSnowballG_C) {
+		return false
+	}
+	env.Bra = env.Cursor
+	z.s_x = env.SliceTo()
+	if !env.EqSB(z.s_x) {
+		return false
+	}
+	if !env.SliceDel() {
+		return false
+	}
+	return true
+}
+
+func (z *finnishSnowball) stem(env *snowball.Env) bool {
+	v1 := env.Cursor
+lab2:
+	for {
+		if !z.r_mark_regions(env) {
+			break lab2
+		}
+		break lab2
+	}
+	env.Cursor = v1
+	z.b_ending_removed = false
+	env.LimitBackward = env.Cursor
+	env.Cursor = env.Limit
+	v3 := env.Limit - env.Cursor
+lab4:
+	for {
+		if !z.r_particle_etc(env) {
+			break lab4
+		}
+		break lab4
+	}
+	env.Cursor = env.Limit - v3
+	v5 := env.Limit - env.Cursor
+lab6:
+	for {
+		if !z.r_possessive(env) {
+			break lab6
+		}
+		break lab6
+	}
+	env.Cursor = env.Limit - v5
+	v7 := env.Limit - env.Cursor
+lab8:
+	for {
+		if !z.r_case_ending(env) {
+			break lab8
+		}
+		break lab8
+	}
+	env.Cursor = env.Limit - v7
+	v9 := env.Limit - env.Cursor
+lab10:
+	for {
+		if !z.r_other_endings(env) {
+			break lab10
+		}
+		break lab10
+	}
+	env.Cursor = env.Limit - v9
+lab11:
+	for {
+		v13 := env.Limit - env.Cursor
+	lab12:
+		for {
+			if !z.b_ending_removed {
+				break lab12
+			}
+			v14 := env.Limit - env.Cursor
+		lab15:
+			for {
+				if !z.r_i_plural(env) {
+					break lab15
+				}
+				break lab15
+			}
+			env.Cursor = env.Limit - v14
+			break lab11
+		}
+		env.Cursor = env.Limit - v13
+		v16 := env.Limit - env.Cursor
+	lab17:
+		for {
+			if !z.r_t_plural(env) {
+				break lab17
+			}
+			break lab17
+		}
+		env.Cursor = env.Limit - v16
+		break lab11
+	}
+	v18 := env.Limit - env.Cursor
+lab19:
+	for {
+		if !z.r_tidy(env) {
+			break lab19
+		}
+		break lab19
+	}
+	env.Cursor = env.Limit - v18
+	env.Cursor = env.LimitBackward
+	return true
+}
@@ -0,0 +1,727 @@
+// Code generated by snowballc from porter.sbl. DO NOT EDIT.
+
+package porter
+
+import "github.com/a2800276/porter/internal/snowball"
+
+var porterSnowballG_v = snowball.NewGrouping("aeiouy")
+var porterSnowballG_v_WXY = snowball.NewGrouping("Yaeiouwxy")
+
+var porterSnowballA0 = []snowball.Among{
+	{S: "sses", Result: 1},
+	{S: "ies", Result: 2},
+	{S: "ss", Result: 3},
+	{S: "s", Result: 4},
+}
+
+var porterSnowballA1 = []snowball.Among{
+	{S: "eed", Result: 1},
+	{S: "ing", Result: 2},
+	{S: "ed", Result: 2},
+}
+
+var porterSnowballA2 = []snowball.Among{
+	{S: "at", Result: 1},
+	{S: "bl", Result: 1},
+	{S: "iz", Result: 1},
+	{S: "bb", Result: 2},
+	{S: "dd", Result: 2},
+	{S: "ff", Result: 2},
+	{S: "gg", Result: 2},
+	{S: "mm", Result: 2},
+	{S: "nn", Result: 2},
+	{S: "pp", Result: 2},
+	{S: "rr", Result: 2},
+	{S: "tt", Result: 2},
+	{S: "", Result: 3},
+}
+
+var porterSnowballA3 = []snowball.Among{
+	{S: "ization", Result: 7},
+	{S: "ational", Result: 8},
+	{S: "fulness", Result: 11},
+	{S: "ousness", Result: 12},
+	{S: "iveness", Result: 13},
+	{S: "tional", Result: 1},
+	{S: "biliti", Result: 14},
+	{S: "entli", Result: 5},
+	{S: "ation", Result: 8},
+	{S: "alism", Result: 10},
+	{S: "aliti", Result: 10},
+	{S: "ousli", Result: 12},
+	{S: "iviti", Result: 13},
+	{S: "enci", Result: 2},
+	{S: "anci", Result: 3},
+	{S: "izer", Result: 7},
+	{S: "ator", Result: 8},
+	{S: "alli", Result: 9},
+	{S: "logi", Result: 15},
+	{S: "bli", Result: 4},
+	{S: "eli", Result: 6},
+}
+
+var porterSnowballA4 = []snowball.Among{
+	{S: "alize", Result: 1},
+	{S: "icate", Result: 2},
+	{S: "iciti", Result: 3},
+	{S: "ative", Result: 4},
+	{S: "ical", Result: 5},
+	{S: "ness", Result: 7},
+	{S: "ful", Result: 6},
+}
+
+var porterSnowballA5 = []snowball.Among{
+	{S: "ement", Result: 1},
+	{S: "ance", Result: 1},
+	{S: "ence", Result: 1},
+	{S: "able", Result: 1},
+	{S: "ible", Result: 1},
+	{S: "ment", Result: 1},
+	{S: "ant", Result: 1},
+	{S: "ent", Result: 1},
+	{S: "ism", Result: 1},
+	{S: "ate", Result: 1},
+	{S: "iti", Result: 1},
+	{S: "ous", Result: 1},
+	{S: "ive", Result: 1},
+	{S: "ize", Result: 1},
+	{S: "ion", Result: 2},
+	{S: "al", Result: 1},
+	{S: "er", Result: 1},
+	{S: "ic", Result: 1},
+	{S: "ou", Result: 1},
+}
+
+// porterSnowball holds the variables of porter.sbl.
+type porterSnowball struct {
+	i_p1      int
+	i_p2      int
+	b_Y_found bool
+}
+
+func (z *porterSnowball) r_shortv(env *snowball.Env) bool {
+	if !env.OutGroupingB(porterSnowballG_v_WXY) {
+		return false
+	}
+	if !env.InGroupingB(porterSnowballG_v) {
+		return false
+	}
+	if !env.OutGroupingB(porterSnowballG_v) {
+		return false
+	}
+	return true
+}
+
+func (z *porterSnowball) r_R1(env *snowball.Env) bool {
+	if !(z.i_p1 <= env.Cursor) {
+		return false
+	}
+	return true
+}
+
+func (z *porterSnowball) r_R2(env *snowball.Env) bool {
+	if !(z.i_p2 <= env.Cursor) {
+		return false
+	}
+	return true
+}
+
+func (z *porterSnowball) r_Step_1a(env *snowball.Env) bool {
+	var amongVar int
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(porterSnowballA0, nil)
+	if amongVar == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	if amongVar == 1 {
+		if !env.SliceFrom("ss") {
+			return false
+		}
+	} else if amongVar == 2 {
+		if !env.SliceFrom("i") {
+			return false
+		}
+	} else if amongVar == 4 {
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	return true
+}
+
+func (z *porterSnowball) r_Step_1b(env *snowball.Env) bool {
+	var amongVar int
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(porterSnowballA1, nil)
+	if amongVar == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	if amongVar == 1 {
+		if !z.r_R1(env) {
+			return false
+		}
+		if !env.SliceFrom("ee") {
+			return false
+		}
+	} else if amongVar == 2 {
+		v1 := env.Limit - env.Cursor
+	lab2:
+		for {
+			v4 := env.Limit - env.Cursor
+		lab3:
+			for {
+				if !env.InGroupingB(porterSnowballG_v) {
+					break lab3
+				}
+				break lab2
+			}
+			env.Cursor = env.Limit - v4
+			if env.Cursor <= env.LimitBackward {
+				return false
+			}
+			env.PrevChar()
+		}
+		env.Cursor = env.Limit - v1
+		if !env.SliceDel() {
+			return false
+		}
+		v5 := env.Limit - env.Cursor
+		amongVar = env.FindAmongB(porterSnowballA2, nil)
+		if amongVar == 0 {
+			return false
+		}
+		env.Cursor = env.Limit - v5
+		if amongVar == 1 {
+			env.Insert("e")
+		} else if amongVar == 2 {
+			env.Ket = env.Cursor
+			if env.Cursor <= env.LimitBackward {
+				return false
+			}
+			env.PrevChar()
+			env.Bra = env.Cursor
+			if !env.SliceDel() {
+				return false
+			}
+		} else if amongVar == 3 {
+			if env.Cursor != z.i_p1 {
+				return false
+			}
+			v6 := env.Limit - env.Cursor
+			if !z.r_shortv(env) {
+				return false
+			}
+			env.Cursor = env.Limit - v6
+			env.Insert("e")
+		}
+	}
+	return true
+}
+
+func (z *porterSnowball) r_Step_1c(env *snowball.Env) bool {
+	env.Ket = env.Cursor
+lab1:
+	for {
+		v3 := env.Limit - env.Cursor
+	lab2:
+		for {
+			if !env.EqSB("y") {
+				break lab2
+			}
+			break lab1
+		}
+		env.Cursor = env.Limit - v3
+		if !env.EqSB("Y") {
+			return false
+		}
+		break lab1
+	}
+	env.Bra = env.Cursor
+lab4:
+	for {
+		v6 := env.Limit - env.Cursor
+	lab5:
+		for {
+			if !env.InGroupingB(porterSnowballG_v) {
+				break lab5
+			}
+			break lab4
+		}
+		env.Cursor = env.Limit - v6
+		if env.Cursor <= env.LimitBackward {
+			return false
+		}
+		env.PrevChar()
+	}
+	if !env.SliceFrom("i") {
+		return false
+	}
+	return true
+}
+
+func (z *porterSnowball) r_Step_2(env *snowball.Env) bool {
+	var amongVar int
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(porterSnowballA3, nil)
+	if amongVar == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	if !z.r_R1(env) {
+		return false
+	}
+	if amongVar == 1 {
+		if !env.SliceFrom("tion") {
+			return false
+		}
+	} else if amongVar == 2 {
+		if !env.SliceFrom("ence") {
+			return false
+		}
+	} else if amongVar == 3 {
+		if !env.SliceFrom("ance") {
+			return false
+		}
+	} else if amongVar == 4 {
+		if !env.SliceFrom("ble") {
+			return false
+		}
+	} else if amongVar == 5 {
+		if !env.SliceFrom("ent") {
+			return false
+		}
+	} else if amongVar == 6 {
+		if !env.SliceFrom("e") {
+			return false
+		}
+	} else if amongVar == 7 {
+		if !env.SliceFrom("ize") {
+			return false
+		}
+	} else if amongVar == 8 {
+		if !env.SliceFrom("ate") {
+			return false
+		}
+	} else if amongVar == 9 {
+		if !env.SliceFrom("al") {
+			return false
+		}
+	} else if amongVar == 10 {
+		if !env.SliceFrom("al") {
+			return false
+		}
+	} else if amongVar == 11 {
+		if !env.SliceFrom("ful") {
+			return false
+		}
+	} else if amongVar == 12 {
+		if !env.SliceFrom("ous") {
+			return false
+		}
+	} else if amongVar == 13 {
+		if !env.SliceFrom("ive") {
+			return false
+		}
+	} else if amongVar == 14 {
+		if !env.SliceFrom("ble") {
+			return false
+		}
+	} else if amongVar == 15 {
+		if !env.SliceFrom("log") {
+			return false
+		}
+	}
+	return true
+}
+
+func (z *porterSnowball) r_Step_3(env *snowball.Env) bool {
+	var amongVar int
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(porterSnowballA4, nil)
+	if amongVar == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	if !z.r_R1(env) {
+		return false
+	}
+	if amongVar == 1 {
+		if !env.SliceFrom("al") {
+			return false
+		}
+	} else if amongVar == 2 {
+		if !env.SliceFrom("ic") {
+			return false
+		}
+	} else if amongVar == 3 {
+		if !env.SliceFrom("ic") {
+			return false
+		}
+	} else if amongVar == 4 {
+		if !env.SliceDel() {
+			return false
+		}
+	} else if amongVar == 5 {
+		if !env.SliceFrom("ic") {
+			return false
+		}
+	} else if amongVar == 6 {
+		if !env.SliceDel() {
+			return false
+		}
+	} else if amongVar == 7 {
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	return true
+}
+
+func (z *porterSnowball) r_Step_4(env *snowball.Env) bool {
+	var amongVar int
+	env.Ket = env.Cursor
+	amongVar = env.FindAmongB(porterSnowballA5, nil)
+	if amongVar == 0 {
+		return false
+	}
+	env.Bra = env.Cursor
+	if !z.r_R2(env) {
+		return false
+	}
+	if amongVar == 1 {
+		if !env.SliceDel() {
+			return false
+		}
+	} else if amongVar == 2 {
+	lab1:
+		for {
+			v3 := env.Limit - env.Cursor
+		lab2:
+			for {
+				if !env.EqSB("s") {
+					break lab2
+				}
+				break lab1
+			}
+			env.Cursor = env.Limit - v3
+			if !env.EqSB("t") {
+				return false
+			}
+			break lab1
+		}
+		if !env.SliceDel() {
+			return false
+		}
+	}
+	return true
+}
+
+func (z *porterSnowball) r_Step_5a(env *snowball.Env) bool {
+	env.Ket = env.Cursor
+	if !env.EqSB("e") {
+		return false
+	}
+	env.Bra = env.Cursor
+lab1:
+	for {
+		v3 := env.Limit - env.Cursor
+	lab2:
+		for {
+			if !z.r_R2(env) {
+				break lab2
+			}
+			break lab1
+		}
+		env.Cursor = env.Limit - v3
+		if !z.r_R1(env) {
+			return false
+		}
+		v5 := env.Limit - env.Cursor
+	lab4:
+		for {
+			if !z.r_shortv(env) {
+				break lab4
+			}
+			return false
+		}
+		env.Cursor = env.Limit - v5
+		break lab1
+	}
+	if !env.SliceDel() {
+		return false
+	}
+	return true
+}
+
+func (z *porterSnowball) r_Step_5b(env *snowball.Env) bool {
+	env.Ket = env.Cursor
+	if !env.EqSB("l") {
+		return false
+	}
+	env.Bra = env.Cursor
+	if !z.r_R2(env) {
+		return false
+	}
+	if !env.EqSB("l") {
+		return false
+	}
+	if !env.SliceDel() {
+		return false
+	}
+	return true
+}
+
+func (z *porterSnowball) stem(env *snowball.Env) bool {
+	v1 := env.Cursor
+	if !env.Hop(3) {
+		return false
+	}
+	env.Cursor = v1
+	z.b_Y_found = false
+	v2 := env.Cursor
+lab3:
+	for {
+		env.Bra = env.Cursor
+		if !env.EqS("y") {
+			break lab3
+		}
+		env.Ket = env.Cursor
+		if !env.SliceFrom("Y") {
+			break lab3
+		}
+		z.b_Y_found = true
+		break lab3
+	}
+	env.Cursor = v2
+	v4 := env.Cursor
+lab5:
+	for {
+		v7 := env.Cursor
+	lab6:
+		for {
+		lab8:
+			for {
+				v10 := env.Cursor
+			lab9:
+				for {
+					if !env.InGrouping(porterSnowballG_v) {
+						break lab9
+					}
+					env.Bra = env.Cursor
+					if !env.EqS("y") {
+						break lab9
+					}
+					env.Ket = env.Cursor
+					env.Cursor = v10
+					break lab8
+				}
+				env.Cursor = v10
+				if env.Cursor >= env.Limit {
+					break lab6
+				}
+				env.NextChar()
+			}
+			if !env.SliceFrom("Y") {
+				break lab6
+			}
+			z.b_Y_found = true
+			continue lab5
+		}
+		env.Cursor = v7
+		break lab5
+	}
+	env.Cursor = v4
+	z.i_p1 = env.Limit
+	z.i_p2 = env.Limit
+	v11 := env.Cursor
+lab12:
+	for {
+	lab13:
+		for {
+			v15 := env.Cursor
+		lab14:
+			for {
+				if !env.InGrouping(porterSnowballG_v) {
+					break lab14
+				}
+				break lab13
+			}
+			env.Cursor = v15
+			if env.Cursor >= env.Limit {
+				break lab12
+			}
+			env.NextChar()
+		}
+	lab16:
+		for {
+			v18 := env.Cursor
+		lab17:
+			for {
+				if !env.OutGrouping(porterSnowballG_v) {
+					break lab17
+				}
+				break lab16
+			}
+			env.Cursor = v18
+			if env.Cursor >= env.Limit {
+				break lab12
+			}
+			env.NextChar()
+		}
+		z.i_p1 = env.Cursor
+	lab19:
+		for {
+			v21 := env.Cursor
+		lab20:
+			for {
+				if !env.InGrouping(porterSnowballG_v) {
+					break lab20
+				}
+				break lab19
+			}
+			env.Cursor = v21
+			if env.Cursor >= env.Limit {
+				break lab12
+			}
+			env.NextChar()
+		}
+	lab22:
+		for {
+			v24 := env.Cursor
+		lab23:
+			for {
+				if !env.OutGrouping(porterSnowballG_v) {
+					break lab23
+				}
+				break lab22
+			}
+			env.Cursor = v24
+			if env.Cursor >= env.Limit {
+				break lab12
+			}
+			env.NextChar()
+		}
+		z.i_p2 = env.Cursor
+		break lab12
+	}
+	env.Cursor = v11
+	env.LimitBackward = env.Cursor
+	env.Cursor = env.Limit
+	v25 := env.Limit - env.Cursor
+lab26:
+	for {
+		if !z.r_Step_1a(env) {
+			break lab26
+		}
+		break lab26
+	}
+	env.Cursor = env.Limit - v25
+	v27 := env.Limit - env.Cursor
+lab28:
+	for {
+		if !z.r_Step_1b(env) {
+			break lab28
+		}
+		break lab28
+	}
+	env.Cursor = env.Limit - v27
+	v29 := env.Limit - env.Cursor
+lab30:
+	for {
+		if !z.r_Step_1c(env) {
+			break lab30
+		}
+		break lab30
+	}
+	env.Cursor = env.Limit - v29
+	v31 := env.Limit - env.Cursor
+lab32:
+	for {
+		if !z.r_Step_2(env) {
+			break lab32
+		}
+		break lab32
+	}
+	env.Cursor = env.Limit - v31
+	v33 := env.Limit - env.Cursor
+lab34:
+	for {
+		if !z.r_Step_3(env) {
+			break lab34
+		}
+		break lab34
+	}
+	env.Cursor = env.Limit - v33
+	v35 := env.Limit - env.Cursor
+lab36:
+	for {
+		if !z.r_Step_4(env) {
+			break lab36
+		}
+		break lab36
+	}
+	env.Cursor = env.Limit - v35
+	v37 := env.Limit - env.Cursor
+lab38:
+	for {
+		if !z.r_Step_5a(env) {
+			break lab38
+		}
+		break lab38
+	}
+	env.Cursor = env.Limit - v37
+	v39 := env.Limit - env.Cursor
+lab40:
+	for {
+		if !z.r_Step_5b(env) {
+			break lab40
+		}
+		break lab40
+	}
+	env.Cursor = env.Limit - v39
+	env.Cursor = env.LimitBackward
+	v41 := env.Cursor
+lab42:
+	for {
+		if !z.b_Y_found {
+			break lab42
+		}
+	lab43:
+		for {
+			v45 := env.Cursor
+		lab44:
+			for {
+			lab46:
+				for {
+					v48 := env.Cursor
+				lab47:
+					for {
+						env.Bra = env.Cursor
+						if !env.EqS("Y") {
+							break lab47
+						}
+						env.Ket = env.Cursor
+						env.Cursor = v48
+						break lab46
+					}
+					env.Cursor = v48
+					if env.Cursor >= env.Limit {
+						break lab44
+					}
+					env.NextChar()
+				}
+				if !env.SliceFrom("y") {
+					break lab44
+				}
+				continue lab43
+			}
+			env.Cursor = v45
+			break lab43
+		}
+		break lab42
+	}
+	env.Cursor = v41
+	return true
+}
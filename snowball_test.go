@@ -0,0 +1,45 @@
+package porter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/a2800276/porter/internal/snowball"
+)
+
+// stemSnowball stems word with the generated porterSnowball stemmer.
+func stemSnowball(word string) string {
+	env := snowball.NewEnv(strings.ToLower(word))
+	var z porterSnowball
+	z.stem(env)
+	return env.Current()
+}
+
+func TestSnowballPorter(t *testing.T) {
+	for _, test := range tests {
+		if stemmed := stemSnowball(test.in); stemmed != test.out {
+			t.Errorf("'%s' want '%s' have '%s'\n", test.in, test.out, stemmed)
+		}
+	}
+}
+
+func TestSnowballFinnish(t *testing.T) {
+	env := snowball.NewEnv("")
+	for _, test := range finnishTests {
+		var z finnishSnowball
+		env.SetCurrent(test.in)
+		z.stem(env)
+		if stemmed := env.Current(); stemmed != test.out {
+			t.Errorf("'%s' want '%s' have '%s'\n", test.in, test.out, stemmed)
+		}
+	}
+}
+
+func BenchmarkSnowballPorter(b *testing.B) {
+	env := snowball.NewEnv("")
+	var z porterSnowball
+	for i := 0; i < b.N; i++ {
+		env.SetCurrent("running")
+		z.stem(env)
+	}
+}
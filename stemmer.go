@@ -76,6 +76,7 @@ var (
 	_BLI     = []byte("bli")
 	_E       = []byte("e")
 	_ED      = []byte("ed")
+	_EE      = []byte("ee")
 	_EED     = []byte("eed")
 	_ELI     = []byte("eli")
 	_EMENT   = []byte("ement")
@@ -95,6 +96,7 @@ var (
 	_IES     = []byte("ies")
 	_ING     = []byte("ing")
 	_ION     = []byte("ion")
+	_IONOU   = []byte("ionou")
 	_ISM     = []byte("ism")
 	_ITI     = []byte("iti")
 	_IVE     = []byte("ive")
@@ -104,6 +106,8 @@ var (
 	_IZATION = []byte("ization")
 	_IZE     = []byte("ize")
 	_IZER    = []byte("izer")
+	_L       = []byte("l")
+	_LL      = []byte("ll")
 	_LOG     = []byte("log")
 	_LOGI    = []byte("logi")
 	_MENT    = []byte("ment")
@@ -112,18 +116,82 @@ var (
 	_OUS     = []byte("ous")
 	_OUSLI   = []byte("ousli")
 	_OUSNESS = []byte("ousness")
+	_S       = []byte("s")
 	_SSES    = []byte("sses")
 	_TION    = []byte("tion")
 	_TIONAL  = []byte("tional")
 	_Y       = []byte("y")
 )
 
+// maxPattern is the length of the longest word whose consonant/vowel
+// pattern is kept by the stemmer; longer words are rescanned.
+const maxPattern = 64
+
 // stemmer is the internal state structure for the Porter stemming algorithm.
 // It holds the word being processed and internal pointers used during stemming.
 type stemmer struct {
 	b []byte // bytes to work on (the word being stemmed)
 	j int    // internal pointer to the start of the suffix being considered
 	k int    // points to the last character in b
+
+	trace func(rule string) // if set, called by z.fire() for each rule applied
+
+	// For words of up to maxPattern letters, which letters are vowels and
+	// the measure of every prefix are computed once, as far as they are
+	// needed, and recomputed only for the letters z.setto() changes, so
+	// that z.consonant(), z.m() and z.vowelinstem() need not scan the word.
+	patterned bool
+	valid     int               // the pattern is valid for b[0],...b[valid-1]
+	vowels    uint64            // bit i is set if b[i] is a vowel
+	measure   [maxPattern]uint8 // measure[i] is z.m() with j == i
+}
+
+// z.reset(b) starts stemming the word b.
+func (z *stemmer) reset(b []byte) {
+	z.b = b
+	z.j = 0
+	z.k = len(b) - 1
+	z.patterned = len(b) <= maxPattern
+	z.valid = 0
+}
+
+// vowelLetters is 1 for the vowels and 2 for 'y'.
+var vowelLetters = [256]uint8{'a': 1, 'e': 1, 'i': 1, 'o': 1, 'u': 1, 'y': 2}
+
+// z.pattern(to) computes the consonant/vowel pattern and the prefix
+// measures up to b[to], where they are not valid yet. The pattern of a
+// letter only depends on the letters before it.
+func (z *stemmer) pattern(to int) {
+	from := z.valid
+	vowels := z.vowels & (1<<from - 1)
+	var m uint8
+	prevVowel := false
+	if from > 0 {
+		m = z.measure[from-1]
+		prevVowel = vowels&(1<<(from-1)) != 0
+	}
+	for i, c := range z.b[from : to+1] {
+		i += from
+		// 'y' is a vowel after a consonant, see z.consonant()
+		vowel := vowelLetters[c] == 1 || vowelLetters[c] == 2 && i > 0 && !prevVowel
+		if vowel {
+			vowels |= 1 << i
+		} else if prevVowel {
+			m++ // a measure counts the consonants that follow a vowel
+		}
+		z.measure[i&(maxPattern-1)] = m
+		prevVowel = vowel
+	}
+	z.vowels = vowels
+	z.valid = to + 1
+}
+
+// z.changed(pos) invalidates the pattern from b[pos] on, after the letter
+// there was changed.
+func (z *stemmer) changed(pos int) {
+	if pos < z.valid {
+		z.valid = pos
+	}
 }
 
 // consonant returns true if the letter at position pos is a consonant.
@@ -133,6 +201,12 @@ func (z *stemmer) consonant(pos int) bool {
 	if len(z.b) <= pos {
 		return false
 	}
+	if z.patterned {
+		if pos >= z.valid {
+			z.pattern(pos)
+		}
+		return z.vowels&(1<<pos) == 0
+	}
 	switch z.b[pos] {
 	case 'a':
 		fallthrough
@@ -170,6 +244,15 @@ func (z *stemmer) vowel(pos int) bool {
 //	<c>vcvcvc<v> gives 3
 //	....
 func (z *stemmer) m() int {
+	if z.patterned {
+		if z.j < 0 {
+			return 0
+		}
+		if z.j >= z.valid {
+			z.pattern(z.j)
+		}
+		return int(z.measure[z.j])
+	}
 	var n, i int
 
 	for {
@@ -209,6 +292,16 @@ func (z *stemmer) m() int {
 
 // z.vowelinstem() is TRUE if 0,...j contains a vowel.
 func (z *stemmer) vowelinstem() bool {
+	if z.patterned {
+		if z.j < 0 {
+			return false
+		}
+		if z.j >= z.valid {
+			z.pattern(z.j)
+		}
+		// the low j+1 bits; a shift by 64 gives 0
+		return z.vowels&(1<<(z.j+1)-1) != 0
+	}
 	for i := 0; i <= z.j; i++ {
 		if !z.consonant(i) {
 			return true
@@ -271,15 +364,26 @@ func (z *stemmer) setto(s []byte) {
 
 	copy(z.b[j+1:], s)
 	z.k = j + len(s)
+	z.changed(j + 1)
 }
 
 // `r` is a shortcut to replace only after a conconsant sequence
 func (z *stemmer) r(s []byte) {
 	if 0 < z.m() {
+		z.fire(z.b[z.j+1:z.k+1], s)
 		z.setto(s)
 	}
 }
 
+// z.fire(suffix, s) reports to z.trace, if set, that the rule replacing
+// suffix with s is about to be applied. It must be called before the word
+// is changed, as suffix is usually a slice of it.
+func (z *stemmer) fire(suffix, s []byte) {
+	if z.trace != nil {
+		z.trace(strings.TrimSpace(string(suffix) + " -> " + string(s)))
+	}
+}
+
 // z.step1ab() gets rid of plurals and -ed or -ing. e.g.
 //
 // caresses  ->  caress
@@ -303,40 +407,46 @@ func (z *stemmer) step1ab() {
 	if 's' == z.b[z.k] {
 		switch {
 		case z.ends(_SSES):
+			z.fire(_SSES, _SS)
 			z.k -= 2
 		case z.ends(_IES):
+			z.fire(_IES, _I)
 			z.setto(_I)
 		default:
 			if 's' != z.b[z.k-1] {
+				z.fire(_S, __BLANK)
 				z.k--
 			}
 		}
 	}
 	if z.ends(_EED) {
 		if 0 < z.m() {
+			z.fire(_EED, _EE)
 			z.k--
 		}
 	} else if (z.ends(_ED) || z.ends(_ING)) && z.vowelinstem() {
+		z.fire(z.b[z.j+1:z.k+1], __BLANK)
 		z.k = z.j
 		switch {
 		case z.ends(_AT):
+			z.fire(_AT, _ATE)
 			z.setto(_ATE)
 		case z.ends(_BL):
+			z.fire(_BL, _BLE)
 			z.setto(_BLE)
 		case z.ends(_IZ):
+			z.fire(_IZ, _IZE)
 			z.setto(_IZE)
 		case z.doublec(z.k):
-			z.k--
 			switch z.b[z.k] {
-			case 'l':
-				fallthrough
-			case 's':
-				fallthrough
-			case 'z':
-				z.k++
+			case 'l', 's', 'z':
+			default:
+				z.fire(z.b[z.k-1:z.k+1], z.b[z.k:z.k+1])
+				z.k--
 			}
 		default:
 			if 1 == z.m() && z.cvc(z.k) {
+				z.fire(__BLANK, _E)
 				z.setto(_E)
 			}
 		}
@@ -346,7 +456,9 @@ func (z *stemmer) step1ab() {
 // z.step1c() turns terminal 'y' to 'i' when there is another vowel in the stem.
 func (z *stemmer) step1c() {
 	if z.ends(_Y) && z.vowelinstem() {
+		z.fire(_Y, _I)
 		z.b[z.k] = 'i'
+		z.changed(z.k)
 	}
 }
 
@@ -354,267 +466,46 @@ func (z *stemmer) step1c() {
 // -ation) maps to -ize etc. note that the string before the suffix must give
 // z.m() > 0.
 func (z *stemmer) step2() {
-	if z.k == 0 {
-		return // "Bug 1" from java impl http://tartarus.org/martin/PorterStemmer/java.txt
-	}
-	switch z.b[z.k-1] {
-	case 'a':
-		z.step2_a()
-	case 'c':
-		z.step2_c()
-	case 'e':
-		z.step2_e()
-	case 'l':
-		z.step2_l()
-	case 'o':
-		z.step2_o()
-	case 's':
-		z.step2_s()
-	case 't':
-		z.step2_t()
-	case 'g':
-		z.step2_g()
-	}
-}
-
-// The following functions are spread out from step2 to avoid clutter.
-func (z *stemmer) step2_a() {
-	switch {
-	case z.ends(_ATIONAL):
-		z.r(_ATE)
-	case z.ends(_TIONAL):
-		z.r(_TION)
-	}
-}
-
-func (z *stemmer) step2_c() {
-	switch {
-	case z.ends(_ENCI):
-		z.r(_ENCE)
-	case z.ends(_ANCI):
-		z.r(_ANCE)
-	}
-}
-
-func (z *stemmer) step2_e() {
-	if z.ends(_IZER) {
-		z.r(_IZE)
-	}
-}
-
-func (z *stemmer) step2_l() {
-	switch {
-	case z.ends(_BLI):
-		z.r(_BLE)
-	case z.ends(_ALLI):
-		z.r(_AL)
-	case z.ends(_ENTLI):
-		z.r(_ENT)
-	case z.ends(_ELI):
-		z.r(_E)
-	case z.ends(_OUSLI):
-		z.r(_OUS)
-	}
-}
-
-func (z *stemmer) step2_o() {
-	switch {
-	case z.ends(_IZATION):
-		z.r(_IZE)
-	case z.ends(_ATION):
-		z.r(_ATE)
-	case z.ends(_ATOR):
-		z.r(_ATE)
-	}
-}
-
-func (z *stemmer) step2_s() {
-	switch {
-	case z.ends(_ALISM):
-		z.r(_AL)
-	case z.ends(_IVENESS):
-		z.r(_IVE)
-	case z.ends(_FULNESS):
-		z.r(_FUL)
-	case z.ends(_OUSNESS):
-		z.r(_OUS)
-	}
-}
-
-func (z *stemmer) step2_t() {
-	switch {
-	case z.ends(_ALITI):
-		z.r(_AL)
-	case z.ends(_IVITI):
-		z.r(_IVE)
-	case z.ends(_BILITI):
-		z.r(_BLE)
-	}
-}
-
-func (z *stemmer) step2_g() {
-	if z.ends(_LOGI) {
-		z.r(_LOG)
+	if rule := z.longest(&step2Suffixes); rule != nil {
+		z.r(rule.repl)
 	}
 }
 
 // z.step3() deals with -ic-, -full, -ness etc. similar strategy to step2.
 func (z *stemmer) step3() {
-	switch z.b[z.k] {
-	case 'e':
-		z.step3_e()
-	case 'i':
-		z.step3_i()
-	case 'l':
-		z.step3_l()
-	case 's':
-		z.step3_s()
-	}
-}
-
-func (z *stemmer) step3_e() {
-	switch {
-	case z.ends(_ICATE):
-		z.r(_IC)
-	case z.ends(_ATIVE):
-		z.r(__BLANK)
-	case z.ends(_ALIZE):
-		z.r(_AL)
-	}
-}
-func (z *stemmer) step3_i() {
-	if z.ends(_ICITI) {
-		z.r(_IC)
-	}
-}
-func (z *stemmer) step3_l() {
-	switch {
-	case z.ends(_ICAL):
-		z.r(_IC)
-	case z.ends(_FUL):
-		z.r(__BLANK)
-	}
-}
-func (z *stemmer) step3_s() {
-	if z.ends(_NESS) {
-		z.r(__BLANK)
+	if rule := z.longest(&step3Suffixes); rule != nil {
+		z.r(rule.repl)
 	}
 }
 
-// z.step4() takes off -ant, -ence etc., in context <c>vcvc<v>.
+// z.step4() takes off -ant, -ence etc., in context <c>vcvc<v>. -ion is only
+// taken off after s or t, and after -ou is taken off.
 func (z *stemmer) step4() {
-	if z.k == 0 {
-		return // "Bug 1" from java impl http://tartarus.org/martin/PorterStemmer/java.txt
-	}
-	switch z.b[z.k-1] {
-	case 'a':
-		z.step4_a()
-	case 'c':
-		z.step4_c()
-	case 'e':
-		z.step4_e()
-	case 'i':
-		z.step4_i()
-	case 'l':
-		z.step4_l()
-	case 'n':
-		z.step4_n()
-	case 'o':
-		z.step4_o()
-	case 's':
-		z.step4_s()
-	case 't':
-		z.step4_t()
-	case 'u':
-		z.step4_u()
-	case 'v':
-		z.step4_v()
-	case 'z':
-		z.step4_z()
+	if rule := z.longest(&step4Suffixes); rule != nil {
+		switch suffix := z.b[z.j+1 : z.k+1]; {
+		case bytes.Equal(suffix, _ION) && 's' != z.b[z.j] && 't' != z.b[z.j]:
+			return
+		case bytes.Equal(suffix, _IONOU):
+			// the stem keeps -ion if it has m = 1 or does not end in s or t
+			if 1 < z.m() && ('s' == z.b[z.j] || 't' == z.b[z.j]) {
+				z.step4_update()
+			} else if 0 < z.m() {
+				z.fire(suffix, _ION)
+				z.k = z.j + len(_ION)
+			}
+			return
+		}
+		z.step4_update()
 	}
 }
 
 func (z *stemmer) step4_update() {
 	if 1 < z.m() {
+		z.fire(z.b[z.j+1:z.k+1], __BLANK)
 		z.k = z.j
 	}
 }
 
-func (z *stemmer) step4_a() {
-	if z.ends(_AL) {
-		z.step4_update()
-	}
-}
-
-func (z *stemmer) step4_c() {
-	if z.ends(_ANCE) || z.ends(_ENCE) {
-		z.step4_update()
-	}
-}
-
-func (z *stemmer) step4_e() {
-	if z.ends(_ER) {
-		z.step4_update()
-	}
-}
-
-func (z *stemmer) step4_i() {
-	if z.ends(_IC) {
-		z.step4_update()
-	}
-}
-
-func (z *stemmer) step4_l() {
-	if z.ends(_ABLE) || z.ends(_IBLE) {
-		z.step4_update()
-	}
-}
-
-func (z *stemmer) step4_n() {
-	if z.ends(_ANT) || z.ends(_EMENT) || z.ends(_MENT) || z.ends(_ENT) {
-		z.step4_update()
-	}
-}
-
-func (z *stemmer) step4_o() {
-	if z.ends(_OU) {
-		z.step4_update()
-	}
-	if z.ends(_ION) && ('s' == z.b[z.j] || 't' == z.b[z.j]) {
-		z.step4_update()
-	}
-}
-
-func (z *stemmer) step4_s() {
-	if z.ends(_ISM) {
-		z.step4_update()
-	}
-}
-
-func (z *stemmer) step4_t() {
-	if z.ends(_ATE) || z.ends(_ITI) {
-		z.step4_update()
-	}
-}
-
-func (z *stemmer) step4_u() {
-	if z.ends(_OUS) {
-		z.step4_update()
-	}
-}
-
-func (z *stemmer) step4_v() {
-	if z.ends(_IVE) {
-		z.step4_update()
-	}
-}
-
-func (z *stemmer) step4_z() {
-	if z.ends(_IZE) {
-		z.step4_update()
-	}
-}
-
 // z.step5() removes a final -e if z.m() > 1, and changes -ll to -l if
 //
 //	z.m() > 1.
@@ -623,10 +514,12 @@ func (z *stemmer) step5() {
 	if 'e' == z.b[z.k] {
 		a := z.m()
 		if 1 < a || 1 == a && !z.cvc(z.k-1) {
+			z.fire(_E, __BLANK)
 			z.k--
 		}
 	}
 	if 'l' == z.b[z.k] && z.doublec(z.k) && 1 < z.m() {
+		z.fire(_LL, _L)
 		z.k--
 	}
 }
@@ -636,9 +529,7 @@ func (z *stemmer) step5() {
 // characters b[0] ... b[k] and returns the new end-point of the string, k'.
 // Stemming never increases word length, so 0 <= k' <= k.
 func (z *stemmer) stem(b []byte) int {
-	z.b = b
-	z.j = 0
-	z.k = len(b) - 1
+	z.reset(b)
 
 	if z.k > 1 {
 		z.step1ab()
@@ -681,7 +572,7 @@ func Stem(word string) (string, error) {
 	}
 	var z stemmer
 	b := []byte(strings.ToLower(word))
-	bn := z.stem(b)
+	bn := z.stemFrequent(b)
 	if bn >= 0 && bn < len(z.b) {
 		return string(z.b[:bn+1]), nil
 	}
@@ -727,14 +618,9 @@ func StemBytes(b []byte) ([]byte, error) {
 	if len(b) == 0 {
 		return b[:0], nil
 	}
-	// Convert to lowercase in place
-	for i := 0; i < len(b); i++ {
-		if b[i] >= 'A' && b[i] <= 'Z' {
-			b[i] += 'a' - 'A'
-		}
-	}
+	lowerLetters(b)
 	var z stemmer
-	bn := z.stem(b)
+	bn := z.stemFrequent(b)
 	if bn >= 0 && bn < len(b) {
 		return b[:bn+1], nil
 	}
@@ -41,7 +41,7 @@ func TestConsonant(t *testing.T) {
 	}
 
 	for _, cons := range consonants {
-		z := stemmer{[]byte(cons), 0, 0}
+		z := stemmer{b: []byte(cons), j: 0, k: 0}
 		if !z.consonant(0) {
 			t.Errorf("Consonant failed for: %s\n", cons)
 		}
@@ -50,7 +50,7 @@ func TestConsonant(t *testing.T) {
 		}
 	}
 	for _, vow := range vowels {
-		z := stemmer{[]byte(vow), 0, 0}
+		z := stemmer{b: []byte(vow), j: 0, k: 0}
 		if z.consonant(0) {
 			t.Errorf("Consonant failed for: %s\n", vow)
 		}
@@ -59,7 +59,7 @@ func TestConsonant(t *testing.T) {
 		}
 	}
 
-	z := stemmer{[]byte("oy"), 0, 1}
+	z := stemmer{b: []byte("oy"), j: 0, k: 1}
 
 	if !z.consonant(1) {
 		t.Errorf("Y consonant failed for: %s\n", "oy")
@@ -68,7 +68,7 @@ func TestConsonant(t *testing.T) {
 		t.Errorf("Y vowel failed for: %s\n", "oy")
 	}
 
-	z = stemmer{[]byte("my"), 0, 1}
+	z = stemmer{b: []byte("my"), j: 0, k: 1}
 	if z.consonant(1) {
 		t.Errorf("Y consonant failed for: %s\n", "my")
 	}
@@ -87,7 +87,7 @@ func TestM(t *testing.T) {
 	for term, res := range test {
 		// set j == k
 		k := len(term) - 1
-		z := stemmer{[]byte(term), k, k}
+		z := stemmer{b: []byte(term), j: k, k: k}
 		rres := z.m()
 		if res != rres {
 			t.Errorf("m(%s) failed, want: %d have %d", term, res, rres)
@@ -108,13 +108,13 @@ func TestCVC(t *testing.T) {
 	}
 
 	for _, term := range yes {
-		z := stemmer{([]byte)(term), 0, len(term) - 1}
+		z := stemmer{b: ([]byte)(term), j: 0, k: len(term) - 1}
 		if !z.cvc(z.k) {
 			t.Errorf("CVC failed on : %s", term)
 		}
 	}
 	for _, term := range no {
-		z := stemmer{[]byte(term), 0, len(term) - 1}
+		z := stemmer{b: []byte(term), j: 0, k: len(term) - 1}
 		if z.cvc(z.k) {
 			t.Errorf("CVC failed on : %s", term)
 		}
@@ -156,7 +156,7 @@ func TestEndsSetto(t *testing.T) {
 	}
 
 	for _, term := range test {
-		z := stemmer{[]byte(term), 0, len(term) - 1}
+		z := stemmer{b: []byte(term), j: 0, k: len(term) - 1}
 		if z.ends(_IES) {
 			z.setto(_I)
 			if !z.ends(_I) {
@@ -190,7 +190,7 @@ func TestStep1ab(t *testing.T) {
 	}
 
 	for term, want := range test {
-		z := stemmer{[]byte(term), 0, len(term) - 1}
+		z := stemmer{b: []byte(term), j: 0, k: len(term) - 1}
 		z.step1ab()
 		have := string(z.b[:z.k+1])
 
@@ -201,19 +201,19 @@ func TestStep1ab(t *testing.T) {
 }
 
 func TestVowelinstem(t *testing.T) {
-	z := stemmer{[]byte("mmmmmmm"), 6, 6}
+	z := stemmer{b: []byte("mmmmmmm"), j: 6, k: 6}
 	if z.vowelinstem() {
 		t.Errorf("vowelinstem failed")
 	}
-	z = stemmer{[]byte("iiiiiii"), 6, 6}
+	z = stemmer{b: []byte("iiiiiii"), j: 6, k: 6}
 	if !z.vowelinstem() {
 		t.Errorf("vowelinstem failed")
 	}
-	z = stemmer{[]byte("mimmmmm"), 6, 6}
+	z = stemmer{b: []byte("mimmmmm"), j: 6, k: 6}
 	if !z.vowelinstem() {
 		t.Errorf("vowelinstem failed")
 	}
-	z = stemmer{[]byte("toy"), 2, 2}
+	z = stemmer{b: []byte("toy"), j: 2, k: 2}
 	if !z.ends(_Y) {
 		t.Errorf("s vowelinstem failed")
 	}
@@ -223,11 +223,11 @@ func TestVowelinstem(t *testing.T) {
 }
 
 func TestDoubleC(t *testing.T) {
-	z := stemmer{[]byte("mmmmmmm"), 6, 6}
+	z := stemmer{b: []byte("mmmmmmm"), j: 6, k: 6}
 	if !z.doublec(4) {
 		t.Errorf("doublec failed")
 	}
-	z = stemmer{[]byte("iiiiiii"), 6, 6}
+	z = stemmer{b: []byte("iiiiiii"), j: 6, k: 6}
 	if z.doublec(4) {
 		t.Errorf("doublec failed")
 	}
@@ -241,7 +241,7 @@ func TestStep1c(t *testing.T) {
 	}
 
 	for term, should := range test {
-		z := stemmer{[]byte(term), 0, len(term) - 1}
+		z := stemmer{b: []byte(term), j: 0, k: len(term) - 1}
 		z.step1c()
 		have := string(z.b)
 		if have != should {
@@ -276,7 +276,7 @@ func TestStep2(t *testing.T) {
 	}
 
 	for term, should := range test {
-		z := stemmer{[]byte(term), 0, len(term) - 1}
+		z := stemmer{b: []byte(term), j: 0, k: len(term) - 1}
 		z.step2()
 		have := string(z.b[:z.k+1])
 		if have != should {
@@ -296,7 +296,7 @@ func TestStep3(t *testing.T) {
 		"playfulness":   "playful",
 	}
 	for term, should := range test {
-		z := stemmer{[]byte(term), 0, len(term) - 1}
+		z := stemmer{b: []byte(term), j: 0, k: len(term) - 1}
 		z.step3()
 		have := string(z.b[:z.k+1])
 		if have != should {
@@ -328,7 +328,7 @@ func TestStep4(t *testing.T) {
 		"tenderive":   "tender",
 	}
 	for term, should := range test {
-		z := stemmer{[]byte(term), 0, len(term) - 1}
+		z := stemmer{b: []byte(term), j: 0, k: len(term) - 1}
 		z.step4()
 		have := string(z.b[:z.k+1])
 		if have != should {
@@ -343,7 +343,7 @@ func TestStep5(t *testing.T) {
 		"lululull": "lululul",
 	}
 	for term, should := range test {
-		z := stemmer{[]byte(term), 0, len(term) - 1}
+		z := stemmer{b: []byte(term), j: 0, k: len(term) - 1}
 		z.step5()
 		have := string(z.b[:z.k+1])
 		if have != should {
@@ -0,0 +1,265 @@
+package porter
+
+// This file keeps the steps 2 to 4 of the stemmer as they were written
+// before suffix tries: each suffix is tried in turn with z.ends(), after
+// dispatching on a letter of the word. They are the reference for the
+// tries in the tests and benchmarks.
+
+// z.step2Ends() maps double suffices to single ones. so -ization ( = -ize plus
+// -ation) maps to -ize etc. note that the string before the suffix must give
+// z.m() > 0.
+func (z *stemmer) step2Ends() {
+	if z.k == 0 {
+		return // "Bug 1" from java impl http://tartarus.org/martin/PorterStemmer/java.txt
+	}
+	switch z.b[z.k-1] {
+	case 'a':
+		z.step2Ends_a()
+	case 'c':
+		z.step2Ends_c()
+	case 'e':
+		z.step2Ends_e()
+	case 'l':
+		z.step2Ends_l()
+	case 'o':
+		z.step2Ends_o()
+	case 's':
+		z.step2Ends_s()
+	case 't':
+		z.step2Ends_t()
+	case 'g':
+		z.step2Ends_g()
+	}
+}
+
+// The following functions are spread out from step2Ends to avoid clutter.
+func (z *stemmer) step2Ends_a() {
+	switch {
+	case z.ends(_ATIONAL):
+		z.r(_ATE)
+	case z.ends(_TIONAL):
+		z.r(_TION)
+	}
+}
+
+func (z *stemmer) step2Ends_c() {
+	switch {
+	case z.ends(_ENCI):
+		z.r(_ENCE)
+	case z.ends(_ANCI):
+		z.r(_ANCE)
+	}
+}
+
+func (z *stemmer) step2Ends_e() {
+	if z.ends(_IZER) {
+		z.r(_IZE)
+	}
+}
+
+func (z *stemmer) step2Ends_l() {
+	switch {
+	case z.ends(_BLI):
+		z.r(_BLE)
+	case z.ends(_ALLI):
+		z.r(_AL)
+	case z.ends(_ENTLI):
+		z.r(_ENT)
+	case z.ends(_ELI):
+		z.r(_E)
+	case z.ends(_OUSLI):
+		z.r(_OUS)
+	}
+}
+
+func (z *stemmer) step2Ends_o() {
+	switch {
+	case z.ends(_IZATION):
+		z.r(_IZE)
+	case z.ends(_ATION):
+		z.r(_ATE)
+	case z.ends(_ATOR):
+		z.r(_ATE)
+	}
+}
+
+func (z *stemmer) step2Ends_s() {
+	switch {
+	case z.ends(_ALISM):
+		z.r(_AL)
+	case z.ends(_IVENESS):
+		z.r(_IVE)
+	case z.ends(_FULNESS):
+		z.r(_FUL)
+	case z.ends(_OUSNESS):
+		z.r(_OUS)
+	}
+}
+
+func (z *stemmer) step2Ends_t() {
+	switch {
+	case z.ends(_ALITI):
+		z.r(_AL)
+	case z.ends(_IVITI):
+		z.r(_IVE)
+	case z.ends(_BILITI):
+		z.r(_BLE)
+	}
+}
+
+func (z *stemmer) step2Ends_g() {
+	if z.ends(_LOGI) {
+		z.r(_LOG)
+	}
+}
+
+// z.step3Ends() deals with -ic-, -full, -ness etc. similar strategy to step2Ends.
+func (z *stemmer) step3Ends() {
+	switch z.b[z.k] {
+	case 'e':
+		z.step3Ends_e()
+	case 'i':
+		z.step3Ends_i()
+	case 'l':
+		z.step3Ends_l()
+	case 's':
+		z.step3Ends_s()
+	}
+}
+
+func (z *stemmer) step3Ends_e() {
+	switch {
+	case z.ends(_ICATE):
+		z.r(_IC)
+	case z.ends(_ATIVE):
+		z.r(__BLANK)
+	case z.ends(_ALIZE):
+		z.r(_AL)
+	}
+}
+func (z *stemmer) step3Ends_i() {
+	if z.ends(_ICITI) {
+		z.r(_IC)
+	}
+}
+func (z *stemmer) step3Ends_l() {
+	switch {
+	case z.ends(_ICAL):
+		z.r(_IC)
+	case z.ends(_FUL):
+		z.r(__BLANK)
+	}
+}
+func (z *stemmer) step3Ends_s() {
+	if z.ends(_NESS) {
+		z.r(__BLANK)
+	}
+}
+
+// z.step4Ends() takes off -ant, -ence etc., in context <c>vcvc<v>.
+func (z *stemmer) step4Ends() {
+	if z.k == 0 {
+		return // "Bug 1" from java impl http://tartarus.org/martin/PorterStemmer/java.txt
+	}
+	switch z.b[z.k-1] {
+	case 'a':
+		z.step4Ends_a()
+	case 'c':
+		z.step4Ends_c()
+	case 'e':
+		z.step4Ends_e()
+	case 'i':
+		z.step4Ends_i()
+	case 'l':
+		z.step4Ends_l()
+	case 'n':
+		z.step4Ends_n()
+	case 'o':
+		z.step4Ends_o()
+	case 's':
+		z.step4Ends_s()
+	case 't':
+		z.step4Ends_t()
+	case 'u':
+		z.step4Ends_u()
+	case 'v':
+		z.step4Ends_v()
+	case 'z':
+		z.step4Ends_z()
+	}
+}
+
+func (z *stemmer) step4Ends_a() {
+	if z.ends(_AL) {
+		z.step4_update()
+	}
+}
+
+func (z *stemmer) step4Ends_c() {
+	if z.ends(_ANCE) || z.ends(_ENCE) {
+		z.step4_update()
+	}
+}
+
+func (z *stemmer) step4Ends_e() {
+	if z.ends(_ER) {
+		z.step4_update()
+	}
+}
+
+func (z *stemmer) step4Ends_i() {
+	if z.ends(_IC) {
+		z.step4_update()
+	}
+}
+
+func (z *stemmer) step4Ends_l() {
+	if z.ends(_ABLE) || z.ends(_IBLE) {
+		z.step4_update()
+	}
+}
+
+func (z *stemmer) step4Ends_n() {
+	if z.ends(_ANT) || z.ends(_EMENT) || z.ends(_MENT) || z.ends(_ENT) {
+		z.step4_update()
+	}
+}
+
+func (z *stemmer) step4Ends_o() {
+	if z.ends(_OU) {
+		z.step4_update()
+	}
+	if z.ends(_ION) && ('s' == z.b[z.j] || 't' == z.b[z.j]) {
+		z.step4_update()
+	}
+}
+
+func (z *stemmer) step4Ends_s() {
+	if z.ends(_ISM) {
+		z.step4_update()
+	}
+}
+
+func (z *stemmer) step4Ends_t() {
+	if z.ends(_ATE) || z.ends(_ITI) {
+		z.step4_update()
+	}
+}
+
+func (z *stemmer) step4Ends_u() {
+	if z.ends(_OUS) {
+		z.step4_update()
+	}
+}
+
+func (z *stemmer) step4Ends_v() {
+	if z.ends(_IVE) {
+		z.step4_update()
+	}
+}
+
+func (z *stemmer) step4Ends_z() {
+	if z.ends(_IZE) {
+		z.step4_update()
+	}
+}
@@ -0,0 +1,108 @@
+package porter
+
+import (
+	"strings"
+	"testing"
+)
+
+// stemScan stems word like StemBytes, but without the consonant/vowel
+// pattern, rescanning the word as the original C implementation does.
+func stemScan(word []byte) []byte {
+	z := stemmer{b: word, k: len(word) - 1}
+	if z.k > 1 {
+		z.step1ab()
+		z.step1c()
+		z.step2()
+		z.step3()
+		z.step4()
+		z.step5()
+	}
+	return word[:z.k+1]
+}
+
+func TestPattern(t *testing.T) {
+	for _, test := range tests {
+		var z, scan stemmer
+		z.reset([]byte(test.in))
+		scan.b = z.b
+		for j := 0; j <= z.k; j++ {
+			z.j, scan.j = j, j
+			if z.consonant(j) != scan.consonant(j) {
+				t.Errorf("'%s' consonant(%d) want %v have %v\n", test.in, j, scan.consonant(j), z.consonant(j))
+			}
+			if z.m() != scan.m() {
+				t.Errorf("'%s' m() with j=%d want %d have %d\n", test.in, j, scan.m(), z.m())
+			}
+			if z.vowelinstem() != scan.vowelinstem() {
+				t.Errorf("'%s' vowelinstem() with j=%d want %v have %v\n", test.in, j, scan.vowelinstem(), z.vowelinstem())
+			}
+		}
+	}
+}
+
+func TestPatternLongWords(t *testing.T) {
+	var longTests = []stemmerTest{
+		{strings.Repeat("ab", 31) + "ational", strings.Repeat("ab", 31)},
+		{strings.Repeat("yo", 40) + "ing", strings.Repeat("yo", 40)},
+		{"antidisestablishmentarianism", "antidisestablishmentarian"},
+		{"pneumonoultramicroscopicsilicovolcanoconiosis", "pneumonoultramicroscopicsilicovolcanoconiosi"},
+	}
+	for _, test := range longTests {
+		stemmed, err := Stem(test.in)
+		if err != nil {
+			t.Errorf("'%s' unexpected error: %v\n", test.in, err)
+			continue
+		}
+		if stemmed != test.out {
+			t.Errorf("'%s' want '%s' have '%s'\n", test.in, test.out, stemmed)
+		}
+		if scanned := string(stemScan([]byte(test.in))); scanned != stemmed {
+			t.Errorf("'%s' want '%s' have '%s'\n", test.in, scanned, stemmed)
+		}
+	}
+}
+
+// longWords are long words, for which rescanning the word in z.m() and
+// z.vowelinstem() is most costly.
+var longWords = []string{
+	"internationalization",
+	"institutionalizations",
+	"counterrevolutionaries",
+	"incomprehensibilities",
+	"antidisestablishmentarianism",
+	"characteristically",
+	"electroencephalographically",
+	"uncharacteristically",
+}
+
+func BenchmarkStemLongWords(b *testing.B) {
+	buf := make([]byte, 64)
+	for i := 0; i < b.N; i++ {
+		w := longWords[i%len(longWords)]
+		StemBytes(buf[:copy(buf, w)])
+	}
+}
+
+func BenchmarkStemLongWordsScan(b *testing.B) {
+	buf := make([]byte, 64)
+	for i := 0; i < b.N; i++ {
+		w := longWords[i%len(longWords)]
+		stemScan(buf[:copy(buf, w)])
+	}
+}
+
+func BenchmarkStemVocabulary(b *testing.B) {
+	buf := make([]byte, 64)
+	for i := 0; i < b.N; i++ {
+		w := tests[i%len(tests)].in
+		StemBytes(buf[:copy(buf, w)])
+	}
+}
+
+func BenchmarkStemVocabularyScan(b *testing.B) {
+	buf := make([]byte, 64)
+	for i := 0; i < b.N; i++ {
+		w := tests[i%len(tests)].in
+		stemScan(buf[:copy(buf, w)])
+	}
+}
@@ -0,0 +1,58 @@
+package porter
+
+import "github.com/a2800276/porter/internal/stemhash"
+
+// stemTable is a table of words and their stems, looked up before running
+// the algorithm so that the most frequent words of a text, which make up
+// most of its tokens, are stemmed with a hash and a comparison. It is a
+// minimal perfect hash table, with the hash of package stemhash. The table
+// of frequent English words is generated from frequent_words.txt by
+// stemtablegen into frequent_stems.go.
+type stemTable struct {
+	maxLen  int      // the length of the longest word
+	seeds   []uint16 // the seed of each bucket
+	tags    []uint16 // the low bits of the hash of each entry's word
+	entries []stemEntry
+}
+
+// stemEntry is a word of a stemTable and its stem.
+type stemEntry struct {
+	word, stem string
+}
+
+// frequentStems is the table of frequent words that Stem, StemBytes and
+// AppendStem look words up in. It is set by frequent_stems.go, so that the
+// package, and stemtablegen with it, builds without that file; the words
+// are then all stemmed by the algorithm, as they are when the benchmarks
+// set it to nil.
+var frequentStems *stemTable
+
+// lookup returns the stem of the word b and true if b is in t, which may be
+// nil.
+func (t *stemTable) lookup(b []byte) (string, bool) {
+	if t == nil || len(b) > t.maxLen || len(t.entries) == 0 {
+		return "", false
+	}
+	h := stemhash.Hash(b)
+	i := stemhash.Slot(h, t.seeds[stemhash.Slot(h, 0, len(t.seeds))], len(t.entries))
+	// most words are not in the table, and the tags tell without reading
+	// the entries, which are larger
+	if t.tags[i] != uint16(h) || t.entries[i].word != string(b) {
+		return "", false
+	}
+	return t.entries[i].stem, true
+}
+
+// z.stemFrequent(b) is z.stem(b) for a lowercase word, which it looks up in
+// frequentStems first: the stem of a frequent word is copied to the start
+// of b.
+func (z *stemmer) stemFrequent(b []byte) int {
+	if len(b) > 2 {
+		if stem, ok := frequentStems.lookup(b); ok {
+			z.b = b
+			z.k = copy(b, stem) - 1
+			return z.k
+		}
+	}
+	return z.stem(b)
+}
@@ -0,0 +1,188 @@
+package porter
+
+import (
+	"bufio"
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/a2800276/porter/internal/stemhash"
+)
+
+// TestFrequentStems checks that the table and the algorithm agree on the
+// stem of every word of the table, and that each word is found in its own
+// entry.
+func TestFrequentStems(t *testing.T) {
+	if frequentStems == nil {
+		t.Fatal("frequentStems is not set, run go generate\n")
+	}
+	n := len(frequentStems.entries)
+	for i, e := range frequentStems.entries {
+		var z stemmer
+		b := []byte(e.word)
+		if want := string(b[:z.stem(b)+1]); e.stem != want {
+			t.Errorf("'%s' want '%s' have '%s', run go generate\n", e.word, want, e.stem)
+		}
+		b = []byte(e.word)
+		h := stemhash.Hash(b)
+		seed := frequentStems.seeds[stemhash.Slot(h, 0, len(frequentStems.seeds))]
+		if slot := stemhash.Slot(h, seed, n); slot != i {
+			t.Errorf("'%s' in entry %d hashes to %d\n", e.word, i, slot)
+		}
+		if stem, ok := frequentStems.lookup(b); !ok || stem != e.stem {
+			t.Errorf("'%s' want '%s' have '%s' %v\n", e.word, e.stem, stem, ok)
+		}
+	}
+}
+
+// frequentWords returns the distinct words of frequent_words.txt of three
+// to stemhash.MaxLen letters, most frequent first.
+func frequentWords(tb testing.TB) []string {
+	f, err := os.Open("frequent_words.txt")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	defer f.Close()
+	var words []string
+	seen := map[string]bool{}
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) == 0 || fields[0][0] == '#' || len(fields[0]) < 3 ||
+			len(fields[0]) > stemhash.MaxLen || seen[fields[0]] {
+			continue
+		}
+		seen[fields[0]] = true
+		words = append(words, fields[0])
+	}
+	if err := s.Err(); err != nil {
+		tb.Fatal(err)
+	}
+	return words
+}
+
+// TestFrequentStemsInSync checks that frequent_stems.go has the words of
+// frequent_words.txt.
+func TestFrequentStemsInSync(t *testing.T) {
+	words := frequentWords(t)
+	if len(words) != len(frequentStems.entries) {
+		t.Errorf("want %d words have %d, run go generate\n", len(words), len(frequentStems.entries))
+	}
+	for _, word := range words {
+		if _, ok := frequentStems.lookup([]byte(word)); !ok {
+			t.Errorf("'%s' not in frequentStems, run go generate\n", word)
+		}
+	}
+}
+
+func TestStemTableMisses(t *testing.T) {
+	in := map[string]bool{}
+	for _, e := range frequentStems.entries {
+		in[e.word] = true
+	}
+	for _, test := range tests {
+		if stem, ok := frequentStems.lookup([]byte(test.in)); ok != in[test.in] {
+			t.Errorf("'%s' want found %v have %v ('%s')\n", test.in, in[test.in], ok, stem)
+		}
+	}
+	for _, word := range []string{"", "The", "the ", "xthe", "internationalization"} {
+		if stem, ok := frequentStems.lookup([]byte(word)); ok {
+			t.Errorf("'%s' want not found have '%s'\n", word, stem)
+		}
+	}
+}
+
+func TestStemFrequent(t *testing.T) {
+	for _, e := range frequentStems.entries {
+		for _, in := range []string{e.word, strings.ToUpper(e.word)} {
+			if stemmed, err := Stem(in); err != nil || stemmed != e.stem {
+				t.Errorf("'%s' want '%s' have '%s' (%v)\n", in, e.stem, stemmed, err)
+			}
+			if stemmed := AppendStem(nil, in); string(stemmed) != e.stem {
+				t.Errorf("'%s' want '%s' have '%s'\n", in, e.stem, stemmed)
+			}
+		}
+	}
+}
+
+// zipfTokens returns a stream of tokens whose frequencies follow Zipf's
+// law, like the words of running text: the words of frequent_words.txt
+// come first, most frequent first, and the words of the test vocabulary make
+// up the long tail.
+func zipfTokens(b *testing.B, n int) [][]byte {
+	ranks := frequentWords(b)
+	seen := map[string]bool{}
+	for _, word := range ranks {
+		seen[word] = true
+	}
+	for _, test := range tests {
+		if !seen[test.in] {
+			ranks = append(ranks, test.in)
+			seen[test.in] = true
+		}
+	}
+	zipf := rand.NewZipf(rand.New(rand.NewSource(1)), 1.1, 1, uint64(len(ranks)-1))
+	tokens := make([][]byte, n)
+	for i := range tokens {
+		tokens[i] = []byte(ranks[zipf.Uint64()])
+	}
+	return tokens
+}
+
+// benchmarkFrequentStems runs StemBytes on tokens with the table of
+// frequent words and without it, reporting the share of tokens found in it.
+func benchmarkFrequentStems(b *testing.B, tokens [][]byte) {
+	hits := 0
+	for _, token := range tokens {
+		if _, ok := frequentStems.lookup(token); ok {
+			hits++
+		}
+	}
+	for _, table := range []bool{true, false} {
+		b.Run(fmt.Sprintf("table=%v", table), func(b *testing.B) {
+			defer func(t *stemTable) { frequentStems = t }(frequentStems)
+			if !table {
+				frequentStems = nil
+			}
+			buf := make([]byte, 0, 64)
+			for i := 0; i < b.N; i++ {
+				buf = append(buf[:0], tokens[i%len(tokens)]...)
+				StemBytes(buf)
+			}
+			if table {
+				b.ReportMetric(float64(hits)/float64(len(tokens)), "hits/token")
+			}
+		})
+	}
+}
+
+func BenchmarkStemBytesZipf(b *testing.B) {
+	benchmarkFrequentStems(b, zipfTokens(b, 1<<16))
+}
+
+// missTokens returns the words of the test vocabulary that are not in the
+// table of frequent words.
+func missTokens() [][]byte {
+	var tokens [][]byte
+	for _, test := range tests {
+		if _, ok := frequentStems.lookup([]byte(test.in)); !ok {
+			tokens = append(tokens, []byte(test.in))
+		}
+	}
+	return tokens
+}
+
+func BenchmarkStemBytesMisses(b *testing.B) {
+	benchmarkFrequentStems(b, missTokens())
+}
+
+// BenchmarkStemTableMiss measures what looking up a word that is not in the
+// table adds to stemming it.
+func BenchmarkStemTableMiss(b *testing.B) {
+	tokens := missTokens()
+	for i := 0; i < b.N; i++ {
+		frequentStems.lookup(tokens[i%len(tokens)])
+	}
+}
@@ -0,0 +1,144 @@
+// Package stemtransform provides a golang.org/x/text/transform.Transformer
+// that replaces each word of a byte stream by its stem, so that stemming
+// can be chained with other transformations such as Unicode normalization
+// or width folding.
+//
+// Like porter.TextStemmer, the Transformer copies everything between
+// words unchanged. A word is a run of letters and digits.
+//
+// Example:
+//
+//	t := transform.Chain(width.Fold, &stemtransform.Transformer{})
+//	r := transform.NewReader(os.Stdin, t)
+//	if _, err := io.Copy(os.Stdout, r); err != nil {
+//	    log.Fatal(err)
+//	}
+package stemtransform
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/a2800276/porter"
+	"golang.org/x/text/transform"
+)
+
+// MaxWordLen is the length in bytes of the longest word that is stemmed.
+// Longer runs of letters and digits are copied unchanged, so that a
+// Transformer never needs to see more than MaxWordLen bytes at once.
+const MaxWordLen = 256
+
+// Transformer stems the words of the text it transforms. The zero value
+// stems with porter.Stem. A Transformer keeps state between calls to
+// Transform and must be used through a pointer.
+type Transformer struct {
+	// Stem stems a word; nil means porter.Stem.
+	Stem func(word string) (string, error)
+
+	long bool // copying the rest of a word longer than MaxWordLen
+}
+
+var _ transform.Transformer = (*Transformer)(nil)
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// Reset implements transform.Transformer.
+func (t *Transformer) Reset() {
+	t.long = false
+}
+
+// Transform implements transform.Transformer. A word that reaches the end
+// of src is only stemmed once the rest of it has been seen: unless atEOF is
+// set, Transform stops before it and returns transform.ErrShortSrc.
+func (t *Transformer) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		r, size := rune(src[nSrc]), 1
+		if r >= utf8.RuneSelf {
+			if !atEOF && !utf8.FullRune(src[nSrc:]) {
+				return nDst, nSrc, transform.ErrShortSrc
+			}
+			r, size = utf8.DecodeRune(src[nSrc:])
+		}
+		if !isWordRune(r) || t.long {
+			// invalid UTF-8 is not a letter and is copied byte by byte
+			t.long = t.long && isWordRune(r)
+			if nDst+size > len(dst) {
+				return nDst, nSrc, transform.ErrShortDst
+			}
+			nDst += copy(dst[nDst:], src[nSrc:nSrc+size])
+			nSrc += size
+			continue
+		}
+
+		// find the end of the word
+		end, complete := nSrc+size, atEOF
+		for end < len(src) {
+			r, size := rune(src[end]), 1
+			if r >= utf8.RuneSelf {
+				if !atEOF && !utf8.FullRune(src[end:]) {
+					break
+				}
+				r, size = utf8.DecodeRune(src[end:])
+			}
+			if !isWordRune(r) {
+				complete = true
+				break
+			}
+			end += size
+		}
+		if end-nSrc > MaxWordLen {
+			t.long = true
+			continue
+		}
+		if !complete {
+			return nDst, nSrc, transform.ErrShortSrc
+		}
+
+		n, err := t.stem(dst[nDst:], src[nSrc:end])
+		if err != nil {
+			return nDst, nSrc, err
+		}
+		nDst += n
+		nSrc = end
+	}
+	return nDst, nSrc, nil
+}
+
+// stem writes the stem of word to dst and returns its length.
+func (t *Transformer) stem(dst, word []byte) (int, error) {
+	ascii := true
+	for _, c := range word {
+		if c >= utf8.RuneSelf {
+			ascii = false
+			break
+		}
+	}
+	if t.Stem == nil && ascii {
+		// stem in place in dst: the stem is never longer than the word
+		if len(word) > len(dst) {
+			return 0, transform.ErrShortDst
+		}
+		copy(dst, word)
+		stemmed, err := porter.StemBytes(dst[:len(word)])
+		if err != nil {
+			return 0, fmt.Errorf("stemtransform: stemming %q: %w", word, err)
+		}
+		return len(stemmed), nil
+	}
+
+	stem := t.Stem
+	if stem == nil {
+		stem = porter.Stem
+	}
+	stemmed, err := stem(string(word))
+	if err != nil {
+		return 0, fmt.Errorf("stemtransform: stemming %q: %w", word, err)
+	}
+	if len(stemmed) > len(dst) {
+		return 0, transform.ErrShortDst
+	}
+	return copy(dst, stemmed), nil
+}
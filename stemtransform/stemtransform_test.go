@@ -0,0 +1,111 @@
+package stemtransform
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/a2800276/porter"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
+)
+
+var texts = []string{
+	"",
+	"running",
+	"The runners (running) ran.",
+	"  Connected,\tconnecting.\r\n\nconnections!",
+	"café \xff\xfe ponies généralement",
+	strings.Repeat("Generalizations: the runners were running!\n", 300),
+}
+
+func TestTransformString(t *testing.T) {
+	for _, text := range texts {
+		want, err := porter.StemText(text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		have, _, err := transform.String(&Transformer{}, text)
+		if err != nil {
+			t.Errorf("'%.20s': %v", text, err)
+		}
+		if have != want {
+			t.Errorf("'%.40s' want '%.40s' have '%.40s'\n", text, want, have)
+		}
+	}
+}
+
+// TestTransformReader feeds the transformer one byte at a time, so that
+// every word and every multi-byte character is split across calls.
+func TestTransformReader(t *testing.T) {
+	for _, text := range texts {
+		want, _ := porter.StemText(text)
+		r := transform.NewReader(iotest.OneByteReader(strings.NewReader(text)), &Transformer{})
+		have, err := io.ReadAll(r)
+		if err != nil {
+			t.Errorf("'%.20s': %v", text, err)
+		}
+		if string(have) != want {
+			t.Errorf("'%.40s' want '%.40s' have '%.40s'\n", text, want, have)
+		}
+	}
+}
+
+func TestTransformShortDst(t *testing.T) {
+	tr := &Transformer{}
+	src := []byte("connected, ran")
+	dst := make([]byte, 5)
+	nDst, nSrc, err := tr.Transform(dst, src, true)
+	if err != transform.ErrShortDst || nDst != 0 || nSrc != 0 {
+		t.Errorf("want ErrShortDst at 0, 0 have %v at %d, %d", err, nDst, nSrc)
+	}
+	dst = make([]byte, 9)
+	nDst, nSrc, err = tr.Transform(dst, src, true)
+	if err != transform.ErrShortDst || string(dst[:nDst]) != "connect, " || nSrc != 11 {
+		t.Errorf("want ErrShortDst after 'connect, ' have %v after '%s'", err, dst[:nDst])
+	}
+}
+
+func TestTransformLongWord(t *testing.T) {
+	long := strings.Repeat("abcdefghs", 40)
+	text := "ponies " + long + " ponies"
+	want := "poni " + long + " poni"
+	r := transform.NewReader(iotest.HalfReader(strings.NewReader(text)), &Transformer{})
+	have, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(have) != want {
+		t.Errorf("want '%s' have '%s'\n", want, have)
+	}
+}
+
+func TestTransformChain(t *testing.T) {
+	// fullwidth letters and a decomposed é are folded before stemming
+	text := "Ｒｕｎｎｉｎｇ cafe\u0301s, ponies"
+	c := transform.Chain(width.Fold, norm.NFC, &Transformer{Stem: porter.StemS})
+	have, _, err := transform.String(c, text)
+	if want := "running caf\u00e9, pony"; err != nil || have != want {
+		t.Errorf("want '%s' have '%s' (%v)\n", want, have, err)
+	}
+}
+
+func TestTransformError(t *testing.T) {
+	tr := &Transformer{Stem: func(w string) (string, error) { return "", porter.ErrInvalidInput }}
+	if _, _, err := transform.String(tr, "word"); !errors.Is(err, porter.ErrInvalidInput) {
+		t.Errorf("want ErrInvalidInput have %v", err)
+	}
+}
+
+func ExampleTransformer() {
+	t := transform.Chain(width.Fold, &Transformer{})
+	r := transform.NewReader(strings.NewReader("Ｃｏｎｎｅｃｔｅｄ, connecting!"), t)
+	stemmed, _ := io.ReadAll(r)
+	fmt.Println(string(stemmed))
+	// Output:
+	// connect, connect!
+}
@@ -0,0 +1,226 @@
+// Code generated by suffixgen from porter.rules. DO NOT EDIT.
+
+package porter
+
+// step2Suffixes finds the longest of the suffixes of step 2:
+// ational, tional, enci, anci, izer, bli, alli, entli, eli, ousli, ization,
+// ation, ator, alism, iveness, fulness, ousness, aliti, iviti, biliti,
+// logi.
+var step2Suffixes = suffixTrie{
+	next: [][26]uint8{
+		0:  {'i' - 'a': 8, 'l' - 'a': 1, 'm' - 'a': 38, 'n' - 'a': 28, 'r' - 'a': 13, 's' - 'a': 43},
+		1:  {'a' - 'a': 2},
+		2:  {'n' - 'a': 3},
+		3:  {'o' - 'a': 4},
+		4:  {'i' - 'a': 5},
+		5:  {'t' - 'a': 6},
+		6:  {'a' - 'a': 7},
+		7:  {},
+		8:  {'c' - 'a': 9, 'g' - 'a': 64, 'l' - 'a': 17, 't' - 'a': 56},
+		9:  {'n' - 'a': 10},
+		10: {'a' - 'a': 12, 'e' - 'a': 11},
+		11: {},
+		12: {},
+		13: {'e' - 'a': 14, 'o' - 'a': 35},
+		14: {'z' - 'a': 15},
+		15: {'i' - 'a': 16},
+		16: {},
+		17: {'b' - 'a': 18, 'e' - 'a': 24, 'l' - 'a': 19, 's' - 'a': 25, 't' - 'a': 21},
+		18: {},
+		19: {'a' - 'a': 20},
+		20: {},
+		21: {'n' - 'a': 22},
+		22: {'e' - 'a': 23},
+		23: {},
+		24: {},
+		25: {'u' - 'a': 26},
+		26: {'o' - 'a': 27},
+		27: {},
+		28: {'o' - 'a': 29},
+		29: {'i' - 'a': 30},
+		30: {'t' - 'a': 31},
+		31: {'a' - 'a': 32},
+		32: {'z' - 'a': 33},
+		33: {'i' - 'a': 34},
+		34: {},
+		35: {'t' - 'a': 36},
+		36: {'a' - 'a': 37},
+		37: {},
+		38: {'s' - 'a': 39},
+		39: {'i' - 'a': 40},
+		40: {'l' - 'a': 41},
+		41: {'a' - 'a': 42},
+		42: {},
+		43: {'s' - 'a': 44},
+		44: {'e' - 'a': 45},
+		45: {'n' - 'a': 46},
+		46: {'e' - 'a': 47, 'l' - 'a': 50, 's' - 'a': 53},
+		47: {'v' - 'a': 48},
+		48: {'i' - 'a': 49},
+		49: {},
+		50: {'u' - 'a': 51},
+		51: {'f' - 'a': 52},
+		52: {},
+		53: {'u' - 'a': 54},
+		54: {'o' - 'a': 55},
+		55: {},
+		56: {'i' - 'a': 57},
+		57: {'l' - 'a': 58, 'v' - 'a': 60},
+		58: {'a' - 'a': 59, 'i' - 'a': 62},
+		59: {},
+		60: {'i' - 'a': 61},
+		61: {},
+		62: {'b' - 'a': 63},
+		63: {},
+		64: {'o' - 'a': 65},
+		65: {'l' - 'a': 66},
+		66: {},
+	},
+	accept: []uint8{0, 0, 0, 0, 0, 0, 2, 1, 0, 0, 0, 3, 4, 0, 0, 0, 5, 0, 6, 0, 7, 0, 0, 8, 9, 0, 0, 10, 0, 0, 0, 0, 12, 0, 11, 0, 0, 13, 0, 0, 0, 0, 14, 0, 0, 0, 0, 0, 0, 15, 0, 0, 16, 0, 0, 17, 0, 0, 0, 18, 0, 19, 0, 20, 0, 0, 21},
+	rules: []suffixRule{
+		{7, []byte("ate")},  // (m>0) ational -> ate
+		{6, []byte("tion")}, // (m>0) tional -> tion
+		{4, []byte("ence")}, // (m>0) enci -> ence
+		{4, []byte("ance")}, // (m>0) anci -> ance
+		{4, []byte("ize")},  // (m>0) izer -> ize
+		{3, []byte("ble")},  // (m>0) bli -> ble
+		{4, []byte("al")},   // (m>0) alli -> al
+		{5, []byte("ent")},  // (m>0) entli -> ent
+		{3, []byte("e")},    // (m>0) eli -> e
+		{5, []byte("ous")},  // (m>0) ousli -> ous
+		{7, []byte("ize")},  // (m>0) ization -> ize
+		{5, []byte("ate")},  // (m>0) ation -> ate
+		{4, []byte("ate")},  // (m>0) ator -> ate
+		{5, []byte("al")},   // (m>0) alism -> al
+		{7, []byte("ive")},  // (m>0) iveness -> ive
+		{7, []byte("ful")},  // (m>0) fulness -> ful
+		{7, []byte("ous")},  // (m>0) ousness -> ous
+		{5, []byte("al")},   // (m>0) aliti -> al
+		{5, []byte("ive")},  // (m>0) iviti -> ive
+		{6, []byte("ble")},  // (m>0) biliti -> ble
+		{4, []byte("log")},  // (m>0) logi -> log
+	},
+}
+
+// step3Suffixes finds the longest of the suffixes of step 3:
+// icate, ative, alize, iciti, ical, ful, ness.
+var step3Suffixes = suffixTrie{
+	next: [][26]uint8{
+		0:  {'e' - 'a': 1, 'i' - 'a': 14, 'l' - 'a': 19, 's' - 'a': 25},
+		1:  {'t' - 'a': 2, 'v' - 'a': 6, 'z' - 'a': 10},
+		2:  {'a' - 'a': 3},
+		3:  {'c' - 'a': 4},
+		4:  {'i' - 'a': 5},
+		5:  {},
+		6:  {'i' - 'a': 7},
+		7:  {'t' - 'a': 8},
+		8:  {'a' - 'a': 9},
+		9:  {},
+		10: {'i' - 'a': 11},
+		11: {'l' - 'a': 12},
+		12: {'a' - 'a': 13},
+		13: {},
+		14: {'t' - 'a': 15},
+		15: {'i' - 'a': 16},
+		16: {'c' - 'a': 17},
+		17: {'i' - 'a': 18},
+		18: {},
+		19: {'a' - 'a': 20, 'u' - 'a': 23},
+		20: {'c' - 'a': 21},
+		21: {'i' - 'a': 22},
+		22: {},
+		23: {'f' - 'a': 24},
+		24: {},
+		25: {'s' - 'a': 26},
+		26: {'e' - 'a': 27},
+		27: {'n' - 'a': 28},
+		28: {},
+	},
+	accept: []uint8{0, 0, 0, 0, 0, 1, 0, 0, 0, 2, 0, 0, 0, 3, 0, 0, 0, 0, 4, 0, 0, 0, 5, 0, 6, 0, 0, 0, 7},
+	rules: []suffixRule{
+		{5, []byte("ic")}, // (m>0) icate -> ic
+		{5, []byte("")},   // (m>0) ative ->
+		{5, []byte("al")}, // (m>0) alize -> al
+		{5, []byte("ic")}, // (m>0) iciti -> ic
+		{4, []byte("ic")}, // (m>0) ical -> ic
+		{3, []byte("")},   // (m>0) ful ->
+		{4, []byte("")},   // (m>0) ness ->
+	},
+}
+
+// step4Suffixes finds the longest of the suffixes of step 4:
+// al, ance, ence, er, ic, able, ible, ant, ement, ment, ent, ion, ou,
+// ionou, ism, ate, iti, ous, ive, ize.
+var step4Suffixes = suffixTrie{
+	next: [][26]uint8{
+		0:  {'c' - 'a': 10, 'e' - 'a': 3, 'i' - 'a': 35, 'l' - 'a': 1, 'm' - 'a': 30, 'n' - 'a': 22, 'r' - 'a': 8, 's' - 'a': 38, 't' - 'a': 16, 'u' - 'a': 25},
+		1:  {'a' - 'a': 2},
+		2:  {},
+		3:  {'c' - 'a': 4, 'l' - 'a': 12, 't' - 'a': 33, 'v' - 'a': 41, 'z' - 'a': 43},
+		4:  {'n' - 'a': 5},
+		5:  {'a' - 'a': 6, 'e' - 'a': 7},
+		6:  {},
+		7:  {},
+		8:  {'e' - 'a': 9},
+		9:  {},
+		10: {'i' - 'a': 11},
+		11: {},
+		12: {'b' - 'a': 13},
+		13: {'a' - 'a': 14, 'i' - 'a': 15},
+		14: {},
+		15: {},
+		16: {'n' - 'a': 17},
+		17: {'a' - 'a': 18, 'e' - 'a': 19},
+		18: {},
+		19: {'m' - 'a': 20},
+		20: {'e' - 'a': 21},
+		21: {},
+		22: {'o' - 'a': 23},
+		23: {'i' - 'a': 24},
+		24: {},
+		25: {'o' - 'a': 26},
+		26: {'n' - 'a': 27},
+		27: {'o' - 'a': 28},
+		28: {'i' - 'a': 29},
+		29: {},
+		30: {'s' - 'a': 31},
+		31: {'i' - 'a': 32},
+		32: {},
+		33: {'a' - 'a': 34},
+		34: {},
+		35: {'t' - 'a': 36},
+		36: {'i' - 'a': 37},
+		37: {},
+		38: {'u' - 'a': 39},
+		39: {'o' - 'a': 40},
+		40: {},
+		41: {'i' - 'a': 42},
+		42: {},
+		43: {'i' - 'a': 44},
+		44: {},
+	},
+	accept: []uint8{0, 0, 1, 0, 0, 0, 2, 3, 0, 4, 0, 5, 0, 0, 6, 7, 0, 0, 8, 11, 10, 9, 0, 0, 12, 0, 13, 0, 0, 14, 0, 0, 16, 0, 17, 0, 0, 18, 0, 0, 19, 0, 20, 0, 21},
+	rules: []suffixRule{
+		{2, []byte("")},    // (m>1) al ->
+		{4, []byte("")},    // (m>1) ance ->
+		{4, []byte("")},    // (m>1) ence ->
+		{2, []byte("")},    // (m>1) er ->
+		{2, []byte("")},    // (m>1) ic ->
+		{4, []byte("")},    // (m>1) able ->
+		{4, []byte("")},    // (m>1) ible ->
+		{3, []byte("")},    // (m>1) ant ->
+		{5, []byte("")},    // (m>1) ement ->
+		{4, []byte("")},    // (m>1) ment ->
+		{3, []byte("")},    // (m>1) ent ->
+		{3, []byte("")},    // (m>1 and (*S or *T)) ion ->
+		{2, []byte("")},    // (m>1) ou ->
+		{5, []byte("")},    // (m>1 and (*S or *T)) ionou ->
+		{5, []byte("ion")}, // (m>0) ionou -> ion
+		{3, []byte("")},    // (m>1) ism ->
+		{3, []byte("")},    // (m>1) ate ->
+		{3, []byte("")},    // (m>1) iti ->
+		{3, []byte("")},    // (m>1) ous ->
+		{3, []byte("")},    // (m>1) ive ->
+		{3, []byte("")},    // (m>1) ize ->
+	},
+}
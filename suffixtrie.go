@@ -0,0 +1,40 @@
+package porter
+
+// suffixTrie is a DFA that reads a word backwards from its last letter and
+// accepts the suffixes of a step, so that the longest suffix of the step a
+// word ends with is found in one walk, instead of trying the suffixes one
+// after another with z.ends(). The tries are generated from porter.rules
+// by suffixgen into suffix_tries.go.
+type suffixTrie struct {
+	next   [][26]uint8 // next[s][c-'a'] is the state after reading c in state s; 0 ends the walk
+	accept []uint8     // accept[s] is 1 + the index in rules of the suffix read in state s, or 0
+	rules  []suffixRule
+}
+
+// suffixRule is a suffix accepted by a suffixTrie and its replacement.
+type suffixRule struct {
+	n    int    // the length of the suffix
+	repl []byte // the replacement
+}
+
+// z.longest(t) returns the rule for the longest suffix of t that 0,...k
+// ends with, leaving at least one letter, or nil if there is none. Like
+// z.ends(), it sets j to the start of the suffix.
+func (z *stemmer) longest(t *suffixTrie) *suffixRule {
+	var rule *suffixRule
+	state := 0
+	for i := z.k; i > 0; i-- {
+		c := z.b[i] - 'a'
+		if c >= 26 {
+			break
+		}
+		if state = int(t.next[state][c]); state == 0 {
+			break
+		}
+		if a := t.accept[state]; a > 0 {
+			rule = &t.rules[a-1]
+			z.j = i - 1
+		}
+	}
+	return rule
+}
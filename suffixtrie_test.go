@@ -0,0 +1,162 @@
+package porter
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+)
+
+// stepFuncs are the steps 2 to 4 with suffix tries and with z.ends().
+var stepFuncs = []struct {
+	name       string
+	tries      *suffixTrie
+	trie, ends func(z *stemmer)
+}{
+	{"2", &step2Suffixes, (*stemmer).step2, (*stemmer).step2Ends},
+	{"3", &step3Suffixes, (*stemmer).step3, (*stemmer).step3Ends},
+	{"4", &step4Suffixes, (*stemmer).step4, (*stemmer).step4Ends},
+}
+
+// suffixWords returns made-up words of a few stems followed by up to two
+// suffixes of the steps 1 to 4, to test the steps on more combinations of
+// suffixes than the vocabulary has, such as "generationous".
+func suffixWords() []string {
+	stems := []string{"x", "ra", "bat", "quest", "opin", "gener", "sens", "fptis", "controll", "hopp"}
+	var suffixes []string
+	for _, step := range Rules() {
+		for _, r := range step.Rules {
+			if r.Suffix != "" && !slices.Contains(suffixes, r.Suffix) {
+				suffixes = append(suffixes, r.Suffix)
+			}
+		}
+	}
+	var words []string
+	for _, stem := range stems {
+		words = append(words, stem)
+		for _, s1 := range suffixes {
+			words = append(words, stem+s1)
+			for _, s2 := range suffixes {
+				words = append(words, stem+s1+s2)
+			}
+		}
+	}
+	return words
+}
+
+func TestSuffixTries(t *testing.T) {
+	words := suffixWords()
+	for _, test := range tests {
+		words = append(words, test.in)
+	}
+	for _, word := range words {
+		if len(word) < 3 {
+			continue
+		}
+		var z stemmer
+		z.reset([]byte(word))
+		z.step1ab()
+		z.step1c()
+		for _, step := range stepFuncs {
+			ends := stemmer{b: bytes.Clone(z.b), k: z.k}
+			step.trie(&z)
+			step.ends(&ends)
+			if want, have := string(ends.b[:ends.k+1]), string(z.b[:z.k+1]); want != have {
+				t.Errorf("'%s' step %s want '%s' have '%s'\n", word, step.name, want, have)
+			}
+		}
+	}
+}
+
+// TestSuffixTriesInSync checks that suffix_tries.go is up to date with
+// porter.rules.
+func TestSuffixTriesInSync(t *testing.T) {
+	for _, step := range stepFuncs {
+		var rules []Rule
+		for _, s := range Rules() {
+			if s.Name == step.name {
+				rules = s.Rules
+			}
+		}
+		if len(rules) != len(step.tries.rules) {
+			t.Errorf("step %s want %d rules have %d, run go generate\n", step.name, len(rules), len(step.tries.rules))
+			continue
+		}
+		for i, r := range rules {
+			if slices.ContainsFunc(rules[:i], func(earlier Rule) bool { return earlier.Suffix == r.Suffix }) {
+				continue // the trie finds the first rule with a suffix
+			}
+			z := stemmer{b: []byte("xy" + r.Suffix)}
+			z.k = len(z.b) - 1
+			rule := z.longest(step.tries)
+			if rule == nil || rule.n != len(r.Suffix) || string(rule.repl) != r.Replacement {
+				t.Errorf("step %s '%s' want '%s' have %v, run go generate\n", step.name, r.Suffix, r.Replacement, rule)
+			}
+		}
+	}
+}
+
+func TestLongest(t *testing.T) {
+	var longestTests = []struct {
+		word   string
+		suffix string
+	}{
+		{"relational", "ational"},
+		{"conditional", "tional"},
+		{"ational", "tional"}, // the stem cannot be empty
+		{"tional", ""},
+		{"organization", "ization"},
+		{"rational", "ational"},
+		{"national", "ational"},
+		{"Rational", "ational"},
+		{"ra-tional", "tional"},
+		{"xyz", ""},
+		{"", ""},
+	}
+	for _, test := range longestTests {
+		z := stemmer{b: []byte(test.word), k: len(test.word) - 1}
+		suffix := ""
+		if rule := z.longest(&step2Suffixes); rule != nil {
+			suffix = test.word[z.j+1:]
+		}
+		if suffix != test.suffix {
+			t.Errorf("'%s' want '%s' have '%s'\n", test.word, test.suffix, suffix)
+		}
+	}
+}
+
+// benchmarkStep runs step on the words of the test vocabulary as they are
+// before it.
+func benchmarkStep(b *testing.B, step int, trie bool) {
+	var words [][]byte
+	for _, test := range tests {
+		if len(test.in) < 3 {
+			continue
+		}
+		var z stemmer
+		z.reset([]byte(test.in))
+		z.step1ab()
+		z.step1c()
+		for _, s := range stepFuncs[:step] {
+			s.trie(&z)
+		}
+		words = append(words, z.b[:z.k+1])
+	}
+	f := stepFuncs[step].ends
+	if trie {
+		f = stepFuncs[step].trie
+	}
+	buf := make([]byte, 64)
+	var z stemmer
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		z.reset(buf[:copy(buf, words[i%len(words)])])
+		f(&z)
+	}
+}
+
+func BenchmarkStep2(b *testing.B)     { benchmarkStep(b, 0, true) }
+func BenchmarkStep2Ends(b *testing.B) { benchmarkStep(b, 0, false) }
+func BenchmarkStep3(b *testing.B)     { benchmarkStep(b, 1, true) }
+func BenchmarkStep3Ends(b *testing.B) { benchmarkStep(b, 1, false) }
+func BenchmarkStep4(b *testing.B)     { benchmarkStep(b, 2, true) }
+func BenchmarkStep4Ends(b *testing.B) { benchmarkStep(b, 2, false) }
@@ -0,0 +1,113 @@
+package porter
+
+import "encoding/binary"
+
+// This file lowercases and checks words a machine word at a time: the eight
+// bytes of a uint64 are treated as eight lanes, and adding a constant to all
+// of them at once sets the high bit of the lanes that are at least some
+// letter. Bytes with the high bit set are never ASCII, so they are masked
+// off first and no addition carries into the next lane.
+
+const (
+	swarOnes = 0x0101010101010101
+	swarHigh = 0x8080808080808080
+)
+
+// swarLower lowercases the ASCII letters of the eight bytes in x and returns
+// the result with a mask that has the high bit set in the lanes that held an
+// ASCII letter.
+func swarLower(x uint64) (lower, letters uint64) {
+	h := x &^ swarHigh
+	ascii := ^x & swarHigh
+	upper := ((h + swarOnes*(0x80-'A')) &^ (h + swarOnes*(0x80-'Z'-1))) & ascii
+	small := ((h + swarOnes*(0x80-'a')) &^ (h + swarOnes*(0x80-'z'-1))) & ascii
+	return x | upper>>2, upper | small
+}
+
+// lowerLetters lowercases the ASCII letters in b in place, eight bytes at a
+// time, and reports whether b has only ASCII letters. The last bytes of a
+// word of eight bytes or more are done as the eight bytes it ends with, some
+// of them for the second time, and a word of four to seven bytes as its
+// first four and last four bytes side by side in one uint64. Shorter words
+// are done a byte at a time.
+func lowerLetters(b []byte) bool {
+	n := len(b)
+	switch {
+	case n < 4:
+		letters := true
+		for i := 0; i < n; i++ {
+			// setting the 0x20 bit lowercases letters and only letters
+			if c := b[i] | 0x20; c < 'a' || c > 'z' {
+				letters = false
+			} else {
+				b[i] = c
+			}
+		}
+		return letters
+	case n < 8:
+		x := uint64(binary.LittleEndian.Uint32(b)) | uint64(binary.LittleEndian.Uint32(b[n-4:]))<<32
+		x, m := swarLower(x)
+		binary.LittleEndian.PutUint32(b[n-4:], uint32(x>>32))
+		binary.LittleEndian.PutUint32(b, uint32(x))
+		return m == swarHigh
+	}
+	letters := uint64(swarHigh)
+	for i := 0; ; i += 8 {
+		if i > n-8 {
+			i = n - 8
+		}
+		x, m := swarLower(binary.LittleEndian.Uint64(b[i:]))
+		binary.LittleEndian.PutUint64(b[i:], x)
+		letters &= m
+		if i == n-8 {
+			break
+		}
+	}
+	return letters == swarHigh
+}
+
+// InputPolicy says what StemBytesPolicy does with a word that has bytes
+// other than ASCII letters, like digits, punctuation or UTF-8.
+type InputPolicy int
+
+const (
+	StemAnyway  InputPolicy = iota // stem the word like StemBytes does
+	PassThrough                    // lowercase the word but do not stem it
+	RejectInput                    // return ErrInvalidInput
+)
+
+// StemBytesPolicy is StemBytes with a policy for words that are not made
+// of ASCII letters only, such as "b2b", "don't" or "café", which the Porter
+// algorithm was not written for: StemBytes treats any byte that is not a
+// vowel as a consonant. Checking the word costs nothing extra, as it is
+// done while lowercasing, eight bytes at a time.
+//
+// Example:
+//
+//	stemmed, err := porter.StemBytesPolicy([]byte("Running"), porter.RejectInput)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	// stemmed is []byte("run")
+//
+//	stemmed, _ = porter.StemBytesPolicy([]byte("MP3s"), porter.PassThrough)
+//	// stemmed is []byte("mp3s")
+func StemBytesPolicy(b []byte, p InputPolicy) ([]byte, error) {
+	if len(b) == 0 {
+		return b[:0], nil
+	}
+	if !lowerLetters(b) {
+		switch p {
+		case PassThrough:
+			return b, nil
+		case RejectInput:
+			return b[:0], ErrInvalidInput
+		}
+	}
+	var z stemmer
+	bn := z.stemFrequent(b)
+	if bn >= 0 && bn < len(b) {
+		return b[:bn+1], nil
+	}
+	return b[:0], ErrInvalidInput
+}
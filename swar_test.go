@@ -0,0 +1,164 @@
+package porter
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// lowerBytewise is the byte at a time version of lowerLetters.
+func lowerBytewise(b []byte) bool {
+	letters := true
+	for i := 0; i < len(b); i++ {
+		switch c := b[i]; {
+		case c >= 'A' && c <= 'Z':
+			b[i] += 'a' - 'A'
+		case c < 'a' || c > 'z':
+			letters = false
+		}
+	}
+	return letters
+}
+
+func TestLowerLetters(t *testing.T) {
+	// every byte value at every position of words of up to 17 bytes, which covers every path
+	for n := 1; n <= 17; n++ {
+		for pos := 0; pos < n; pos++ {
+			for c := 0; c < 256; c++ {
+				word := []byte(strings.Repeat("Ab", n)[:n])
+				word[pos] = byte(c)
+				want := append([]byte(nil), word...)
+				wantLetters := lowerBytewise(want)
+				have := append([]byte(nil), word...)
+				haveLetters := lowerLetters(have)
+				if string(have) != string(want) || haveLetters != wantLetters {
+					t.Fatalf("%q want %q %v have %q %v\n", word, want, wantLetters, have, haveLetters)
+				}
+			}
+		}
+	}
+}
+
+func TestLowerLettersSubslice(t *testing.T) {
+	// the tail must not touch the bytes after it
+	buf := []byte("HELLO WORLD")
+	if lowerLetters(buf[:5]) != true {
+		t.Errorf("'HELLO' want letters only\n")
+	}
+	if string(buf) != "hello WORLD" {
+		t.Errorf("want 'hello WORLD' have '%s'\n", buf)
+	}
+}
+
+var policyTests = []struct {
+	in     string
+	policy InputPolicy
+	out    string
+	err    error
+}{
+	{"", RejectInput, "", nil},
+	{"Running", StemAnyway, "run", nil},
+	{"Running", PassThrough, "run", nil},
+	{"Running", RejectInput, "run", nil},
+	{"GENERALIZATIONS", RejectInput, "gener", nil},
+	{"MP3s", StemAnyway, "mp3", nil},
+	{"MP3s", PassThrough, "mp3s", nil},
+	{"MP3s", RejectInput, "", ErrInvalidInput},
+	{"don't", PassThrough, "don't", nil},
+	{"Cafés", PassThrough, "cafés", nil},
+	{"Cafés", RejectInput, "", ErrInvalidInput},
+	{"relational-ly", PassThrough, "relational-ly", nil},
+}
+
+func TestStemBytesPolicy(t *testing.T) {
+	for _, test := range policyTests {
+		have, err := StemBytesPolicy([]byte(test.in), test.policy)
+		if err != test.err {
+			t.Errorf("'%s' (%d) want error %v have %v\n", test.in, test.policy, test.err, err)
+		}
+		if string(have) != test.out {
+			t.Errorf("'%s' (%d) want '%s' have '%s'\n", test.in, test.policy, test.out, have)
+		}
+	}
+	for _, test := range tests {
+		have, err := StemBytesPolicy([]byte(strings.ToUpper(test.in)), RejectInput)
+		if err != nil || string(have) != test.out {
+			t.Errorf("'%s' want '%s' have '%s' (%v)\n", test.in, test.out, have, err)
+		}
+	}
+}
+
+// tokenLengths are typical lengths of English tokens: most are short, and
+// few are longer than two machine words.
+var tokenLengths = []int{3, 5, 8, 12, 20}
+
+// benchmarkTokens returns 64 capitalized words of the vocabulary, cut or
+// repeated to n letters, laid out one after the other like the tokens of a
+// text in a read buffer.
+func benchmarkTokens(n int) []byte {
+	text := make([]byte, 0, 64*n)
+	for _, test := range tests[:64] {
+		word := strings.Repeat(strings.ToUpper(test.in[:1])+test.in[1:], n)
+		text = append(text, word[:n]...)
+	}
+	return text
+}
+
+// benchmarkLower runs lower on a token of each typical length per
+// iteration, refilling the buffer with capitalized tokens every 64.
+func benchmarkLower(b *testing.B, lower func([]byte) bool) {
+	for _, n := range tokenLengths {
+		text := benchmarkTokens(n)
+		buf := make([]byte, len(text))
+		b.Run(fmt.Sprintf("len=%d", n), func(b *testing.B) {
+			b.SetBytes(int64(n))
+			for i := 0; i < b.N; i++ {
+				j := i % 64
+				if j == 0 {
+					copy(buf, text)
+				}
+				lower(buf[j*n : (j+1)*n])
+			}
+		})
+	}
+}
+
+func BenchmarkLowerLetters(b *testing.B) {
+	benchmarkLower(b, lowerLetters)
+}
+
+func BenchmarkLowerBytewise(b *testing.B) {
+	benchmarkLower(b, lowerBytewise)
+}
+
+func BenchmarkStemBytesPolicy(b *testing.B) {
+	for _, n := range tokenLengths {
+		text := benchmarkTokens(n)
+		buf := make([]byte, len(text))
+		b.Run(fmt.Sprintf("len=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				j := i % 64
+				if j == 0 {
+					copy(buf, text)
+				}
+				StemBytesPolicy(buf[j*n:(j+1)*n], RejectInput)
+			}
+		})
+	}
+}
+
+func ExampleStemBytesPolicy() {
+	for _, word := range []string{"Connections", "MP3s", "café"} {
+		stemmed, err := StemBytesPolicy([]byte(word), PassThrough)
+		fmt.Println(string(stemmed), err)
+		_, err = StemBytesPolicy([]byte(word), RejectInput)
+		fmt.Println(err)
+	}
+	// Output:
+	// connect <nil>
+	// <nil>
+	// mp3s <nil>
+	// invalid input for stemming
+	// café <nil>
+	// invalid input for stemming
+}
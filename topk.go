@@ -0,0 +1,155 @@
+package porter
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// This file implements finding the most frequent stems of a stream that
+// has too many distinct stems to count them all, using the SpaceSaving
+// algorithm described in:
+//
+//	Metwally, Agrawal and El Abbadi, 2005, Efficient Computation of
+//	Frequent and Top-k Elements in Data Streams, Proceedings of ICDT '05,
+//	pp 398-412
+//
+// At most capacity stems are counted. When a new stem arrives and all
+// counters are taken, the stem with the lowest count is replaced by the new
+// one, which inherits the old count plus one. The old count is an upper
+// bound on how much the new stem's count is overestimated, and it can never
+// exceed total/capacity: capacity counters sum to total, so the smallest is
+// at most total/capacity.
+
+// StemCount is a stem with its estimated number of occurrences.
+type StemCount struct {
+	Stem  string
+	Count int64 // an upper bound of the number of occurrences
+	Error int64 // the most Count may be over: it is at least Count-Error
+}
+
+// TopK tracks the most frequent stems of an unbounded stream in constant
+// memory, with bounded error:
+//
+//   - Every count is an overestimate by at most Error, and Error is at most
+//     Total()/capacity.
+//   - Every stem occurring more than Total()/capacity times is tracked, so
+//     a stem that is missing from Top is less frequent than that.
+//   - While at most capacity distinct stems have been added, all counts are
+//     exact.
+//
+// A larger capacity gives smaller errors; tracking ten times as many stems
+// as are asked for from Top is a good start.
+//
+// A TopK must not be used by several goroutines at once.
+type TopK struct {
+	capacity int
+	total    int64
+	counts   topKHeap
+	index    map[string]int // stem -> position in counts
+}
+
+// NewTopK returns a TopK that tracks at most capacity stems. It panics if
+// capacity is not positive.
+//
+// Example:
+//
+//	top := porter.NewTopK(1000)
+//	for _, word := range words {
+//	    if err := top.AddWord(word); err != nil {
+//	        log.Fatal(err)
+//	    }
+//	}
+//	for _, c := range top.Top(10) {
+//	    fmt.Println(c.Count, c.Stem)
+//	}
+func NewTopK(capacity int) *TopK {
+	if capacity <= 0 {
+		panic("porter: NewTopK capacity must be positive")
+	}
+	t := &TopK{capacity: capacity, index: make(map[string]int, capacity)}
+	t.counts.index = t.index
+	return t
+}
+
+// Add counts an occurrence of stem.
+func (t *TopK) Add(stem string) {
+	t.total++
+	if i, ok := t.index[stem]; ok {
+		t.counts.items[i].Count++
+		heap.Fix(&t.counts, i)
+		return
+	}
+	if len(t.counts.items) < t.capacity {
+		heap.Push(&t.counts, StemCount{Stem: stem, Count: 1})
+		return
+	}
+	// replace the least frequent stem
+	min := t.counts.items[0]
+	delete(t.index, min.Stem)
+	t.counts.items[0] = StemCount{Stem: stem, Count: min.Count + 1, Error: min.Count}
+	t.index[stem] = 0
+	heap.Fix(&t.counts, 0)
+}
+
+// AddWord stems word with Stem and counts an occurrence of the stem. Empty
+// words are ignored.
+func (t *TopK) AddWord(word string) error {
+	stem, err := Stem(word)
+	if err != nil || stem == "" {
+		return err
+	}
+	t.Add(stem)
+	return nil
+}
+
+// Total returns the number of stems added.
+func (t *TopK) Total() int64 {
+	return t.total
+}
+
+// Top returns the n stems with the highest counts, most frequent first;
+// ties are ordered by stem. If n is not positive, all tracked stems are
+// returned.
+func (t *TopK) Top(n int) []StemCount {
+	top := make([]StemCount, len(t.counts.items))
+	copy(top, t.counts.items)
+	sort.Slice(top, func(i, j int) bool {
+		if top[i].Count != top[j].Count {
+			return top[i].Count > top[j].Count
+		}
+		return top[i].Stem < top[j].Stem
+	})
+	if n > 0 && n < len(top) {
+		top = top[:n]
+	}
+	return top
+}
+
+// topKHeap is a min-heap of counts that keeps index up to date.
+type topKHeap struct {
+	items []StemCount
+	index map[string]int
+}
+
+func (h *topKHeap) Len() int { return len(h.items) }
+
+func (h *topKHeap) Less(i, j int) bool { return h.items[i].Count < h.items[j].Count }
+
+func (h *topKHeap) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.index[h.items[i].Stem] = i
+	h.index[h.items[j].Stem] = j
+}
+
+func (h *topKHeap) Push(x any) {
+	c := x.(StemCount)
+	h.index[c.Stem] = len(h.items)
+	h.items = append(h.items, c)
+}
+
+func (h *topKHeap) Pop() any {
+	c := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	delete(h.index, c.Stem)
+	return c
+}
@@ -0,0 +1,91 @@
+package porter
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestTopKExact(t *testing.T) {
+	top := NewTopK(10)
+	for _, w := range []string{"Running", "runs", "cats", "run", "cat", "dog", ""} {
+		if err := top.AddWord(w); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := []StemCount{{"run", 3, 0}, {"cat", 2, 0}, {"dog", 1, 0}}
+	if have := top.Top(0); fmt.Sprint(have) != fmt.Sprint(want) {
+		t.Errorf("want: %v have: %v", want, have)
+	}
+	if have := top.Top(1); len(have) != 1 || have[0] != want[0] {
+		t.Errorf("want: %v have: %v", want[:1], have)
+	}
+	if top.Total() != 6 {
+		t.Errorf("want total 6 have %d", top.Total())
+	}
+}
+
+// TestTopKErrorBounds checks the guarantees documented on TopK against
+// exact counts of a skewed stream with far more distinct stems than
+// counters.
+func TestTopKErrorBounds(t *testing.T) {
+	const capacity = 100
+	rng := rand.New(rand.NewSource(1))
+	zipf := rand.NewZipf(rng, 1.2, 1, 5000)
+	top := NewTopK(capacity)
+	exact := map[string]int64{}
+	for i := 0; i < 100000; i++ {
+		stem := fmt.Sprintf("s%d", zipf.Uint64())
+		top.Add(stem)
+		exact[stem]++
+	}
+
+	bound := top.Total() / capacity
+	tracked := map[string]bool{}
+	for _, c := range top.Top(0) {
+		tracked[c.Stem] = true
+		if c.Error > bound {
+			t.Errorf("%s: error %d above total/capacity %d", c.Stem, c.Error, bound)
+		}
+		if n := exact[c.Stem]; n > c.Count || n < c.Count-c.Error {
+			t.Errorf("%s: count %d not in [%d, %d]", c.Stem, n, c.Count-c.Error, c.Count)
+		}
+	}
+	for stem, n := range exact {
+		if n > bound && !tracked[stem] {
+			t.Errorf("%s: %d occurrences above total/capacity %d but not tracked", stem, n, bound)
+		}
+	}
+	// the most frequent stems of a skewed stream come out in order
+	for i, c := range top.Top(5) {
+		if want := fmt.Sprintf("s%d", i); c.Stem != want {
+			t.Errorf("rank %d: want '%s' have '%s'\n", i, want, c.Stem)
+		}
+	}
+}
+
+func BenchmarkTopKAdd(b *testing.B) {
+	stems := make([]string, 1024)
+	zipf := rand.NewZipf(rand.New(rand.NewSource(1)), 1.2, 1, 1<<20)
+	for i := range stems {
+		stems[i] = fmt.Sprintf("s%d", zipf.Uint64())
+	}
+	top := NewTopK(1000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		top.Add(stems[i%len(stems)])
+	}
+}
+
+func ExampleTopK() {
+	top := NewTopK(100)
+	for _, w := range []string{"connect", "connected", "connection", "runs", "running"} {
+		top.AddWord(w)
+	}
+	for _, c := range top.Top(2) {
+		fmt.Println(c.Count, c.Stem)
+	}
+	// Output:
+	// 3 connect
+	// 2 run
+}
@@ -0,0 +1,54 @@
+package porter
+
+import "strings"
+
+// StemTrace stems the given word like Stem and calls fired, if not nil,
+// for each rule of the algorithm that is applied, in order. step is the
+// step the rule belongs to, one of "step1ab", "step1c", "step2", "step3",
+// "step4" and "step5", and rule the suffix replaced and its replacement,
+// e.g. "ational -> ate", "ing ->" or "-> e" for the 'e' that step1ab adds
+// to stems like "hop".
+//
+// Example:
+//
+//	stemmed, err := porter.StemTrace("relational", func(step, rule string) {
+//	    fmt.Println(step, rule)
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	// prints "step2 ational -> ate" and "step5 e ->",
+//	// stemmed is "relat"
+func StemTrace(word string, fired func(step, rule string)) (string, error) {
+	if word == "" {
+		return "", nil
+	}
+	var z stemmer
+	step := ""
+	if fired != nil {
+		z.trace = func(rule string) { fired(step, rule) }
+	}
+	z.reset([]byte(strings.ToLower(word)))
+
+	if z.k > 1 {
+		steps := []struct {
+			name string
+			f    func()
+		}{
+			{"step1ab", z.step1ab},
+			{"step1c", z.step1c},
+			{"step2", z.step2},
+			{"step3", z.step3},
+			{"step4", z.step4},
+			{"step5", z.step5},
+		}
+		for _, s := range steps {
+			step = s.name
+			s.f()
+		}
+	}
+	if z.k >= 0 && z.k < len(z.b) {
+		return string(z.b[:z.k+1]), nil
+	}
+	return "", ErrInvalidInput
+}
@@ -0,0 +1,92 @@
+package porter
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestStemTrace(t *testing.T) {
+	var test = []struct {
+		word  string
+		rules string
+	}{
+		{"caresses", "step1ab sses -> ss"},
+		{"ponies", "step1ab ies -> i"},
+		{"agreed", "step1ab eed -> ee, step5 e ->"},
+		{"hopping", "step1ab ing ->, step1ab pp -> p"},
+		{"hoped", "step1ab ed ->, step1ab -> e"},
+		{"sized", "step1ab ed ->, step1ab iz -> ize"},
+		{"conflated", "step1ab ed ->, step1ab at -> ate, step5 e ->"},
+		{"happy", "step1c y -> i"},
+		{"generalizations", "step1ab s ->, step2 ization -> ize, step3 alize -> al, step4 al ->"},
+		{"controll", "step5 ll -> l"},
+		{"generationous", "step1ab s ->, step4 ionou ->"},
+		{"fptisizationou", "step4 ionou ->"},
+		{"mationou", "step4 ionou -> ion"},
+		{"questionou", "step4 ionou -> ion"},
+		{"opinionou", "step4 ionou -> ion"},
+		{"rate", ""},
+	}
+
+	for _, tc := range test {
+		var rules []string
+		stemmed, err := StemTrace(tc.word, func(step, rule string) {
+			rules = append(rules, step+" "+rule)
+		})
+		if err != nil {
+			t.Errorf("'%s': %v", tc.word, err)
+		}
+		if have := strings.Join(rules, ", "); have != tc.rules {
+			t.Errorf("'%s' want '%s' have '%s'\n", tc.word, tc.rules, have)
+		}
+		if want, _ := Stem(tc.word); stemmed != want {
+			t.Errorf("'%s' want '%s' have '%s'\n", tc.word, want, stemmed)
+		}
+	}
+}
+
+func TestStemTraceVocabulary(t *testing.T) {
+	for _, tc := range tests {
+		have, err := StemTrace(tc.in, nil)
+		if err != nil {
+			t.Errorf("'%s': %v", tc.in, err)
+		}
+		if have != tc.out {
+			t.Errorf("'%s' want '%s' have '%s'\n", tc.in, tc.out, have)
+		}
+	}
+}
+
+// A double l, s or z left by step1ab is kept, so no rule fires for it.
+func TestStemTraceDoubleLSZ(t *testing.T) {
+	var test = []struct {
+		word  string
+		rules string
+	}{
+		{"falling", "step1ab ing ->"},
+		{"hissing", "step1ab ing ->"},
+		{"fizzed", "step1ab ed ->"},
+	}
+
+	for _, tc := range test {
+		var rules []string
+		StemTrace(tc.word, func(step, rule string) {
+			rules = append(rules, step+" "+rule)
+		})
+		if have := strings.Join(rules, ", "); have != tc.rules {
+			t.Errorf("'%s' want '%s' have '%s'\n", tc.word, tc.rules, have)
+		}
+	}
+}
+
+func ExampleStemTrace() {
+	stemmed, _ := StemTrace("relational", func(step, rule string) {
+		fmt.Println(step, rule)
+	})
+	fmt.Println(stemmed)
+	// Output:
+	// step2 ational -> ate
+	// step5 e ->
+	// relat
+}